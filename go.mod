@@ -13,6 +13,7 @@ require (
 require (
 	github.com/ebitengine/gomobile v0.0.0-20260211053922-3d992dae95d1 // indirect
 	github.com/ebitengine/hideconsole v1.0.0 // indirect
+	github.com/ebitengine/oto/v3 v3.4.0 // indirect
 	github.com/ebitengine/purego v0.10.1 // indirect
 	github.com/go-text/typesetting v0.3.4 // indirect
 	github.com/jezek/xgb v1.3.1 // indirect
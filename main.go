@@ -1,16 +1,23 @@
 package main
 
 import (
+	"errors"
 	"flag"
+	"fmt"
 	"log"
 	"math/rand"
 	"os"
+	"runtime"
+	"runtime/debug"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/leonelquinteros/gotext"
 
 	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/debuglog"
 	"darkstation/pkg/game/devtools"
 	"darkstation/pkg/game/gamemode"
 	"darkstation/pkg/game/gameplay"
@@ -27,6 +34,9 @@ var (
 	date    = "unknown"
 )
 
+// activeGame is the run being played, read when the window closes to log its final state.
+var activeGame atomic.Pointer[state.Game]
+
 func initGettext() {
 	// Load embedded .mo file directly
 	mo := gotext.NewMo()
@@ -42,9 +52,23 @@ func initGettext() {
 
 func main() {
 	startLevel := flag.Int("level", 1, "starting level/deck number (for developer testing)")
-	gameMode := flag.String("gamemode", string(gamemode.SinglePlayerPuzzle), "game mode ID (SinglePlayerPuzzle, SingleDeckSandbox, FindTheBatteries)")
+	gameMode := flag.String("gamemode", "", "preselect the game mode ID (SinglePlayerPuzzle, SingleDeckSandbox, FindTheBatteries); defaults to the Settings choice")
+	give := flag.String("give", "", "comma-separated items to start with, e.g. Map,Battery,Battery (testing and accessibility)")
+	creative := flag.Bool("creative", false, "start new runs in creative mode: generators powered, doors open, hazards passable, map revealed (not recorded)")
+	logPath := flag.String("log", "", "write a structured debug log to this file for bug reports, e.g. debug.log")
+	difficultyName := flag.String("difficulty", "", "preselect the new-game difficulty: Easy, Normal or Hard; defaults to the Settings choice")
+	deckPath := flag.String("deck", "", "play a hand-authored deck layout JSON file (see console exportdeck) instead of a generated run")
 	flag.Parse()
 
+	if *logPath != "" {
+		if err := debuglog.Open(*logPath); err != nil {
+			log.Printf("Could not open -log file: %v", err)
+			os.Exit(2)
+		}
+		defer debuglog.Close()
+		defer reportCrash()
+	}
+
 	// Check for LEVEL environment variable (takes precedence over flag)
 	if envLevel := os.Getenv("LEVEL"); envLevel != "" {
 		if parsedLevel, err := strconv.Atoi(envLevel); err == nil && parsedLevel > 0 {
@@ -54,13 +78,52 @@ func main() {
 	if envMode := os.Getenv("GAMEMODE"); envMode != "" {
 		*gameMode = envMode
 	}
+	if envGive := os.Getenv("GIVE"); envGive != "" {
+		*give = envGive
+	}
+	startingItems, err := gameplay.ParseStartingItems(*give)
+	if err != nil {
+		log.Printf("Invalid -give: %v", err)
+		os.Exit(2)
+	}
+	if _, err := gamemode.ParseDifficulty(*difficultyName); err != nil {
+		log.Printf("Invalid -difficulty: %v", err)
+		os.Exit(2)
+	}
+
+	// A -deck file is checked before the window opens so a bad layout fails fast.
+	var deckGame *state.Game
+	if *deckPath != "" {
+		deckGame = state.NewGame()
+		if err := devtools.LoadDeckFile(deckGame, *deckPath); err != nil {
+			log.Printf("Invalid -deck: %v", err)
+			os.Exit(2)
+		}
+	}
 
 	initGettext()
 	rand.Seed(time.Now().UnixNano())
+	if path, err := config.AutosavePath(); err == nil {
+		gameplay.EnableAutosave(path)
+	}
+	if err := gamemenu.LoadActiveBindingProfile(); err != nil {
+		log.Printf("Could not load binding profile: %v", err)
+	}
 
 	// Set version information for renderers
 	renderer.SetVersion(version, commit, date)
 	log.Printf("Starting TheDarkCastle (built %s, commit: %s)", renderer.BuildLabel, commit)
+	debuglog.Info("session.start", "version", version, "commit", commit, "date", date,
+		"os", runtime.GOOS, "arch", runtime.GOARCH, "level", *startLevel, "gamemode", *gameMode, "creative", *creative)
+
+	// Ebiten is the only renderer, so without a display there is nothing to fall back to;
+	// say so plainly rather than failing deep inside the window setup.
+	if reason := headlessReason(); reason != "" {
+		log.Printf("Cannot open the game window: %s. The Dark Station needs a graphical desktop session.", reason)
+		debuglog.Error("window.headless", "reason", reason)
+		debuglog.Close()
+		os.Exit(1)
+	}
 
 	// Initialize the Ebiten renderer
 	ebitRenderer := ebitenRenderer.New()
@@ -81,32 +144,28 @@ func main() {
 	// Run a single game loop that handles both menu and game
 	if err := ebitRenderer.RunWithGameLoop(func() {
 		for {
-			// Run the main menu (this blocks until user makes a selection)
-			menuAction, perfMapScenario, selectedMode := runMainMenuInLoop(gamemode.ID(*gameMode))
-
-			// Build the game based on menu selection
 			var g *state.Game
-			switch menuAction {
-			case gamemenu.MainMenuActionGenerate:
-				g = gameplay.BuildGameWithMode(*startLevel, selectedMode)
-			case gamemenu.MainMenuActionPerfMap:
-				g = state.NewGame()
-				devtools.SwitchToPerfMap(g, perfMapScenario)
-			case gamemenu.MainMenuActionQuit:
-				// Quit (should have been handled in RunMainMenu, but just in case)
-				os.Exit(0)
-			default:
-				g = gameplay.BuildGameWithMode(*startLevel, selectedMode)
+			if deckGame != nil {
+				// -deck skips the title menu once; quitting to title returns to normal play.
+				g, deckGame = deckGame, nil
+				gameplay.UpdateLightingExploration(g)
+			} else {
+				g = buildGameFromMenu(*startLevel, gamemode.ID(*gameMode), *difficultyName, startingItems, *creative)
 			}
 
 			// Reset QuitToTitle flag
 			g.QuitToTitle = false
+			activeGame.Store(g)
+			gameplay.LogRunState(g, "run.start")
 
 			// Now run the actual game loop
 			for {
-				mainLoop(g)
+				runTick(g)
 				// Check if we should quit to title
 				if g.QuitToTitle {
+					gameplay.LogRunState(g, "run.end")
+					renderer.SetAmbientHum(false)
+					activeGame.Store(nil)
 					g.ResetAllProgress()
 					break
 				}
@@ -117,23 +176,114 @@ func main() {
 		}
 	}); err != nil {
 		log.Printf("Failed to open main window: %v", err)
+		debuglog.Error("window.failed", "err", err)
+		debuglog.Close()
 		os.Exit(1)
 	}
+	gameplay.LogRunState(activeGame.Load(), "window.closed")
+}
+
+// headlessReason returns why no game window can open here, or "" when a display looks
+// available. Only X11/Wayland platforms are checked; the others always have a window system.
+func headlessReason() string {
+	switch runtime.GOOS {
+	case "linux", "freebsd", "openbsd", "netbsd", "dragonfly":
+	default:
+		return ""
+	}
+	if os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == "" {
+		return "no display found (DISPLAY and WAYLAND_DISPLAY are unset)"
+	}
+	return ""
+}
+
+// runTick runs one mainLoop tick. A panic is logged and shown on screen, and the run
+// returns to the title menu instead of taking the whole game down.
+func runTick(g *state.Game) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[Recover] game tick: %v\n%s", r, debug.Stack())
+			debuglog.Recovered("game tick", r)
+			renderer.ReportFault("game tick", fmt.Sprint(r))
+			g.QuitToTitle = true
+		}
+	}()
+	mainLoop(g)
+}
+
+// reportCrash writes a recovered panic and the run's seeds to the debug log, then
+// re-panics so the process still dies with the usual trace. Deferred directly.
+func reportCrash() {
+	if r := recover(); r != nil {
+		debuglog.Crash(r)
+		debuglog.Close()
+		panic(r)
+	}
+}
+
+// buildGameFromMenu runs the title menu and builds the run it asks for.
+func buildGameFromMenu(startLevel int, flagMode gamemode.ID, flagDifficulty string, startingItems []string, creative bool) *state.Game {
+	// Run the main menu (this blocks until user makes a selection)
+	menuAction, perfMapScenario, selectedMode, runOpts := runMainMenuInLoop(flagMode, flagDifficulty)
+
+	// Build the game based on menu selection
+	switch menuAction {
+	case gamemenu.MainMenuActionResume:
+		if save := loadAutosave(); save != nil {
+			return save.Resume()
+		}
+	case gamemenu.MainMenuActionGenerate:
+		runOpts.StartingItems = startingItems
+		runOpts.Creative = runOpts.Creative || creative
+		return gameplay.BuildGameWithOptions(startLevel, selectedMode, runOpts)
+	case gamemenu.MainMenuActionDaily:
+		return gameplay.BuildGameWithOptions(1, gamemode.SinglePlayerPuzzle, runOpts)
+	case gamemenu.MainMenuActionPerfMap:
+		g := state.NewGame()
+		devtools.SwitchToPerfMap(g, perfMapScenario)
+		return g
+	case gamemenu.MainMenuActionQuit:
+		// Quit (should have been handled in RunMainMenu, but just in case)
+		debuglog.Close()
+		os.Exit(0)
+	}
+	defaultMode, difficulty := newGameDefaults(flagMode, flagDifficulty)
+	return gameplay.BuildGameWithOptions(startLevel, defaultMode, gamemode.RunOptions{Difficulty: difficulty, StartingItems: startingItems, Creative: creative})
+}
+
+// newGameDefaults returns the mode and difficulty the new-game screens preselect: the
+// -gamemode / -difficulty choices when given, otherwise the ones saved in Settings.
+func newGameDefaults(flagMode gamemode.ID, flagDifficulty string) (gamemode.ID, gamemode.Difficulty) {
+	mode := flagMode
+	if mode == "" {
+		mode = gamemenu.PreferredGameMode().ID
+	}
+	difficulty := gamemenu.PreferredDifficulty()
+	if flagDifficulty != "" {
+		difficulty, _ = gamemode.ParseDifficulty(flagDifficulty)
+	}
+	return mode, difficulty
 }
 
 // runMainMenuInLoop runs the main menu inside the Ebiten game loop
 // This allows the menu to render and receive input properly.
-// defaultMode preselects a row on the game mode screen (-gamemode / GAMEMODE).
-func runMainMenuInLoop(defaultMode gamemode.ID) (gamemenu.MainMenuAction, string, gamemode.ID) {
+// The game mode and new-game difficulty screens preselect the flag choices (-gamemode /
+// GAMEMODE, -difficulty) or, without them, the Settings choices (see newGameDefaults).
+// New Game continues to the mode picker and new-game options; Daily fixes the seed.
+func runMainMenuInLoop(flagMode gamemode.ID, flagDifficulty string) (gamemenu.MainMenuAction, string, gamemode.ID, gamemode.RunOptions) {
 	// Create a minimal game state for the menu (needed for rendering)
 	g := state.NewGame()
 
 	for {
 		handler := gamemenu.NewMainMenuHandler()
+		if save := loadAutosave(); save != nil {
+			handler.SetResume(save.Summary())
+		}
 		items := handler.GetMenuItems()
 		gamemenu.RunMenu(g, items, handler)
 
 		if handler.ShouldQuit() {
+			debuglog.Close()
 			os.Exit(0)
 		}
 
@@ -145,16 +295,44 @@ func runMainMenuInLoop(defaultMode gamemode.ID) (gamemenu.MainMenuAction, string
 		}
 
 		if action == gamemenu.MainMenuActionGenerate {
+			defaultMode, difficulty := newGameDefaults(flagMode, flagDifficulty)
 			modeID, ok := gamemenu.RunGameModeMenu(g, defaultMode)
 			if !ok {
 				continue
 			}
-			return action, handler.GetPerfMapScenario(), modeID
+			opts, ok := gamemenu.RunNewGameMenu(g, gamemode.Get(modeID), difficulty)
+			if !ok {
+				continue
+			}
+			return action, handler.GetPerfMapScenario(), modeID, opts
+		}
+
+		if action == gamemenu.MainMenuActionDaily {
+			opts := gamemode.RunOptions{Seed: gameplay.DailySeed(time.Now())}
+			return action, "", gamemode.SinglePlayerPuzzle, opts
 		}
 
 		// For other actions, return to let the caller handle them
-		return action, handler.GetPerfMapScenario(), ""
+		return action, handler.GetPerfMapScenario(), "", gamemode.RunOptions{}
+	}
+}
+
+// loadAutosave reads the run checkpoint, or returns nil when there is none. A corrupt
+// or incompatible file is logged and ignored, so the player starts a fresh game.
+func loadAutosave() *gameplay.Autosave {
+	path, err := config.AutosavePath()
+	if err != nil {
+		return nil
+	}
+	save, err := gameplay.LoadAutosave(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Printf("Ignoring autosave: %v", err)
+			debuglog.Warn("autosave.ignored", "path", path, "err", err)
+		}
+		return nil
 	}
+	return save
 }
 
 func mainLoop(g *state.Game) {
@@ -181,6 +359,19 @@ func mainLoop(g *state.Game) {
 		return
 	}
 
+	// Game over: only restart-deck and return-to-title input is accepted.
+	if g.GameOver {
+		if intent, ok := renderer.TryGetIntent(); ok {
+			gameplay.ProcessGameOverInput(g, intent)
+		}
+		if g.QuitToTitle {
+			return
+		}
+		renderer.RenderFrame(g)
+		time.Sleep(16 * time.Millisecond)
+		return
+	}
+
 	renderer.Clear()
 
 	if g.CurrentCell == nil || g.Grid == nil {
@@ -188,38 +379,76 @@ func mainLoop(g *state.Game) {
 	}
 
 	renderer.ClearCalloutsIfMoved(g.CurrentCell.Row, g.CurrentCell.Col)
-	renderer.ShowRoomEntryIfNew(g.CurrentCell.Row, g.CurrentCell.Col, g.CurrentCell.Name)
+	renderer.ShowRoomEntryIfNew(g.Level, g.CurrentCell.Row, g.CurrentCell.Col, g.CurrentCell.Name, g.CurrentCell.IsCorridor)
 
-	if g.ExitAnimating {
-		elapsed := time.Now().UnixMilli() - g.ExitAnimStartTime
-		const exitAnimDuration = 2000
-		if elapsed >= exitAnimDuration {
-			g.ExitAnimating = false
-		}
-	}
+	gameplay.UpdateExitAnimation(g, time.Now().UnixMilli())
 
 	gameplay.PickUpItemsOnFloor(g)
 	gameplay.PickUpAdjacentFloorItemsOnBlockingDevices(g)
 	gameplay.CheckAdjacentGenerators(g)
 	gameplay.UpdateLightingExploration(g)
+	gameplay.CheckSoftLock(g)
 
 	g.RemoveOldMessages()
 
+	gameplay.RetireTutorialHints(g)
 	gameplay.ShowInteractableHints(g)
 	gameplay.ShowMovementHint(g)
 
 	renderer.RenderFrame(g)
 
 	// If exit animation is running, continue loop without waiting for input
-	// This allows the animation to complete automatically
+	// This allows the animation to complete automatically; any key skips it.
 	if g.ExitAnimating {
+		if intent, ok := renderer.TryGetIntent(); ok && intent.Action != engineinput.ActionNone {
+			gameplay.RequestExitAnimationSkip(g)
+		}
 		// Small delay to allow animation to render smoothly
 		time.Sleep(16 * time.Millisecond) // ~60 FPS
 		return
 	}
 
-	// Get and process input (tiered input system -> Intent -> game logic)
-	gameplay.ProcessIntent(g, renderer.Current.GetInput())
+	// Auto-explore steps on its own; any key press cancels it.
+	if gameplay.IsAutoExploreActive(g) {
+		if intent, ok := renderer.TryGetIntent(); ok && intent.Action != engineinput.ActionNone {
+			gameplay.StopAutoExplore(g, "")
+		} else {
+			gameplay.StepAutoExplore(g)
+			gameplay.TickTurn(g)
+			time.Sleep(60 * time.Millisecond)
+		}
+		return
+	}
+
+	// Click-to-walk steps toward the chosen cell on its own; any key press cancels it.
+	if gameplay.IsWalkToActive(g) {
+		if intent, ok := renderer.TryGetIntent(); ok && intent.Action != engineinput.ActionNone {
+			gameplay.StopWalkTo(g, "")
+			if intent.Action == engineinput.ActionWalkTo || intent.Action == engineinput.ActionInteractAt {
+				gameplay.ProcessIntent(g, intent)
+			}
+		} else {
+			gameplay.StepWalkTo(g)
+			gameplay.TickTurn(g)
+			time.Sleep(60 * time.Millisecond)
+		}
+		return
+	}
+
+	// A telegraphed or live power surge plays out on the clock; poll input until it passes.
+	if gameplay.IsPowerSurgeActive(g) {
+		gameplay.PollDuringPowerSurge(g)
+		return
+	}
+
+	// Get and process input (tiered input system -> Intent -> game logic). Escape pauses
+	// instead, and a pause is not a turn: nothing below ticks for it.
+	intent := renderer.Current.GetInput()
+	if gameplay.PauseForIntent(g, intent) {
+		return
+	}
+	gameplay.ProcessIntent(g, intent)
+	gameplay.TickTurn(g)
 	if gameplay.IsHoldLongUseActive(g) {
 		gameplay.WaitForLongUseComplete(g)
 	}
@@ -1,6 +1,10 @@
 package input
 
-import "strings"
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
 
 // IsGamepadCode reports whether code is a controller binding identifier.
 func IsGamepadCode(code string) bool {
@@ -61,11 +65,69 @@ func FormatBindingCode(code string) string {
 		return "F10"
 	case "f":
 		return "F"
+	case "x":
+		return "X"
+	case "p":
+		return "P"
+	case "c":
+		return "C"
+	case "g":
+		return "G"
+	case "r":
+		return "R"
+	case "m":
+		return "M"
 	default:
 		return code
 	}
 }
 
+// namedKeyCodes are the keyboard codes that are not a single letter, digit or function key.
+var namedKeyCodes = []string{
+	"arrow_up", "arrow_down", "arrow_left", "arrow_right",
+	"enter", "escape", "tab", "space", "backspace", "menu",
+	"+", "-", "=", "/", "?",
+	"comma", "period", "semicolon", "quote", "backquote", "backslash",
+	"bracketleft", "bracketright",
+	"home", "end", "pageup", "pagedown", "insert", "delete",
+	"numpad_add", "numpad_subtract",
+	// Named aliases from the default bindings
+	"north", "south", "east", "west", "hint", "action", "screenshot", "quit",
+}
+
+// gamepadCodes are the controller buttons binding capture can produce.
+var gamepadCodes = []string{
+	"gamepad_a", "gamepad_b", "gamepad_x", "gamepad_y",
+	"gamepad_lb", "gamepad_rb", "gamepad_back", "gamepad_start",
+	"gamepad_ls", "gamepad_rs",
+	"gamepad_dpad_up", "gamepad_dpad_down", "gamepad_dpad_left", "gamepad_dpad_right",
+}
+
+// IsKnownBindingCode reports whether code names a key or controller button the game can
+// read: a letter, a digit (plain, digitN or numpadN), F1–F12, or one of the named codes.
+func IsKnownBindingCode(code string) bool {
+	if IsGamepadCode(code) {
+		return slices.Contains(gamepadCodes, code)
+	}
+	if slices.Contains(namedKeyCodes, code) {
+		return true
+	}
+	if len(code) == 1 {
+		c := code[0]
+		return (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9')
+	}
+	for _, prefix := range []string{"digit", "numpad"} {
+		if rest, ok := strings.CutPrefix(code, prefix); ok {
+			return len(rest) == 1 && rest[0] >= '0' && rest[0] <= '9'
+		}
+	}
+	if rest, ok := strings.CutPrefix(code, "f"); ok {
+		n, err := strconv.Atoi(rest)
+		return err == nil && n >= 1 && n <= 12 && rest[0] != '0'
+	}
+	return false
+}
+
 func isReservedKeyboardBinding(code string) bool {
 	return code == "arrow_up" || code == "arrow_down" ||
 		code == "arrow_left" || code == "arrow_right" ||
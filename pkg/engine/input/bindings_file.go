@@ -0,0 +1,145 @@
+package input
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// movementActions must each keep at least one binding after an import.
+var movementActions = []Action{ActionMoveNorth, ActionMoveSouth, ActionMoveWest, ActionMoveEast}
+
+// ActionKey returns the stable identifier used for an action in exported bindings files
+// (e.g. "move_north"). Actions without a name return "".
+func ActionKey(a Action) string {
+	name := ActionName(a)
+	if a == ActionNone || name == "None" {
+		return ""
+	}
+	return strings.ReplaceAll(strings.ToLower(name), " ", "_")
+}
+
+// actionForKey resolves an exported action identifier; ok is false for unknown keys.
+func actionForKey(key string) (Action, bool) {
+	for a := ActionNone + 1; a <= ActionCircuitFull; a++ {
+		if k := ActionKey(a); k != "" && k == key {
+			return a, true
+		}
+	}
+	return ActionNone, false
+}
+
+// IsNonRebindable reports actions whose bindings are fixed: the rebinding menu will not
+// edit them and an import leaves them untouched.
+func IsNonRebindable(a Action) bool {
+	return a == ActionAction || a == ActionInteract || a == ActionOpenMenu ||
+		a == ActionCancel || a == ActionQuit
+}
+
+// ExportBindings encodes every current binding as JSON keyed by action.
+func ExportBindings() ([]byte, error) {
+	out := make(map[string][]string)
+	for act, codes := range GetBindingsByAction() {
+		if key := ActionKey(act); key != "" {
+			out[key] = codes
+		}
+	}
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode bindings: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// ImportBindings applies JSON written by ExportBindings immediately. Unknown actions,
+// reserved codes, and non-rebindable actions are ignored. Each listed action has its
+// other rebindable codes removed. Nothing is applied if a code is not a known key or
+// controller button, or if the result would leave a movement direction unbound.
+func ImportBindings(data []byte) error {
+	var in map[string][]string
+	if err := json.Unmarshal(data, &in); err != nil {
+		return fmt.Errorf("parse bindings: %w", err)
+	}
+
+	next := make(map[string]Action, len(bindings))
+	for code, act := range bindings {
+		next[code] = act
+	}
+	keys := make([]string, 0, len(in))
+	for key := range in {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys) // a code listed under two actions resolves the same way every time
+	for _, key := range keys {
+		codes := in[key]
+		act, ok := actionForKey(key)
+		if !ok || IsNonRebindable(act) {
+			continue
+		}
+		for _, code := range codes {
+			if code = strings.TrimSpace(code); code != "" && !IsKnownBindingCode(code) {
+				return fmt.Errorf("unknown key or button %q for %s", code, ActionName(act))
+			}
+		}
+		for code, a := range next {
+			if a == act && !isReservedBindingCode(code) && !slices.Contains(codes, code) {
+				delete(next, code)
+			}
+		}
+		for _, code := range codes {
+			code = strings.TrimSpace(code)
+			if code == "" || isReservedBindingCode(code) || IsNonRebindable(next[code]) {
+				continue
+			}
+			next[code] = act
+		}
+	}
+
+	for _, act := range movementActions {
+		bound := false
+		for _, a := range next {
+			if a == act {
+				bound = true
+				break
+			}
+		}
+		if !bound {
+			return fmt.Errorf("bindings leave %s unbound", ActionName(act))
+		}
+	}
+
+	for code := range bindings {
+		delete(bindings, code)
+	}
+	for code, act := range next {
+		bindings[code] = act
+	}
+	return nil
+}
+
+// WriteBindingsFile saves ExportBindings output to path, creating its directory.
+func WriteBindingsFile(path string) error {
+	data, err := ExportBindings()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create bindings directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("write bindings: %w", err)
+	}
+	return nil
+}
+
+// ReadBindingsFile loads path and applies it with ImportBindings.
+func ReadBindingsFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read bindings: %w", err)
+	}
+	return ImportBindings(data)
+}
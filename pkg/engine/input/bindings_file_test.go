@@ -0,0 +1,119 @@
+package input
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func restoreBindings(t *testing.T) {
+	t.Helper()
+	orig := make(map[string]Action, len(bindings))
+	for k, v := range bindings {
+		orig[k] = v
+	}
+	t.Cleanup(func() {
+		for k := range bindings {
+			delete(bindings, k)
+		}
+		for k, v := range orig {
+			bindings[k] = v
+		}
+	})
+}
+
+func TestExportImportBindingsRoundTrip(t *testing.T) {
+	restoreBindings(t)
+	path := filepath.Join(t.TempDir(), "cfg", "bindings.json")
+
+	SetSingleBinding(ActionHint, "h")
+	if err := WriteBindingsFile(path); err != nil {
+		t.Fatalf("WriteBindingsFile: %v", err)
+	}
+	SetSingleBinding(ActionHint, "y")
+	if err := ReadBindingsFile(path); err != nil {
+		t.Fatalf("ReadBindingsFile: %v", err)
+	}
+	if bindings["h"] != ActionHint {
+		t.Errorf("h = %v, want Hint restored from file", bindings["h"])
+	}
+	if _, ok := bindings["y"]; ok {
+		t.Error("binding set after export should be replaced by the import")
+	}
+}
+
+func TestImportBindingsIgnoresUnknownAndReserved(t *testing.T) {
+	restoreBindings(t)
+	data := `{"teleport": ["t"], "hint": ["arrow_up", "gamepad_a", "z"], "interact": ["o"], "open_menu": ["y"]}`
+	if err := ImportBindings([]byte(data)); err != nil {
+		t.Fatalf("ImportBindings: %v", err)
+	}
+	if _, ok := bindings["t"]; ok {
+		t.Error("unknown action should be ignored")
+	}
+	if bindings["arrow_up"] != ActionMoveNorth || bindings["gamepad_a"] != ActionInteract {
+		t.Error("reserved codes must keep their actions")
+	}
+	if bindings["z"] != ActionHint {
+		t.Error("z should be bound to Hint")
+	}
+	if _, ok := bindings["o"]; ok {
+		t.Error("fixed interact action should not be imported")
+	}
+	if _, ok := bindings["y"]; ok || bindings["menu"] != ActionOpenMenu {
+		t.Error("non-rebindable open menu action should keep its bindings")
+	}
+}
+
+func TestImportBindingsRejectsUnknownCode(t *testing.T) {
+	restoreBindings(t)
+	before := len(bindings)
+	err := ImportBindings([]byte(`{"hint": ["z"], "pick_up": ["gamepad_turbo"]}`))
+	if err == nil || !strings.Contains(err.Error(), "gamepad_turbo") {
+		t.Fatalf("err = %v, want it to name the unknown code", err)
+	}
+	if _, ok := bindings["z"]; ok || len(bindings) != before {
+		t.Error("a rejected import must not change bindings")
+	}
+}
+
+func TestIsKnownBindingCode(t *testing.T) {
+	for _, code := range []string{"z", "7", "digit3", "numpad0", "f5", "f12", "space", "arrow_left", "?", "gamepad_lb", "gamepad_dpad_up"} {
+		if !IsKnownBindingCode(code) {
+			t.Errorf("IsKnownBindingCode(%q) = false, want true", code)
+		}
+	}
+	for _, code := range []string{"", "zz", "f0", "f13", "digit", "gamepad_turbo", "ctrl+z"} {
+		if IsKnownBindingCode(code) {
+			t.Errorf("IsKnownBindingCode(%q) = true, want false", code)
+		}
+	}
+}
+
+func TestImportBindingsRejectsBadFile(t *testing.T) {
+	restoreBindings(t)
+	path := filepath.Join(t.TempDir(), "bindings.json")
+	if err := os.WriteFile(path, []byte("not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	before := len(bindings)
+	if err := ReadBindingsFile(path); err == nil {
+		t.Fatal("expected a parse error")
+	}
+	if len(bindings) != before {
+		t.Error("a failed import must not change bindings")
+	}
+}
+
+func TestActionKey(t *testing.T) {
+	if got := ActionKey(ActionMoveNorth); got != "move_north" {
+		t.Errorf("ActionKey(MoveNorth) = %q", got)
+	}
+	if got := ActionKey(ActionNone); got != "" {
+		t.Errorf("ActionKey(None) = %q, want empty", got)
+	}
+	if a, ok := actionForKey("auto_explore"); !ok || a != ActionAutoExplore {
+		t.Errorf("actionForKey(auto_explore) = %v, %v", a, ok)
+	}
+}
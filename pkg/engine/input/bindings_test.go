@@ -80,6 +80,23 @@ func TestOpenInventoryDefaultBindings(t *testing.T) {
 	}
 }
 
+func TestZoomDefaultBindingsAndRebind(t *testing.T) {
+	restoreBindings(t)
+	for code, want := range map[string]Action{"+": ActionZoomIn, "-": ActionZoomOut, "0": ActionZoomReset, "numpad0": ActionZoomReset} {
+		if got := MapToIntent(NewDebouncedInput(RawInput{Device: DeviceKeyboard, Code: code})).Action; got != want {
+			t.Fatalf("%s = %v, want %v", code, got, want)
+		}
+	}
+
+	SetSingleBinding(ActionZoomIn, "z")
+	if got := MapToIntent(NewDebouncedInput(RawInput{Device: DeviceKeyboard, Code: "z"})).Action; got != ActionZoomIn {
+		t.Fatalf("z = %v after rebinding, want ActionZoomIn", got)
+	}
+	if got := MapToIntent(NewDebouncedInput(RawInput{Device: DeviceKeyboard, Code: "+"})).Action; got == ActionZoomIn {
+		t.Fatal("previous zoom in key should be replaced")
+	}
+}
+
 func TestQuitIsEscapeAndCancelIsBackOnly(t *testing.T) {
 	tests := []struct {
 		code string
@@ -107,3 +124,10 @@ func TestFormatBindingCode(t *testing.T) {
 		t.Fatalf("got %q", FormatBindingCode("w"))
 	}
 }
+
+func TestMouseClickMapsToInteractAt(t *testing.T) {
+	got := MapToIntent(NewDebouncedInput(RawInput{Device: DeviceMouse, Code: "mouse_left", Row: 4, Col: 7}))
+	if got.Action != ActionInteractAt || got.Row != 4 || got.Col != 7 {
+		t.Fatalf("mouse click = %+v, want ActionInteractAt at (4,7)", got)
+	}
+}
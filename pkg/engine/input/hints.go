@@ -18,6 +18,40 @@ func HintInteractPrefix() string {
 	return "Press E or Enter to interact"
 }
 
+// HintInteractAgain returns the callout for the next target when repeated presses cycle
+// between adjacent interactables.
+func HintInteractAgain() string {
+	if GetPrimaryDevice() == PrimaryGamepad {
+		return "Press A again to interact"
+	}
+	return "Press E again to interact"
+}
+
+// HintPickUp returns "Press … to pick up" for the manual pick-up prompt.
+func HintPickUp() string {
+	if GetPrimaryDevice() == PrimaryGamepad {
+		return "Press X to pick up"
+	}
+	return "Press G to pick up"
+}
+
+// HintPickLock returns "Press … to pick the lock" for pickable locked doors. On a
+// gamepad interact starts picking, so it names A.
+func HintPickLock() string {
+	if GetPrimaryDevice() == PrimaryGamepad {
+		return "Press A to pick the lock"
+	}
+	return "Press B to pick the lock"
+}
+
+// HintUseItem returns "Press … to use it" for a picked-up consumable such as an EMP Charge.
+func HintUseItem() string {
+	if GetPrimaryDevice() == PrimaryGamepad {
+		return "Press LB to use it"
+	}
+	return "Press U to use it"
+}
+
 // HintMenuSelect returns navigation text for menus (without trailing period).
 func HintMenuSelect() string {
 	if GetPrimaryDevice() == PrimaryGamepad {
@@ -142,6 +176,14 @@ func HintDevMenuInstructions() string {
 	return "Up/Down: select | Enter: activate | Esc/Q: close"
 }
 
+// HintScrollInstructions returns footer text for read-only menus that scroll a long list.
+func HintScrollInstructions() string {
+	if GetPrimaryDevice() == PrimaryGamepad {
+		return "D-pad up/down: scroll | D-pad left/right: page | Start/B: close"
+	}
+	return "Up/Down: scroll | Left/Right: page | Esc/Q: close"
+}
+
 // IsMovementHintMessage reports whether a callout uses the movement tutorial text.
 func IsMovementHintMessage(msg string) bool {
 	return msg == "Press WASD or arrow keys to move" || msg == "Use left stick or D-pad to move"
@@ -159,3 +201,11 @@ func HintConfirmYesNo() string {
 	}
 	return "Y or Enter: Yes | N or Esc: No"
 }
+
+// HintGameOverInstructions returns the restart/title footer for the game-over overlay.
+func HintGameOverInstructions() string {
+	if GetPrimaryDevice() == PrimaryGamepad {
+		return "A: restart deck | Start/B: return to title"
+	}
+	return "Enter: restart deck | Esc/Q: return to title"
+}
@@ -13,6 +13,7 @@ const (
 	DeviceKeyboard
 	DeviceGamepad
 	DeviceTerminal
+	DeviceMouse // Pointer click on a map cell (RawInput.Row/Col)
 )
 
 // Action represents a high‑level intent in the game.
@@ -26,6 +27,10 @@ const (
 	ActionMoveSouth
 	ActionMoveWest
 	ActionMoveEast
+	ActionMoveNE // Diagonal moves (keypad 9/7/3/1); ignored unless diagonal movement is on
+	ActionMoveNW
+	ActionMoveSE
+	ActionMoveSW
 
 	// Meta / UI
 	ActionHint
@@ -40,10 +45,26 @@ const (
 	ActionDevMap   // Switch to developer testing map (menu / console)
 	ActionMaintPanTestMap
 	ActionPerfTestMap
-	ActionDebugMapDump // Dump revealed map to map.txt (F8)
-	ActionResetLevel   // Reset current level (F5)
-	ActionZoomIn       // Zoom in (increase font/tile size)
-	ActionZoomOut      // Zoom out (decrease font/tile size)
+	ActionRenderBenchmark  // Console "benchmark": time map drawing on the largest deck
+	ActionDebugMapDump     // Dump revealed map to map.txt (F8)
+	ActionResetLevel       // Reset current level (F5)
+	ActionZoomIn           // Zoom in (increase font/tile size)
+	ActionZoomOut          // Zoom out (decrease font/tile size)
+	ActionZoomReset        // Reset font/tile size to the default
+	ActionAutoExplore      // Walk to the nearest unexplored frontier until something turns up
+	ActionExportMap        // Export the whole explored deck map to a text file (F7)
+	ActionExportDeck       // Export the full deck layout as JSON plus an ASCII map (console exportdeck)
+	ActionLoadDeck         // Load a deck layout JSON file (console loaddeck; Intent.Code is the path)
+	ActionPowerDiagnostics // Toggle the deck power diagnostics overlay (P)
+	ActionCycleObjectives  // Pan the camera to the next known objective (C)
+	ActionPickUp           // Pick up floor items when auto pick-up is off (G)
+	ActionRecenter         // Snap the camera back to the player after browsing (R)
+	ActionMessageLog       // Open the scrollable history of this run's messages (M)
+	ActionDropItem         // Choose a carried item to drop on the current cell (V)
+	ActionPickLock         // Pick the lock of an adjacent pickable door without its keycard (B)
+	ActionUseItem          // Set off a carried consumable, e.g. an EMP Charge (U)
+	ActionWalkTo           // Walk to the clicked map cell (Intent.Row/Col; mouse only)
+	ActionInteractAt       // Interact with the clicked cell if in reach, else walk there (Intent.Row/Col; mouse only)
 
 	// Maintenance menu (only consumed while maintenance menu is open)
 	ActionMaintModeToggle  // Tab: switch Controls / Diagnostics
@@ -56,13 +77,18 @@ const (
 type Intent struct {
 	Action Action
 	Code   string // device-specific binding code (used during rebinding capture)
+	Row    int    // Map cell for pointer actions (ActionWalkTo, ActionInteractAt)
+	Col    int
 }
 
 // RawInput is the 1st‑layer event emitted directly from an input device.
 // Code is a device‑specific identifier (e.g. "KeyW", "arrow_up", "GamepadDPadUp").
+// Mouse events also carry the map cell under the pointer.
 type RawInput struct {
 	Device    Device
 	Code      string
+	Row       int // Map cell for DeviceMouse events
+	Col       int
 	Timestamp time.Time
 }
 
@@ -73,6 +99,8 @@ type RawInput struct {
 type DebouncedInput struct {
 	Device Device
 	Code   string
+	Row    int
+	Col    int
 }
 
 // NewDebouncedInput converts a raw event to a debounced event.
@@ -82,6 +110,8 @@ func NewDebouncedInput(raw RawInput) DebouncedInput {
 	return DebouncedInput{
 		Device: raw.Device,
 		Code:   raw.Code,
+		Row:    raw.Row,
+		Col:    raw.Col,
 	}
 }
 
@@ -105,6 +135,12 @@ var bindings = map[string]Action{
 	"east":        ActionMoveEast,
 	"l":           ActionMoveEast,
 
+	// Diagonal movement (numeric keypad)
+	"numpad9": ActionMoveNE,
+	"numpad7": ActionMoveNW,
+	"numpad3": ActionMoveSE,
+	"numpad1": ActionMoveSW,
+
 	// Help / hint
 	"?":    ActionHint,
 	"hint": ActionHint,
@@ -121,8 +157,18 @@ var bindings = map[string]Action{
 	// Menu
 	"menu":        ActionOpenMenu,
 	"f":           ActionOpenInventory,
+	"x":           ActionAutoExplore,
+	"p":           ActionPowerDiagnostics,
+	"c":           ActionCycleObjectives,
+	"g":           ActionPickUp,
+	"r":           ActionRecenter,
+	"m":           ActionMessageLog,
+	"v":           ActionDropItem,
+	"b":           ActionPickLock,
+	"u":           ActionUseItem,
 	"f9":          ActionDevMenu,
 	"f8":          ActionDebugMapDump,
+	"f7":          ActionExportMap,
 
 	// Controller/gamepad specific bindings
 	"gamepad_dpad_up":    ActionMoveNorth,
@@ -131,17 +177,26 @@ var bindings = map[string]Action{
 	"gamepad_dpad_right": ActionMoveEast,
 
 	// Interaction (E, Enter, A button)
-	"e":         ActionInteract,
-	"enter":     ActionInteract,
-	"gamepad_a": ActionInteract, // A button / Cross
-	"gamepad_y": ActionOpenInventory,
+	"e":          ActionInteract,
+	"enter":      ActionInteract,
+	"gamepad_a":  ActionInteract, // A button / Cross
+	"gamepad_y":  ActionOpenInventory,
+	"gamepad_x":  ActionPickUp,
+	"gamepad_rs": ActionRecenter, // right stick click
+
+	// Drop a carried item (V, Back / Select button)
+	"gamepad_back": ActionDropItem,
+	// Use a carried consumable (U, left shoulder)
+	"gamepad_lb": ActionUseItem,
 
-	// Zoom (fixed bindings, not rebindable)
+	// Zoom
 	"=":               ActionZoomIn,
 	"+":               ActionZoomIn,
 	"numpad_add":      ActionZoomIn,
 	"-":               ActionZoomOut,
 	"numpad_subtract": ActionZoomOut,
+	"0":               ActionZoomReset,
+	"numpad0":         ActionZoomReset,
 
 	// Generic action/confirm inputs (reserved, not unbindable)
 	"action": ActionAction,
@@ -152,18 +207,20 @@ var bindings = map[string]Action{
 	"tab":     ActionMaintModeToggle,
 	"1":       ActionCircuitOff,
 	"digit1":  ActionCircuitOff,
-	"numpad1": ActionCircuitOff,
 	"2":       ActionCircuitFull,
 	"digit2":  ActionCircuitFull,
 	"numpad2": ActionCircuitFull,
 	"3":       ActionCircuitFull,
 	"digit3":  ActionCircuitFull,
-	"numpad3": ActionCircuitFull,
 }
 
 // MapToIntent is the 3rd+4th layer: it applies the current bindings to a
 // debounced input and returns a high‑level Intent.
+// Mouse clicks are not rebindable: any click targets the cell under the pointer.
 func MapToIntent(ev DebouncedInput) Intent {
+	if ev.Device == DeviceMouse {
+		return Intent{Action: ActionInteractAt, Row: ev.Row, Col: ev.Col}
+	}
 	if act, ok := bindings[ev.Code]; ok {
 		return Intent{Action: act}
 	}
@@ -181,6 +238,14 @@ func ActionName(a Action) string {
 		return "Move West"
 	case ActionMoveEast:
 		return "Move East"
+	case ActionMoveNE:
+		return "Move Northeast"
+	case ActionMoveNW:
+		return "Move Northwest"
+	case ActionMoveSE:
+		return "Move Southeast"
+	case ActionMoveSW:
+		return "Move Southwest"
 	case ActionHint:
 		return "Hint"
 	case ActionQuit:
@@ -211,6 +276,36 @@ func ActionName(a Action) string {
 		return "Zoom In"
 	case ActionZoomOut:
 		return "Zoom Out"
+	case ActionZoomReset:
+		return "Reset Zoom"
+	case ActionAutoExplore:
+		return "Auto Explore"
+	case ActionExportMap:
+		return "Export Map"
+	case ActionExportDeck:
+		return "Export Deck"
+	case ActionLoadDeck:
+		return "Load Deck"
+	case ActionPowerDiagnostics:
+		return "Power Diagnostics"
+	case ActionCycleObjectives:
+		return "Cycle Objectives"
+	case ActionPickUp:
+		return "Pick Up"
+	case ActionRecenter:
+		return "Recenter Camera"
+	case ActionMessageLog:
+		return "Message Log"
+	case ActionDropItem:
+		return "Drop Item"
+	case ActionPickLock:
+		return "Pick Lock"
+	case ActionUseItem:
+		return "Use Item"
+	case ActionWalkTo:
+		return "Walk To"
+	case ActionInteractAt:
+		return "Interact At"
 	default:
 		return "None"
 	}
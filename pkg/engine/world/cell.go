@@ -27,14 +27,22 @@ type Cell struct {
 	South *Cell
 	West  *Cell
 
+	// Diagonal links (set by BuildAllCellConnections; only followed when a game allows
+	// 8-direction movement)
+	NE *Cell
+	NW *Cell
+	SE *Cell
+	SW *Cell
+
 	// Visibility state
 	Visited    bool
 	Discovered bool
 
 	// Cell type flags
-	Room     bool // Is this cell a walkable room/corridor?
-	ExitCell bool // Is this the exit/goal cell?
-	Locked   bool // Is this cell locked (requires condition to enter)?
+	Room       bool // Is this cell a walkable room/corridor?
+	IsCorridor bool // Is this room cell part of a connecting corridor (not a named room)?
+	ExitCell   bool // Is this the exit/goal cell?
+	Locked     bool // Is this cell locked (requires condition to enter)?
 
 	// GameData holds game-specific extensions.
 	// Games should cast this to their specific type (e.g., *GameCellData).
@@ -74,6 +82,14 @@ func (c *Cell) GetNeighbor(dir Direction) *Cell {
 		return c.South
 	case West:
 		return c.West
+	case NorthEast:
+		return c.NE
+	case SouthEast:
+		return c.SE
+	case SouthWest:
+		return c.SW
+	case NorthWest:
+		return c.NW
 	default:
 		return nil
 	}
@@ -93,6 +109,14 @@ func (c *Cell) SetNeighbor(dir Direction, neighbor *Cell) {
 		c.South = neighbor
 	case West:
 		c.West = neighbor
+	case NorthEast:
+		c.NE = neighbor
+	case SouthEast:
+		c.SE = neighbor
+	case SouthWest:
+		c.SW = neighbor
+	case NorthWest:
+		c.NW = neighbor
 	}
 }
 
@@ -106,6 +130,20 @@ func (c *Cell) IsExitUnlocked() bool {
 	return c.ExitCell && !c.Locked
 }
 
+// DiagonalDirectionTo returns the diagonal direction from c to target when target is one
+// of c's diagonal neighbors.
+func (c *Cell) DiagonalDirectionTo(target *Cell) (Direction, bool) {
+	if c == nil || target == nil {
+		return 0, false
+	}
+	for _, dir := range DiagonalDirections() {
+		if c.GetNeighbor(dir) == target {
+			return dir, true
+		}
+	}
+	return 0, false
+}
+
 // GetNeighbors returns all non-nil adjacent cells
 func (c *Cell) GetNeighbors() []*Cell {
 	var neighbors []*Cell
@@ -1,6 +1,6 @@
 package world
 
-// Direction represents a cardinal direction
+// Direction represents a cardinal or diagonal direction
 type Direction int
 
 // Direction constants
@@ -9,13 +9,24 @@ const (
 	East
 	South
 	West
+
+	// Diagonals (only linked for games that allow 8-direction movement)
+	NorthEast
+	SouthEast
+	SouthWest
+	NorthWest
 )
 
-// AllDirections returns all valid directions for iteration
+// AllDirections returns all valid cardinal directions for iteration
 func AllDirections() []Direction {
 	return []Direction{North, East, South, West}
 }
 
+// DiagonalDirections returns the four diagonal directions
+func DiagonalDirections() []Direction {
+	return []Direction{NorthEast, SouthEast, SouthWest, NorthWest}
+}
+
 // String returns the string representation of a direction
 func (d Direction) String() string {
 	switch d {
@@ -27,6 +38,14 @@ func (d Direction) String() string {
 		return "South"
 	case West:
 		return "West"
+	case NorthEast:
+		return "NorthEast"
+	case SouthEast:
+		return "SouthEast"
+	case SouthWest:
+		return "SouthWest"
+	case NorthWest:
+		return "NorthWest"
 	default:
 		return "Unknown"
 	}
@@ -37,6 +56,28 @@ func (d Direction) IsValid() bool {
 	return d >= North && d <= West
 }
 
+// IsDiagonal returns true if the direction is one of the four diagonals
+func (d Direction) IsDiagonal() bool {
+	return d >= NorthEast && d <= NorthWest
+}
+
+// Orthogonals returns the two cardinal directions a diagonal step passes between
+// (e.g. North and East for NorthEast). ok is false for cardinal directions.
+func (d Direction) Orthogonals() (vertical, horizontal Direction, ok bool) {
+	switch d {
+	case NorthEast:
+		return North, East, true
+	case SouthEast:
+		return South, East, true
+	case SouthWest:
+		return South, West, true
+	case NorthWest:
+		return North, West, true
+	default:
+		return d, d, false
+	}
+}
+
 // Opposite returns the opposite direction
 func (d Direction) Opposite() Direction {
 	switch d {
@@ -48,6 +89,14 @@ func (d Direction) Opposite() Direction {
 		return West
 	case West:
 		return East
+	case NorthEast:
+		return SouthWest
+	case SouthWest:
+		return NorthEast
+	case SouthEast:
+		return NorthWest
+	case NorthWest:
+		return SouthEast
 	default:
 		return d
 	}
@@ -64,6 +113,14 @@ func (d Direction) Delta() (rowDelta, colDelta int) {
 		return 1, 0
 	case West:
 		return 0, -1
+	case NorthEast:
+		return -1, 1
+	case SouthEast:
+		return 1, 1
+	case SouthWest:
+		return 1, -1
+	case NorthWest:
+		return -1, -1
 	default:
 		return 0, 0
 	}
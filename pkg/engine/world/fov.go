@@ -48,7 +48,8 @@ type FOVRay struct {
 }
 
 // RayCastEndpoint traces a Bresenham ray from (r0,c0) toward (r1,c1) and returns the last room
-// cell reached before a wall or sight blocker stops the ray.
+// cell reached before a wall or sight blocker stops the ray. A diagonal step between two wall
+// cells also stops it, so the ray cannot slip through a wall corner into a sealed room.
 func RayCastEndpoint(grid *Grid, r0, c0, r1, c1 int, blockSight SightBlocker) (endRow, endCol int, ok bool) {
 	var lastR, lastC int
 	found := false
@@ -57,6 +58,9 @@ func RayCastEndpoint(grid *Grid, r0, c0, r1, c1 int, blockSight SightBlocker) (e
 		if cell == nil || !cell.Room {
 			return false
 		}
+		if found && row != lastR && col != lastC && !isRoomAt(grid, lastR, col) && !isRoomAt(grid, row, lastC) {
+			return false
+		}
 		lastR, lastC = row, col
 		found = true
 		if blockSight != nil && blockSight(cell) {
@@ -70,6 +74,24 @@ func RayCastEndpoint(grid *Grid, r0, c0, r1, c1 int, blockSight SightBlocker) (e
 	return lastR, lastC, true
 }
 
+// HasLineOfSight reports whether a sight ray from from reaches to (see RayCastEndpoint).
+// Bresenham rays are asymmetric, so the reverse ray is accepted too; this keeps diagonal
+// corners consistent whichever end looks.
+func HasLineOfSight(grid *Grid, from, to *Cell, blockSight SightBlocker) bool {
+	if grid == nil || from == nil || to == nil {
+		return false
+	}
+	if from == to {
+		return true
+	}
+	endRow, endCol, ok := RayCastEndpoint(grid, from.Row, from.Col, to.Row, to.Col, blockSight)
+	if ok && endRow == to.Row && endCol == to.Col {
+		return true
+	}
+	endRow, endCol, ok = RayCastEndpoint(grid, to.Row, to.Col, from.Row, from.Col, blockSight)
+	return ok && endRow == from.Row && endCol == from.Col
+}
+
 // CollectFOVRays returns one ray per unique endpoint used by CalculateFOV (same cast targets).
 func CollectFOVRays(grid *Grid, center *Cell, blockSight SightBlocker) []FOVRay {
 	if center == nil || grid == nil {
@@ -103,18 +125,38 @@ func CollectFOVRays(grid *Grid, center *Cell, blockSight SightBlocker) []FOVRay
 }
 
 func collectVisibleCells(grid *Grid, center *Cell, blockSight SightBlocker) map[*Cell]bool {
+	return visibleCellsWithin(grid, center, 0, DefaultFOVOptions(blockSight))
+}
+
+// visibleCellsWithin returns the room cells visible from center under opts, limited to
+// radius cells (Euclidean) when radius > 0.
+func visibleCellsWithin(grid *Grid, center *Cell, radius int, opts FOVOptions) map[*Cell]bool {
 	visible := make(map[*Cell]bool)
-	if !markVisibleRoom(grid, center.Row, center.Col, visible, blockSight) {
+	if !markVisibleRoom(grid, center.Row, center.Col, visible, opts.BlockSight) {
 		return visible
 	}
 	for _, path := range fovRayPlanFor(grid, center).paths {
+		lastR, lastC := center.Row, center.Col
 		for _, point := range path {
+			// Ray points only move away from center, so the first one out of range ends the ray.
+			if radius > 0 && !withinRadius(center, point, radius) {
+				break
+			}
 			cell := gridCellAt(grid, point.row, point.col)
 			if cell == nil || !cell.Room {
+				if opts.WallsBlock {
+					break
+				}
+				lastR, lastC = point.row, point.col
+				continue
+			}
+			if opts.WallsBlock && !opts.DiagonalPeek && point.row != lastR && point.col != lastC &&
+				!isRoomAt(grid, lastR, point.col) && !isRoomAt(grid, point.row, lastC) {
 				break
 			}
+			lastR, lastC = point.row, point.col
 			visible[cell] = true
-			if blockSight != nil && blockSight(cell) {
+			if opts.BlockSight != nil && opts.BlockSight(cell) {
 				break
 			}
 		}
@@ -122,6 +164,12 @@ func collectVisibleCells(grid *Grid, center *Cell, blockSight SightBlocker) map[
 	return visible
 }
 
+func withinRadius(center *Cell, point fovRayPoint, radius int) bool {
+	dr := point.row - center.Row
+	dc := point.col - center.Col
+	return dr*dr+dc*dc <= radius*radius
+}
+
 func rayCastEndpointForPath(grid *Grid, centerRow, centerCol int, path []fovRayPoint, centerBlocks bool, blockSight SightBlocker) (endRow, endCol int, ok bool) {
 	lastR, lastC := centerRow, centerCol
 	if centerBlocks {
@@ -203,6 +251,11 @@ func gridCellAt(grid *Grid, row, col int) *Cell {
 	return rowMap[col]
 }
 
+func isRoomAt(grid *Grid, row, col int) bool {
+	cell := grid.GetCell(row, col)
+	return cell != nil && cell.Room
+}
+
 func absInt(v int) int {
 	if v < 0 {
 		return -v
@@ -314,18 +367,43 @@ func DistanceCells(a, b *Cell) float64 {
 	return math.Sqrt(dr*dr + dc*dc)
 }
 
-// RevealFOV marks all cells within line-of-sight of the center cell as discovered.
+// FOVOptions are the line-of-sight rules for RevealFOV.
+type FOVOptions struct {
+	// BlockSight stops rays at visible cells such as unpowered doors (nil: none).
+	BlockSight SightBlocker
+	// WallsBlock stops a ray at the first non-room cell. When false, sight passes over
+	// walls and reveals every room cell in range.
+	WallsBlock bool
+	// DiagonalPeek lets a ray step diagonally between two wall cells, seeing past the
+	// corner. When false that step stops the ray, as in RayCastEndpoint.
+	DiagonalPeek bool
+	// Dark reports unlit cells. A limited radius shrinks by one (to no less than 1)
+	// when the center is dark; nil ignores darkness.
+	Dark func(cell *Cell) bool
+}
+
+// DefaultFOVOptions returns the rules RevealFOVDefault uses: walls and blockSight stop
+// rays, rays may peek past wall corners, and darkness is ignored.
+func DefaultFOVOptions(blockSight SightBlocker) FOVOptions {
+	return FOVOptions{BlockSight: blockSight, WallsBlock: true, DiagonalPeek: true}
+}
+
+// RevealFOV marks all cells within line-of-sight of the center cell as discovered,
+// up to radius cells away (Euclidean; radius <= 0 is unlimited) under opts.
 // Visited is set only when the player steps on a cell (see gameplay movement).
-func RevealFOV(grid *Grid, center *Cell, blockSight SightBlocker) {
+func RevealFOV(grid *Grid, center *Cell, radius int, opts FOVOptions) {
 	if center == nil || grid == nil {
 		return
 	}
-	for cell := range collectVisibleCells(grid, center, blockSight) {
+	if radius > 0 && opts.Dark != nil && opts.Dark(center) {
+		radius = max(radius-1, 1)
+	}
+	for cell := range visibleCellsWithin(grid, center, radius, opts) {
 		cell.Discovered = true
 	}
 }
 
-// RevealFOVDefault reveals cells using ray-cast line of sight from center.
+// RevealFOVDefault reveals cells using unlimited ray-cast line of sight from center.
 func RevealFOVDefault(grid *Grid, center *Cell, blockSight SightBlocker) {
-	RevealFOV(grid, center, blockSight)
+	RevealFOV(grid, center, 0, DefaultFOVOptions(blockSight))
 }
@@ -100,6 +100,25 @@ func TestCalculateFOV_sightBlockerStopsRay(t *testing.T) {
 	}
 }
 
+func TestRayCastEndpoint_stopsAtWallCorner(t *testing.T) {
+	// (0,0) and (1,1) are diagonal neighbours with walls at (0,1) and (1,0).
+	grid, _ := makeFOVGrid(t, 3, 3, [][2]int{{0, 0}, {1, 1}, {2, 2}})
+	endRow, endCol, ok := RayCastEndpoint(grid, 0, 0, 2, 2, nil)
+	if !ok {
+		t.Fatal("expected the ray to start on a room cell")
+	}
+	if endRow != 0 || endCol != 0 {
+		t.Fatalf("ray slipped through the wall corner to (%d,%d)", endRow, endCol)
+	}
+
+	grid.GetCell(0, 1).Room = true
+	grid.GetCell(1, 2).Room = true
+	endRow, endCol, _ = RayCastEndpoint(grid, 0, 0, 2, 2, nil)
+	if endRow != 2 || endCol != 2 {
+		t.Fatalf("with an open side the diagonal ray should reach (2,2), got (%d,%d)", endRow, endCol)
+	}
+}
+
 func TestCollectFOVRays_matchesVisibleEndpoints(t *testing.T) {
 	var rooms [][2]int
 	for c := 0; c <= 4; c++ {
@@ -147,7 +166,7 @@ func TestRevealFOV_doesNotMarkVisited(t *testing.T) {
 	center.Room = true
 	center.Visited = true
 
-	RevealFOV(grid, center, nil)
+	RevealFOVDefault(grid, center, nil)
 
 	for _, rc := range rooms {
 		cell := grid.GetCell(rc[0], rc[1])
@@ -179,3 +198,92 @@ func TestCastRay_stopsAtWall(t *testing.T) {
 		t.Fatal("non-room cell should not be marked visible")
 	}
 }
+
+// makePillarRoom builds an open 11x11 room with a three-cell pillar wall at column 6
+// (rows 4-6) and returns it with the center at (5,4), just west of the pillar.
+func makePillarRoom(t *testing.T) (*Grid, *Cell) {
+	t.Helper()
+	var rooms [][2]int
+	for r := 0; r < 11; r++ {
+		for c := 0; c < 11; c++ {
+			if c == 6 && r >= 4 && r <= 6 {
+				continue
+			}
+			rooms = append(rooms, [2]int{r, c})
+		}
+	}
+	grid, _ := makeFOVGrid(t, 11, 11, rooms)
+	return grid, grid.GetCell(5, 4)
+}
+
+func TestRevealFOV_pillarHidesCellsBehindItAtRadius3(t *testing.T) {
+	grid, center := makePillarRoom(t)
+	RevealFOV(grid, center, 3, DefaultFOVOptions(nil))
+
+	if !grid.GetCell(5, 1).Discovered {
+		t.Error("cell 3 away in the open should be revealed")
+	}
+	if grid.GetCell(5, 0).Discovered {
+		t.Error("cell 4 away should stay hidden at radius 3")
+	}
+	if grid.GetCell(5, 7).Discovered {
+		t.Error("cell behind the pillar should stay hidden when walls block")
+	}
+}
+
+func TestRevealFOV_radius5SeesBehindPillarWhenWallsDoNotBlock(t *testing.T) {
+	grid, center := makePillarRoom(t)
+	RevealFOV(grid, center, 5, FOVOptions{})
+
+	for _, col := range []int{7, 8, 9} {
+		if !grid.GetCell(5, col).Discovered {
+			t.Errorf("cell (5,%d) behind the pillar should be revealed", col)
+		}
+	}
+	if grid.GetCell(5, 10).Discovered {
+		t.Error("cell 6 away should stay hidden at radius 5")
+	}
+	if grid.GetCell(5, 6).Discovered {
+		t.Error("the pillar itself is not a room cell and should not be discovered")
+	}
+}
+
+func TestRevealFOV_radius5WallsStillBlockByDefault(t *testing.T) {
+	grid, center := makePillarRoom(t)
+	RevealFOV(grid, center, 5, DefaultFOVOptions(nil))
+
+	if grid.GetCell(5, 8).Discovered {
+		t.Error("cell behind the pillar should stay hidden when walls block")
+	}
+	if !grid.GetCell(5, 0).Discovered {
+		t.Error("cell 4 away in the open should be revealed at radius 5")
+	}
+}
+
+func TestRevealFOV_darknessShrinksRadius(t *testing.T) {
+	grid, center := makePillarRoom(t)
+	opts := DefaultFOVOptions(nil)
+	opts.Dark = func(*Cell) bool { return true }
+	RevealFOV(grid, center, 3, opts)
+
+	if !grid.GetCell(5, 2).Discovered {
+		t.Error("cell 2 away should be revealed in the dark at radius 3")
+	}
+	if grid.GetCell(5, 1).Discovered {
+		t.Error("cell 3 away should stay hidden when darkness shrinks radius 3 to 2")
+	}
+}
+
+func TestRevealFOV_diagonalPeekPastWallCorner(t *testing.T) {
+	for _, peek := range []bool{true, false} {
+		// Floor at (1,1) and (0,0); walls at (0,1) and (1,0) pinch the diagonal.
+		grid, center := makeFOVGrid(t, 3, 3, [][2]int{{0, 0}})
+		opts := DefaultFOVOptions(nil)
+		opts.DiagonalPeek = peek
+		RevealFOV(grid, center, 0, opts)
+
+		if got := grid.GetCell(0, 0).Discovered; got != peek {
+			t.Errorf("DiagonalPeek=%v: corner cell discovered = %v", peek, got)
+		}
+	}
+}
@@ -101,7 +101,7 @@ func (g *Grid) GetCellRelative(c *Cell, dir Direction) *Cell {
 	if c == nil {
 		return nil
 	}
-	if !dir.IsValid() {
+	if !dir.IsValid() && !dir.IsDiagonal() {
 		return nil
 	}
 	rowRel, colRel := dir.Delta()
@@ -175,18 +175,28 @@ func (g *Grid) MarkAsRoom(row, col int) bool {
 	return true
 }
 
+// CorridorName is the internal room name shared by all corridor cells.
+const CorridorName = "Corridor"
+
 // MarkAsRoomWithName marks a cell as a room and sets its name and description.
+// Using CorridorName marks a corridor, as MarkAsCorridor does.
 func (g *Grid) MarkAsRoomWithName(row, col int, name, description string) bool {
 	cell := g.GetCell(row, col)
 	if cell == nil {
 		return false
 	}
 	cell.Room = true
+	cell.IsCorridor = name == CorridorName
 	cell.Name = name
 	cell.Description = description
 	return true
 }
 
+// MarkAsCorridor marks a cell as a corridor with the given description.
+func (g *Grid) MarkAsCorridor(row, col int, description string) bool {
+	return g.MarkAsRoomWithName(row, col, CorridorName, description)
+}
+
 // GenerateCellDescription returns a room description (deterministic default for test grids).
 func GenerateCellDescription() string {
 	return roomDescriptions[0]
@@ -237,7 +247,7 @@ func (g *Grid) buildCellConnections(current *Cell) {
 		return
 	}
 
-	for _, dir := range AllDirections() {
+	for _, dir := range append(AllDirections(), DiagonalDirections()...) {
 		adj := g.GetCellRelative(current, dir)
 
 		if adj == nil {
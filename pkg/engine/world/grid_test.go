@@ -0,0 +1,54 @@
+package world
+
+import "testing"
+
+func TestMarkAsCorridor_setsAndClearsFlag(t *testing.T) {
+	tests := []struct {
+		name string
+		mark func(g *Grid)
+		want bool
+	}{
+		{"corridor", func(g *Grid) { g.MarkAsCorridor(0, 0, "ROOM_CORRIDOR") }, true},
+		{"corridor by name", func(g *Grid) { g.MarkAsRoomWithName(0, 0, CorridorName, "desc") }, true},
+		{"named room", func(g *Grid) { g.MarkAsRoomWithName(0, 0, "Corridor Control", "desc") }, false},
+		{"corridor remarked as room", func(g *Grid) {
+			g.MarkAsCorridor(0, 0, "ROOM_CORRIDOR")
+			g.MarkAsRoomWithName(0, 0, "Lab", "desc")
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGrid(1, 1)
+			tt.mark(g)
+			if cell := g.GetCell(0, 0); !cell.Room || cell.IsCorridor != tt.want {
+				t.Errorf("Room=%v IsCorridor=%v, want room with IsCorridor=%v", cell.Room, cell.IsCorridor, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildAllCellConnections_linksDiagonals(t *testing.T) {
+	g := NewGrid(3, 3)
+	g.BuildAllCellConnections()
+	center := g.GetCell(1, 1)
+	want := map[Direction][2]int{NorthEast: {0, 2}, SouthEast: {2, 2}, SouthWest: {2, 0}, NorthWest: {0, 0}}
+	for dir, pos := range want {
+		n := center.GetNeighbor(dir)
+		if n == nil || n.Row != pos[0] || n.Col != pos[1] {
+			t.Errorf("%s neighbor = %v, want (%d,%d)", dir, n, pos[0], pos[1])
+			continue
+		}
+		if n.GetNeighbor(dir.Opposite()) != center {
+			t.Errorf("%s neighbor does not link back", dir)
+		}
+		if got, ok := center.DiagonalDirectionTo(n); !ok || got != dir {
+			t.Errorf("DiagonalDirectionTo(%s neighbor) = %s, %v", dir, got, ok)
+		}
+	}
+	if corner := g.GetCell(0, 0); corner.NW != nil || corner.NE != nil || corner.SW != nil {
+		t.Error("edge cells should have no diagonal links off the grid")
+	}
+	if len(center.GetNeighbors()) != 4 {
+		t.Error("GetNeighbors should stay orthogonal")
+	}
+}
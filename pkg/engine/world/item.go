@@ -16,9 +16,15 @@ func NewItemSet() *ItemSet {
 // Item represents a collectible item in the world
 type Item struct {
 	Name string
+	Tag  string // Variant marker, e.g. the colour of a sequenced-generator battery; empty for plain items
 }
 
 // NewItem creates a new item with the given name
 func NewItem(name string) *Item {
 	return &Item{Name: name}
 }
+
+// NewTaggedItem creates a new item carrying a variant tag
+func NewTaggedItem(name, tag string) *Item {
+	return &Item{Name: name, Tag: tag}
+}
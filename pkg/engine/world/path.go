@@ -0,0 +1,61 @@
+package world
+
+// Passable reports whether a path may step onto cell.
+type Passable func(cell *Cell) bool
+
+// FindPath returns the shortest 4-connected path from start to goal, inclusive of
+// both ends, or nil when goal is unreachable. Intermediate cells must satisfy
+// passable; goal itself need not, so a path can end at a blocking device or hazard
+// the player has to stand next to.
+func FindPath(start, goal *Cell, passable Passable) []*Cell {
+	if goal == nil {
+		return nil
+	}
+	return FindNearest(start, func(cell *Cell) bool { return cell == goal }, passable)
+}
+
+// FindNearest returns the shortest 4-connected path from start to the closest cell
+// for which isGoal is true, inclusive of both ends, or nil when none is reachable.
+// Goal cells are tested before passable, as in FindPath. Neighbours are expanded in
+// N, E, S, W order, so ties resolve deterministically.
+func FindNearest(start *Cell, isGoal func(cell *Cell) bool, passable Passable) []*Cell {
+	if start == nil || isGoal == nil {
+		return nil
+	}
+	if isGoal(start) {
+		return []*Cell{start}
+	}
+	parents := map[*Cell]*Cell{start: nil}
+	queue := []*Cell{start}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, n := range cur.GetNeighbors() {
+			if _, seen := parents[n]; seen {
+				continue
+			}
+			if isGoal(n) {
+				parents[n] = cur
+				return unwindPath(parents, n)
+			}
+			if passable == nil || !passable(n) {
+				continue
+			}
+			parents[n] = cur
+			queue = append(queue, n)
+		}
+	}
+	return nil
+}
+
+func unwindPath(parents map[*Cell]*Cell, goal *Cell) []*Cell {
+	var rev []*Cell
+	for c := goal; c != nil; c = parents[c] {
+		rev = append(rev, c)
+	}
+	path := make([]*Cell, len(rev))
+	for i, c := range rev {
+		path[len(rev)-1-i] = c
+	}
+	return path
+}
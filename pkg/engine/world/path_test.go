@@ -0,0 +1,67 @@
+package world
+
+import "testing"
+
+// pathGrid builds a grid from rows of '.', '#' and 'x' (floor, wall, blocked floor).
+func pathGrid(t *testing.T, rows []string) *Grid {
+	t.Helper()
+	g := NewGrid(len(rows), len(rows[0]))
+	g.BuildAllCellConnections()
+	for r, line := range rows {
+		for c, ch := range line {
+			if ch != '#' {
+				g.GetCell(r, c).Room = true
+			}
+			if ch == 'x' {
+				g.GetCell(r, c).Name = "blocked"
+			}
+		}
+	}
+	return g
+}
+
+func pathOpen(cell *Cell) bool { return cell.Room && cell.Name != "blocked" }
+
+func TestFindPath_routesAroundWalls(t *testing.T) {
+	g := pathGrid(t, []string{
+		".#...",
+		".#.#.",
+		"...#.",
+	})
+	path := FindPath(g.GetCell(0, 0), g.GetCell(0, 4), pathOpen)
+	if len(path) != 9 {
+		t.Fatalf("path length = %d, want 9", len(path))
+	}
+	if path[0] != g.GetCell(0, 0) || path[len(path)-1] != g.GetCell(0, 4) {
+		t.Error("path does not start at start and end at goal")
+	}
+	for i := 1; i < len(path); i++ {
+		dr, dc := path[i].Row-path[i-1].Row, path[i].Col-path[i-1].Col
+		if dr*dr+dc*dc != 1 {
+			t.Fatalf("step %d is not 4-connected", i)
+		}
+	}
+}
+
+func TestFindPath_goalMayBeImpassable(t *testing.T) {
+	g := pathGrid(t, []string{"..x"})
+	if path := FindPath(g.GetCell(0, 0), g.GetCell(0, 2), pathOpen); len(path) != 3 {
+		t.Errorf("path to blocked goal length = %d, want 3", len(path))
+	}
+}
+
+func TestFindPath_unreachable(t *testing.T) {
+	g := pathGrid(t, []string{".x."})
+	if path := FindPath(g.GetCell(0, 0), g.GetCell(0, 2), pathOpen); path != nil {
+		t.Errorf("expected nil path through blocked cell, got %d cells", len(path))
+	}
+}
+
+func TestFindNearest_picksClosestGoal(t *testing.T) {
+	g := pathGrid(t, []string{"x...x."})
+	isGoal := func(cell *Cell) bool { return cell.Name == "blocked" }
+	path := FindNearest(g.GetCell(0, 1), isGoal, pathOpen)
+	if len(path) != 2 || path[len(path)-1] != g.GetCell(0, 0) {
+		t.Fatalf("path = %d cells, want 2 ending at (0,0)", len(path))
+	}
+}
@@ -7,20 +7,163 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+
+	"darkstation/pkg/game/debuglog"
 )
 
 const (
 	appName        = "DarkStation"
 	settingsFile   = "settings.ini"
+	bindingsFile   = "bindings.json"
+	autosaveFile   = "autosave.json"
 	defaultSection = "General"
 )
 
+// Camera follow styles, from instant to loosest. CameraFollowTight keeps the camera on the
+// sliding player marker; the looser styles trail behind it and ease to a stop.
+const (
+	CameraFollowSnap    = "snap"
+	CameraFollowTight   = "tight"
+	CameraFollowSmooth  = "smooth"
+	CameraFollowRelaxed = "relaxed"
+)
+
+// CameraFollowStyles lists the camera follow styles in settings-menu order.
+var CameraFollowStyles = []string{CameraFollowSnap, CameraFollowTight, CameraFollowSmooth, CameraFollowRelaxed}
+
+// Room-focus camera easing curves. CameraEasingQuintic (smootherstep) starts and stops
+// softest; CameraEasingLinear moves at a constant rate.
+const (
+	CameraEasingLinear    = "linear"
+	CameraEasingEaseInOut = "ease_in_out"
+	CameraEasingQuintic   = "quintic"
+)
+
+// CameraEasings lists the room-focus easing curves in settings-menu order.
+var CameraEasings = []string{CameraEasingLinear, CameraEasingEaseInOut, CameraEasingQuintic}
+
+// Colorblind palette presets. Each remaps the red/green state colors (generators,
+// hazards, doors, the lift) to hues that stay apart for that kind of color vision.
+const (
+	ColorblindOff          = "off"
+	ColorblindDeuteranopia = "deuteranopia"
+	ColorblindProtanopia   = "protanopia"
+	ColorblindTritanopia   = "tritanopia"
+)
+
+// ColorblindModes lists the palette presets in settings-menu order.
+var ColorblindModes = []string{ColorblindOff, ColorblindDeuteranopia, ColorblindProtanopia, ColorblindTritanopia}
+
+// BindingProfileDefault is the binding profile used until the player switches.
+const BindingProfileDefault = "default"
+
+// BindingProfiles lists the named key and controller binding profiles in settings-menu order.
+var BindingProfiles = []string{BindingProfileDefault, "custom1", "custom2", "custom3"}
+
+// CameraPanDurationsMs lists the room-focus pan lengths offered in settings (0 = instant).
+var CameraPanDurationsMs = []int{0, 250, 500, 750, 1000, 1500}
+
+// FOVRadii lists the sight radii offered in settings (0 = unlimited, the classic rules).
+var FOVRadii = []int{0, 2, 3, 4, 5}
+
+// maxFOVRadius caps a hand-edited fov_radius.
+const maxFOVRadius = 5
+
+// TileSizes lists the Map Zoom tile sizes offered in settings, in pixels. The zoom keys
+// step between them in finer increments.
+var TileSizes = []int{12, 16, 24, 32, 48, 72, 96, 144}
+
+// MaxViewportColsOptions lists the Map Width caps offered in settings, in tiles (0 fills
+// the window). Odd counts keep the player's tile in the middle column.
+var MaxViewportColsOptions = []int{0, 31, 41, 51, 61}
+
+// Volumes lists the Volume percentages offered in settings.
+var Volumes = []int{25, 50, 75, 100}
+
+// UIScales lists the Text Size multipliers offered in settings.
+var UIScales = []float64{0.75, 1, 1.25, 1.5, 2}
+
+// UI scale bounds for a hand-edited ui_scale.
+const (
+	minUIScale = 0.5
+	maxUIScale = 3
+)
+
+// maxCameraPanMs caps a hand-edited camera_pan_ms so the camera cannot stall for long.
+const maxCameraPanMs = 5000
+
+// HardcoreInventoryCap is the inventory limit the hardcore setting applies.
+const HardcoreInventoryCap = 6
+
 // Config holds application settings
 type Config struct {
 	// Display settings
 	TileSize int `ini:"tile_size"`
+	// Widest and tallest the map viewport may be, in tiles; 0 fills the window. Extra
+	// window space is letterboxed (for ultrawide monitors)
+	MaxViewportCols int `ini:"max_viewport_cols"`
+	MaxViewportRows int `ini:"max_viewport_rows"`
+	// Multiplier on UI text (status panel, messages, menus), independent of map zoom
+	UIScale float64 `ini:"ui_scale"`
+	// Draw the batteries still needed on unpowered generator tiles
+	GeneratorBadges bool `ini:"generator_badges"`
+	// Show how many of the deck's rooms have been explored in the status panel
+	RoomProgress bool `ini:"room_progress"`
+	// How the map camera follows the player (one of CameraFollowStyles)
+	CameraFollow string `ini:"camera_follow"`
+	// How long the camera takes to pan to a focused room, in milliseconds (0 jumps there)
+	CameraPanMs int `ini:"camera_pan_ms"`
+	// Easing curve for room-focus camera pans (one of CameraEasings)
+	CameraEasing string `ini:"camera_easing"`
+	// Hold animated map effects (exit pulse, bump bounce, callout slides, shimmer) still
+	ReduceMotion bool `ini:"reduce_motion"`
+	// Silence sound effects and the ambient power hum
+	Muted bool `ini:"muted"`
+	// Sound effect and ambient hum loudness, in percent of the built-in mix
+	Volume int `ini:"volume"`
+	// Palette preset for color vision deficiency (one of ColorblindModes); also gives doors distinct glyphs
+	ColorblindMode string `ini:"colorblind_mode"`
+
+	// Gameplay settings
+	// Nudge the player toward the next objective after a long stretch without progress
+	HintsEnabled bool `ini:"hints_enabled"`
+	// Movement/interact prompts for the first few actions; switched off after the first cleared deck
+	TutorialHints bool `ini:"tutorial_hints"`
+	// How many cells the player can see; 0 is unlimited line of sight. A limited radius shrinks in unpowered rooms
+	FOVRadius int `ini:"fov_radius"`
+	// Reveal a whole named room the first time the player steps into it (corridors stay FOV-limited)
+	RevealRoomOnEntry bool `ini:"reveal_room_on_entry"`
+	// Show a brief "Entering <room>" callout the first time the player walks into each room
+	RoomEntryCallout bool `ini:"room_entry_callout"`
+	// Hold back the first step onto hazardous floor until the player moves again
+	ConfirmRiskyMoves bool `ini:"confirm_risky_moves"`
+	// Random power surges on deep decks
+	PowerSurges bool `ini:"power_surges"`
+	// Leave floor items where they lie until the pick-up key is pressed
+	ManualPickup bool `ini:"manual_pickup"`
+	// Watch for decks that can no longer be finished and offer a reset or reroll
+	SoftLockCheck bool `ini:"soft_lock_check"`
+	// Most non-battery items carried at once; 0 is unlimited (hardcore uses HardcoreInventoryCap)
+	InventoryCap int `ini:"inventory_cap"`
+	// Allow 8-direction movement on the keypad; diagonal steps cannot cut between two walls
+	DiagonalMovement bool `ini:"diagonal_movement"`
+	// Difficulty the new-game screen preselects (a gamemode.Difficulty name); empty is Normal
+	Difficulty string `ini:"difficulty"`
+	// Game mode the mode screen preselects (a gamemode.ID); empty is the standard mode
+	GameMode string `ini:"game_mode"`
+
+	// Controls
+	// Active key and controller binding profile (one of BindingProfiles); its bindings live in BindingProfilePath
+	BindingProfile string `ini:"binding_profile"`
+
+	// Endless mode
+	EndlessHighScore int `ini:"high_score"`
+
+	// Fewest actions (moves plus interactions) to clear each deck, keyed by level and seed
+	pars map[parKey]int
 
 	// Internal: path to config file
 	configPath string
@@ -29,7 +172,20 @@ type Config struct {
 // DefaultConfig returns a Config with default values
 func DefaultConfig() *Config {
 	return &Config{
-		TileSize: 24, // Default tile size
+		TileSize:        24, // Default tile size
+		UIScale:         1,
+		Volume:          100,
+		GeneratorBadges: true,
+		RoomProgress:    true,
+		CameraFollow:    CameraFollowTight,
+		CameraPanMs:     1000,
+		CameraEasing:    CameraEasingQuintic,
+		ColorblindMode:  ColorblindOff,
+		HintsEnabled:    true,
+		TutorialHints:   true,
+		PowerSurges:     true,
+		SoftLockCheck:   true,
+		BindingProfile:  BindingProfileDefault,
 	}
 }
 
@@ -58,6 +214,33 @@ func getConfigPath() (string, error) {
 	return filepath.Join(dir, settingsFile), nil
 }
 
+// BindingsPath returns where exported key and controller bindings are written and read
+func BindingsPath() (string, error) {
+	dir, err := getConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, bindingsFile), nil
+}
+
+// BindingProfilePath returns where the named binding profile's bindings are kept
+func BindingProfilePath(name string) (string, error) {
+	dir, err := getConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "bindings-"+name+".json"), nil
+}
+
+// AutosavePath returns where the run checkpoint written on each new deck is kept
+func AutosavePath() (string, error) {
+	dir, err := getConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, autosaveFile), nil
+}
+
 // Load loads the configuration from disk
 // If the file doesn't exist, returns default config
 func Load() (*Config, error) {
@@ -116,6 +299,140 @@ func Load() (*Config, error) {
 				if v, err := strconv.Atoi(value); err == nil {
 					cfg.TileSize = v
 				}
+			case "max_viewport_cols":
+				if v, err := strconv.Atoi(value); err == nil && v >= 0 {
+					cfg.MaxViewportCols = v
+				}
+			case "max_viewport_rows":
+				if v, err := strconv.Atoi(value); err == nil && v >= 0 {
+					cfg.MaxViewportRows = v
+				}
+			case "ui_scale":
+				if v, err := strconv.ParseFloat(value, 64); err == nil {
+					cfg.UIScale = min(max(v, minUIScale), maxUIScale)
+				}
+			case "generator_badges":
+				if v, err := strconv.ParseBool(value); err == nil {
+					cfg.GeneratorBadges = v
+				}
+			case "room_progress":
+				if v, err := strconv.ParseBool(value); err == nil {
+					cfg.RoomProgress = v
+				}
+			case "camera_follow":
+				for _, style := range CameraFollowStyles {
+					if value == style {
+						cfg.CameraFollow = value
+					}
+				}
+			case "camera_pan_ms":
+				if v, err := strconv.Atoi(value); err == nil && v >= 0 {
+					cfg.CameraPanMs = min(v, maxCameraPanMs)
+				}
+			case "camera_easing":
+				for _, easing := range CameraEasings {
+					if value == easing {
+						cfg.CameraEasing = value
+					}
+				}
+			case "reduce_motion":
+				if v, err := strconv.ParseBool(value); err == nil {
+					cfg.ReduceMotion = v
+				}
+			case "muted":
+				if v, err := strconv.ParseBool(value); err == nil {
+					cfg.Muted = v
+				}
+			case "volume":
+				if v, err := strconv.Atoi(value); err == nil {
+					cfg.Volume = min(max(v, 0), 100)
+				}
+			case "colorblind_mode":
+				for _, mode := range ColorblindModes {
+					if value == mode {
+						cfg.ColorblindMode = value
+					}
+				}
+			}
+		}
+		if currentSection == "Gameplay" {
+			switch key {
+			case "hints_enabled":
+				if v, err := strconv.ParseBool(value); err == nil {
+					cfg.HintsEnabled = v
+				}
+			case "tutorial_hints":
+				if v, err := strconv.ParseBool(value); err == nil {
+					cfg.TutorialHints = v
+				}
+			case "fov_radius":
+				if v, err := strconv.Atoi(value); err == nil && v >= 0 {
+					cfg.FOVRadius = min(v, maxFOVRadius)
+				}
+			case "reveal_room_on_entry":
+				if v, err := strconv.ParseBool(value); err == nil {
+					cfg.RevealRoomOnEntry = v
+				}
+			case "room_entry_callout":
+				if v, err := strconv.ParseBool(value); err == nil {
+					cfg.RoomEntryCallout = v
+				}
+			case "confirm_risky_moves":
+				if v, err := strconv.ParseBool(value); err == nil {
+					cfg.ConfirmRiskyMoves = v
+				}
+			case "power_surges":
+				if v, err := strconv.ParseBool(value); err == nil {
+					cfg.PowerSurges = v
+				}
+			case "manual_pickup":
+				if v, err := strconv.ParseBool(value); err == nil {
+					cfg.ManualPickup = v
+				}
+			case "soft_lock_check":
+				if v, err := strconv.ParseBool(value); err == nil {
+					cfg.SoftLockCheck = v
+				}
+			case "inventory_cap":
+				if v, err := strconv.Atoi(value); err == nil && v >= 0 {
+					cfg.InventoryCap = v
+				}
+			case "diagonal_movement":
+				if v, err := strconv.ParseBool(value); err == nil {
+					cfg.DiagonalMovement = v
+				}
+			case "difficulty":
+				cfg.Difficulty = value
+			case "game_mode":
+				cfg.GameMode = value
+			}
+		}
+		if currentSection == "Controls" {
+			switch key {
+			case "binding_profile":
+				for _, name := range BindingProfiles {
+					if value == name {
+						cfg.BindingProfile = value
+					}
+				}
+			}
+		}
+		if currentSection == "Endless" {
+			switch key {
+			case "high_score":
+				if v, err := strconv.Atoi(value); err == nil {
+					cfg.EndlessHighScore = v
+				}
+			}
+		}
+		if currentSection == "Par" {
+			if k, ok := parseParKey(key); ok {
+				if v, err := strconv.Atoi(value); err == nil && v >= 0 {
+					if cfg.pars == nil {
+						cfg.pars = make(map[parKey]int)
+					}
+					cfg.pars[k] = v
+				}
 			}
 		}
 	}
@@ -162,8 +479,69 @@ func (c *Config) Save() error {
 	// Display section
 	fmt.Fprintln(writer, "[Display]")
 	fmt.Fprintf(writer, "tile_size = %d\n", c.TileSize)
+	fmt.Fprintf(writer, "max_viewport_cols = %d\n", c.MaxViewportCols)
+	fmt.Fprintf(writer, "max_viewport_rows = %d\n", c.MaxViewportRows)
+	fmt.Fprintf(writer, "ui_scale = %g\n", c.UIScale)
+	fmt.Fprintf(writer, "generator_badges = %t\n", c.GeneratorBadges)
+	fmt.Fprintf(writer, "room_progress = %t\n", c.RoomProgress)
+	fmt.Fprintf(writer, "camera_follow = %s\n", c.CameraFollow)
+	fmt.Fprintf(writer, "camera_pan_ms = %d\n", c.CameraPanMs)
+	fmt.Fprintf(writer, "camera_easing = %s\n", c.CameraEasing)
+	fmt.Fprintf(writer, "reduce_motion = %t\n", c.ReduceMotion)
+	fmt.Fprintf(writer, "muted = %t\n", c.Muted)
+	fmt.Fprintf(writer, "volume = %d\n", c.Volume)
+	fmt.Fprintf(writer, "colorblind_mode = %s\n", c.ColorblindMode)
+	fmt.Fprintln(writer)
+
+	// Gameplay section
+	fmt.Fprintln(writer, "[Gameplay]")
+	fmt.Fprintf(writer, "hints_enabled = %t\n", c.HintsEnabled)
+	fmt.Fprintf(writer, "tutorial_hints = %t\n", c.TutorialHints)
+	fmt.Fprintf(writer, "fov_radius = %d\n", c.FOVRadius)
+	fmt.Fprintf(writer, "reveal_room_on_entry = %t\n", c.RevealRoomOnEntry)
+	fmt.Fprintf(writer, "room_entry_callout = %t\n", c.RoomEntryCallout)
+	fmt.Fprintf(writer, "confirm_risky_moves = %t\n", c.ConfirmRiskyMoves)
+	fmt.Fprintf(writer, "power_surges = %t\n", c.PowerSurges)
+	fmt.Fprintf(writer, "manual_pickup = %t\n", c.ManualPickup)
+	fmt.Fprintf(writer, "soft_lock_check = %t\n", c.SoftLockCheck)
+	fmt.Fprintf(writer, "inventory_cap = %d\n", c.InventoryCap)
+	fmt.Fprintf(writer, "diagonal_movement = %t\n", c.DiagonalMovement)
+	fmt.Fprintf(writer, "difficulty = %s\n", c.Difficulty)
+	fmt.Fprintf(writer, "game_mode = %s\n", c.GameMode)
 	fmt.Fprintln(writer)
 
+	// Controls section
+	fmt.Fprintln(writer, "[Controls]")
+	fmt.Fprintf(writer, "binding_profile = %s\n", c.BindingProfile)
+	fmt.Fprintln(writer)
+
+	// Endless section
+	fmt.Fprintln(writer, "[Endless]")
+	fmt.Fprintf(writer, "high_score = %d\n", c.EndlessHighScore)
+	fmt.Fprintln(writer)
+
+	// Par section (best action count per level, seed and variant)
+	if len(c.pars) > 0 {
+		keys := make([]parKey, 0, len(c.pars))
+		for k := range c.pars {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			if keys[i].level != keys[j].level {
+				return keys[i].level < keys[j].level
+			}
+			if keys[i].seed != keys[j].seed {
+				return keys[i].seed < keys[j].seed
+			}
+			return keys[i].variant < keys[j].variant
+		})
+		fmt.Fprintln(writer, "[Par]")
+		for _, k := range keys {
+			fmt.Fprintf(writer, "%s = %d\n", k, c.pars[k])
+		}
+		fmt.Fprintln(writer)
+	}
+
 	return writer.Flush()
 }
 
@@ -173,6 +551,236 @@ func (c *Config) SetTileSize(size int) error {
 	return c.Save()
 }
 
+// SetMaxViewportCols sets the widest the map viewport may be (0 = fill the window) and saves the config
+func (c *Config) SetMaxViewportCols(cols int) error {
+	c.MaxViewportCols = max(cols, 0)
+	return c.Save()
+}
+
+// SetUIScale sets the UI text size multiplier and saves the config
+func (c *Config) SetUIScale(scale float64) error {
+	c.UIScale = scale
+	return c.Save()
+}
+
+// SetGeneratorBadges sets whether generator tiles show batteries needed and saves the config
+func (c *Config) SetGeneratorBadges(on bool) error {
+	c.GeneratorBadges = on
+	return c.Save()
+}
+
+// SetRoomProgress sets whether the status panel shows rooms explored and saves the config
+func (c *Config) SetRoomProgress(on bool) error {
+	c.RoomProgress = on
+	return c.Save()
+}
+
+// SetCameraFollow sets how the map camera follows the player and saves the config
+func (c *Config) SetCameraFollow(style string) error {
+	c.CameraFollow = style
+	return c.Save()
+}
+
+// SetCameraPanMs sets how long room-focus camera pans take and saves the config
+func (c *Config) SetCameraPanMs(ms int) error {
+	c.CameraPanMs = ms
+	return c.Save()
+}
+
+// SetCameraEasing sets the room-focus camera easing curve and saves the config
+func (c *Config) SetCameraEasing(easing string) error {
+	c.CameraEasing = easing
+	return c.Save()
+}
+
+// SetReduceMotion sets whether animated map effects are held still and saves the config
+func (c *Config) SetReduceMotion(on bool) error {
+	c.ReduceMotion = on
+	return c.Save()
+}
+
+// SetMuted sets whether sound effects are silenced and saves the config
+func (c *Config) SetMuted(on bool) error {
+	c.Muted = on
+	return c.Save()
+}
+
+// SetVolume sets the sound volume percent (0-100) and saves the config
+func (c *Config) SetVolume(percent int) error {
+	c.Volume = min(max(percent, 0), 100)
+	return c.Save()
+}
+
+// SetColorblindMode sets the colorblind palette preset and saves the config
+func (c *Config) SetColorblindMode(mode string) error {
+	c.ColorblindMode = mode
+	return c.Save()
+}
+
+// SetHintsEnabled sets whether stuck-player hint nudges are shown and saves the config
+func (c *Config) SetHintsEnabled(on bool) error {
+	c.HintsEnabled = on
+	return c.Save()
+}
+
+// SetTutorialHints sets whether the first-actions tutorial prompts are shown and saves the config
+func (c *Config) SetTutorialHints(on bool) error {
+	c.TutorialHints = on
+	return c.Save()
+}
+
+// SetFOVRadius sets how many cells the player can see (0 = unlimited) and saves the config
+func (c *Config) SetFOVRadius(radius int) error {
+	c.FOVRadius = min(max(radius, 0), maxFOVRadius)
+	return c.Save()
+}
+
+// SetRevealRoomOnEntry sets whether entering a room reveals all of it and saves the config
+func (c *Config) SetRevealRoomOnEntry(on bool) error {
+	c.RevealRoomOnEntry = on
+	return c.Save()
+}
+
+// SetRoomEntryCallout sets whether entering a new room shows its name and saves the config
+func (c *Config) SetRoomEntryCallout(on bool) error {
+	c.RoomEntryCallout = on
+	return c.Save()
+}
+
+// SetConfirmRiskyMoves sets whether stepping onto hazardous floor needs a second move and saves the config
+func (c *Config) SetConfirmRiskyMoves(on bool) error {
+	c.ConfirmRiskyMoves = on
+	return c.Save()
+}
+
+// SetPowerSurges sets whether random power surges happen on deep decks and saves the config
+func (c *Config) SetPowerSurges(on bool) error {
+	c.PowerSurges = on
+	return c.Save()
+}
+
+// SetManualPickup sets whether floor items wait for the pick-up key and saves the config
+func (c *Config) SetManualPickup(on bool) error {
+	c.ManualPickup = on
+	return c.Save()
+}
+
+// SetSoftLockCheck sets whether unfinishable decks are detected and saves the config
+func (c *Config) SetSoftLockCheck(on bool) error {
+	c.SoftLockCheck = on
+	return c.Save()
+}
+
+// SetInventoryCap sets the most non-battery items carried at once (0 = unlimited) and saves the config
+func (c *Config) SetInventoryCap(n int) error {
+	c.InventoryCap = max(n, 0)
+	return c.Save()
+}
+
+// SetDiagonalMovement sets whether the keypad diagonals move the player and saves the config
+func (c *Config) SetDiagonalMovement(on bool) error {
+	c.DiagonalMovement = on
+	return c.Save()
+}
+
+// SetDifficulty sets the difficulty the new-game screen preselects and saves the config
+func (c *Config) SetDifficulty(name string) error {
+	c.Difficulty = name
+	return c.Save()
+}
+
+// SetGameMode sets the game mode the mode screen preselects and saves the config
+func (c *Config) SetGameMode(id string) error {
+	c.GameMode = id
+	return c.Save()
+}
+
+// ResetToDefaults restores every display, gameplay and new-game setting to its default
+// and saves the config. Records (the endless high score and deck pars) and the active
+// binding profile are kept.
+func (c *Config) ResetToDefaults() error {
+	d := DefaultConfig()
+	d.BindingProfile = c.BindingProfile
+	d.EndlessHighScore = c.EndlessHighScore
+	d.pars = c.pars
+	d.configPath = c.configPath
+	*c = *d
+	return c.Save()
+}
+
+// SetBindingProfile sets the active binding profile and saves the config
+func (c *Config) SetBindingProfile(name string) error {
+	c.BindingProfile = name
+	return c.Save()
+}
+
+// RecordEndlessScore saves score as the endless high score when it beats the
+// current one. Returns true when a new high score was set.
+func (c *Config) RecordEndlessScore(score int) (bool, error) {
+	if score <= c.EndlessHighScore {
+		return false, nil
+	}
+	c.EndlessHighScore = score
+	return true, c.Save()
+}
+
+// parKey identifies one generated deck layout. Level and seed alone are not enough: the
+// mode, difficulty and deck size all change what the generator builds, so they travel
+// together as the variant (see state.Game.ParVariant).
+type parKey struct {
+	level   int
+	seed    int64
+	variant string
+}
+
+// String is the settings-file key for the par, e.g. "deck_3_12345_SinglePlayerPuzzle.Normal.Standard".
+func (k parKey) String() string {
+	return fmt.Sprintf("deck_%d_%d_%s", k.level, k.seed, k.variant)
+}
+
+// parseParKey reads a key written by parKey.String. Keys without a variant predate it and
+// cannot be matched to a layout, so they are rejected.
+func parseParKey(s string) (parKey, bool) {
+	rest, ok := strings.CutPrefix(s, "deck_")
+	if !ok {
+		return parKey{}, false
+	}
+	levelStr, rest, ok := strings.Cut(rest, "_")
+	if !ok {
+		return parKey{}, false
+	}
+	seedStr, variant, ok := strings.Cut(rest, "_")
+	if !ok || variant == "" {
+		return parKey{}, false
+	}
+	level, err := strconv.Atoi(levelStr)
+	if err != nil {
+		return parKey{}, false
+	}
+	seed, err := strconv.ParseInt(seedStr, 10, 64)
+	if err != nil {
+		return parKey{}, false
+	}
+	return parKey{level: level, seed: seed, variant: variant}, true
+}
+
+// GetPar returns the fewest actions recorded for clearing the deck built from level and
+// seed under variant.
+func (c *Config) GetPar(level int, seed int64, variant string) (int, bool) {
+	par, ok := c.pars[parKey{level: level, seed: seed, variant: variant}]
+	return par, ok
+}
+
+// SetPar records actions as the par for the deck built from level and seed under variant
+// and saves the config
+func (c *Config) SetPar(level int, seed int64, variant string, actions int) error {
+	if c.pars == nil {
+		c.pars = make(map[parKey]int)
+	}
+	c.pars[parKey{level: level, seed: seed, variant: variant}] = actions
+	return c.Save()
+}
+
 // Global config instance
 var current *Config
 
@@ -183,7 +791,7 @@ func Current() *Config {
 		current, err = Load()
 		if err != nil {
 			// Log error but continue with defaults
-			fmt.Fprintf(os.Stderr, "Warning: could not load config: %v\n", err)
+			debuglog.Warnf("could not load config: %v", err)
 			current = DefaultConfig()
 		}
 	}
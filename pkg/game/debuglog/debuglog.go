@@ -0,0 +1,118 @@
+// Package debuglog writes an opt-in structured log file (-log debug.log) that players
+// can attach to bug reports. Nothing is written until Open is called; every call is a
+// no-op otherwise, so gameplay code can log unconditionally.
+package debuglog
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"runtime/debug"
+	"sync"
+)
+
+var (
+	mu         sync.Mutex
+	file       *os.File
+	logger     *slog.Logger
+	runContext []any // key/value pairs repeated on crash and session-end records
+)
+
+// Open starts logging to path (truncating it) at debug level.
+func Open(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if file != nil {
+		file.Close()
+	}
+	file = f
+	logger = slog.New(slog.NewTextHandler(f, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	return nil
+}
+
+// Close writes a session-end record with the last run context and closes the file.
+func Close() {
+	mu.Lock()
+	defer mu.Unlock()
+	if file == nil {
+		return
+	}
+	logger.Info("session.end", runContext...)
+	file.Close()
+	file = nil
+	logger = nil
+}
+
+// Enabled reports whether a log file is open.
+func Enabled() bool {
+	mu.Lock()
+	defer mu.Unlock()
+	return logger != nil
+}
+
+// SetContext replaces the key/value pairs (run seed, deck, level seed) that identify
+// what was being played; crash and session-end records repeat them.
+func SetContext(args ...any) {
+	mu.Lock()
+	defer mu.Unlock()
+	runContext = append([]any(nil), args...)
+}
+
+func write(level slog.Level, msg string, args []any) {
+	mu.Lock()
+	defer mu.Unlock()
+	if logger != nil {
+		logger.Log(context.Background(), level, msg, args...)
+	}
+}
+
+// Debug logs a low-level event, e.g. a message-log line.
+func Debug(msg string, args ...any) { write(slog.LevelDebug, msg, args) }
+
+// Info logs a key game event with key/value attributes.
+func Info(msg string, args ...any) { write(slog.LevelInfo, msg, args) }
+
+// Warn logs a recoverable problem with key/value attributes.
+func Warn(msg string, args ...any) { write(slog.LevelWarn, msg, args) }
+
+// Error logs a failure with key/value attributes.
+func Error(msg string, args ...any) { write(slog.LevelError, msg, args) }
+
+// Warnf prints "Warning: ..." to stderr, as internal warnings always have, and
+// records the same text in the log file.
+func Warnf(format string, args ...any) {
+	text := fmt.Sprintf(format, args...)
+	fmt.Fprintf(os.Stderr, "Warning: %s\n", text)
+	Warn(text)
+}
+
+// Crash records a recovered panic value, the current stack and the run context, then
+// syncs the file so the record survives the process dying. Callers re-panic afterwards.
+func Crash(r any) {
+	mu.Lock()
+	defer mu.Unlock()
+	if logger == nil {
+		return
+	}
+	args := append([]any{"panic", fmt.Sprint(r), "stack", string(debug.Stack())}, runContext...)
+	logger.Error("crash", args...)
+	file.Sync()
+	fmt.Fprintf(os.Stderr, "Crash details written to %s\n", file.Name())
+}
+
+// Recovered records a panic the game survived (a renderer frame, a game tick) with the
+// stack and run context. Call it from the deferred function that recovered.
+func Recovered(where string, r any) {
+	mu.Lock()
+	defer mu.Unlock()
+	if logger == nil {
+		return
+	}
+	args := append([]any{"where", where, "panic", fmt.Sprint(r), "stack", string(debug.Stack())}, runContext...)
+	logger.Error("recovered", args...)
+}
@@ -0,0 +1,51 @@
+package debuglog
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDisabledCallsAreNoOps(t *testing.T) {
+	if Enabled() {
+		t.Fatal("logging should start disabled")
+	}
+	Info("deck.generated", "level", 1)
+	Crash("boom")
+	Close()
+}
+
+func TestOpen_writesEventsCrashAndSessionEnd(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "debug.log")
+	if err := Open(path); err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	t.Cleanup(func() { Close(); SetContext() })
+
+	SetContext("run_seed", "5EED", "level", 3)
+	Info("deck.generated", "layout", "abc123")
+	Debug("message", "text", "Level reset!")
+	Crash("index out of range")
+	Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	out := string(data)
+	for _, want := range []string{
+		"level=INFO msg=deck.generated layout=abc123",
+		"level=DEBUG msg=message",
+		"level=ERROR msg=crash panic=\"index out of range\"",
+		"stack=",
+		"msg=session.end run_seed=5EED level=3",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log is missing %q:\n%s", want, out)
+		}
+	}
+	if Enabled() {
+		t.Error("Close should disable logging")
+	}
+}
@@ -141,8 +141,9 @@ type DecayParams struct {
 
 // DecayParamsForDeck returns decay parameters for the given deck ID (0-based).
 // Deeper decks have reduced generator output and increased power costs (Phase 4.2).
+// Endless decks past the final deck keep decaying until costs reach double.
 func DecayParamsForDeck(deckID int) DecayParams {
-	if deckID < 0 || deckID >= TotalDecks {
+	if deckID < 0 {
 		return DecayParams{1.0, 1.0}
 	}
 	depth := float64(deckID)
@@ -152,6 +153,9 @@ func DecayParamsForDeck(deckID int) DecayParams {
 		outputMult = 0.5
 	}
 	costMult := 1.0 + 0.08*depth
+	if costMult > 2.0 {
+		costMult = 2.0
+	}
 	return DecayParams{GeneratorOutputMultiplier: outputMult, PowerCostMultiplier: costMult}
 }
 
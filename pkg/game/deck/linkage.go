@@ -11,7 +11,3 @@ func MultiHopLinkageActive(level int, minimalSystems bool) bool {
 
 // MultiHopLinkageToken is the canonical relay string embedded in plaques and furniture.
 const MultiHopLinkageToken = "LINK-MHOP-A"
-
-// MultiHopKeyedSequenceSolution is the PuzzleSequence solution that wears linkage metadata (Story 5.3).
-// Keep aligned with pkg/game/levelgen/puzzles.go sequence entries — second numeric puzzle when ≥2 terminals.
-const MultiHopKeyedSequenceSolution = "2-4-6-8"
@@ -1,5 +1,11 @@
 package deck
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 // ObservationLedPuzzleCuesActive returns true when corridor signage may carry
 // sequence fingerprints matching security puzzles (Story 5.2 / FR26 tiering).
 // Early decks (level < 3) and the minimal final-deck layout stay unchanged.
@@ -10,16 +16,23 @@ func ObservationLedPuzzleCuesActive(level int, minimalSystems bool) bool {
 	return true
 }
 
-// ObservationSeqPlaqueMsgID maps known numeric sequence puzzle solutions to
-// diegetic plaque gettext msgids. Keep in sync with levelgen.PlacePuzzles
-// puzzleSolutions order for sequence-type entries.
-func ObservationSeqPlaqueMsgID(solution string) (msgid string, ok bool) {
-	switch solution {
-	case "1-2-3-4":
-		return "ENV_PLAQUE_OBS_SEQ_1234", true
-	case "2-4-6-8":
-		return "ENV_PLAQUE_OBS_SEQ_2468", true
-	default:
+// ObservationSeqPlaqueMsgID is the plaque msgid for a sequence fingerprint stamp; its
+// text takes the stamp from ObservationSeqStamp as its argument.
+const ObservationSeqPlaqueMsgID = "ENV_PLAQUE_OBS_SEQ"
+
+// ObservationSeqStamp formats a sequence puzzle solution as a corridor stamp:
+// numbers are zero-padded and segments joined with '·' ("3-17-42-8" → "03·17·42·08",
+// "K7-Q2-X9" → "K7·Q2·X9"). Solutions without a digit are direction patterns and
+// carry no stamp.
+func ObservationSeqStamp(solution string) (stamp string, ok bool) {
+	if !strings.ContainsAny(solution, "0123456789") {
 		return "", false
 	}
+	parts := strings.Split(solution, "-")
+	for i, part := range parts {
+		if n, err := strconv.Atoi(part); err == nil {
+			parts[i] = fmt.Sprintf("%02d", n)
+		}
+	}
+	return strings.Join(parts, "·"), true
 }
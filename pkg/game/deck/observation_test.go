@@ -17,20 +17,21 @@ func TestObservationLedPuzzleCuesActive_deckThree_on(t *testing.T) {
 	}
 }
 
-func TestObservationSeqPlaqueMsgID_knownSequences(t *testing.T) {
+func TestObservationSeqStamp_formatsSequences(t *testing.T) {
 	for _, tt := range []struct {
 		in   string
 		want string
 	}{
-		{"1-2-3-4", "ENV_PLAQUE_OBS_SEQ_1234"},
-		{"2-4-6-8", "ENV_PLAQUE_OBS_SEQ_2468"},
+		{"1-2-3-4", "01·02·03·04"},
+		{"3-17-42-8", "03·17·42·08"},
+		{"K7-Q2-X9", "K7·Q2·X9"},
 	} {
-		got, ok := ObservationSeqPlaqueMsgID(tt.in)
+		got, ok := ObservationSeqStamp(tt.in)
 		if !ok || got != tt.want {
 			t.Fatalf("solution %q: got (%q,%v), want (%q,true)", tt.in, got, ok, tt.want)
 		}
 	}
-	if _, ok := ObservationSeqPlaqueMsgID("north-south"); ok {
+	if _, ok := ObservationSeqStamp("north-south"); ok {
 		t.Fatal("pattern solutions should not map to observation plaques")
 	}
 }
@@ -0,0 +1,292 @@
+package devtools
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/zyedidia/generic/mapset"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/deck"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/levelgen"
+	"darkstation/pkg/game/setup"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// ImportedDeckLevel marks g.Level while a deck loaded from a JSON file is being played.
+const ImportedDeckLevel = 996
+
+// deckFileVersion is bumped whenever the deck JSON layout changes incompatibly.
+const deckFileVersion = 1
+
+// maxDeckFileSide bounds the grid a deck file may ask for, so a bad file cannot
+// allocate an enormous grid.
+const maxDeckFileSide = 500
+
+// deckFile is the shareable JSON form of one deck: its room cells, the entities and
+// items on them, and the deck's hints. Wall cells are implied by absence. Name and
+// Objectives let a hand-authored deck replace the deck title and objectives list.
+type deckFile struct {
+	Version    int        `json:"version"`
+	Name       string     `json:"name,omitempty"`
+	Objectives []string   `json:"objectives,omitempty"`
+	Level      int        `json:"level"`
+	Rows       int        `json:"rows"`
+	Cols       int        `json:"cols"`
+	Start      *deckPos   `json:"start"`
+	Exit       *deckPos   `json:"exit"`
+	Cells      []deckCell `json:"cells"`
+	Hints      []string   `json:"hints,omitempty"`
+}
+
+type deckPos struct {
+	Row int `json:"row"`
+	Col int `json:"col"`
+}
+
+type deckCell struct {
+	deckPos
+	Room          string                   `json:"room"`
+	Description   string                   `json:"description,omitempty"`
+	Items         []world.Item             `json:"items,omitempty"`
+	Door          *entities.Door           `json:"door,omitempty"`
+	Generator     *entities.Generator      `json:"generator,omitempty"`
+	Terminal      *entities.CCTVTerminal   `json:"terminal,omitempty"`
+	Puzzle        *entities.PuzzleTerminal `json:"puzzle,omitempty"`
+	Furniture     *entities.Furniture      `json:"furniture,omitempty"`
+	Hazard        *deckHazard              `json:"hazard,omitempty"`
+	HazardControl *deckHazardControl       `json:"hazard_control,omitempty"`
+}
+
+// deckHazard and deckHazardControl stand in for the entity types, which point at each
+// other; a control names its hazard by cell instead.
+type deckHazard struct {
+	Type      entities.HazardType `json:"type"`
+	Fixed     bool                `json:"fixed,omitempty"`
+	Spreading bool                `json:"spreading,omitempty"`
+}
+
+type deckHazardControl struct {
+	Type      entities.HazardType `json:"type"`
+	Activated bool                `json:"activated,omitempty"`
+	Hazard    *deckPos            `json:"hazard,omitempty"`
+}
+
+// EncodeDeckJSON returns the whole current deck as indented JSON, ignoring fog: every
+// room cell with its room name, entities, floor items and furniture contents, plus the
+// start and exit cells and the deck's hints.
+func EncodeDeckJSON(g *state.Game) ([]byte, error) {
+	if g == nil || g.Grid == nil {
+		return nil, fmt.Errorf("no grid")
+	}
+	start := g.Grid.StartCell()
+	if start == nil {
+		start = g.CurrentCell
+	}
+	if start == nil {
+		return nil, fmt.Errorf("deck has no start cell")
+	}
+	exit := g.Grid.ExitCell()
+	if exit == nil {
+		return nil, fmt.Errorf("deck has no exit cell")
+	}
+	f := deckFile{
+		Version:    deckFileVersion,
+		Name:       g.DeckName,
+		Objectives: g.DeckObjectives,
+		Level:      g.Level,
+		Rows:       g.Grid.Rows(),
+		Cols:       g.Grid.Cols(),
+		Start:      &deckPos{start.Row, start.Col},
+		Exit:       &deckPos{exit.Row, exit.Col},
+		Hints:      g.Hints,
+	}
+
+	hazardAt := make(map[*entities.Hazard]deckPos)
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if h := gameworld.GetGameData(cell).Hazard; cell.Room && h != nil {
+			if _, ok := hazardAt[h]; !ok {
+				hazardAt[h] = deckPos{row, col}
+			}
+		}
+	})
+
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if !cell.Room {
+			return
+		}
+		data := gameworld.GetGameData(cell)
+		c := deckCell{
+			deckPos:     deckPos{row, col},
+			Room:        cell.Name,
+			Description: cell.Description,
+			Door:        data.Door,
+			Generator:   data.Generator,
+			Terminal:    data.Terminal,
+			Puzzle:      data.Puzzle,
+			Furniture:   data.Furniture,
+		}
+		cell.ItemsOnFloor.Each(func(item *world.Item) {
+			c.Items = append(c.Items, *item)
+		})
+		if h := data.Hazard; h != nil {
+			c.Hazard = &deckHazard{Type: h.Type, Fixed: h.Fixed, Spreading: h.Spreading}
+		}
+		if hc := data.HazardControl; hc != nil {
+			c.HazardControl = &deckHazardControl{Type: hc.Type, Activated: hc.Activated}
+			if pos, ok := hazardAt[hc.Hazard]; ok {
+				c.HazardControl.Hazard = &pos
+			}
+		}
+		f.Cells = append(f.Cells, c)
+	})
+	return json.MarshalIndent(f, "", "  ")
+}
+
+// DecodeDeckJSON builds a playable deck from JSON written by EncodeDeckJSON (or by
+// hand) and switches g to it, with the player on the start cell. The file must name a
+// start and an exit room cell, with the exit reachable from the start ignoring doors.
+// Like the developer maps the deck sits outside the station's deck graph, so the lift
+// does not lead anywhere.
+func DecodeDeckJSON(g *state.Game, data []byte) error {
+	var f deckFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return err
+	}
+	if f.Version != deckFileVersion {
+		return fmt.Errorf("unsupported deck file version %d", f.Version)
+	}
+	if f.Rows < 1 || f.Cols < 1 || f.Rows > maxDeckFileSide || f.Cols > maxDeckFileSide {
+		return fmt.Errorf("deck size %dx%d out of range", f.Rows, f.Cols)
+	}
+
+	grid := world.NewGrid(f.Rows, f.Cols)
+	var generators []*entities.Generator
+	var controls []deckCell
+	for _, c := range f.Cells {
+		if !grid.MarkAsRoomWithName(c.Row, c.Col, c.Room, c.Description) {
+			return fmt.Errorf("cell (%d,%d) is outside the %dx%d deck", c.Row, c.Col, f.Rows, f.Cols)
+		}
+		cell := grid.GetCell(c.Row, c.Col)
+		for _, item := range c.Items {
+			cell.ItemsOnFloor.Put(world.NewTaggedItem(item.Name, item.Tag))
+		}
+		gd := gameworld.InitGameData(cell)
+		gd.Door = c.Door
+		gd.Generator = c.Generator
+		gd.Terminal = c.Terminal
+		gd.Puzzle = c.Puzzle
+		gd.Furniture = c.Furniture
+		if c.Generator != nil {
+			generators = append(generators, c.Generator)
+		}
+		if h := c.Hazard; h != nil {
+			gd.Hazard = entities.NewHazard(h.Type)
+			gd.Hazard.Fixed = h.Fixed
+			gd.Hazard.Spreading = h.Spreading
+		}
+		if c.HazardControl != nil {
+			controls = append(controls, c)
+		}
+	}
+	// Controls are placed once every hazard exists so they can link to it.
+	for _, c := range controls {
+		hazard := entities.NewHazard(c.HazardControl.Type)
+		if pos := c.HazardControl.Hazard; pos != nil {
+			linked := grid.GetCell(pos.Row, pos.Col)
+			if linked == nil || gameworld.GetGameData(linked).Hazard == nil {
+				return fmt.Errorf("hazard control at (%d,%d) names no hazard at (%d,%d)", c.Row, c.Col, pos.Row, pos.Col)
+			}
+			hazard = gameworld.GetGameData(linked).Hazard
+		}
+		control := entities.NewHazardControl(c.HazardControl.Type, hazard)
+		control.Activated = c.HazardControl.Activated
+		gameworld.GetGameData(grid.GetCell(c.Row, c.Col)).HazardControl = control
+	}
+
+	if f.Start == nil || f.Exit == nil {
+		return fmt.Errorf("a deck needs one start and one exit cell")
+	}
+	start := grid.GetCell(f.Start.Row, f.Start.Col)
+	if start == nil || !start.Room {
+		return fmt.Errorf("start (%d,%d) is not a room cell", f.Start.Row, f.Start.Col)
+	}
+	exit := grid.GetCell(f.Exit.Row, f.Exit.Col)
+	if exit == nil || !exit.Room {
+		return fmt.Errorf("exit (%d,%d) is not a room cell", f.Exit.Row, f.Exit.Col)
+	}
+	grid.SetExitCell(exit)
+	grid.BuildAllCellConnections()
+	grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		gameworld.InitGameData(cell)
+	})
+	noDoors := mapset.New[*world.Cell]()
+	if !levelgen.GetReachableCells(grid, start, &noDoors).Has(exit) {
+		return fmt.Errorf("exit (%d,%d) cannot be reached from the start", f.Exit.Row, f.Exit.Col)
+	}
+	grid.SetStartCell(start)
+	start.Discovered = true
+	start.Visited = true
+
+	g.Grid = grid
+	g.CurrentCell = start
+	g.CurrentDeckID = deck.TotalDecks
+	g.Level = ImportedDeckLevel
+	g.PerfMapScenario = ""
+	g.DeckName = f.Name
+	g.DeckObjectives = append([]string(nil), f.Objectives...)
+	g.HasMap = false
+	g.Hints = append([]string(nil), f.Hints...)
+	g.FoundCodes = make(map[string]bool)
+	g.OwnedItems = mapset.New[*world.Item]()
+	g.ResetObservationCueAnnounced()
+	g.ResetLinkageTokensSeen()
+	g.Generators = generators
+	world.RevealFOVDefault(grid, start, nil)
+	setup.InitRoomPower(g)
+	g.UpdatePowerSupply()
+	g.PowerConsumption = g.CalculatePowerConsumption()
+	return nil
+}
+
+// ExportDeck writes the whole current deck, fog ignored, as a JSON layout and an ASCII
+// map to timestamped files and returns their paths.
+func ExportDeck(g *state.Game) (jsonPath, asciiPath string, err error) {
+	data, err := EncodeDeckJSON(g)
+	if err != nil {
+		return "", "", err
+	}
+	base := fmt.Sprintf("deck%d-layout-%s", g.Level, time.Now().Format("20060102-150405"))
+	if jsonPath, err = filepath.Abs(base + ".json"); err != nil {
+		return "", "", err
+	}
+	if asciiPath, err = filepath.Abs(base + ".txt"); err != nil {
+		return "", "", err
+	}
+	if err := os.WriteFile(jsonPath, data, 0644); err != nil {
+		return "", "", err
+	}
+	if err := os.WriteFile(asciiPath, []byte(renderDeckMap(g, true)), 0644); err != nil {
+		return "", "", err
+	}
+	return jsonPath, asciiPath, nil
+}
+
+// LoadDeckFile reads a deck JSON file and switches g to it (see DecodeDeckJSON).
+func LoadDeckFile(g *state.Game, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := DecodeDeckJSON(g, data); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	g.ClearMessages()
+	logMessage(g, "Loaded deck layout from ITEM{%s}", filepath.Base(path))
+	return nil
+}
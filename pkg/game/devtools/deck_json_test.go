@@ -0,0 +1,117 @@
+package devtools
+
+import (
+	"strings"
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func TestDeckJSON_roundTripsDevMap(t *testing.T) {
+	src := state.NewGame()
+	SwitchToDevMap(src)
+	src.Hints = []string{"Check the lockers"}
+	src.DeckName = "Everything"
+	src.DeckObjectives = []string{"Look around"}
+	data, err := EncodeDeckJSON(src)
+	if err != nil {
+		t.Fatalf("EncodeDeckJSON: %v", err)
+	}
+
+	dst := state.NewGame()
+	if err := DecodeDeckJSON(dst, data); err != nil {
+		t.Fatalf("DecodeDeckJSON: %v", err)
+	}
+	if dst.Level != ImportedDeckLevel || !IsDevLevel(dst.Level) {
+		t.Errorf("Level = %d, want ImportedDeckLevel", dst.Level)
+	}
+	if dst.DeckName != "Everything" || len(dst.DeckObjectives) != 1 {
+		t.Errorf("name = %q, objectives = %v; want the source's", dst.DeckName, dst.DeckObjectives)
+	}
+	if got, want := dst.Grid.StartCell(), src.Grid.StartCell(); got.Row != want.Row || got.Col != want.Col || dst.CurrentCell != got {
+		t.Errorf("start = (%d,%d), want (%d,%d) with the player on it", got.Row, got.Col, want.Row, want.Col)
+	}
+	if got, want := dst.Grid.ExitCell(), src.Grid.ExitCell(); got == nil || got.Row != want.Row || got.Col != want.Col || !got.ExitCell {
+		t.Errorf("exit = %+v, want (%d,%d)", got, want.Row, want.Col)
+	}
+	if len(dst.Generators) != len(src.Generators) || len(dst.Hints) != 1 {
+		t.Errorf("generators = %d, hints = %v; want %d and the source hint", len(dst.Generators), dst.Hints, len(src.Generators))
+	}
+
+	src.Grid.ForEachCell(func(row, col int, want *world.Cell) {
+		got := dst.Grid.GetCell(row, col)
+		if got.Room != want.Room || got.Name != want.Name {
+			t.Fatalf("(%d,%d) room = %v %q, want %v %q", row, col, got.Room, got.Name, want.Room, want.Name)
+		}
+		if got.ItemsOnFloor.Size() != want.ItemsOnFloor.Size() {
+			t.Errorf("(%d,%d) floor items = %d, want %d", row, col, got.ItemsOnFloor.Size(), want.ItemsOnFloor.Size())
+		}
+		w, d := gameworld.GetGameData(want), gameworld.GetGameData(got)
+		if (w.Door == nil) != (d.Door == nil) || (w.Generator == nil) != (d.Generator == nil) ||
+			(w.Terminal == nil) != (d.Terminal == nil) || (w.Puzzle == nil) != (d.Puzzle == nil) ||
+			(w.Furniture == nil) != (d.Furniture == nil) || (w.Hazard == nil) != (d.Hazard == nil) ||
+			(w.HazardControl == nil) != (d.HazardControl == nil) {
+			t.Errorf("(%d,%d) entities differ after the round trip", row, col)
+		}
+		if w.Door != nil && d.Door != nil && d.Door.Locked != w.Door.Locked {
+			t.Errorf("(%d,%d) door locked = %v, want %v", row, col, d.Door.Locked, w.Door.Locked)
+		}
+		if w.Generator != nil && d.Generator != nil && d.Generator.IsPowered() != w.Generator.IsPowered() {
+			t.Errorf("(%d,%d) generator powered = %v, want %v", row, col, d.Generator.IsPowered(), w.Generator.IsPowered())
+		}
+		if w.Furniture != nil && d.Furniture != nil && (w.Furniture.ContainedItem == nil) != (d.Furniture.ContainedItem == nil) {
+			t.Errorf("(%d,%d) furniture contents lost", row, col)
+		}
+		if hc := d.HazardControl; hc != nil {
+			if hc.Hazard == nil || hc.Hazard.Control != hc || hc.Activated != w.HazardControl.Activated || hc.Hazard.Fixed != hc.Activated {
+				t.Errorf("(%d,%d) hazard control not relinked to its hazard", row, col)
+			}
+		}
+	})
+}
+
+func TestDecodeDeckJSON_rejectsBadFiles(t *testing.T) {
+	tests := []struct {
+		name, json, want string
+	}{
+		{"version", `{"version":2,"rows":1,"cols":1}`, "version"},
+		{"size", `{"version":1,"rows":0,"cols":3}`, "out of range"},
+		{"cell outside", `{"version":1,"rows":1,"cols":1,"cells":[{"row":0,"col":4,"room":"A"}]}`, "outside"},
+		{"no exit", `{"version":1,"rows":1,"cols":1,"start":{"row":0,"col":0},"cells":[{"row":0,"col":0,"room":"A"}]}`, "one start and one exit"},
+		{"start on wall", `{"version":1,"rows":1,"cols":2,"start":{"row":0,"col":1},"exit":{"row":0,"col":0},"cells":[{"row":0,"col":0,"room":"A"}]}`, "start"},
+		{"exit unreachable", `{"version":1,"rows":1,"cols":3,"start":{"row":0,"col":0},"exit":{"row":0,"col":2},"cells":[{"row":0,"col":0,"room":"A"},{"row":0,"col":2,"room":"B"}]}`, "cannot be reached"},
+		{"dangling control", `{"version":1,"rows":1,"cols":2,"cells":[{"row":0,"col":0,"room":"A","hazard_control":{"type":1,"hazard":{"row":0,"col":1}}}]}`, "names no hazard"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := DecodeDeckJSON(state.NewGame(), []byte(tt.json))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("err = %v, want one mentioning %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeDeckJSON_handAuthored(t *testing.T) {
+	g := state.NewGame()
+	deck := `{"version":1,"name":"Tutorial","rows":1,"cols":3,"start":{"row":0,"col":0},"exit":{"row":0,"col":2},
+		"cells":[{"row":0,"col":0,"room":"Dock","items":[{"Name":"Battery"}]},
+		{"row":0,"col":1,"room":"Dock","generator":{"Name":"G","BatteriesRequired":1}},
+		{"row":0,"col":2,"room":"Dock"}]}`
+	if err := DecodeDeckJSON(g, []byte(deck)); err != nil {
+		t.Fatalf("DecodeDeckJSON: %v", err)
+	}
+	if g.CurrentCell.East == nil || g.CurrentCell.ItemsOnFloor.Size() != 1 {
+		t.Error("decoded deck should be connected, with the battery on the start cell")
+	}
+	gen := gameworld.GetGameData(g.Grid.GetCell(0, 1)).Generator
+	if gen == nil || gen.IsPowered() || gen.RatedOutput() != entities.GeneratorOutputStandard || len(g.Generators) != 1 {
+		t.Errorf("generator = %+v, want one unpowered standard generator", gen)
+	}
+	if g.DeckName != "Tutorial" || g.DeckObjectives != nil {
+		t.Errorf("name = %q, objectives = %v; want the file's name and no override", g.DeckName, g.DeckObjectives)
+	}
+}
@@ -18,6 +18,15 @@ func ContainsSubstring(s, substr string) bool {
 	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
 }
 
+// DevMapLevel marks g.Level while the developer testing map is loaded.
+const DevMapLevel = 999
+
+// IsDevLevel reports whether level belongs to a developer map (dev map, maintenance pan
+// test, perfmap or a deck loaded from a JSON file) rather than a generated deck.
+func IsDevLevel(level int) bool {
+	return level == DevMapLevel || level == MaintPanTestLevel || level == PerfMapLevel || level == ImportedDeckLevel
+}
+
 // SwitchToDevMap switches the game to a hard-coded 50x50 developer testing map
 // All possible game cells are placed with a 3-cell margin between each, grouped by type in rows
 func SwitchToDevMap(g *state.Game) {
@@ -233,8 +242,9 @@ func SwitchToDevMap(g *state.Game) {
 	// Update game state (CurrentDeckID out of range so graph/lift logic does not apply)
 	g.Grid = grid
 	g.CurrentDeckID = deck.TotalDecks
-	g.Level = 999 // Mark as dev map
+	g.Level = DevMapLevel
 	g.PerfMapScenario = ""
+	g.DeckName, g.DeckObjectives = "", nil
 	g.UpdatePowerSupply()
 	g.PowerConsumption = g.CalculatePowerConsumption()
 	g.ClearMessages()
@@ -72,9 +72,7 @@ func SwitchToMaintPanTestMap(g *state.Game) {
 				if cell == nil {
 					continue
 				}
-				cell.Room = true
-				cell.Name = "Corridor"
-				cell.Description = "ROOM_CORRIDOR"
+				grid.MarkAsCorridor(r, c, "ROOM_CORRIDOR")
 				cell.Discovered = true
 				cell.Visited = true
 			}
@@ -114,6 +112,7 @@ func SwitchToMaintPanTestMap(g *state.Game) {
 	g.CurrentDeckID = deck.TotalDecks
 	g.Level = MaintPanTestLevel
 	g.PerfMapScenario = ""
+	g.DeckName, g.DeckObjectives = "", nil
 	g.HasMap = true
 	g.MaintenanceMenuRoom = ""
 	g.Batteries = 0
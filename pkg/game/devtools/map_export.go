@@ -0,0 +1,114 @@
+package devtools
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"darkstation/pkg/game/state"
+)
+
+// mapExportLegend lists the legend entries for the deck map export, in display order.
+// Keys are the classes returned by getCellHTMLInfo.
+var mapExportLegend = []struct {
+	Class string
+	Label string
+}{
+	{"player", "You"},
+	{"survivor", "Survivor"},
+	{"patrol", "Maintenance bot"},
+	{"floor-visited", "Floor (visited)"},
+	{"floor", "Floor (not yet visited)"},
+	{"wall", "Wall"},
+	{"door-unlocked", "Door"},
+	{"door-locked", "Locked door"},
+	{"generator-off", "Generator (unpowered)"},
+	{"generator-on", "Generator (powered)"},
+	{"terminal", "CCTV terminal"},
+	{"terminal-used", "Used terminal / control"},
+	{"hazard", "Hazard"},
+	{"hazard-ctrl", "Hazard control"},
+	{"furniture", "Furniture"},
+	{"furniture-checked", "Furniture (searched)"},
+	{"keycard", "Keycard"},
+	{"battery", "Battery"},
+	{"item", "Item"},
+	{"exit-locked", "Lift (no power)"},
+	{"exit-pending", "Lift (repairs pending)"},
+	{"exit-unlocked", "Lift (ready)"},
+}
+
+// ExportDeckMap writes the whole current deck as ASCII art with a legend to a
+// standalone text file and returns its path. Only known cells are drawn unless full
+// is set (debug), which ignores fog.
+func ExportDeckMap(g *state.Game, full bool) (string, error) {
+	if g.Grid == nil {
+		return "", fmt.Errorf("no grid")
+	}
+	filename := fmt.Sprintf("deck%d-map-%s-%s.txt", g.Level, mapExportVariant(full), time.Now().Format("20060102-150405"))
+	absPath, err := filepath.Abs(filename)
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(absPath, []byte(renderDeckMap(g, full)), 0644); err != nil {
+		return "", err
+	}
+	return absPath, nil
+}
+
+// renderDeckMap draws the deck cropped to the cells that have anything to show,
+// followed by a legend of the glyphs that appear.
+func renderDeckMap(g *state.Game, full bool) string {
+	rows, cols := g.Grid.Rows(), g.Grid.Cols()
+	icons := make([][]string, rows)
+	seen := make(map[string][]string)
+	minRow, maxRow, minCol, maxCol := rows, -1, cols, -1
+	for row := 0; row < rows; row++ {
+		icons[row] = make([]string, cols)
+		for col := 0; col < cols; col++ {
+			icon, class := getCellHTMLInfo(g, g.Grid.GetCell(row, col), full)
+			icons[row][col] = icon
+			if class == "void" {
+				continue
+			}
+			if !slices.Contains(seen[class], icon) {
+				seen[class] = append(seen[class], icon)
+			}
+			minRow, maxRow = min(minRow, row), max(maxRow, row)
+			minCol, maxCol = min(minCol, col), max(maxCol, col)
+		}
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "The Dark Station - Deck %d map (%s)\n", g.Level, mapExportVariant(full))
+	if g.CurrentCell != nil {
+		fmt.Fprintf(&out, "In: %s\n", g.CurrentCell.Name)
+	}
+	out.WriteString("\n")
+	if maxRow < 0 {
+		out.WriteString("(nothing explored yet)\n")
+		return out.String()
+	}
+	for row := minRow; row <= maxRow; row++ {
+		out.WriteString(strings.TrimRight(strings.Join(icons[row][minCol:maxCol+1], ""), " "))
+		out.WriteString("\n")
+	}
+
+	out.WriteString("\nLegend:\n")
+	for _, entry := range mapExportLegend {
+		if glyphs := seen[entry.Class]; len(glyphs) > 0 {
+			fmt.Fprintf(&out, "  %-8s %s\n", strings.Join(glyphs, " "), entry.Label)
+		}
+	}
+	return out.String()
+}
+
+func mapExportVariant(full bool) string {
+	if full {
+		return "full"
+	}
+	return "explored"
+}
@@ -0,0 +1,76 @@
+package devtools
+
+import (
+	"strings"
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// makeExportTestGame builds a 1x6 strip: the player's room on the left is explored,
+// the right half (with a generator) is still fogged.
+func makeExportTestGame() *state.Game {
+	g := state.NewGame()
+	g.Level = 2
+	grid := world.NewGrid(1, 6)
+	for col := 0; col < 6; col++ {
+		grid.MarkAsRoomWithName(0, col, "Galley", "desc")
+		gameworld.InitGameData(grid.GetCell(0, col))
+	}
+	grid.BuildAllCellConnections()
+	for col := 0; col < 3; col++ {
+		grid.GetCell(0, col).Discovered = true
+		grid.GetCell(0, col).Visited = true
+	}
+	gen := entities.NewGenerator("G1", 1)
+	gameworld.GetGameData(grid.GetCell(0, 5)).Generator = gen
+	g.AddGenerator(gen)
+	g.Grid = grid
+	g.CurrentCell = grid.GetCell(0, 0)
+	return g
+}
+
+func TestRenderDeckMap(t *testing.T) {
+	tests := []struct {
+		name          string
+		full          bool
+		wantGenerator bool
+		wantCols      int
+	}{
+		{"explored respects fog", false, false, 3},
+		{"full ignores fog", true, true, 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := renderDeckMap(makeExportTestGame(), tt.full)
+			if !strings.HasPrefix(out, "The Dark Station - Deck 2 map") {
+				t.Fatalf("missing header:\n%s", out)
+			}
+			if got := strings.Contains(out, "Generator (unpowered)"); got != tt.wantGenerator {
+				t.Errorf("generator in legend = %v, want %v:\n%s", got, tt.wantGenerator, out)
+			}
+			if !strings.Contains(out, "Legend:") || !strings.Contains(out, "You") {
+				t.Errorf("legend should list the player:\n%s", out)
+			}
+			mapLine := strings.Split(out, "\n")[3]
+			if got := len([]rune(mapLine)); got != tt.wantCols {
+				t.Errorf("map row = %q (%d cols), want %d cols", mapLine, got, tt.wantCols)
+			}
+		})
+	}
+}
+
+func TestRenderDeckMap_nothingExplored(t *testing.T) {
+	g := makeExportTestGame()
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		cell.Discovered = false
+		cell.Visited = false
+	})
+	g.CurrentCell = nil
+	if out := renderDeckMap(g, false); !strings.Contains(out, "nothing explored yet") {
+		t.Errorf("expected an empty-map note:\n%s", out)
+	}
+}
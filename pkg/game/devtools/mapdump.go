@@ -228,7 +228,7 @@ func DumpRevealedMapToFile(g *state.Game) (string, error) {
 	var adjRoomNames []string
 	adjSeen := make(map[string]bool)
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
-		if cell == nil || !cell.Room || cell.Name == "" || cell.Name == "Corridor" || adjSeen[cell.Name] {
+		if cell == nil || !cell.Room || cell.Name == "" || cell.IsCorridor || adjSeen[cell.Name] {
 			return
 		}
 		adjSeen[cell.Name] = true
@@ -239,7 +239,7 @@ func DumpRevealedMapToFile(g *state.Game) (string, error) {
 		adj := setup.GetAdjacentRoomNames(g.Grid, rn)
 		filtered := make([]string, 0, len(adj))
 		for _, a := range adj {
-			if a != "Corridor" {
+			if a != world.CorridorName {
 				filtered = append(filtered, a)
 			}
 		}
@@ -103,6 +103,7 @@ func resetPerfGameState(g *state.Game, grid *world.Grid) {
 	g.CurrentDeckID = deck.TotalDecks
 	g.Level = PerfMapLevel
 	g.PerfMapScenario = ""
+	g.DeckName, g.DeckObjectives = "", nil
 	g.LevelSeed = 0
 	g.OwnedItems = mapset.New[*world.Item]()
 	g.Generators = make([]*entities.Generator, 0)
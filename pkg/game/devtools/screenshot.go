@@ -16,18 +16,44 @@ import (
 	gameworld "darkstation/pkg/game/world"
 )
 
-// SaveScreenshotHTML saves the current map view as an HTML file
-func SaveScreenshotHTML(g *state.Game) string {
+// SaveScreenshotHTML saves the current map view as an HTML file. With wholeDeck set it
+// draws every cell the player knows about instead of the viewport around them.
+func SaveScreenshotHTML(g *state.Game, wholeDeck bool) string {
 	timestamp := time.Now().Format("20060102-150405")
 	filename := fmt.Sprintf("screenshot-%s.html", timestamp)
+	if wholeDeck {
+		filename = fmt.Sprintf("screenshot-deck%d-%s.html", g.Level, timestamp)
+	}
+
+	// Write to file
+	os.WriteFile(filename, []byte(renderScreenshotHTML(g, wholeDeck)), 0644)
+	return filename
+}
 
-	viewportRows, viewportCols := renderer.GetViewportSize()
+// screenshotBounds returns the grid rows and columns to draw: the viewport centered on
+// the player, or with wholeDeck the smallest box holding every known cell.
+func screenshotBounds(g *state.Game, wholeDeck bool) (startRow, startCol, rows, cols int) {
+	if !wholeDeck {
+		viewportRows, viewportCols := renderer.GetViewportSize()
+		return g.CurrentCell.Row - viewportRows/2, g.CurrentCell.Col - viewportCols/2, viewportRows, viewportCols
+	}
+	minRow, maxRow, minCol, maxCol := g.Grid.Rows(), -1, g.Grid.Cols(), -1
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if _, class := getCellHTMLInfo(g, cell, false); class == "void" {
+			return
+		}
+		minRow, maxRow = min(minRow, row), max(maxRow, row)
+		minCol, maxCol = min(minCol, col), max(maxCol, col)
+	})
+	if maxRow < 0 {
+		return g.CurrentCell.Row, g.CurrentCell.Col, 1, 1
+	}
+	return minRow, minCol, maxRow - minRow + 1, maxCol - minCol + 1
+}
 
-	// Calculate viewport bounds centered on player
-	playerRow := g.CurrentCell.Row
-	playerCol := g.CurrentCell.Col
-	startRow := playerRow - viewportRows/2
-	startCol := playerCol - viewportCols/2
+// renderScreenshotHTML builds the screenshot page: map, inventory, generators and messages.
+func renderScreenshotHTML(g *state.Game, wholeDeck bool) string {
+	startRow, startCol, viewportRows, viewportCols := screenshotBounds(g, wholeDeck)
 
 	// Build the HTML
 	var html strings.Builder
@@ -84,6 +110,8 @@ func SaveScreenshotHTML(g *state.Game) string {
         .furniture-checked { color: #aaaa00; }
         .exit-locked { color: #ff4444; font-weight: bold; }
         .exit-unlocked { color: #00aa00; }
+        .survivor { color: #60e6aa; font-weight: bold; }
+        .patrol { color: #ff6e28; font-weight: bold; }
         .void { color: #1a1a2e; }
         .inventory {
             margin-top: 20px;
@@ -102,13 +130,17 @@ func SaveScreenshotHTML(g *state.Game) string {
 `)
 
 	// Header
-	html.WriteString(fmt.Sprintf(`    <div class="header">Deck %d</div>`+"\n", g.Level))
+	if wholeDeck {
+		html.WriteString(fmt.Sprintf(`    <div class="header">Deck %d (whole deck)</div>`+"\n", g.Level))
+	} else {
+		html.WriteString(fmt.Sprintf(`    <div class="header">Deck %d</div>`+"\n", g.Level))
+	}
 	html.WriteString(fmt.Sprintf(`    <div class="room-name">In: %s</div>`+"\n", g.CurrentCell.Name))
 
 	// Map container
 	html.WriteString(`    <div class="map-container">` + "\n")
 
-	// Render the viewport
+	// Render the viewport (or the known deck)
 	for vRow := 0; vRow < viewportRows; vRow++ {
 		mapRow := startRow + vRow
 		html.WriteString(`        <div class="map-row">`)
@@ -116,7 +148,7 @@ func SaveScreenshotHTML(g *state.Game) string {
 		for vCol := 0; vCol < viewportCols; vCol++ {
 			mapCol := startCol + vCol
 			cell := g.Grid.GetCell(mapRow, mapCol)
-			icon, class := getCellHTMLInfo(g, cell)
+			icon, class := getCellHTMLInfo(g, cell, false)
 			html.WriteString(fmt.Sprintf(`<span class="%s">%s</span>`, class, icon))
 		}
 
@@ -161,6 +193,9 @@ func SaveScreenshotHTML(g *state.Game) string {
 			}
 		}
 		html.WriteString(`</div>` + "\n")
+		if needed := g.TotalBatteriesNeeded(); needed > 0 {
+			html.WriteString(fmt.Sprintf(`    <div class="inventory"><span class="battery">Batteries needed: %d (have %d)</span></div>`+"\n", needed, g.Batteries))
+		}
 	}
 
 	// Messages
@@ -178,16 +213,16 @@ func SaveScreenshotHTML(g *state.Game) string {
 </html>
 `)
 
-	// Write to file
-	os.WriteFile(filename, []byte(html.String()), 0644)
-	return filename
+	return html.String()
 }
 
-// getCellHTMLInfo returns the icon and CSS class for a cell
-func getCellHTMLInfo(g *state.Game, r *world.Cell) (string, string) {
+// getCellHTMLInfo returns the icon and CSS class for a cell.
+// With revealAll set, fog is ignored and every cell shows as if mapped.
+func getCellHTMLInfo(g *state.Game, r *world.Cell, revealAll bool) (string, string) {
 	if r == nil {
 		return " ", "void"
 	}
+	mapped := g.HasMap || revealAll
 
 	// Player position
 	if g.CurrentCell == r {
@@ -197,15 +232,25 @@ func getCellHTMLInfo(g *state.Game, r *world.Cell) (string, string) {
 	// Get game-specific data for this cell
 	data := gameworld.GetGameData(r)
 
+	// Patrol bot (show if discovered; it moves, so the map alone does not reveal it)
+	if gameworld.HasPatrol(r) && (revealAll || r.Discovered) {
+		return rendererebiten.IconPatrol, "patrol"
+	}
+
+	// Survivor (show if discovered; they move, so the map alone does not reveal them)
+	if gameworld.HasSurvivor(r) && (revealAll || r.Discovered) {
+		return rendererebiten.IconSurvivor, "survivor"
+	}
+
 	// Hazard (show if has map or discovered)
-	if gameworld.HasHazard(r) && (g.HasMap || r.Discovered) {
+	if gameworld.HasHazard(r) && (mapped || r.Discovered) {
 		if data.Hazard.IsBlocking() {
 			return data.Hazard.GetIcon(), "hazard"
 		}
 	}
 
 	// Hazard Control (show if has map or discovered)
-	if gameworld.HasHazardControl(r) && (g.HasMap || r.Discovered) {
+	if gameworld.HasHazardControl(r) && (mapped || r.Discovered) {
 		if !data.HazardControl.Activated {
 			return entities.GetControlIcon(data.HazardControl.Type), "hazard-ctrl"
 		}
@@ -213,7 +258,7 @@ func getCellHTMLInfo(g *state.Game, r *world.Cell) (string, string) {
 	}
 
 	// Door (show if has map or discovered)
-	if gameworld.HasDoor(r) && (g.HasMap || r.Discovered) {
+	if gameworld.HasDoor(r) && (mapped || r.Discovered) {
 		if data.Door.Locked {
 			return rendererebiten.IconDoorLocked, "door-locked"
 		}
@@ -221,7 +266,7 @@ func getCellHTMLInfo(g *state.Game, r *world.Cell) (string, string) {
 	}
 
 	// Generator (show if has map or discovered)
-	if gameworld.HasGenerator(r) && (g.HasMap || r.Discovered) {
+	if gameworld.HasGenerator(r) && (mapped || r.Discovered) {
 		if data.Generator.IsPowered() {
 			return "◆", "generator-on"
 		}
@@ -229,7 +274,7 @@ func getCellHTMLInfo(g *state.Game, r *world.Cell) (string, string) {
 	}
 
 	// CCTV Terminal (show if has map or discovered)
-	if gameworld.HasTerminal(r) && (g.HasMap || r.Discovered) {
+	if gameworld.HasTerminal(r) && (mapped || r.Discovered) {
 		if data.Terminal.IsUsed() {
 			return "▪", "terminal-used"
 		}
@@ -237,7 +282,7 @@ func getCellHTMLInfo(g *state.Game, r *world.Cell) (string, string) {
 	}
 
 	// Furniture (show if has map or discovered)
-	if gameworld.HasFurniture(r) && (g.HasMap || r.Discovered) {
+	if gameworld.HasFurniture(r) && (mapped || r.Discovered) {
 		if data.Furniture.IsChecked() {
 			return data.Furniture.Icon, "furniture-checked"
 		}
@@ -245,11 +290,11 @@ func getCellHTMLInfo(g *state.Game, r *world.Cell) (string, string) {
 	}
 
 	// Exit cell (show if has map or discovered)
-	if r.ExitCell && (g.HasMap || r.Discovered) {
+	if r.ExitCell && (mapped || r.Discovered) {
 		switch setup.ExitLiftState(g) {
 		case state.ExitLiftLockedUnpowered:
 			return "▲", "exit-locked"
-		case state.ExitLiftLockedIncomplete:
+		case state.ExitLiftLockedIncomplete, state.ExitLiftLockedLowPower:
 			return "▲", "exit-pending"
 		default:
 			return "△", "exit-unlocked"
@@ -257,7 +302,7 @@ func getCellHTMLInfo(g *state.Game, r *world.Cell) (string, string) {
 	}
 
 	// Items on floor (show if has map or discovered)
-	if r.ItemsOnFloor.Size() > 0 && (g.HasMap || r.Discovered) {
+	if r.ItemsOnFloor.Size() > 0 && (mapped || r.Discovered) {
 		if cellHasKeycard(r) {
 			return "K", "keycard"
 		}
@@ -281,12 +326,12 @@ func getCellHTMLInfo(g *state.Game, r *world.Cell) (string, string) {
 	}
 
 	// Has map - show rooms faintly
-	if g.HasMap && r.Room {
+	if mapped && r.Room {
 		return getFloorIconHTML(r.Name, false), "floor"
 	}
 
 	// Non-room cells adjacent to discovered/visited rooms render as walls
-	if !r.Room && hasAdjacentDiscoveredRoomHTML(r) {
+	if !r.Room && hasAdjacentDiscoveredRoomHTML(r, revealAll) {
 		return "▒", "wall"
 	}
 
@@ -336,10 +381,11 @@ func getFloorIconHTML(roomName string, visited bool) string {
 }
 
 // hasAdjacentDiscoveredRoomHTML checks if any adjacent cell is a discovered or visited room
-func hasAdjacentDiscoveredRoomHTML(c *world.Cell) bool {
+// (or any room at all when revealAll is set)
+func hasAdjacentDiscoveredRoomHTML(c *world.Cell, revealAll bool) bool {
 	neighbors := []*world.Cell{c.North, c.East, c.South, c.West}
 	for _, n := range neighbors {
-		if n != nil && n.Room && (n.Discovered || n.Visited) {
+		if n != nil && n.Room && (revealAll || n.Discovered || n.Visited) {
 			return true
 		}
 	}
@@ -0,0 +1,28 @@
+package devtools
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderScreenshotHTML_wholeDeckRespectsFog(t *testing.T) {
+	g := makeExportTestGame()
+	g.CurrentCell = g.Grid.GetCell(0, 2)
+
+	startRow, startCol, rows, cols := screenshotBounds(g, true)
+	if startRow != 0 || startCol != 0 || rows != 1 || cols != 3 {
+		t.Fatalf("bounds = (%d,%d) %dx%d, want (0,0) 1x3 covering the explored cells", startRow, startCol, rows, cols)
+	}
+
+	out := renderScreenshotHTML(g, true)
+	if !strings.Contains(out, "Deck 2 (whole deck)") {
+		t.Error("whole-deck screenshot should say so in the header")
+	}
+	if got := strings.Count(out, `<div class="map-row">`); got != 1 {
+		t.Errorf("map rows = %d, want 1", got)
+	}
+	mapHTML := out[strings.Index(out, `<div class="map-container">`):strings.Index(out, `<div class="inventory">`)]
+	if strings.Contains(mapHTML, `class="generator-off"`) {
+		t.Error("the fogged generator should not appear in the screenshot")
+	}
+}
@@ -2,12 +2,94 @@
 // These are space station themed objects that extend the generic engine primitives.
 package entities
 
+import "strings"
+
+// KeycardTier grades keycard doors by clearance. A master keycard opens any door whose
+// tier is at or below its own, so a higher tier outranks every lower one.
+type KeycardTier int
+
+const (
+	KeycardTierNone KeycardTier = iota // not a master keycard
+	KeycardTierGreen
+	KeycardTierBlue
+	KeycardTierRed
+)
+
+// String returns the tier's colour name.
+func (t KeycardTier) String() string {
+	switch t {
+	case KeycardTierGreen:
+		return "Green"
+	case KeycardTierBlue:
+		return "Blue"
+	case KeycardTierRed:
+		return "Red"
+	}
+	return ""
+}
+
+// Opens reports whether a card of tier t clears a door of tier door. Doors with no
+// tier count as Green.
+func (t KeycardTier) Opens(door KeycardTier) bool {
+	return t != KeycardTierNone && t >= max(door, KeycardTierGreen)
+}
+
+// Above returns the next tier up from t, staying at Red once there.
+func (t KeycardTier) Above() KeycardTier {
+	return min(t+1, KeycardTierRed)
+}
+
+// KeycardTierForLevel returns the door tier for a deck: Green on the upper decks,
+// Blue in the middle of the station and Red from deck 7 down.
+func KeycardTierForLevel(level int) KeycardTier {
+	switch {
+	case level >= 7:
+		return KeycardTierRed
+	case level >= 4:
+		return KeycardTierBlue
+	}
+	return KeycardTierGreen
+}
+
+// masterKeycardSuffix ends every master keycard name, after the tier colour.
+const masterKeycardSuffix = " Master Keycard"
+
+// MasterKeycardName returns the item name of the master keycard for tier t.
+func MasterKeycardName(t KeycardTier) string {
+	return t.String() + masterKeycardSuffix
+}
+
+// MasterKeycardTier returns the tier of a master keycard item name, or KeycardTierNone
+// when name is not a master keycard.
+func MasterKeycardTier(name string) KeycardTier {
+	colour, ok := strings.CutSuffix(name, masterKeycardSuffix)
+	if !ok {
+		return KeycardTierNone
+	}
+	for t := KeycardTierGreen; t <= KeycardTierRed; t++ {
+		if t.String() == colour {
+			return t
+		}
+	}
+	return KeycardTierNone
+}
+
 // Door represents a door that connects a room to a corridor.
-// Doors can be unlocked with keycards matching the room name.
+// Doors can be unlocked with keycards matching the room name, or with a master
+// keycard of at least the door's Tier.
 type Door struct {
 	RoomName     string // Name of the room this door belongs to
 	Locked       bool
-	KeycardGated bool // true for level keycard doors; stays passable without power once unlocked
+	KeycardGated bool        // true for level keycard doors; stays passable without power once unlocked
+	RequiresCode string      // non-empty for vault doors: the access code must be found as well as the keycard
+	Tier         KeycardTier // lowest master keycard tier that opens the door
+
+	// Pickable doors can also be opened one cell at a time by picking the lock;
+	// PickDifficulty is the lock's pin count (see LockpickDifficulty) and PickSlips the
+	// wrong pins pushed so far, kept so backing away does not restore the pick.
+	Pickable       bool
+	PickDifficulty int
+	PickSlips      int
 }
 
 // NewDoor creates a new locked keycard door for the given room.
@@ -16,6 +98,7 @@ func NewDoor(roomName string) *Door {
 		RoomName:     roomName,
 		Locked:       true,
 		KeycardGated: true,
+		Tier:         KeycardTierGreen,
 	}
 }
 
@@ -42,3 +125,14 @@ func (d *Door) KeycardName() string {
 func (d *Door) DoorName() string {
 	return d.RoomName + " Door"
 }
+
+// CanPick reports whether the door's lock can be picked: a locked pickable door that
+// is not a vault.
+func (d *Door) CanPick() bool {
+	return d != nil && d.Locked && d.Pickable && !d.IsVault()
+}
+
+// IsVault reports whether the door needs an access code in addition to its keycard.
+func (d *Door) IsVault() bool {
+	return d != nil && d.RequiresCode != ""
+}
@@ -0,0 +1,52 @@
+package entities
+
+import "testing"
+
+func TestKeycardTier_Opens(t *testing.T) {
+	tests := []struct {
+		card, door KeycardTier
+		want       bool
+	}{
+		{KeycardTierGreen, KeycardTierGreen, true},
+		{KeycardTierBlue, KeycardTierGreen, true},
+		{KeycardTierRed, KeycardTierBlue, true},
+		{KeycardTierGreen, KeycardTierBlue, false},
+		{KeycardTierBlue, KeycardTierRed, false},
+		{KeycardTierGreen, KeycardTierNone, true},
+		{KeycardTierNone, KeycardTierNone, false},
+	}
+	for _, tc := range tests {
+		if got := tc.card.Opens(tc.door); got != tc.want {
+			t.Errorf("%v.Opens(%v) = %v, want %v", tc.card, tc.door, got, tc.want)
+		}
+	}
+}
+
+func TestMasterKeycardTier_roundTrip(t *testing.T) {
+	for tier := KeycardTierGreen; tier <= KeycardTierRed; tier++ {
+		if got := MasterKeycardTier(MasterKeycardName(tier)); got != tier {
+			t.Errorf("MasterKeycardTier(%q) = %v, want %v", MasterKeycardName(tier), got, tier)
+		}
+	}
+	for _, name := range []string{"Lab Keycard", "Purple Master Keycard", "Red Master"} {
+		if got := MasterKeycardTier(name); got != KeycardTierNone {
+			t.Errorf("MasterKeycardTier(%q) = %v, want none", name, got)
+		}
+	}
+}
+
+func TestKeycardTier_Above(t *testing.T) {
+	for tier, want := range map[KeycardTier]KeycardTier{KeycardTierGreen: KeycardTierBlue, KeycardTierBlue: KeycardTierRed, KeycardTierRed: KeycardTierRed} {
+		if got := tier.Above(); got != want {
+			t.Errorf("%v.Above() = %v, want %v", tier, got, want)
+		}
+	}
+}
+
+func TestKeycardTierForLevel(t *testing.T) {
+	for level, want := range map[int]KeycardTier{1: KeycardTierGreen, 3: KeycardTierGreen, 4: KeycardTierBlue, 6: KeycardTierBlue, 7: KeycardTierRed, 10: KeycardTierRed} {
+		if got := KeycardTierForLevel(level); got != want {
+			t.Errorf("KeycardTierForLevel(%d) = %v, want %v", level, got, want)
+		}
+	}
+}
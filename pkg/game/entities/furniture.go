@@ -10,6 +10,7 @@ type Furniture struct {
 	Description   string      // Hint text shown when player is adjacent
 	Icon          string      // Icon to display on the map
 	Checked       bool        // Whether the player has examined this furniture
+	CodeRevealed  bool        // Whether a puzzle code in Description has been reported to the player
 	ContainedItem *world.Item // Item hidden in this furniture (if any)
 	PowerConduit  bool        // Hard-linked power feed; walkable and conductive
 }
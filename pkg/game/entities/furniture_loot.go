@@ -0,0 +1,75 @@
+package entities
+
+import (
+	"strings"
+)
+
+// FurnitureLoot is one line of a room's loot table.
+type FurnitureLoot struct {
+	Item string // Item name, or a fragment of it such as "Keycard"
+	// Weight scales the mode's hide-in-furniture chance for matching floor items, in
+	// percent (100 = unchanged). Zero leaves the item to the default table.
+	Weight int
+	// Stock is the percent chance that furniture left empty starts with a fresh Item.
+	Stock int
+}
+
+// DefaultFurnitureLoot lists the floor items furniture may hide in any room: the
+// puzzle items a player is sent looking for.
+var DefaultFurnitureLoot = []FurnitureLoot{
+	{Item: "Keycard", Weight: 100},
+	{Item: "Patch Kit", Weight: 100},
+}
+
+// RoomLoot biases what furniture holds by room type, so a room's name says something
+// about what its lockers are worth. Keys match room names the way RoomFurniture does.
+var RoomLoot = map[string][]FurnitureLoot{
+	"Armory": {
+		{Item: "Keycard", Weight: 200},
+		{Item: "Patch Kit", Weight: 50},
+	},
+	"Security": {
+		{Item: "Keycard", Weight: 150},
+	},
+	"Med Bay": {
+		{Item: "Keycard", Weight: 50},
+		{Item: "Patch Kit", Weight: 200},
+	},
+	"Engineering": {
+		{Item: "Battery", Stock: 20},
+	},
+	"Reactor Core": {
+		{Item: "Battery", Stock: 15},
+	},
+	"Maintenance Bay": {
+		{Item: "Battery", Stock: 15},
+	},
+}
+
+// GetLootForRoom returns the loot table for a room type, or nil when the room has
+// none. The longest matching key wins so lookups do not depend on map order.
+func GetLootForRoom(roomName string) []FurnitureLoot {
+	best := ""
+	for baseRoom := range RoomLoot {
+		if len(baseRoom) > len(best) && strings.Contains(roomName, baseRoom) {
+			best = baseRoom
+		}
+	}
+	if best == "" {
+		return nil
+	}
+	return RoomLoot[best]
+}
+
+// FurnitureHideWeight returns the hide-chance weight (percent) for an item found on
+// the floor of roomName; zero means furniture there never hides it.
+func FurnitureHideWeight(roomName, itemName string) int {
+	for _, table := range [][]FurnitureLoot{GetLootForRoom(roomName), DefaultFurnitureLoot} {
+		for _, loot := range table {
+			if loot.Weight > 0 && strings.Contains(itemName, loot.Item) {
+				return loot.Weight
+			}
+		}
+	}
+	return 0
+}
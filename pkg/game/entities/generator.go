@@ -1,21 +1,106 @@
 package entities
 
+// Generator output ratings in watts. Larger units take more batteries to start.
+const (
+	GeneratorOutputStandard   = 100
+	GeneratorOutputHeavy      = 125
+	GeneratorOutputIndustrial = 150
+)
+
+// BatteryCharge is how many drain ticks one inserted battery lasts in survival runs.
+const BatteryCharge = 30
+
 // Generator represents a power generator that requires batteries to activate
 type Generator struct {
 	Name              string
 	BatteriesRequired int
 	BatteriesInserted int
+	OutputWatts       int  // Rated output when powered (0 means GeneratorOutputStandard)
 	Online            bool // Running after startup sequence (hold USE)
 	Tripped           bool // Overload shut down; batteries may remain until restart
 	Permanent         bool // Ship fusion reactor; always powered and immune to trip
+	InteractRadius    int  // Cells away a large unit can be used from (0 or 1 = adjacent only)
+	// BatterySequence lists the tagged batteries a sequenced generator accepts, in
+	// insertion order. Empty for ordinary generators, which take any battery.
+	BatterySequence []string
+	// AutoInsertHeld is set once batteries are withdrawn so walking past the generator
+	// does not refuel it straight away; using the generator clears it.
+	AutoInsertHeld bool
+	// Spent counts drain ticks taken from the battery in use (survival runs only).
+	Spent int
+}
+
+// SequencedBatteryTags are the battery tags sequenced generators draw from, in the
+// order a sequence uses them.
+var SequencedBatteryTags = []string{"Red", "Amber", "Green", "Blue"}
+
+// TaggedBatteryName returns the item name of the battery carrying tag (e.g. "Red Battery").
+func TaggedBatteryName(tag string) string {
+	return tag + " Battery"
 }
 
-// NewGenerator creates a new unpowered generator
+// NewGenerator creates a new unpowered generator with the standard output rating
 func NewGenerator(name string, batteriesRequired int) *Generator {
 	return &Generator{
 		Name:              name,
 		BatteriesRequired: batteriesRequired,
 		BatteriesInserted: 0,
+		OutputWatts:       GeneratorOutputStandard,
+	}
+}
+
+// NewSequencedGenerator creates an unpowered generator that only starts once the tagged
+// batteries in sequence are inserted in that order; plain batteries do not fit it.
+func NewSequencedGenerator(name string, sequence []string) *Generator {
+	gen := NewGenerator(name, len(sequence))
+	gen.OutputWatts = GeneratorOutputForBatteries(len(sequence))
+	gen.BatterySequence = append([]string(nil), sequence...)
+	return gen
+}
+
+// GeneratorOutputForBatteries returns the output rating for a generator that needs
+// batteriesRequired batteries: hungrier units are the bigger ones.
+func GeneratorOutputForBatteries(batteriesRequired int) int {
+	switch {
+	case batteriesRequired >= 4:
+		return GeneratorOutputIndustrial
+	case batteriesRequired >= 2:
+		return GeneratorOutputHeavy
+	default:
+		return GeneratorOutputStandard
+	}
+}
+
+// RatedOutput returns the generator's output in watts when powered.
+func (g *Generator) RatedOutput() int {
+	if g == nil {
+		return 0
+	}
+	if g.OutputWatts <= 0 {
+		return GeneratorOutputStandard
+	}
+	return g.OutputWatts
+}
+
+// PartialOutput returns the brownout watts of an underfueled generator: its rated output
+// scaled by BatteriesInserted/BatteriesRequired. Running, empty, fully fueled (awaiting
+// startup), tripped and permanent generators give no partial output.
+func (g *Generator) PartialOutput() int {
+	if g == nil || g.Permanent || g.Tripped || g.BatteriesInserted <= 0 || g.BatteriesInserted >= g.BatteriesRequired {
+		return 0
+	}
+	return g.RatedOutput() * g.BatteriesInserted / g.BatteriesRequired
+}
+
+// TierName returns a short label for the generator's output rating.
+func (g *Generator) TierName() string {
+	switch out := g.RatedOutput(); {
+	case out >= GeneratorOutputIndustrial:
+		return "Industrial"
+	case out >= GeneratorOutputHeavy:
+		return "Heavy"
+	default:
+		return "Standard"
 	}
 }
 
@@ -49,6 +134,22 @@ func (g *Generator) Trip() {
 	}
 }
 
+// Blowout trips a running generator after a sustained overload and burns out one of its
+// batteries, so it needs refueling before it can restart. Permanent and sequenced
+// generators are immune (a sequenced unit could not take its burned tagged battery
+// again). Returns false when nothing happened.
+func (g *Generator) Blowout() bool {
+	if g == nil || g.Permanent || g.IsSequenced() || !g.IsPowered() {
+		return false
+	}
+	g.Trip()
+	if g.BatteriesInserted > 0 {
+		g.BatteriesInserted--
+	}
+	g.Spent = 0
+	return true
+}
+
 // BringOnline starts the generator when it has enough batteries and is not tripped.
 func (g *Generator) BringOnline() bool {
 	if g == nil || g.Tripped || !g.HasEnoughBatteries() {
@@ -76,9 +177,43 @@ func (g *Generator) BatteriesNeeded() int {
 	return needed
 }
 
+// IsSequenced reports whether the generator only takes tagged batteries in a set order.
+func (g *Generator) IsSequenced() bool {
+	return g != nil && len(g.BatterySequence) > 0
+}
+
+// NextBatteryTag returns the tag of the battery a sequenced generator takes next, or ""
+// when it is not sequenced or already has every battery.
+func (g *Generator) NextBatteryTag() string {
+	if !g.IsSequenced() || g.BatteriesInserted >= len(g.BatterySequence) {
+		return ""
+	}
+	return g.BatterySequence[g.BatteriesInserted]
+}
+
+// InsertTaggedBattery inserts one battery into a sequenced generator when tag is the
+// one it takes next. Returns false (and inserts nothing) for an out-of-order tag.
+// Does not start the generator; call BringOnline after the startup sequence.
+func (g *Generator) InsertTaggedBattery(tag string) bool {
+	if tag == "" || tag != g.NextBatteryTag() {
+		return false
+	}
+	g.BatteriesInserted++
+	return true
+}
+
 // InsertBatteries adds batteries to the generator, returns how many were actually inserted.
+// Sequenced generators take none; they only accept InsertTaggedBattery.
 // Does not start the generator; call BringOnline after the startup sequence.
 func (g *Generator) InsertBatteries(count int) int {
+	if g.IsSequenced() {
+		return 0
+	}
+	return g.fill(count)
+}
+
+// fill installs up to count batteries without checking tags.
+func (g *Generator) fill(count int) int {
 	needed := g.BatteriesNeeded()
 	if count > needed {
 		count = needed
@@ -88,18 +223,67 @@ func (g *Generator) InsertBatteries(count int) int {
 }
 
 // InsertBatteriesAndStart inserts batteries and brings the generator online when fully fueled.
-// Used by level spawn and tests that need an already-running generator.
+// Used by level spawn and tests that need an already-running generator; it fills
+// sequenced generators too, ignoring tags.
 func (g *Generator) InsertBatteriesAndStart(count int) int {
 	if g != nil && g.Permanent {
 		return 0
 	}
-	inserted := g.InsertBatteries(count)
+	inserted := g.fill(count)
 	if g.HasEnoughBatteries() {
 		g.BringOnline()
 	}
 	return inserted
 }
 
+// WithdrawBatteries takes every inserted battery back out, shuts the generator down and
+// holds it from auto-insert. Returns how many came out. Permanent and sequenced
+// generators give none back (tagged batteries cannot return as plain ones).
+func (g *Generator) WithdrawBatteries() int {
+	if g == nil || g.Permanent || g.IsSequenced() || g.BatteriesInserted == 0 {
+		return 0
+	}
+	n := g.BatteriesInserted
+	g.BatteriesInserted = 0
+	g.Online = false
+	g.Tripped = false
+	g.AutoInsertHeld = true
+	return n
+}
+
+// Drains reports whether the generator burns through its batteries in survival runs:
+// permanent reactors and sequenced generators (whose tagged batteries cannot be
+// replaced) never run down.
+func (g *Generator) Drains() bool {
+	return g != nil && !g.Permanent && !g.IsSequenced()
+}
+
+// ChargePercent returns how much charge is left in the battery in use, 0–100.
+func (g *Generator) ChargePercent() int {
+	if !g.Drains() || g.BatteriesInserted == 0 {
+		return 0
+	}
+	return 100 * (BatteryCharge - g.Spent) / BatteryCharge
+}
+
+// Drain takes one tick of charge from a running generator. When the battery in use runs
+// flat it is used up and the generator, now one battery short, goes offline; inserting
+// a fresh battery and running the startup sequence brings it back. Returns true when
+// the generator went dark.
+func (g *Generator) Drain() bool {
+	if !g.Drains() || !g.IsPowered() {
+		return false
+	}
+	g.Spent++
+	if g.Spent < BatteryCharge {
+		return false
+	}
+	g.Spent = 0
+	g.BatteriesInserted--
+	g.Online = false
+	return true
+}
+
 // NewPermanentFusionReactor creates the deck 1 ship reactor (always online, never trips).
 func NewPermanentFusionReactor(name string) *Generator {
 	return &Generator{
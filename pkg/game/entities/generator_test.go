@@ -161,3 +161,170 @@ func TestPermanentFusionReactor_alwaysPowered(t *testing.T) {
 		t.Fatal("permanent reactor should ignore battery insertion")
 	}
 }
+
+func TestGenerator_OutputTiers(t *testing.T) {
+	tests := []struct {
+		batteries int
+		wantWatts int
+		wantTier  string
+	}{
+		{1, GeneratorOutputStandard, "Standard"},
+		{2, GeneratorOutputHeavy, "Heavy"},
+		{3, GeneratorOutputHeavy, "Heavy"},
+		{4, GeneratorOutputIndustrial, "Industrial"},
+		{5, GeneratorOutputIndustrial, "Industrial"},
+	}
+	for _, tt := range tests {
+		gen := NewGenerator("G", tt.batteries)
+		gen.OutputWatts = GeneratorOutputForBatteries(tt.batteries)
+		if got := gen.RatedOutput(); got != tt.wantWatts {
+			t.Errorf("%d batteries: RatedOutput = %d, want %d", tt.batteries, got, tt.wantWatts)
+		}
+		if got := gen.TierName(); got != tt.wantTier {
+			t.Errorf("%d batteries: TierName = %q, want %q", tt.batteries, got, tt.wantTier)
+		}
+	}
+	if got := (&Generator{}).RatedOutput(); got != GeneratorOutputStandard {
+		t.Errorf("unrated generator output = %d, want %d", got, GeneratorOutputStandard)
+	}
+}
+
+func TestGenerator_PartialOutput(t *testing.T) {
+	tests := []struct {
+		inserted  int
+		wantWatts int
+	}{
+		{0, 0},
+		{1, 33},
+		{2, 66},
+		{3, 0}, // fully fueled: awaiting startup, not a brownout
+	}
+	for _, tt := range tests {
+		gen := NewGenerator("G", 3)
+		gen.InsertBatteries(tt.inserted)
+		if got := gen.PartialOutput(); got != tt.wantWatts {
+			t.Errorf("%d of 3 batteries: PartialOutput = %d, want %d", tt.inserted, got, tt.wantWatts)
+		}
+	}
+
+	heavy := NewGenerator("H", 2)
+	heavy.OutputWatts = GeneratorOutputHeavy
+	heavy.InsertBatteries(1)
+	if got := heavy.PartialOutput(); got != GeneratorOutputHeavy/2 {
+		t.Errorf("heavy 1 of 2: PartialOutput = %d, want %d", got, GeneratorOutputHeavy/2)
+	}
+	heavy.Trip()
+	if got := heavy.PartialOutput(); got != 0 {
+		t.Errorf("tripped generator PartialOutput = %d, want 0", got)
+	}
+	if got := (*Generator)(nil).PartialOutput(); got != 0 {
+		t.Errorf("nil generator PartialOutput = %d, want 0", got)
+	}
+}
+
+func TestSequencedGenerator_TakesTaggedBatteriesInOrder(t *testing.T) {
+	gen := NewSequencedGenerator("G", []string{"Red", "Blue"})
+	if gen.InsertBatteries(2) != 0 {
+		t.Fatal("sequenced generator accepted plain batteries")
+	}
+	if gen.InsertTaggedBattery("Blue") {
+		t.Fatal("Blue accepted before Red")
+	}
+	if !gen.InsertTaggedBattery("Red") || gen.NextBatteryTag() != "Blue" {
+		t.Fatalf("after Red: inserted=%d next=%q, want 1 and Blue", gen.BatteriesInserted, gen.NextBatteryTag())
+	}
+	if gen.HasEnoughBatteries() {
+		t.Fatal("half-fed sequenced generator reports enough batteries")
+	}
+	if !gen.InsertTaggedBattery("Blue") || !gen.NeedsStartupSequence() {
+		t.Fatal("full sequence should leave the generator waiting for startup")
+	}
+	if gen.InsertTaggedBattery("Blue") || gen.NextBatteryTag() != "" {
+		t.Error("a full sequenced generator took another battery")
+	}
+}
+
+func TestGenerator_WithdrawBatteries(t *testing.T) {
+	gen := NewGenerator("G", 2)
+	gen.InsertBatteriesAndStart(2)
+	if n := gen.WithdrawBatteries(); n != 2 {
+		t.Fatalf("withdrew %d, want 2", n)
+	}
+	if gen.IsPowered() || gen.BatteriesInserted != 0 || !gen.AutoInsertHeld {
+		t.Errorf("after withdraw: powered=%v inserted=%d held=%v, want offline, empty and held",
+			gen.IsPowered(), gen.BatteriesInserted, gen.AutoInsertHeld)
+	}
+	if NewPermanentFusionReactor("R").WithdrawBatteries() != 0 {
+		t.Error("permanent reactor gave up batteries")
+	}
+	seq := NewSequencedGenerator("S", []string{"Red"})
+	seq.InsertTaggedBattery("Red")
+	if seq.WithdrawBatteries() != 0 || seq.BatteriesInserted != 1 {
+		t.Error("sequenced generator gave up a tagged battery")
+	}
+}
+
+func TestGenerator_Drain(t *testing.T) {
+	gen := NewGenerator("G", 2)
+	if gen.Drain() || gen.Spent != 0 {
+		t.Fatal("an unpowered generator should not drain")
+	}
+	gen.InsertBatteriesAndStart(2)
+	for i := 1; i < BatteryCharge; i++ {
+		if gen.Drain() {
+			t.Fatalf("generator went dark after %d ticks, want %d", i, BatteryCharge)
+		}
+	}
+	if got := gen.ChargePercent(); got != 100/BatteryCharge {
+		t.Fatalf("ChargePercent with one tick left = %d, want %d", got, 100/BatteryCharge)
+	}
+	if !gen.Drain() {
+		t.Fatalf("generator still running after %d ticks", BatteryCharge)
+	}
+	if gen.IsPowered() || gen.BatteriesInserted != 1 || gen.Spent != 0 {
+		t.Errorf("after running flat: powered=%v inserted=%d spent=%d, want offline, 1 battery, fresh charge",
+			gen.IsPowered(), gen.BatteriesInserted, gen.Spent)
+	}
+	if gen.InsertBatteries(1) != 1 || !gen.BringOnline() || gen.ChargePercent() != 100 {
+		t.Error("a fresh battery should let the generator restart at full charge")
+	}
+
+	reactor := NewPermanentFusionReactor("R")
+	seq := NewSequencedGenerator("S", []string{"Red"})
+	seq.InsertBatteriesAndStart(1)
+	for i := 0; i < BatteryCharge; i++ {
+		reactor.Drain()
+		seq.Drain()
+	}
+	if !reactor.IsPowered() || !seq.IsPowered() {
+		t.Error("permanent and sequenced generators should never run down")
+	}
+}
+
+func TestGenerator_BlowoutBurnsOneBattery(t *testing.T) {
+	gen := NewGenerator("Gen", 2)
+	gen.InsertBatteriesAndStart(2)
+	if !gen.Blowout() {
+		t.Fatal("running generator should blow")
+	}
+	if gen.IsPowered() || !gen.Tripped || gen.BatteriesInserted != 1 {
+		t.Fatalf("after blowout: %+v, want tripped with one battery left", gen)
+	}
+	if gen.Restart() {
+		t.Error("blown generator should need refueling before it restarts")
+	}
+	if gen.Blowout() {
+		t.Error("a generator that is already down cannot blow again")
+	}
+
+	reactor := NewPermanentFusionReactor("Reactor")
+	if reactor.Blowout() {
+		t.Error("permanent reactor must not blow")
+	}
+	seq := NewSequencedGenerator("Seq", []string{"Red"})
+	seq.InsertTaggedBattery("Red")
+	seq.BringOnline()
+	if seq.Blowout() || !seq.IsPowered() {
+		t.Error("sequenced generator must not blow")
+	}
+}
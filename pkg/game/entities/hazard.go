@@ -1,5 +1,7 @@
 package entities
 
+import "darkstation/pkg/engine/world"
+
 // HazardType represents different types of environmental hazards
 type HazardType int
 
@@ -9,6 +11,10 @@ const (
 	HazardElectrical                   // Electrical fault - needs Circuit Breaker reset
 	HazardGas                          // Gas leak - needs Vent Control activated
 	HazardRadiation                    // Radiation leak - needs Containment Field activated
+
+	// Ambient hazards never block movement; they make a room costly to linger in.
+	HazardFlooding   // Standing water - wading slows movement
+	HazardPowerBleed // Reactor bleed - slowly drains carried batteries
 )
 
 // Hazard represents an environmental hazard blocking a cell
@@ -18,6 +24,10 @@ type Hazard struct {
 	Description string         // Description shown when trying to enter
 	Fixed       bool           // Whether the hazard has been cleared
 	Control     *HazardControl // The control that fixes this hazard (nil for item-based fixes)
+	Ambient     bool           // Passable room hazard with a per-turn cost (never blocks or gates the exit)
+	Spreading   bool           // Creeps into adjacent corridor cells over time (see Tick)
+	Spread      int            // Cells this hazard has crept into so far
+	Source      *Generator     // Generator whose overload blowout sparked this fault; restarting it clears the fault
 }
 
 // HazardControl represents a control panel that can fix a hazard
@@ -40,6 +50,10 @@ type HazardInfo struct {
 	ControlIcon    string
 	RequiresItem   bool   // If true, needs an item instead of a control
 	ItemName       string // Item needed (if RequiresItem is true)
+	Ambient        bool   // Non-blocking room hazard (see Hazard.Ambient)
+	EntryMessage   string // Callout hint shown when entering an ambient hazard room
+	SpawnWeight    int    // Relative chance of being picked for a blocking hazard (0 counts as 1)
+	Spreadable     bool   // May be generated as a spreading hazard (see Hazard.Tick)
 }
 
 // HazardTypes maps hazard types to their display information
@@ -52,6 +66,7 @@ var HazardTypes = map[HazardType]HazardInfo{
 		IconFixed:      "·",
 		RequiresItem:   true,
 		ItemName:       "Patch Kit",
+		SpawnWeight:    3,
 	},
 	HazardCoolant: {
 		Name:           "Coolant Leak",
@@ -61,6 +76,8 @@ var HazardTypes = map[HazardType]HazardInfo{
 		IconFixed:      "·",
 		ControlName:    "Coolant Shutoff",
 		ControlIcon:    "⊗",
+		SpawnWeight:    4,
+		Spreadable:     true,
 	},
 	HazardElectrical: {
 		Name:           "Electrical Fault",
@@ -70,6 +87,7 @@ var HazardTypes = map[HazardType]HazardInfo{
 		IconFixed:      "·",
 		ControlName:    "Circuit Breaker",
 		ControlIcon:    "⊠",
+		SpawnWeight:    4,
 	},
 	HazardGas: {
 		Name:           "Gas Leak",
@@ -79,6 +97,8 @@ var HazardTypes = map[HazardType]HazardInfo{
 		IconFixed:      "·",
 		ControlName:    "Vent Control",
 		ControlIcon:    "◎",
+		SpawnWeight:    4,
+		Spreadable:     true,
 	},
 	HazardRadiation: {
 		Name:           "Radiation Leak",
@@ -88,6 +108,23 @@ var HazardTypes = map[HazardType]HazardInfo{
 		IconFixed:      "·",
 		ControlName:    "Containment Control",
 		ControlIcon:    "⊛",
+		SpawnWeight:    1,
+	},
+	HazardFlooding: {
+		Name:           "Flooded Section",
+		BlockedMessage: "Ankle-deep water covers the deck plating.",
+		Icon:           "~",
+		IconFixed:      "·",
+		Ambient:        true,
+		EntryMessage:   "Wading slows your movement.",
+	},
+	HazardPowerBleed: {
+		Name:           "Reactor Bleed",
+		BlockedMessage: "Stray induction hums through the bulkheads.",
+		Icon:           "≈",
+		IconFixed:      "·",
+		Ambient:        true,
+		EntryMessage:   "Carried batteries drain while you linger.",
 	},
 }
 
@@ -99,6 +136,7 @@ func NewHazard(hazardType HazardType) *Hazard {
 		Name:        info.Name,
 		Description: info.BlockedMessage,
 		Fixed:       false,
+		Ambient:     info.Ambient,
 	}
 }
 
@@ -132,9 +170,32 @@ func (h *Hazard) Fix() {
 	h.Fixed = true
 }
 
-// IsBlocking returns true if this hazard is currently blocking passage
+// IsBlocking returns true if this hazard is currently blocking passage.
+// Ambient hazards are always passable.
 func (h *Hazard) IsBlocking() bool {
-	return !h.Fixed
+	return !h.Fixed && !h.Ambient
+}
+
+// MaxHazardSpread is how many extra cells one spreading hazard can creep into.
+const MaxHazardSpread = 4
+
+// Tick advances a spreading hazard on cell by one step and returns the neighboring
+// cell it creeps into, or nil. open reports whether a neighbor may take the hazard;
+// the caller places h there, so one fix clears every cell it reached. Neighbors are
+// tried in a rotating order so the hazard does not always creep the same way.
+func (h *Hazard) Tick(grid *world.Grid, cell *world.Cell, open func(*world.Cell) bool) *world.Cell {
+	if !h.Spreading || !h.IsBlocking() || h.Spread >= MaxHazardSpread || grid == nil || cell == nil {
+		return nil
+	}
+	dirs := []world.Direction{world.North, world.East, world.South, world.West}
+	for i := range dirs {
+		next := grid.GetCellRelative(cell, dirs[(h.Spread+i)%len(dirs)])
+		if next != nil && next.Room && open(next) {
+			h.Spread++
+			return next
+		}
+	}
+	return nil
 }
 
 // RequiresItem returns true if this hazard type needs an item to fix
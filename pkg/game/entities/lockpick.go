@@ -0,0 +1,34 @@
+package entities
+
+import "math/rand"
+
+const (
+	lockpickMinPins = 3
+	lockpickMaxPins = 6
+)
+
+// LockpickDifficulty returns the pin count for a pickable door on a deck: three pins on
+// the upper decks and one more every third deck, up to six.
+func LockpickDifficulty(level int) int {
+	return min(lockpickMinPins+max(level-1, 0)/3, lockpickMaxPins)
+}
+
+// LockpickSlips returns how many wrong pins a pick survives on a lock with pins pins.
+func LockpickSlips(pins int) int {
+	return max(pins, 1)
+}
+
+// LockpickSeed derives a deterministic seed for the lock on the door at row, col.
+func LockpickSeed(levelSeed int64, row, col int) int64 {
+	return levelSeed ^ int64(uint64(row)<<32|uint64(uint32(col)))*0x2545f4914f6cdd1d
+}
+
+// LockpickOrder returns the order a lock's pins bind in: a permutation of 0..pins-1.
+// Pins must be set in this order; setting any other pin drops every pin back down.
+func LockpickOrder(seed int64, pins int) []int {
+	if pins <= 0 {
+		return nil
+	}
+	rng := rand.New(rand.NewSource(seed ^ 0x6c6f636b7069636b))
+	return rng.Perm(pins)
+}
@@ -0,0 +1,46 @@
+package entities
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLockpickDifficulty_scalesWithLevel(t *testing.T) {
+	for level, want := range map[int]int{1: 3, 3: 3, 4: 4, 7: 5, 10: 6, 40: 6} {
+		if got := LockpickDifficulty(level); got != want {
+			t.Errorf("LockpickDifficulty(%d) = %d, want %d", level, got, want)
+		}
+	}
+}
+
+func TestLockpickOrder_isDeterministicPermutation(t *testing.T) {
+	seed := LockpickSeed(12345, 4, 9)
+	order := LockpickOrder(seed, 5)
+	if !slices.Equal(order, LockpickOrder(seed, 5)) {
+		t.Fatal("same seed should give the same binding order")
+	}
+	sorted := slices.Sorted(slices.Values(order))
+	if !slices.Equal(sorted, []int{0, 1, 2, 3, 4}) {
+		t.Fatalf("order %v is not a permutation of 5 pins", order)
+	}
+}
+
+func TestDoor_CanPick(t *testing.T) {
+	door := NewDoor("Lab")
+	if door.CanPick() {
+		t.Fatal("doors are not pickable by default")
+	}
+	door.Pickable = true
+	if !door.CanPick() {
+		t.Fatal("locked pickable door should be pickable")
+	}
+	door.RequiresCode = "1-2-3-4"
+	if door.CanPick() {
+		t.Fatal("vault doors cannot be picked")
+	}
+	door.RequiresCode = ""
+	door.Unlock()
+	if door.CanPick() {
+		t.Fatal("an unlocked door has nothing to pick")
+	}
+}
@@ -0,0 +1,114 @@
+package entities
+
+import "darkstation/pkg/engine/world"
+
+// PatrolCaughtCooldown is how many steps a patrol ignores the player after catching them,
+// so one encounter is not punished again on the very next move.
+const PatrolCaughtCooldown = 6
+
+// PatrolSightRange is how many cells ahead a patrol sees into lit cells.
+const PatrolSightRange = 5
+
+// PatrolDarkSightRange is how many cells ahead a patrol sees into unlit (unpowered) cells.
+const PatrolDarkSightRange = 2
+
+// EMPChargeName is the consumable whose pulse stuns patrols near the player.
+const EMPChargeName = "EMP Charge"
+
+// EMPRadius is how far (in cells, counting diagonals as one) an EMP Charge's pulse reaches.
+const EMPRadius = 4
+
+// EMPStunSteps is how many steps a patrol caught in an EMP pulse stays down.
+const EMPStunSteps = 8
+
+// Patrol is a malfunctioning maintenance bot roaming the deck's corridors. It keeps its
+// heading until blocked, then turns (see Step), so its route is fixed by the layout, and
+// watches the corridor ahead through a vision cone (see ConeCovers). An EMP leaves it
+// stunned for a while (see Stun), neither moving nor seeing.
+type Patrol struct {
+	Name     string
+	Row, Col int             // Cell the bot stands on
+	Heading  world.Direction // Facing: the direction of the last step
+	Cooldown int             // Steps left before it can catch the player again
+	Stunned  int             // Steps left before it reboots after an EMP
+}
+
+// NewPatrol creates a patrol standing on (row, col) and facing heading.
+func NewPatrol(name string, row, col int, heading world.Direction) *Patrol {
+	return &Patrol{Name: name, Row: row, Col: col, Heading: heading}
+}
+
+// Stun knocks the patrol out for steps steps, or tops up a stun already running.
+func (p *Patrol) Stun(steps int) {
+	if p != nil {
+		p.Stunned = max(p.Stunned, steps)
+	}
+}
+
+// IsStunned reports whether the patrol is still down from an EMP.
+func (p *Patrol) IsStunned() bool {
+	return p != nil && p.Stunned > 0
+}
+
+// Step moves the patrol one cell and returns it, or nil when every neighbor is shut.
+// open reports whether a neighbor may be entered. The bot goes straight on when it can,
+// otherwise turns right, then left, and only doubles back at a dead end. A stunned bot
+// spends the step recovering instead and stays put.
+func (p *Patrol) Step(grid *world.Grid, open func(*world.Cell) bool) *world.Cell {
+	if p == nil || grid == nil {
+		return nil
+	}
+	if p.Stunned > 0 {
+		p.Stunned--
+		return nil
+	}
+	if p.Cooldown > 0 {
+		p.Cooldown--
+	}
+	cell := grid.GetCell(p.Row, p.Col)
+	if cell == nil {
+		return nil
+	}
+	right := turnRight(p.Heading)
+	for _, dir := range []world.Direction{p.Heading, right, right.Opposite(), p.Heading.Opposite()} {
+		next := grid.GetCellRelative(cell, dir)
+		if next == nil || !open(next) {
+			continue
+		}
+		p.Row, p.Col = next.Row, next.Col
+		p.Heading = dir
+		return next
+	}
+	return nil
+}
+
+// ConeCovers reports whether the cell at offset (dr, dc) from the bot lies within reach
+// cells inside its vision cone: ahead of it and no further to the side than ahead (90
+// degrees wide). Its own cell is always covered; cells beside and behind it never are.
+// A stunned bot's cone covers nothing.
+func (p *Patrol) ConeCovers(dr, dc, reach int) bool {
+	if p == nil || p.IsStunned() {
+		return false
+	}
+	faceRow, faceCol := p.Heading.Delta()
+	ahead := dr*faceRow + dc*faceCol
+	side := dr*faceCol - dc*faceRow
+	if side < 0 {
+		side = -side
+	}
+	return ahead >= 0 && side <= ahead && ahead <= reach
+}
+
+// turnRight returns the orthogonal direction clockwise from d.
+func turnRight(d world.Direction) world.Direction {
+	switch d {
+	case world.North:
+		return world.East
+	case world.East:
+		return world.South
+	case world.South:
+		return world.West
+	default:
+		return world.North
+	}
+}
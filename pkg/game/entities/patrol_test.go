@@ -0,0 +1,135 @@
+package entities
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+)
+
+// patrolTestGrid returns a grid whose corridor cells are marked '.' in rows.
+func patrolTestGrid(rows ...string) *world.Grid {
+	grid := world.NewGrid(len(rows), len(rows[0]))
+	for r, line := range rows {
+		for c, ch := range line {
+			if ch == '.' {
+				grid.MarkAsRoomWithName(r, c, world.CorridorName, "")
+			}
+		}
+	}
+	grid.BuildAllCellConnections()
+	return grid
+}
+
+func corridorOpen(c *world.Cell) bool { return c.Room }
+
+func TestPatrolStep_keepsHeadingThenTurnsRight(t *testing.T) {
+	grid := patrolTestGrid(
+		"...",
+		"#.#",
+		"#.#",
+	)
+	p := NewPatrol("Bot", 2, 1, world.North)
+	if next := p.Step(grid, corridorOpen); next == nil || next.Row != 1 || next.Col != 1 {
+		t.Fatalf("first step = %v, want straight on to (1,1)", next)
+	}
+	p.Step(grid, corridorOpen)
+	if p.Row != 0 || p.Col != 1 {
+		t.Fatalf("bot at (%d,%d), want (0,1)", p.Row, p.Col)
+	}
+	// North is shut at the T: turn right before left.
+	p.Step(grid, corridorOpen)
+	if p.Row != 0 || p.Col != 2 || p.Heading != world.East {
+		t.Fatalf("bot at (%d,%d) heading %v, want (0,2) heading East", p.Row, p.Col, p.Heading)
+	}
+}
+
+func TestPatrolStep_doublesBackOnlyAtDeadEnd(t *testing.T) {
+	grid := patrolTestGrid(
+		"...",
+		"###",
+		"###",
+	)
+	p := NewPatrol("Bot", 0, 1, world.East)
+	p.Step(grid, corridorOpen)
+	if p.Row != 0 || p.Col != 2 {
+		t.Fatalf("bot at (%d,%d), want (0,2)", p.Row, p.Col)
+	}
+	p.Step(grid, corridorOpen)
+	if p.Row != 0 || p.Col != 1 || p.Heading != world.West {
+		t.Fatalf("bot at (%d,%d) heading %v, want back to (0,1) heading West", p.Row, p.Col, p.Heading)
+	}
+}
+
+func TestPatrolStep_waitsWhenBoxedInAndCoolsDown(t *testing.T) {
+	grid := patrolTestGrid(
+		"...",
+		"###",
+		"###",
+	)
+	p := NewPatrol("Bot", 0, 1, world.East)
+	p.Cooldown = 2
+	if next := p.Step(grid, func(*world.Cell) bool { return false }); next != nil {
+		t.Fatalf("boxed-in bot moved to %v", next)
+	}
+	if p.Row != 0 || p.Col != 1 || p.Heading != world.East {
+		t.Fatalf("boxed-in bot should stay put: %+v", p)
+	}
+	if p.Cooldown != 1 {
+		t.Fatalf("cooldown = %d, want 1 after a step", p.Cooldown)
+	}
+}
+
+func TestPatrolConeCovers_geometry(t *testing.T) {
+	p := NewPatrol("Bot", 0, 0, world.North)
+	cases := []struct {
+		dr, dc, reach int
+		want          bool
+	}{
+		{0, 0, 5, true},   // its own cell
+		{-1, 0, 5, true},  // straight ahead
+		{-3, 3, 5, true},  // edge of the 90 degree cone
+		{-2, 3, 5, false}, // wider than the cone
+		{0, 1, 5, false},  // beside it
+		{1, 0, 5, false},  // behind it
+		{-5, 0, 5, true},  // at full reach
+		{-3, 0, 2, false}, // beyond a shortened (dark) reach
+	}
+	for _, tc := range cases {
+		if got := p.ConeCovers(tc.dr, tc.dc, tc.reach); got != tc.want {
+			t.Errorf("ConeCovers(%d, %d, %d) facing North = %v, want %v", tc.dr, tc.dc, tc.reach, got, tc.want)
+		}
+	}
+
+	p.Heading = world.East
+	if !p.ConeCovers(1, 2, 5) || p.ConeCovers(-2, 1, 5) {
+		t.Fatal("the cone should turn with the bot's heading")
+	}
+}
+
+func TestPatrolStun_holdsStillAndBlindsCone(t *testing.T) {
+	grid := patrolTestGrid(
+		"...",
+		"###",
+		"###",
+	)
+	p := NewPatrol("Bot", 0, 0, world.East)
+	p.Stun(2)
+	p.Stun(1) // a weaker pulse does not cut the stun short
+	if p.ConeCovers(0, 1, PatrolSightRange) {
+		t.Fatal("a stunned bot should see nothing")
+	}
+	for i := 0; i < 2; i++ {
+		if next := p.Step(grid, corridorOpen); next != nil {
+			t.Fatalf("stunned bot moved to %v on step %d", next, i+1)
+		}
+	}
+	if p.IsStunned() {
+		t.Fatal("the stun should wear off after its steps")
+	}
+	if !p.ConeCovers(0, 1, PatrolSightRange) {
+		t.Error("a rebooted bot should see ahead again")
+	}
+	if next := p.Step(grid, corridorOpen); next == nil || next.Col != 1 {
+		t.Errorf("rebooted bot should move on, got %v", next)
+	}
+}
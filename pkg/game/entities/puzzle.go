@@ -24,6 +24,26 @@ type PuzzleTerminal struct {
 	LinkageToken string
 }
 
+// codePrefixes introduce a code in log or furniture text (e.g. "Code: 1-2-3-4").
+var codePrefixes = []string{"code:", "sequence:", "pattern:", "solution:"}
+
+// ParseCode extracts the code from text such as "Code: 1-2-3-4." Only the first
+// prefix found is read, up to the next sentence or line break. Returns "" when
+// text holds no code.
+func ParseCode(text string) string {
+	lowerText := strings.ToLower(text)
+	for _, prefix := range codePrefixes {
+		if idx := strings.Index(lowerText, prefix); idx != -1 {
+			codeText := strings.TrimSpace(text[idx+len(prefix):])
+			if endIdx := strings.IndexAny(codeText, ".,;!?\n"); endIdx != -1 {
+				codeText = codeText[:endIdx]
+			}
+			return strings.TrimSpace(codeText)
+		}
+	}
+	return ""
+}
+
 // PuzzleReward represents what the player gets for solving a puzzle
 type PuzzleReward int
 
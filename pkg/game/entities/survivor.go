@@ -0,0 +1,28 @@
+package entities
+
+// Survivor is a stranded crew member who must be escorted to the deck's lift.
+// A survivor waits in place until recruited, then follows one step behind the player.
+type Survivor struct {
+	Name      string
+	Following bool
+	Stalled   bool // Refused the last step (hazard in the way); cleared when they move again
+}
+
+// NewSurvivor creates a survivor waiting to be recruited
+func NewSurvivor(name string) *Survivor {
+	return &Survivor{Name: name}
+}
+
+// Recruit starts the survivor following the player; returns false if already following
+func (s *Survivor) Recruit() bool {
+	if s.Following {
+		return false
+	}
+	s.Following = true
+	return true
+}
+
+// IsFollowing returns whether the survivor has been recruited
+func (s *Survivor) IsFollowing() bool {
+	return s != nil && s.Following
+}
@@ -2,9 +2,10 @@ package entities
 
 // CCTVTerminal represents a security terminal that can reveal nearby rooms
 type CCTVTerminal struct {
-	Name       string
-	Used       bool
-	TargetRoom string // Name of the room this terminal reveals
+	Name           string
+	Used           bool
+	TargetRoom     string // Name of the room this terminal reveals
+	InteractRadius int    // Reach of a wide terminal bank; 0 or 1 keeps it adjacent-only
 }
 
 // NewCCTVTerminal creates a new CCTV terminal
@@ -11,6 +11,8 @@ const (
 	SingleDeckSandbox ID = "SingleDeckSandbox"
 	// FindTheBatteries is a single large deck: collect scattered batteries and power the generator.
 	FindTheBatteries ID = "FindTheBatteries"
+	// Endless keeps generating ever-harder decks past the finale for score attack.
+	Endless ID = "Endless"
 )
 
 // ItemPlacementPrefs controls procedural item placement for a mode.
@@ -49,8 +51,12 @@ type LevelGenPrefs struct {
 	PlaceConduitFaults        bool
 	PlaceRelays               bool
 	PlaceAdditionalGenerators bool
+	PlaceSurvivors            bool
+	PlacePatrols              bool // Roaming maintenance bot on deeper decks
 	BootstrapDeck1Ship        bool
 	RunSimulateGate           bool
+	// SizePercent scales the playable area of middle decks (zero = 100).
+	SizePercent int
 	// BatteryHunt uses a stripped layout: one unpowered generator and scattered floor batteries.
 	BatteryHunt            bool
 	BatteryHuntMinRequired int
@@ -63,6 +69,11 @@ type Mode struct {
 	DisplayName          string
 	TotalDecks           int
 	UsesCrossDeckUnlocks bool
+	Endless              bool // Each lift ride generates a deeper deck; TotalDecks is ignored
+	Creative             bool // Generators powered, doors open, hazards passable, map revealed; nothing recorded
+	Survival             bool // Running generators drain their batteries and go dark unless refuelled
+	Difficulty           Difficulty
+	DeckSize             DeckSize
 	Items                ItemPlacementPrefs
 	LevelGen             LevelGenPrefs
 }
@@ -71,6 +82,7 @@ var registry = map[ID]Mode{
 	SinglePlayerPuzzle: singlePlayerPuzzle(),
 	SingleDeckSandbox:  singleDeckSandbox(),
 	FindTheBatteries:   findTheBatteries(),
+	Endless:            endless(),
 }
 
 func defaultLevelGen() LevelGenPrefs {
@@ -85,6 +97,8 @@ func defaultLevelGen() LevelGenPrefs {
 		PlaceConduitFaults:          true,
 		PlaceRelays:                 true,
 		PlaceAdditionalGenerators:   true,
+		PlaceSurvivors:              true,
+		PlacePatrols:                true,
 		BootstrapDeck1Ship:          true,
 		RunSimulateGate:             true,
 	}
@@ -165,6 +179,26 @@ func findTheBatteries() Mode {
 	}
 }
 
+func endless() Mode {
+	return Mode{
+		ID:                   Endless,
+		DisplayName:          "Endless",
+		UsesCrossDeckUnlocks: false,
+		Endless:              true,
+		Items: ItemPlacementPrefs{
+			PlaceFloorBatteries:       true,
+			ExtraBatteryMin:           0,
+			ExtraBatteryMax:           1,
+			HideItemsInFurniture:      true,
+			HideInFurnitureChancePct:  50,
+			PlaceUnlockObjectives:     false,
+			PlaceConservationPolicies: true,
+			PlaceHazardSolutionItems:  true,
+		},
+		LevelGen: defaultLevelGen(),
+	}
+}
+
 // Get returns the mode for id, or SinglePlayerPuzzle when unknown.
 func Get(id ID) Mode {
 	if m, ok := registry[id]; ok {
@@ -184,6 +218,7 @@ func All() []Mode {
 		registry[SinglePlayerPuzzle],
 		registry[SingleDeckSandbox],
 		registry[FindTheBatteries],
+		registry[Endless],
 	}
 }
 
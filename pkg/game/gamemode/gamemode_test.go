@@ -40,8 +40,8 @@ func TestSingleDeckSandbox(t *testing.T) {
 
 func TestAll_IncludesFindTheBatteries(t *testing.T) {
 	modes := All()
-	if len(modes) != 3 {
-		t.Fatalf("All() = %d modes, want 3", len(modes))
+	if len(modes) != 4 {
+		t.Fatalf("All() = %d modes, want 4", len(modes))
 	}
 	found := false
 	for _, m := range modes {
@@ -57,6 +57,16 @@ func TestAll_IncludesFindTheBatteries(t *testing.T) {
 	}
 }
 
+func TestEndless(t *testing.T) {
+	m := Get(Endless)
+	if !m.Endless {
+		t.Fatal("Endless = false, want true")
+	}
+	if m.UsesCrossDeckUnlocks || m.Items.PlaceUnlockObjectives {
+		t.Fatal("endless decks are never revisited; cross-deck unlocks should be off")
+	}
+}
+
 func TestBatteryHuntRequiredRoll(t *testing.T) {
 	lg := Get(FindTheBatteries).LevelGen
 	for i := 0; i < 20; i++ {
@@ -76,3 +86,34 @@ func TestExtraBatteryRoll(t *testing.T) {
 		t.Fatalf("roll 1 = %d, want 2", got)
 	}
 }
+
+func TestWithOptions(t *testing.T) {
+	base := Get(SinglePlayerPuzzle)
+	easy := base.WithOptions(RunOptions{Difficulty: DifficultyEasy, DeckSize: DeckSizeLarge})
+	if easy.Items.ExtraBatteryMin != base.Items.ExtraBatteryMin+1 || easy.LevelGen.SizePercent != 125 {
+		t.Fatalf("easy/large = %+v %+v", easy.Items, easy.LevelGen)
+	}
+	hard := base.WithOptions(RunOptions{Difficulty: DifficultyHard})
+	if hard.Items.ExtraBatteryMax != 0 || hard.LevelGen.SizePercent != 0 {
+		t.Fatalf("hard/standard = %+v %+v", hard.Items, hard.LevelGen)
+	}
+	survival := base.WithOptions(RunOptions{Survival: true})
+	if !survival.Survival || survival.Items.ExtraBatteryMin != base.Items.ExtraBatteryMin+survivalSpareBatteries {
+		t.Fatalf("survival = %v %+v, want extra spare batteries", survival.Survival, survival.Items)
+	}
+	if base.Items.ExtraBatteryMax != Get(SinglePlayerPuzzle).Items.ExtraBatteryMax {
+		t.Fatal("WithOptions mutated the registered mode")
+	}
+}
+
+func TestParseDifficulty(t *testing.T) {
+	for name, want := range map[string]Difficulty{"": DifficultyNormal, "easy": DifficultyEasy, "Normal": DifficultyNormal, " HARD ": DifficultyHard} {
+		got, err := ParseDifficulty(name)
+		if err != nil || got != want {
+			t.Fatalf("ParseDifficulty(%q) = %v, %v; want %v", name, got, err, want)
+		}
+	}
+	if _, err := ParseDifficulty("nightmare"); err == nil {
+		t.Fatal("unknown difficulty should be an error")
+	}
+}
@@ -0,0 +1,128 @@
+package gamemode
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Difficulty tunes how many spare resources a run places, and (see
+// generator.DifficultyParams) how hard each deck is generated.
+type Difficulty int
+
+const (
+	DifficultyNormal Difficulty = iota
+	DifficultyEasy
+	DifficultyHard
+)
+
+// Difficulties lists difficulties in menu order (easiest first).
+func Difficulties() []Difficulty {
+	return []Difficulty{DifficultyEasy, DifficultyNormal, DifficultyHard}
+}
+
+func (d Difficulty) String() string {
+	switch d {
+	case DifficultyEasy:
+		return "Easy"
+	case DifficultyHard:
+		return "Hard"
+	default:
+		return "Normal"
+	}
+}
+
+// ParseDifficulty reads a difficulty name as written by String, ignoring case.
+// An empty name is Normal.
+func ParseDifficulty(name string) (Difficulty, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return DifficultyNormal, nil
+	}
+	for _, d := range Difficulties() {
+		if strings.EqualFold(name, d.String()) {
+			return d, nil
+		}
+	}
+	return DifficultyNormal, fmt.Errorf("unknown difficulty %q (want Easy, Normal or Hard)", name)
+}
+
+// DeckSize scales the playable area of middle decks.
+type DeckSize int
+
+const (
+	DeckSizeStandard DeckSize = iota
+	DeckSizeCompact
+	DeckSizeLarge
+)
+
+// DeckSizes lists deck sizes in menu order (smallest first).
+func DeckSizes() []DeckSize {
+	return []DeckSize{DeckSizeCompact, DeckSizeStandard, DeckSizeLarge}
+}
+
+func (s DeckSize) String() string {
+	switch s {
+	case DeckSizeCompact:
+		return "Compact"
+	case DeckSizeLarge:
+		return "Large"
+	default:
+		return "Standard"
+	}
+}
+
+// Percent returns the playable-area scale for the deck size.
+func (s DeckSize) Percent() int {
+	switch s {
+	case DeckSizeCompact:
+		return 75
+	case DeckSizeLarge:
+		return 125
+	default:
+		return 100
+	}
+}
+
+// survivalSpareBatteries is how many extra spare batteries a survival run places per deck.
+const survivalSpareBatteries = 2
+
+// RunOptions are the new-game choices made before a run is generated.
+type RunOptions struct {
+	Difficulty Difficulty
+	DeckSize   DeckSize
+	Seed       int64 // Zero picks a random seed
+	// Creative opens every deck up for free exploration (-creative); scores are not recorded
+	Creative bool
+	// Survival makes running generators drain their batteries over time
+	Survival bool
+	// StartingItems are granted on the first deck (-give / GIVE; testing and accessibility)
+	StartingItems []string
+}
+
+// WithOptions returns a copy of the mode tuned for difficulty and deck size.
+// Easy places an extra spare battery and hides fewer items; Hard places no spares
+// and hides more. Survival places two more spares on top, to refuel drained generators.
+func (m Mode) WithOptions(opts RunOptions) Mode {
+	m.Difficulty = opts.Difficulty
+	m.DeckSize = opts.DeckSize
+	m.Creative = opts.Creative
+	m.Survival = opts.Survival
+	switch opts.Difficulty {
+	case DifficultyEasy:
+		m.Items.ExtraBatteryMin++
+		m.Items.ExtraBatteryMax++
+		m.Items.HideInFurnitureChancePct /= 2
+	case DifficultyHard:
+		m.Items.ExtraBatteryMin = 0
+		m.Items.ExtraBatteryMax = 0
+		m.Items.HideInFurnitureChancePct = min(100, m.Items.HideInFurnitureChancePct+25)
+	}
+	if opts.Survival {
+		m.Items.ExtraBatteryMin += survivalSpareBatteries
+		m.Items.ExtraBatteryMax += survivalSpareBatteries
+	}
+	if opts.DeckSize != DeckSizeStandard {
+		m.LevelGen.SizePercent = opts.DeckSize.Percent()
+	}
+	return m
+}
@@ -0,0 +1,67 @@
+package gameplay
+
+import (
+	"fmt"
+
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// ambientDrainTurns is how many moves made in a reactor-bleed room drain one spare battery.
+const ambientDrainTurns = 6
+
+// ApplyAmbientHazards applies the per-move cost of the ambient hazard under the player.
+// Called once per processed input from TickTurn; only inputs that moved the player count.
+// Entering an ambient room shows a callout; lingering in a reactor-bleed room slowly
+// drains carried batteries, but never ones the deck's generators still need.
+func ApplyAmbientHazards(g *state.Game) {
+	if g == nil || g.CurrentCell == nil {
+		return
+	}
+	cell := g.CurrentCell
+	hazard := gameworld.AmbientHazardAt(cell)
+	if hazard != g.AmbientHazard.Hazard {
+		g.ResetAmbientHazard()
+		g.AmbientHazard.Hazard = hazard
+		g.AmbientHazard.TurnAt = g.MovementCount
+		if hazard != nil {
+			info := entities.HazardTypes[hazard.Type]
+			renderer.AddCallout(cell.Row, cell.Col,
+				fmt.Sprintf("HAZARD{%s}\nSUBTLE{%s}", hazard.Name, info.EntryMessage),
+				renderer.CalloutColorWarning, 4000)
+		}
+		return
+	}
+	if hazard == nil || g.MovementCount == g.AmbientHazard.TurnAt {
+		return
+	}
+	g.AmbientHazard.TurnAt = g.MovementCount
+
+	g.AmbientHazard.Turns++
+	if hazard.Type == entities.HazardPowerBleed && g.AmbientHazard.Turns%ambientDrainTurns == 0 &&
+		g.Batteries > g.TotalBatteriesNeeded() {
+		g.UseBatteries(1)
+		logMessage(g, "Stray induction drains a spare battery. ACTION{%d} left.", g.Batteries)
+		renderer.AddCallout(cell.Row, cell.Col, "HAZARD{Battery drained}", renderer.CalloutColorBattery, 3000)
+	}
+}
+
+// ambientWadeConsumesMove reports whether a move out of a flooded cell is spent wading.
+// Every other move from flooded floor is consumed, halving movement speed in the room.
+func ambientWadeConsumesMove(g *state.Game) bool {
+	if g == nil || g.CurrentCell == nil {
+		return false
+	}
+	hazard := gameworld.AmbientHazardAt(g.CurrentCell)
+	if hazard == nil || hazard.Type != entities.HazardFlooding {
+		return false
+	}
+	if g.AmbientHazard.Wading {
+		g.AmbientHazard.Wading = false
+		return false
+	}
+	g.AmbientHazard.Wading = true
+	return true
+}
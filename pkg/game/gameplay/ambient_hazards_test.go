@@ -0,0 +1,84 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/entities"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func TestApplyAmbientHazards_PowerBleedDrainsBatteries(t *testing.T) {
+	g, cell, _ := makeMinimalGameWithGrid(t)
+	gameworld.GetGameData(cell).AmbientHazard = entities.NewHazard(entities.HazardPowerBleed)
+	g.CurrentCell = cell
+	g.AddBatteries(2)
+
+	ApplyAmbientHazards(g) // entry turn: callout only
+	for i := 0; i < ambientDrainTurns-1; i++ {
+		g.MovementCount++
+		ApplyAmbientHazards(g)
+	}
+	if g.Batteries != 2 {
+		t.Fatalf("Batteries = %d before drain interval, want 2", g.Batteries)
+	}
+	g.MovementCount++
+	ApplyAmbientHazards(g)
+	if g.Batteries != 1 {
+		t.Errorf("Batteries = %d after %d turns, want 1", g.Batteries, ambientDrainTurns)
+	}
+}
+
+func TestApplyAmbientHazards_PowerBleedIgnoresNonMoves(t *testing.T) {
+	g, cell, _ := makeMinimalGameWithGrid(t)
+	gameworld.GetGameData(cell).AmbientHazard = entities.NewHazard(entities.HazardPowerBleed)
+	g.CurrentCell = cell
+	g.AddBatteries(2)
+
+	// Menus, hints and unbound keys still run the per-input tick but are not moves.
+	for i := 0; i < ambientDrainTurns*3; i++ {
+		ApplyAmbientHazards(g)
+	}
+	if g.Batteries != 2 {
+		t.Fatalf("Batteries = %d after inputs that made no move, want 2", g.Batteries)
+	}
+}
+
+func TestApplyAmbientHazards_PowerBleedSparesNeededBatteries(t *testing.T) {
+	g, cell, _ := makeMinimalGameWithGrid(t)
+	gameworld.GetGameData(cell).AmbientHazard = entities.NewHazard(entities.HazardPowerBleed)
+	g.CurrentCell = cell
+	g.AddGenerator(entities.NewGenerator("Generator", 2))
+	g.AddBatteries(2)
+
+	ApplyAmbientHazards(g)
+	for i := 0; i < ambientDrainTurns*3; i++ {
+		g.MovementCount++
+		ApplyAmbientHazards(g)
+	}
+	if g.Batteries != 2 {
+		t.Fatalf("Batteries = %d, want both kept for the unpowered generator", g.Batteries)
+	}
+}
+
+func TestAmbientWadeConsumesMove_Alternates(t *testing.T) {
+	g, cell, _ := makeMinimalGameWithGrid(t)
+	g.CurrentCell = cell
+	if ambientWadeConsumesMove(g) {
+		t.Fatal("dry floor consumed a move")
+	}
+	gameworld.GetGameData(cell).AmbientHazard = entities.NewHazard(entities.HazardFlooding)
+	want := []bool{true, false, true, false}
+	for i, w := range want {
+		if got := ambientWadeConsumesMove(g); got != w {
+			t.Errorf("move %d: consumed = %v, want %v", i, got, w)
+		}
+	}
+}
+
+func TestAmbientHazard_NotBlocking(t *testing.T) {
+	for _, ht := range []entities.HazardType{entities.HazardFlooding, entities.HazardPowerBleed} {
+		if entities.NewHazard(ht).IsBlocking() {
+			t.Errorf("ambient hazard %d reports blocking", ht)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package gameplay
+
+import (
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// IsAutoExploreActive reports whether auto-explore is stepping the player.
+func IsAutoExploreActive(g *state.Game) bool {
+	return g != nil && g.AutoExplore != nil
+}
+
+// StartAutoExplore begins walking toward unexplored floor. Anything notable already
+// discovered is marked seen so only newly revealed things stop the walk.
+func StartAutoExplore(g *state.Game) {
+	if g == nil || g.Grid == nil || g.CurrentCell == nil {
+		return
+	}
+	g.AutoExplore = state.NewAutoExploreSession()
+	noteAutoExploreStop(g)
+	logMessage(g, "Auto-exploring. Press any key to stop.")
+}
+
+// StopAutoExplore ends auto-explore, logging reason when non-empty.
+func StopAutoExplore(g *state.Game, reason string) {
+	if g == nil || g.AutoExplore == nil {
+		return
+	}
+	g.AutoExplore = nil
+	if reason != "" {
+		logMessage(g, "Auto-explore stopped: %s", reason)
+	}
+}
+
+// StepAutoExplore advances auto-explore by one move toward the nearest frontier
+// (walkable floor next to undiscovered room floor). The walk stops when nothing is
+// left in reach, a move fails, the patrol bot blocks the next step, or the bot, an
+// item, hazard, or interactable comes into view.
+func StepAutoExplore(g *state.Game) {
+	if !IsAutoExploreActive(g) || g.CurrentCell == nil {
+		return
+	}
+	session := g.AutoExplore
+	start := g.CurrentCell
+	if isExploreFrontier(start) {
+		// Standing on a frontier that FOV did not resolve: skip it from now on.
+		session.Exhausted[start] = true
+	}
+
+	path := world.FindNearest(start, func(cell *world.Cell) bool {
+		return cell != start && !session.Exhausted[cell] && explorePassable(g, cell) && isExploreFrontier(cell)
+	}, func(cell *world.Cell) bool {
+		return explorePassable(g, cell)
+	})
+	if len(path) < 2 {
+		StopAutoExplore(g, "nothing left to explore in reach.")
+		return
+	}
+	if gameworld.HasPatrol(path[1]) {
+		StopAutoExplore(g, "patrol ahead.")
+		return
+	}
+
+	MoveCell(g, path[1])
+	if g.CurrentCell == start && riskyMovePending(g, path[1]) {
+		StopAutoExplore(g, "hazard ahead.")
+		return
+	}
+	if g.CurrentCell == start && !g.AmbientHazard.Wading {
+		StopAutoExplore(g, "the way is blocked.")
+		return
+	}
+	if reason := noteAutoExploreStop(g); reason != "" {
+		StopAutoExplore(g, reason)
+	}
+}
+
+// explorePassable is routePassable without anything auto-explore should never do on
+// its own: unlocking doors, clearing hazards, or stepping onto the lift.
+func explorePassable(g *state.Game, cell *world.Cell) bool {
+	if cell == nil || cell.ExitCell || gameworld.HasLockedDoor(cell) || gameworld.HasBlockingHazard(cell) {
+		return false
+	}
+	return routePassable(g, cell)
+}
+
+// isExploreFrontier reports whether cell borders room floor the player has not discovered.
+func isExploreFrontier(cell *world.Cell) bool {
+	for _, n := range cell.GetNeighbors() {
+		if n.Room && !n.Discovered {
+			return true
+		}
+	}
+	return false
+}
+
+// noteAutoExploreStop marks notable discovered cells as seen and returns a stop reason
+// for the first one that was not seen before, or "". The patrol bot coming into view
+// takes precedence.
+func noteAutoExploreStop(g *state.Game) string {
+	session := g.AutoExplore
+	reason := ""
+	if notePatrolInView(g, &session.PatrolInView) {
+		reason = "patrol spotted."
+	}
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if !cell.Discovered || session.Seen[cell] {
+			return
+		}
+		why := autoExploreNotable(g, cell)
+		if why == "" {
+			return
+		}
+		session.Seen[cell] = true
+		if reason == "" {
+			reason = why
+		}
+	})
+	return reason
+}
+
+// autoExploreNotable describes why cell should interrupt auto-explore, or "".
+func autoExploreNotable(g *state.Game, cell *world.Cell) string {
+	switch {
+	case cell == nil:
+		return ""
+	case cell.ItemsOnFloor.Size() > 0:
+		return "item spotted."
+	case gameworld.HasBlockingHazard(cell):
+		return "hazard ahead."
+	case unpoweredGeneratorAt(cell),
+		gameworld.HasFurniture(cell) && !gameworld.GetGameData(cell).Furniture.IsChecked(),
+		gameworld.HasUnusedTerminal(cell),
+		gameworld.HasUnsolvedPuzzle(cell),
+		gameworld.HasInactiveHazardControl(cell),
+		gameworld.HasIncompleteRepairDevice(cell),
+		gameworld.HasMaintenanceTerminal(cell):
+		return "something to interact with."
+	case gameworld.HasLockedDoor(cell):
+		return "locked door."
+	}
+	return ""
+}
@@ -0,0 +1,101 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// exploreSnake is a switchback corridor: sight never reaches more than one leg ahead.
+var exploreSnake = []string{
+	".......",
+	"######.",
+	".......",
+	".######",
+	".......",
+}
+
+// makeExploreTestGame builds rows ('.' floor, '#' wall) with only the player's cell at (0,0) discovered.
+func makeExploreTestGame(t *testing.T, rows []string) *state.Game {
+	t.Helper()
+	g := state.NewGame()
+	grid := world.NewGrid(len(rows), len(rows[0]))
+	for r, line := range rows {
+		for c, ch := range line {
+			if ch == '.' {
+				grid.MarkAsRoomWithName(r, c, "Corridor", "")
+			}
+		}
+	}
+	grid.BuildAllCellConnections()
+	grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		gameworld.InitGameData(cell)
+	})
+	g.Grid = grid
+	g.CurrentCell = grid.GetCell(0, 0)
+	g.CurrentCell.Discovered = true
+	return g
+}
+
+func runAutoExplore(g *state.Game, maxSteps int) int {
+	steps := 0
+	for IsAutoExploreActive(g) && steps < maxSteps {
+		StepAutoExplore(g)
+		steps++
+	}
+	return steps
+}
+
+func TestAutoExplore_walksUntilExplored(t *testing.T) {
+	g := makeExploreTestGame(t, exploreSnake)
+	StartAutoExplore(g)
+	runAutoExplore(g, 100)
+	if IsAutoExploreActive(g) {
+		t.Fatal("auto-explore still active after step budget")
+	}
+	if g.CurrentCell.Row < 2 {
+		t.Errorf("stopped on row %d; expected the walk to follow the switchback", g.CurrentCell.Row)
+	}
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell.Room && !cell.Discovered {
+			t.Errorf("cell (%d,%d) left undiscovered", row, col)
+		}
+	})
+}
+
+func TestAutoExplore_stopsWhenItemComesIntoView(t *testing.T) {
+	g := makeExploreTestGame(t, exploreSnake)
+	itemCell := g.Grid.GetCell(4, 6)
+	itemCell.ItemsOnFloor.Put(world.NewItem("Battery"))
+	StartAutoExplore(g)
+	runAutoExplore(g, 100)
+	if IsAutoExploreActive(g) {
+		t.Fatal("auto-explore still active after step budget")
+	}
+	if !itemCell.Discovered {
+		t.Fatal("stopped before the item was discovered")
+	}
+	if g.CurrentCell.Row != 4 || g.CurrentCell.Col > 1 {
+		t.Errorf("stopped at (%d,%d), want the bottom leg's entrance where the item first shows", g.CurrentCell.Row, g.CurrentCell.Col)
+	}
+}
+
+func TestAutoExplore_stopsWhenPatrolComesIntoView(t *testing.T) {
+	g := makeExploreTestGame(t, exploreSnake)
+	botCell := g.Grid.GetCell(4, 5)
+	gameworld.GetGameData(botCell).Patrol = entities.NewPatrol("Maintenance Bot", 4, 5, world.East)
+	StartAutoExplore(g)
+	runAutoExplore(g, 100)
+	if IsAutoExploreActive(g) {
+		t.Fatal("auto-explore still active after step budget")
+	}
+	if !botCell.Discovered {
+		t.Fatal("stopped before the patrol was in view")
+	}
+	if g.CurrentCell.Row != 4 || g.CurrentCell.Col > 1 {
+		t.Errorf("stopped at (%d,%d), want the bottom leg's entrance where the bot first shows", g.CurrentCell.Row, g.CurrentCell.Col)
+	}
+}
@@ -0,0 +1,282 @@
+package gameplay
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/debuglog"
+	"darkstation/pkg/game/gamemode"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// autosaveVersion is bumped whenever the Autosave layout changes; older files are
+// rejected rather than half-read.
+const autosaveVersion = 2
+
+// autosavePath is where the deck-entry checkpoint is written. Empty (the default, and
+// in tests) turns autosaving off.
+var autosavePath string
+
+// EnableAutosave writes a checkpoint to path each time the run enters a newly generated
+// deck. An empty path turns autosaving off.
+func EnableAutosave(path string) {
+	autosavePath = path
+}
+
+// Autosave is the run checkpoint taken on entering a new deck. Decks are rebuilt from
+// the run seed, so only run-wide progress is stored; the saved deck restarts fresh.
+// Decks visited earlier are listed so a revisit after resuming finds them looted
+// rather than restocked.
+type Autosave struct {
+	Version    int                 `json:"version"`
+	SavedAt    time.Time           `json:"saved_at"`
+	Mode       gamemode.ID         `json:"mode"`
+	Difficulty gamemode.Difficulty `json:"difficulty"`
+	DeckSize   gamemode.DeckSize   `json:"deck_size"`
+	Creative   bool                `json:"creative,omitempty"`
+	Survival   bool                `json:"survival,omitempty"`
+	RunSeed    int64               `json:"run_seed"`
+	Level      int                 `json:"level"`
+	ElapsedMs  int64               `json:"elapsed_ms"`
+
+	Batteries          int                     `json:"batteries"`
+	RunInventory       []world.Item            `json:"run_inventory,omitempty"`
+	FoundCodes         []string                `json:"found_codes,omitempty"`
+	UnlockSatisfied    map[string]bool         `json:"unlock_satisfied,omitempty"`
+	LiftRoutingPowered map[int]bool            `json:"lift_routing_powered,omitempty"`
+	ReactorOnline      bool                    `json:"reactor_online,omitempty"`
+	DeckHistory        []state.DeckClearRecord `json:"deck_history,omitempty"`
+	DeckItemsCollected map[int]int             `json:"deck_items_collected,omitempty"`
+	VisitedDecks       []int                   `json:"visited_decks,omitempty"`
+	EndlessScore       int                     `json:"endless_score,omitempty"`
+}
+
+// newAutosave captures g's run-wide progress.
+func newAutosave(g *state.Game) *Autosave {
+	mode := g.Mode()
+	a := &Autosave{
+		Version:            autosaveVersion,
+		SavedAt:            time.Now().UTC(),
+		Mode:               mode.ID,
+		Difficulty:         mode.Difficulty,
+		DeckSize:           mode.DeckSize,
+		Creative:           mode.Creative,
+		Survival:           mode.Survival,
+		RunSeed:            g.RunSeed,
+		Level:              g.Level,
+		Batteries:          g.Batteries,
+		UnlockSatisfied:    g.UnlockSatisfied,
+		LiftRoutingPowered: g.LiftRoutingPowered,
+		ReactorOnline:      g.ReactorOnline,
+		DeckHistory:        g.DeckHistory,
+		DeckItemsCollected: g.DeckItemsCollected,
+		EndlessScore:       g.EndlessScore,
+	}
+	if g.RunStartedAt > 0 {
+		a.ElapsedMs = time.Now().UnixMilli() - g.RunStartedAt
+	}
+	g.RunInventory.Each(func(item *world.Item) {
+		a.RunInventory = append(a.RunInventory, *item)
+	})
+	slices.SortFunc(a.RunInventory, func(x, y world.Item) int {
+		return cmp.Or(cmp.Compare(x.Name, y.Name), cmp.Compare(x.Tag, y.Tag))
+	})
+	for code, found := range g.FoundCodes {
+		if found {
+			a.FoundCodes = append(a.FoundCodes, code)
+		}
+	}
+	slices.Sort(a.FoundCodes)
+	for id, ds := range g.DeckStates {
+		if id != g.CurrentDeckID && ds != nil && ds.Grid != nil {
+			a.VisitedDecks = append(a.VisitedDecks, id)
+		}
+	}
+	for id, looted := range g.LootedDecks {
+		if looted && id != g.CurrentDeckID && !slices.Contains(a.VisitedDecks, id) {
+			a.VisitedDecks = append(a.VisitedDecks, id)
+		}
+	}
+	slices.Sort(a.VisitedDecks)
+	return a
+}
+
+// writeAutosave checkpoints g after it enters a newly generated deck. Failures are
+// logged and never interrupt play.
+func writeAutosave(g *state.Game) {
+	if autosavePath == "" || g == nil || g.Grid == nil {
+		return
+	}
+	if err := saveAutosave(autosavePath, newAutosave(g)); err != nil {
+		debuglog.Warnf("could not write autosave: %v", err)
+		return
+	}
+	debuglog.Info("autosave.written", "path", autosavePath, "level", g.Level)
+}
+
+// saveAutosave writes a via a temporary file so a crash mid-write leaves the previous
+// checkpoint intact.
+func saveAutosave(path string, a *Autosave) error {
+	data, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode autosave: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create autosave directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("write autosave: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("write autosave: %w", err)
+	}
+	return nil
+}
+
+// clearAutosave removes the checkpoint once the run is over for good.
+func clearAutosave() {
+	if autosavePath == "" {
+		return
+	}
+	if err := os.Remove(autosavePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		debuglog.Warnf("could not remove autosave: %v", err)
+	}
+}
+
+// LoadAutosave reads the checkpoint at path. A missing file returns an error matching
+// os.ErrNotExist; a corrupt file or one from another save version returns a
+// descriptive error and should be ignored in favour of a fresh game.
+func LoadAutosave(path string) (*Autosave, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var a Autosave
+	if err := json.Unmarshal(data, &a); err != nil {
+		return nil, fmt.Errorf("autosave is corrupt: %w", err)
+	}
+	if a.Version != autosaveVersion {
+		return nil, fmt.Errorf("autosave version %d is not supported (want %d)", a.Version, autosaveVersion)
+	}
+	if gamemode.Get(a.Mode).ID != a.Mode {
+		return nil, fmt.Errorf("autosave has unknown game mode %q", a.Mode)
+	}
+	scratch := state.NewGame()
+	scratch.SetMode(a.Mode)
+	if a.Level < 1 || (!scratch.Mode().Endless && a.Level > scratch.TotalDecks()) {
+		return nil, fmt.Errorf("autosave deck %d is out of range", a.Level)
+	}
+	return &a, nil
+}
+
+// runOptions returns the new-game options the saved run was started with.
+func (a *Autosave) runOptions() gamemode.RunOptions {
+	return gamemode.RunOptions{Difficulty: a.Difficulty, DeckSize: a.DeckSize, Seed: a.RunSeed, Creative: a.Creative, Survival: a.Survival}
+}
+
+// Summary describes the checkpoint for the title menu, e.g. "Deck 4, Hard, saved 16 Oct 14:05".
+func (a *Autosave) Summary() string {
+	return fmt.Sprintf("Deck %d, %s, saved %s", a.Level, a.Difficulty, a.SavedAt.Local().Format("2 Jan 15:04"))
+}
+
+// Resume rebuilds the saved run: run-wide progress is restored and the saved deck is
+// regenerated from its seed, with the player at the lift. Decks visited before the
+// checkpoint are marked looted for when the player goes back to them.
+func (a *Autosave) Resume() *state.Game {
+	g := state.NewGame()
+	g.SetMode(a.Mode)
+	g.GameMode = g.Mode().WithOptions(a.runOptions())
+	g.InitRunUnlocks(a.RunSeed)
+
+	g.Batteries = a.Batteries
+	for _, item := range a.RunInventory {
+		g.RunInventory.Put(world.NewTaggedItem(item.Name, item.Tag))
+	}
+	for _, code := range a.FoundCodes {
+		g.AddFoundCode(code)
+	}
+	for id, ok := range a.UnlockSatisfied {
+		g.UnlockSatisfied[id] = ok
+	}
+	for id, on := range a.LiftRoutingPowered {
+		g.LiftRoutingPowered[id] = on
+	}
+	g.ReactorOnline = a.ReactorOnline
+	g.DeckHistory = a.DeckHistory
+	for id, n := range a.DeckItemsCollected {
+		g.DeckItemsCollected[id] = n
+	}
+	g.EndlessScore = a.EndlessScore
+	if len(a.VisitedDecks) > 0 {
+		g.LootedDecks = make(map[int]bool, len(a.VisitedDecks))
+		for _, id := range a.VisitedDecks {
+			g.LootedDecks[id] = true
+		}
+	}
+
+	generateLevel(g, a.Level, deckSeed(g, a.Level))
+	refreshDeckPower(g)
+	g.SaveCurrentDeckState()
+	UpdateLightingExploration(g)
+	spawnOnDeckEntry(g, SpawnModeLiftShaft)
+
+	InitRunTracking(g)
+	g.RunStartedAt -= a.ElapsedMs
+	g.ClearMessages()
+	logMessage(g, "Resumed from autosave: deck %d.", g.Level)
+	announceIfStuck(g)
+	return g
+}
+
+// lootRebuiltDeck returns a deck rebuilt after a resume to how the player left it, so a
+// revisit cannot hand out its batteries, keycards and items a second time. The player
+// only ever leaves a deck through a ready lift, so its generators, doors, hazards,
+// repairs and survivor are all settled too; otherwise the empty deck could strand them.
+func lootRebuiltDeck(g *state.Game) {
+	if g == nil || g.Grid == nil {
+		return
+	}
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell == nil || !cell.Room {
+			return
+		}
+		var items []*world.Item
+		cell.ItemsOnFloor.Each(func(item *world.Item) { items = append(items, item) })
+		for _, item := range items {
+			cell.ItemsOnFloor.Remove(item)
+		}
+		data := gameworld.GetGameData(cell)
+		data.PendingUnlockKeycard = ""
+		data.Survivor = nil
+		if data.Furniture != nil && !data.Furniture.PowerConduit {
+			data.Furniture.Check()
+		}
+		if data.Generator != nil {
+			data.Generator.Tripped = false
+			data.Generator.InsertBatteriesAndStart(data.Generator.BatteriesNeeded())
+		}
+		if data.Door != nil {
+			data.Door.Unlock()
+		}
+		if data.Hazard != nil {
+			data.Hazard.Fix()
+		}
+		if data.HazardControl != nil {
+			data.HazardControl.Activate()
+		}
+		data.RepairDevice.Complete()
+		data.RepairBlocker.Complete()
+	})
+	for _, repair := range g.RepairObjectives {
+		repair.Complete()
+	}
+}
@@ -0,0 +1,134 @@
+package gameplay
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// enableTestAutosave points autosaving at a temp file for the test's duration.
+func enableTestAutosave(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "autosave.json")
+	EnableAutosave(path)
+	t.Cleanup(func() { EnableAutosave("") })
+	return path
+}
+
+func TestTravelToDeck_writesAutosaveThatResumesTheRun(t *testing.T) {
+	path := enableTestAutosave(t)
+	g := buildGameWithSeed(1, 424242)
+	unlockAllDecksForTest(g)
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("autosave written before any deck transition: %v", err)
+	}
+
+	g.AddBatteries(2)
+	g.AddRunKeycard(world.NewItem("Reactor Auth Keycard"))
+	g.AddFoundCode("K7-Q2-X9")
+	if err := TravelToDeck(g, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	save, err := LoadAutosave(path)
+	if err != nil {
+		t.Fatalf("LoadAutosave: %v", err)
+	}
+	if save.Level != 2 || save.RunSeed != 424242 {
+		t.Fatalf("autosave level=%d seed=%d, want 2 and 424242", save.Level, save.RunSeed)
+	}
+	if !strings.HasPrefix(save.Summary(), "Deck 2, ") {
+		t.Fatalf("summary = %q", save.Summary())
+	}
+
+	resumed := save.Resume()
+	if resumed.Level != 2 || resumed.CurrentDeckID != 1 || resumed.LevelSeed != g.LevelSeed {
+		t.Fatalf("resumed level=%d deck=%d seed=%d, want 2, 1, %d", resumed.Level, resumed.CurrentDeckID, resumed.LevelSeed, g.LevelSeed)
+	}
+	if resumed.Batteries != g.Batteries || !resumed.HasFoundCode("K7-Q2-X9") || !resumed.HasRunKeycard("Reactor Auth Keycard") {
+		t.Fatalf("run progress not restored: batteries=%d", resumed.Batteries)
+	}
+	if resumed.Grid.ExitCell() == nil || resumed.CurrentCell != resumed.Grid.ExitCell() {
+		t.Fatal("resumed player should stand on the lift exit")
+	}
+	if len(resumed.DeckHistory) != len(g.DeckHistory) {
+		t.Fatalf("deck history len=%d, want %d", len(resumed.DeckHistory), len(g.DeckHistory))
+	}
+
+	// Returning to a deck already generated this run does not rewrite the checkpoint.
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := TravelToDeck(g, 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("revisiting a deck rewrote the autosave: %v", err)
+	}
+}
+
+func TestResume_revisitedDeckComesBackLooted(t *testing.T) {
+	path := enableTestAutosave(t)
+	g := buildGameWithSeed(1, 424242)
+	unlockAllDecksForTest(g)
+	if err := TravelToDeck(g, 2); err != nil {
+		t.Fatal(err)
+	}
+	save, err := LoadAutosave(path)
+	if err != nil {
+		t.Fatalf("LoadAutosave: %v", err)
+	}
+	if !slices.Equal(save.VisitedDecks, []int{0}) {
+		t.Fatalf("VisitedDecks = %v, want [0]", save.VisitedDecks)
+	}
+
+	resumed := save.Resume()
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := TravelToDeck(resumed, 1); err != nil {
+		t.Fatal(err)
+	}
+	resumed.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		data := gameworld.GetGameData(cell)
+		if cell.ItemsOnFloor.Size() > 0 || (data.Furniture != nil && data.Furniture.HasItem()) {
+			t.Fatalf("loot left at (%d,%d) on a deck looted before the checkpoint", row, col)
+		}
+		if gameworld.HasBlockingHazard(cell) {
+			t.Fatalf("blocking hazard left at (%d,%d) on a deck cleared before the checkpoint", row, col)
+		}
+	})
+	if resumed.UnpoweredGeneratorCount() != 0 || resumed.IncompleteRepairCount() != 0 {
+		t.Fatal("a deck left through its lift should come back with its generators and repairs done")
+	}
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("revisiting a looted deck rewrote the autosave: %v", err)
+	}
+}
+
+func TestLoadAutosave_rejectsCorruptAndIncompatibleFiles(t *testing.T) {
+	dir := t.TempDir()
+	cases := map[string]string{
+		"corrupt":      `{"version": 1, "level": `,
+		"old version":  `{"version": 0, "mode": "SinglePlayerPuzzle", "level": 2}`,
+		"unknown mode": `{"version": 1, "mode": "no_such_mode", "level": 2}`,
+	}
+	for name, body := range cases {
+		path := filepath.Join(dir, strings.ReplaceAll(name, " ", "_")+".json")
+		if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadAutosave(path); err == nil {
+			t.Errorf("%s: LoadAutosave accepted %s", name, body)
+		}
+	}
+	if _, err := LoadAutosave(filepath.Join(dir, "missing.json")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("missing file: err = %v, want os.ErrNotExist", err)
+	}
+}
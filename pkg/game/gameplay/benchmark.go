@@ -0,0 +1,78 @@
+package gameplay
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/gamemode"
+	"darkstation/pkg/game/generator"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/setup"
+	"darkstation/pkg/game/state"
+)
+
+const (
+	renderBenchmarkSeconds    = 5.0
+	renderBenchmarkMaxSeconds = 60.0
+)
+
+// StartRenderBenchmark swaps in the largest deck the generator builds (the peak of the
+// middle-deck curve at the Large deck size), reveals all of it and has the renderer time
+// map frames at the overview zoom for arg seconds (default 5). Generation time is logged
+// on its own so a slow deck can be told apart from a slow draw. Developer/testing only
+// (console "benchmark"); like perfmap it replaces the deck in play.
+func StartRenderBenchmark(g *state.Game, arg string) {
+	if g == nil {
+		return
+	}
+	seconds := renderBenchmarkSeconds
+	if s, err := strconv.ParseFloat(arg, 64); err == nil && s > 0 {
+		seconds = min(s, renderBenchmarkMaxSeconds)
+	}
+
+	g.SaveCurrentDeckState()
+	clearCrossDeckPowerState(g)
+	clearCompletionState(g)
+	clearLevelProgress(g)
+
+	level := min(generator.LargestDeckLevel, g.TotalDecks())
+	runMode := g.GameMode
+	large := g.Mode()
+	large.LevelGen.SizePercent = gamemode.DeckSizeLarge.Percent()
+	g.GameMode = large
+	start := time.Now()
+	generateLevel(g, level, time.Now().UnixNano())
+	elapsed := time.Since(start)
+	g.GameMode = runMode
+
+	UpdateLightingExploration(g)
+	setup.EnsureSolvabilityDoorPower(g)
+	setup.ApplyGridConductivePower(g)
+	setup.ForceMaintBootstrapOK(g)
+	SpawnOnDeckEntry(g, SpawnModeLiftShaft)
+	revealWholeDeck(g.Grid)
+	g.ClearMessages()
+
+	log.Printf("[Benchmark] generated deck %d (%dx%d, %d attempt(s)) in %v",
+		g.Level, g.Grid.Rows(), g.Grid.Cols(), g.LevelGenAttempts, elapsed.Round(time.Millisecond))
+	if !renderer.StartRenderBenchmark(seconds) {
+		logMessage(g, "Render benchmark is not available (or already running).")
+		return
+	}
+	logMessage(g, "Benchmarking the renderer for %gs on deck %d; results go to the log.", seconds, g.Level)
+}
+
+// revealWholeDeck marks every cell discovered and visited so the map draws all of it.
+func revealWholeDeck(grid *world.Grid) {
+	if grid == nil {
+		return
+	}
+	grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell != nil {
+			cell.Discovered = true
+			cell.Visited = true
+		}
+	})
+}
@@ -25,8 +25,10 @@ func TriggerGameComplete(g *state.Game) {
 	if g == nil {
 		return
 	}
+	g.RecordDeckCleared()
 	g.RunStatsSnapshot = g.SnapshotRunStats()
 	g.GameComplete = true
+	clearAutosave()
 	g.CompletionPhase = state.CompletionPhaseSummary
 	g.CreditsLineIndex = 0
 	g.CreditsLineStartMs = 0
@@ -0,0 +1,32 @@
+package gameplay
+
+import (
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// applyCreativeMode opens a freshly generated deck up for free exploration: every
+// generator is fuelled and running, every door unlocked, and the whole deck revealed.
+// Hazards stay on the map but CanEnter lets the player through them.
+func applyCreativeMode(g *state.Game) {
+	if !g.Creative() || g.Grid == nil {
+		return
+	}
+	g.HasMap = true
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell == nil || !cell.Room {
+			return
+		}
+		cell.Discovered = true
+		data := gameworld.GetGameData(cell)
+		if data.Generator != nil {
+			data.Generator.Tripped = false
+			data.Generator.InsertBatteriesAndStart(data.Generator.BatteriesNeeded())
+		}
+		if data.Door != nil {
+			data.Door.Unlock()
+		}
+	})
+	refreshDeckPower(g)
+}
@@ -0,0 +1,49 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/gamemode"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func TestApplyCreativeMode_opensDeck(t *testing.T) {
+	g := makeTestGame(1, 4)
+	gen := entities.NewGenerator("G1", 2)
+	gameworld.GetGameData(g.Grid.GetCell(0, 3)).Generator = gen
+	g.AddGenerator(gen)
+	doorCell := g.Grid.GetCell(0, 1)
+	gameworld.GetGameData(doorCell).Door = entities.NewDoor("Vault")
+	hazardCell := g.Grid.GetCell(0, 2)
+	gameworld.GetGameData(hazardCell).Hazard = entities.NewHazard(entities.HazardElectrical)
+
+	applyCreativeMode(g)
+	if gen.IsPowered() || !gameworld.HasLockedDoor(doorCell) {
+		t.Fatal("a normal run should be left untouched")
+	}
+	if ok, _ := CanEnter(g, hazardCell, false); ok {
+		t.Fatal("a normal run should be blocked by the hazard")
+	}
+
+	g.GameMode = g.Mode().WithOptions(gamemode.RunOptions{Creative: true})
+	applyCreativeMode(g)
+	if !gen.IsPowered() {
+		t.Error("creative mode should start every generator")
+	}
+	if gameworld.HasLockedDoor(doorCell) {
+		t.Error("creative mode should unlock every door")
+	}
+	if !g.HasMap || !g.Grid.GetCell(0, 3).Discovered {
+		t.Error("creative mode should reveal the deck")
+	}
+	if ok, _ := CanEnter(g, doorCell, false); !ok {
+		t.Error("creative mode should let the player through doors regardless of power")
+	}
+	if ok, _ := CanEnter(g, hazardCell, false); !ok {
+		t.Error("creative mode should let the player through hazards")
+	}
+	if recordEndlessHighScore(g) {
+		t.Error("creative runs should never set a high score")
+	}
+}
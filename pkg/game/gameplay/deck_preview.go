@@ -0,0 +1,82 @@
+package gameplay
+
+import (
+	"github.com/zyedidia/generic/mapset"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/debuglog"
+	"darkstation/pkg/game/state"
+)
+
+// deckSeed returns the level seed a deck is generated from on its first visit.
+func deckSeed(g *state.Game, level int) int64 {
+	return g.RunSeed + int64(level-1)*9973
+}
+
+// previewNextDeck rates the deck below the current one before the player descends:
+// the deck is generated from its own seed on a scratch game, so the rating matches
+// what the lift will build, and kept in g.PreviewDecks for adoptPreviewDeck. Only an
+// unlocked, unvisited next deck is rated; the result is cached in g.DeckRatings for the
+// rest of the run.
+func previewNextDeck(g *state.Game) {
+	if g == nil || g.Mode().Endless {
+		return
+	}
+	nextID := g.CurrentDeckID + 1
+	if nextID >= g.TotalDecks() || !g.IsDeckTravelUnlocked(nextID) {
+		return
+	}
+	if ds := g.DeckStates[nextID]; ds != nil && ds.Grid != nil {
+		return
+	}
+	if _, ok := g.DeckRatings[nextID]; ok || g.LootedDecks[nextID] {
+		return
+	}
+
+	scratch := state.NewGame()
+	scratch.GameMode = g.GameMode
+	scratch.InitRunUnlocks(g.RunSeed)
+	runItems := mapset.New[*world.Item]()
+	g.RunInventory.Each(func(item *world.Item) { runItems.Put(item) })
+	scratch.RunInventory = runItems
+	level := nextID + 1
+	generateLevel(scratch, level, deckSeed(g, level))
+	if debuglog.Enabled() {
+		// generateLevel points the log context at the scratch deck; put it back.
+		debuglog.SetContext(runLogContext(g)...)
+	}
+
+	if g.DeckRatings == nil {
+		g.DeckRatings = make(map[int]state.DeckRating)
+	}
+	g.DeckRatings[nextID] = scratch.RateDeck()
+
+	scratch.SaveCurrentDeckState()
+	if g.PreviewDecks == nil {
+		g.PreviewDecks = make(map[int]*state.PreviewDeck)
+	}
+	g.PreviewDecks[nextID] = &state.PreviewDeck{
+		State:            scratch.DeckStates[nextID],
+		Hints:            scratch.Hints,
+		LevelGenAttempts: scratch.LevelGenAttempts,
+		RunItems:         g.RunInventory.Size(),
+	}
+}
+
+// adoptPreviewDeck makes the deck previewNextDeck built for deckID the current deck, so
+// its first visit does not generate it a second time. Reports false when there is no
+// usable preview: none was built, or run keycards picked up since would change the deck.
+func adoptPreviewDeck(g *state.Game, deckID int) bool {
+	pre := g.PreviewDecks[deckID]
+	delete(g.PreviewDecks, deckID)
+	if pre == nil || pre.State == nil || pre.RunItems != g.RunInventory.Size() {
+		return false
+	}
+	g.DeckStates[deckID] = pre.State
+	g.LoadDeckState(deckID)
+	g.Hints = pre.Hints
+	g.LevelGenAttempts = pre.LevelGenAttempts
+	applyCreativeMode(g)
+	logDeckGenerated(g)
+	return true
+}
@@ -0,0 +1,57 @@
+package gameplay
+
+import (
+	"reflect"
+	"testing"
+
+	"darkstation/pkg/engine/world"
+)
+
+func TestPreviewNextDeck_matchesDeckTheLiftBuilds(t *testing.T) {
+	g := buildGameWithSeed(1, 424242)
+	unlockAllDecksForTest(g)
+
+	previewNextDeck(g)
+	preview, ok := g.DeckRatings[1]
+	if !ok {
+		t.Fatal("no rating cached for deck 2")
+	}
+	if g.CurrentDeckID != 0 || g.Level != 1 {
+		t.Fatalf("preview moved the player to deck %d (level %d)", g.CurrentDeckID, g.Level)
+	}
+	pre := g.PreviewDecks[1]
+	if pre == nil || pre.State == nil || len(pre.Hints) == 0 {
+		t.Fatalf("preview deck not kept for adoption: %+v", pre)
+	}
+
+	if err := TravelToDeck(g, 2); err != nil {
+		t.Fatalf("TravelToDeck: %v", err)
+	}
+	if got := g.RateDeck(); !reflect.DeepEqual(got, preview) {
+		t.Errorf("deck 2 rates %+v on arrival, preview said %+v", got, preview)
+	}
+	if g.Grid != pre.State.Grid {
+		t.Error("descending generated deck 2 again instead of adopting the preview")
+	}
+	if !reflect.DeepEqual(g.Hints, pre.Hints) {
+		t.Errorf("hints on arrival = %v, want the preview's %v", g.Hints, pre.Hints)
+	}
+	if _, ok := g.PreviewDecks[1]; ok {
+		t.Error("adopted preview should leave PreviewDecks")
+	}
+}
+
+func TestAdoptPreviewDeck_skipsPreviewAfterRunKeycardPickup(t *testing.T) {
+	g := buildGameWithSeed(1, 424242)
+	unlockAllDecksForTest(g)
+	previewNextDeck(g)
+	stale := g.PreviewDecks[1].State.Grid
+
+	g.RunInventory.Put(world.NewItem("Test Keycard"))
+	if err := TravelToDeck(g, 2); err != nil {
+		t.Fatalf("TravelToDeck: %v", err)
+	}
+	if g.Grid == stale {
+		t.Error("a preview built before a run keycard pickup should be regenerated")
+	}
+}
@@ -0,0 +1,54 @@
+package gameplay
+
+import (
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/state"
+)
+
+// Deck radiation meter tuning. Each uncleared radiation leak adds to the meter every move;
+// with every leak contained it drains faster than a single leak fills it.
+const (
+	deckRadiationRisePerLeak = 1
+	deckRadiationDecay       = 2
+	deckRadiationWarnLevel   = state.DeckRadiationMax / 2
+	deckRadiationBurnTurns   = 8 // moves at a full meter per carried battery burned
+)
+
+// UpdateDeckRadiation advances the deck-wide radiation meter by one move. Called once per
+// processed input from TickTurn; inputs that did not move the player are skipped. Uncleared
+// radiation leaks raise the meter wherever the player stands; once it is full, suit
+// shielding burns a carried battery every few moves until the leaks are contained and the
+// meter drains.
+func UpdateDeckRadiation(g *state.Game) {
+	if g == nil || g.Grid == nil || g.CurrentCell == nil || g.MovementCount == g.DeckRadiation.TurnAt {
+		return
+	}
+	r := &g.DeckRadiation
+	r.TurnAt = g.MovementCount
+	leaks := g.ActiveHazardCount(entities.HazardRadiation)
+	if leaks == 0 {
+		r.Level = max(r.Level-deckRadiationDecay, 0)
+		r.TurnsAtMax = 0
+		if r.Level == 0 {
+			r.Warned = false
+		}
+		return
+	}
+
+	r.Level = min(r.Level+leaks*deckRadiationRisePerLeak, state.DeckRadiationMax)
+	if r.Level >= deckRadiationWarnLevel && !r.Warned {
+		r.Warned = true
+		logMessage(g, "Deck radiation is climbing. Contain the HAZARD{Radiation Leak} before your suit shielding gives out.")
+	}
+	if r.Level < state.DeckRadiationMax {
+		r.TurnsAtMax = 0
+		return
+	}
+	r.TurnsAtMax++
+	if r.TurnsAtMax%deckRadiationBurnTurns == 0 && g.Batteries > 0 {
+		g.UseBatteries(1)
+		logMessage(g, "Deck radiation overloads your suit shielding and burns a battery. ACTION{%d} left.", g.Batteries)
+		renderer.AddCallout(g.CurrentCell.Row, g.CurrentCell.Col, "HAZARD{Shielding burned a battery}", renderer.CalloutColorBattery, 3000)
+	}
+}
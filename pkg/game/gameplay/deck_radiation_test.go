@@ -0,0 +1,67 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func TestUpdateDeckRadiation_LeakFillsMeterThenBurnsBatteries(t *testing.T) {
+	g, cell, leakCell := makeMinimalGameWithGrid(t)
+	leak := entities.NewHazard(entities.HazardRadiation)
+	gameworld.GetGameData(leakCell).Hazard = leak
+	g.CurrentCell = cell
+	g.AddBatteries(2)
+
+	for i := 0; i < state.DeckRadiationMax; i++ {
+		g.MovementCount++
+		UpdateDeckRadiation(g)
+	}
+	if g.DeckRadiation.Level != state.DeckRadiationMax {
+		t.Fatalf("Level = %d after %d turns beside one leak, want full", g.DeckRadiation.Level, state.DeckRadiationMax)
+	}
+	if !g.DeckRadiation.Warned {
+		t.Error("climbing past half the meter should log a warning")
+	}
+	for i := 0; i < deckRadiationBurnTurns; i++ {
+		g.MovementCount++
+		UpdateDeckRadiation(g)
+	}
+	if g.Batteries != 1 {
+		t.Errorf("Batteries = %d after %d turns at a full meter, want 1", g.Batteries, deckRadiationBurnTurns)
+	}
+
+	leak.Fixed = true
+	g.MovementCount++
+	UpdateDeckRadiation(g)
+	if want := state.DeckRadiationMax - deckRadiationDecay; g.DeckRadiation.Level != want {
+		t.Errorf("Level = %d one turn after containment, want %d", g.DeckRadiation.Level, want)
+	}
+}
+
+func TestUpdateDeckRadiation_IgnoresNonMoves(t *testing.T) {
+	g, cell, leakCell := makeMinimalGameWithGrid(t)
+	gameworld.GetGameData(leakCell).Hazard = entities.NewHazard(entities.HazardRadiation)
+	g.CurrentCell = cell
+	g.MovementCount = 1
+
+	// Menus, hints and unbound keys still run the per-input tick but are not moves.
+	for i := 0; i < 10; i++ {
+		UpdateDeckRadiation(g)
+	}
+	if want := deckRadiationRisePerLeak; g.DeckRadiation.Level != want {
+		t.Fatalf("Level = %d after one move and nine other inputs, want %d", g.DeckRadiation.Level, want)
+	}
+}
+
+func TestUpdateDeckRadiation_NoLeaksStaysEmpty(t *testing.T) {
+	g, cell, _ := makeMinimalGameWithGrid(t)
+	g.CurrentCell = cell
+	g.MovementCount = 1
+	UpdateDeckRadiation(g)
+	if g.DeckRadiation.Level != 0 {
+		t.Errorf("Level = %d on a deck without leaks, want 0", g.DeckRadiation.Level)
+	}
+}
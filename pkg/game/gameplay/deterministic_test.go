@@ -2,6 +2,8 @@ package gameplay
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"testing"
 
 	"darkstation/pkg/engine/world"
@@ -100,3 +102,61 @@ func TestResetLevel_MatchesRegenerateFromSeed(t *testing.T) {
 		t.Fatal("ResetLevel and RegenerateFromSeed should produce identical layouts")
 	}
 }
+
+// levelPlacementDigest lists what was placed where: furniture (and any item hidden in it),
+// puzzles, hazards and their types, maintenance terminals, and floor items by name.
+func levelPlacementDigest(g *state.Game) string {
+	var parts []string
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell == nil {
+			return
+		}
+		data := gameworld.GetGameData(cell)
+		at := fmt.Sprintf("%d,%d", row, col)
+		if data.Furniture != nil {
+			held := ""
+			if data.Furniture.ContainedItem != nil {
+				held = data.Furniture.ContainedItem.Name
+			}
+			parts = append(parts, fmt.Sprintf("%s furniture %s [%s]", at, data.Furniture.Name, held))
+		}
+		if data.Puzzle != nil {
+			parts = append(parts, fmt.Sprintf("%s puzzle %s", at, data.Puzzle.Name))
+		}
+		if data.Hazard != nil {
+			parts = append(parts, fmt.Sprintf("%s hazard %d", at, data.Hazard.Type))
+		}
+		if data.MaintenanceTerm != nil {
+			parts = append(parts, fmt.Sprintf("%s maintenance", at))
+		}
+		var items []string
+		cell.ItemsOnFloor.Each(func(item *world.Item) { items = append(items, item.Name) })
+		sort.Strings(items)
+		for _, name := range items {
+			parts = append(parts, fmt.Sprintf("%s item %s", at, name))
+		}
+	})
+	return strings.Join(parts, "\n")
+}
+
+func TestRegenerateFromSeed_SamePlacementsAcrossRuns(t *testing.T) {
+	cases := []struct {
+		level int
+		seed  int64
+	}{{3, 7}, {5, 90210}}
+	for _, tc := range cases {
+		var digests [2]string
+		for i := range digests {
+			g := state.NewGame()
+			g.InitRunUnlocks(tc.seed)
+			g.Level = tc.level
+			g.CurrentDeckID = tc.level - 1
+			RegenerateFromSeed(g, tc.seed)
+			digests[i] = levelPlacementDigest(g)
+		}
+		if digests[0] != digests[1] {
+			t.Fatalf("level %d seed %d: placements differ between same-seed generations:\n%s\n---\n%s",
+				tc.level, tc.seed, digests[0], digests[1])
+		}
+	}
+}
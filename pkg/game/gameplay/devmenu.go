@@ -26,6 +26,7 @@ const (
 	DevMenuActionToggleFOVRays
 	DevMenuActionToggleFPSDisplay
 	DevMenuActionTogglePlayerPosition
+	DevMenuActionToggleCellCoords
 	DevMenuActionTriggerOverload
 	DevMenuActionLoadSeed
 	DevMenuActionJumpToDeck
@@ -57,6 +58,8 @@ func (d *DevMenuItem) GetHelpText() string {
 		return "Toggle draw.fps cvar (FPS counter in top-right corner)"
 	case DevMenuActionTogglePlayerPosition:
 		return "Toggle draw.player_pos cvar (player X/Y below FPS counter)"
+	case DevMenuActionToggleCellCoords:
+		return "Toggle draw.coords cvar (row,col on each discovered map tile, for bug reports)"
 	case DevMenuActionTriggerOverload:
 		return "Force power overload in the current room (trips generators, shorts other loads)"
 	case DevMenuActionLoadSeed:
@@ -137,8 +140,14 @@ func (h *DevMenuHandler) OnActivate(item gamemenu.MenuItem, index int) (bool, st
 			return false, "Player position: ON"
 		}
 		return false, "Player position: OFF"
+	case DevMenuActionToggleCellCoords:
+		on := renderer.ToggleShowCellCoords()
+		if on {
+			return false, "Cell coordinates: ON"
+		}
+		return false, "Cell coordinates: OFF"
 	case DevMenuActionTriggerOverload:
-		if h.g.CurrentCell == nil || h.g.CurrentCell.Name == "" || h.g.CurrentCell.Name == "Corridor" {
+		if h.g.CurrentCell == nil || h.g.CurrentCell.Name == "" || h.g.CurrentCell.IsCorridor {
 			return false, "Stand in a named room to trigger overload"
 		}
 		room := h.g.CurrentCell.Name
@@ -236,6 +245,13 @@ func playerPositionMenuLabel() string {
 	return devToggleMenuLabel("Player position", false)
 }
 
+func cellCoordsMenuLabel() string {
+	if renderer.ShowCellCoordsEnabled() {
+		return devToggleMenuLabel("Cell coordinates", true)
+	}
+	return devToggleMenuLabel("Cell coordinates", false)
+}
+
 func devToggleMenuLabel(label string, on bool) string {
 	value := "UNPOWERED{OFF}"
 	if on {
@@ -268,6 +284,7 @@ func (h *DevMenuHandler) GetMenuItems() []gamemenu.MenuItem {
 		&DevMenuItem{Label: fovRaysMenuLabel(), Action: DevMenuActionToggleFOVRays, G: h.g},
 		&DevMenuItem{Label: fpsDisplayMenuLabel(), Action: DevMenuActionToggleFPSDisplay, G: h.g},
 		&DevMenuItem{Label: playerPositionMenuLabel(), Action: DevMenuActionTogglePlayerPosition, G: h.g},
+		&DevMenuItem{Label: cellCoordsMenuLabel(), Action: DevMenuActionToggleCellCoords, G: h.g},
 		&DevMenuItem{Label: levelSeedMenuLabel(h.g), Action: DevMenuActionLoadSeed, G: h.g},
 		&DevMenuItem{Label: "Jump to deck\tSUBTLE{select}", Action: DevMenuActionJumpToDeck, G: h.g},
 		&DevMenuItem{Label: "Trigger overload\tUNPOWERED{danger}", Action: DevMenuActionTriggerOverload, G: h.g},
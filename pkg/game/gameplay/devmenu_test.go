@@ -11,8 +11,8 @@ import (
 func TestDevMenuHandler_GetMenuItems(t *testing.T) {
 	h := NewDevMenuHandler(state.NewGame())
 	items := h.GetMenuItems()
-	if len(items) != 13 {
-		t.Fatalf("expected 13 items, got %d", len(items))
+	if len(items) != 14 {
+		t.Fatalf("expected 14 items, got %d", len(items))
 	}
 	if items[0].GetLabel() != "Zoom\tSUBTLE{24px (30×15 tiles)}" {
 		t.Fatalf("item 0 label = %q", items[0].GetLabel())
@@ -25,6 +25,7 @@ func TestDevMenuHandler_GetMenuItems(t *testing.T) {
 		DevMenuActionToggleFOVRays:        "FOV ray lines",
 		DevMenuActionToggleFPSDisplay:     "FPS display",
 		DevMenuActionTogglePlayerPosition: "Player position",
+		DevMenuActionToggleCellCoords:     "Cell coordinates",
 		DevMenuActionLoadSeed:             "Load level seed",
 		DevMenuActionJumpToDeck:           "Jump to deck",
 		DevMenuActionTriggerOverload:      "Trigger overload",
@@ -35,8 +36,8 @@ func TestDevMenuHandler_GetMenuItems(t *testing.T) {
 			t.Fatalf("action %v label = %q, want prefix %q", action, item.GetLabel(), wantPrefix)
 		}
 	}
-	if items[12].GetLabel() != "Close" {
-		t.Fatalf("item 12 label = %q", items[12].GetLabel())
+	if items[13].GetLabel() != "Close" {
+		t.Fatalf("item 13 label = %q", items[13].GetLabel())
 	}
 }
 
@@ -0,0 +1,50 @@
+package gameplay
+
+import (
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// UseEMPCharge sets off a carried EMP Charge (ActionUseItem). Every patrol within
+// entities.EMPRadius of the player is stunned for entities.EMPStunSteps steps; the charge
+// is spent whether or not anything was in range. Returns false when no charge is carried.
+func UseEMPCharge(g *state.Game) bool {
+	if g == nil || g.Grid == nil || g.CurrentCell == nil {
+		return false
+	}
+	var charge *world.Item
+	g.OwnedItems.Each(func(item *world.Item) {
+		if charge == nil && item.Name == entities.EMPChargeName {
+			charge = item
+		}
+	})
+	if charge == nil {
+		return false
+	}
+	g.OwnedItems.Remove(charge)
+	renderer.PlaySound(renderer.SoundPowerWarning)
+
+	here := g.CurrentCell
+	stunned := 0
+	for dr := -entities.EMPRadius; dr <= entities.EMPRadius; dr++ {
+		for dc := -entities.EMPRadius; dc <= entities.EMPRadius; dc++ {
+			cell := g.Grid.GetCell(here.Row+dr, here.Col+dc)
+			if cell == nil || !gameworld.HasPatrol(cell) {
+				continue
+			}
+			patrol := gameworld.GetGameData(cell).Patrol
+			patrol.Stun(entities.EMPStunSteps)
+			stunned++
+			logMessage(g, "The EMP pulse fries the %s's sensors. It is down for ACTION{%d} moves.", patrol.Name, entities.EMPStunSteps)
+			renderer.AddCallout(cell.Row, cell.Col, "Stunned!", renderer.CalloutColorInfo, 0)
+		}
+	}
+	if stunned == 0 {
+		logMessage(g, "The EMP Charge crackles, but nothing is in range.")
+		renderer.AddCallout(here.Row, here.Col, "EMP: nothing in range", renderer.CalloutColorWarning, 0)
+	}
+	return true
+}
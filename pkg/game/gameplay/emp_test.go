@@ -0,0 +1,75 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func TestUseEMPCharge_stunsBotInRange(t *testing.T) {
+	// Unstunned, the bot would close in and catch the player on the next move.
+	g, patrol := makePatrolTestGame(t, 1)
+	charge := world.NewItem(entities.EMPChargeName)
+	g.OwnedItems.Put(charge)
+
+	if !UseEMPCharge(g) {
+		t.Fatal("UseEMPCharge with a charge carried = false, want true")
+	}
+	if g.OwnedItems.Has(charge) {
+		t.Fatal("the EMP Charge should be spent")
+	}
+	if patrol.Stunned != entities.EMPStunSteps {
+		t.Fatalf("bot stunned for %d steps, want %d", patrol.Stunned, entities.EMPStunSteps)
+	}
+
+	g.MovementCount = 1
+	MovePatrol(g)
+	if patrol.Col != 4 || patrol.Cooldown != 0 {
+		t.Fatalf("stunned bot = %+v, want it still at col 4 without a catch", patrol)
+	}
+}
+
+func TestUseEMPCharge_nothingInRangeStillSpendsIt(t *testing.T) {
+	g, _ := makePatrolTestGame(t, 0)
+	gameworld.GetGameData(g.Grid.GetCell(0, 4)).Patrol = nil
+	charge := world.NewItem(entities.EMPChargeName)
+	g.OwnedItems.Put(charge)
+
+	if !UseEMPCharge(g) || g.OwnedItems.Has(charge) {
+		t.Fatal("a pulse with nothing in range should still use up the charge")
+	}
+	if UseEMPCharge(g) {
+		t.Fatal("UseEMPCharge with no charge carried = true, want false")
+	}
+}
+
+func TestLoadLevel_EMPChargeOnlyOnPatrolDecks(t *testing.T) {
+	withPatrol, withoutPatrol := 0, 0
+	for seed := int64(1); seed <= 12 && (withPatrol == 0 || withoutPatrol == 0); seed++ {
+		g := loadLevelFromSeedForTest(t, 5, seed)
+		charges := 0
+		g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+			cell.ItemsOnFloor.Each(func(item *world.Item) {
+				if item.Name == entities.EMPChargeName {
+					charges++
+				}
+			})
+		})
+		if _, patrol := g.DeckPatrol(); patrol != nil {
+			withPatrol++
+			if charges != 1 {
+				t.Fatalf("seed %d: patrol deck has %d EMP Charges on the floor, want 1", seed, charges)
+			}
+		} else {
+			withoutPatrol++
+			if charges != 0 {
+				t.Fatalf("seed %d: deck without a patrol has %d EMP Charges, want 0", seed, charges)
+			}
+		}
+	}
+	if withPatrol == 0 || withoutPatrol == 0 {
+		t.Fatalf("seeds covered %d patrol decks and %d without; want both", withPatrol, withoutPatrol)
+	}
+}
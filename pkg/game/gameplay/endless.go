@@ -0,0 +1,51 @@
+package gameplay
+
+import (
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/debuglog"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/state"
+)
+
+// DescendEndless scores the current deck and rides the lift to a freshly generated,
+// deeper deck. Endless runs never revisit decks, so the cleared deck is discarded.
+func DescendEndless(g *state.Game) {
+	if g == nil {
+		return
+	}
+	points := g.AwardEndlessDeckClear()
+	newBest := recordEndlessHighScore(g)
+
+	clearedID := g.CurrentDeckID
+	clearCrossDeckPowerState(g)
+	clearCompletionState(g)
+	delete(g.DeckStates, clearedID)
+
+	targetLevel := g.Level + 1
+	generateLevel(g, targetLevel, deckSeed(g, targetLevel))
+	refreshDeckPower(g)
+	UpdateLightingExploration(g)
+	spawnOnDeckEntry(g, SpawnModeLiftShaft)
+
+	g.ClearMessages()
+	logMessage(g, "Deck cleared: +%d points. Score: %d.", points, g.EndlessScore)
+	if newBest && g.CurrentCell != nil {
+		logMessage(g, "New high score!")
+		renderer.AddCallout(g.CurrentCell.Row, g.CurrentCell.Col, "TITLE{New high score!}", renderer.CalloutColorInfo, 3000)
+	}
+	logMessage(g, "Lift routing: deck %d.", g.Level)
+	writeAutosave(g)
+}
+
+// recordEndlessHighScore saves the run's score when it beats the stored high score.
+// Creative runs never touch the high score.
+func recordEndlessHighScore(g *state.Game) bool {
+	if g.Creative() {
+		return false
+	}
+	newBest, err := config.Current().RecordEndlessScore(g.EndlessScore)
+	if err != nil {
+		debuglog.Warnf("could not save high score: %v", err)
+	}
+	return newBest
+}
@@ -0,0 +1,41 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/deck"
+	"darkstation/pkg/game/gamemode"
+)
+
+func TestDescendEndless_keepsGeneratingPastFinale(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	defer config.SetCurrent(nil)
+
+	g := BuildGameWithMode(deck.TotalDecks, gamemode.Endless)
+	if g.Level != deck.TotalDecks {
+		t.Fatalf("Level = %d, want %d", g.Level, deck.TotalDecks)
+	}
+
+	DescendEndless(g)
+
+	if g.GameComplete {
+		t.Fatal("endless descent should never complete the game")
+	}
+	if g.Level != deck.TotalDecks+1 || g.CurrentDeckID != deck.TotalDecks {
+		t.Fatalf("Level=%d CurrentDeckID=%d, want %d,%d", g.Level, g.CurrentDeckID, deck.TotalDecks+1, deck.TotalDecks)
+	}
+	if len(g.DeckHistory) != 1 || g.EndlessScore <= 0 {
+		t.Fatalf("DeckHistory=%d EndlessScore=%d, want one scored deck", len(g.DeckHistory), g.EndlessScore)
+	}
+	if len(g.Generators) < 2 {
+		t.Errorf("generators = %d, want a full deck rather than the minimal final layout", len(g.Generators))
+	}
+	if _, ok := g.DeckStates[deck.FinalDeckIndex]; ok {
+		t.Error("cleared endless deck should be discarded")
+	}
+	if got := config.Current().EndlessHighScore; got != g.EndlessScore {
+		t.Errorf("EndlessHighScore = %d, want %d", got, g.EndlessScore)
+	}
+}
@@ -0,0 +1,33 @@
+package gameplay
+
+import "darkstation/pkg/game/state"
+
+// RequestExitAnimationSkip asks a running exit animation to finish on the next
+// update. It is a no-op when no animation is playing, so a stray key press after
+// the transition cannot end a later one.
+func RequestExitAnimationSkip(g *state.Game) {
+	if g == nil || !g.ExitAnimating {
+		return
+	}
+	g.ExitAnimSkipRequested = true
+}
+
+// UpdateExitAnimation ends the exit animation once it has run for
+// state.ExitAnimDurationMs or a skip was requested. It returns true while the
+// animation is still playing. Ending clears both flags exactly once, so a skip
+// and a timeout landing on the same frame do not finish the transition twice.
+func UpdateExitAnimation(g *state.Game, nowMs int64) bool {
+	if g == nil {
+		return false
+	}
+	if !g.ExitAnimating {
+		g.ExitAnimSkipRequested = false
+		return false
+	}
+	if g.ExitAnimSkipRequested || nowMs-g.ExitAnimStartTime >= state.ExitAnimDurationMs {
+		g.ExitAnimating = false
+		g.ExitAnimSkipRequested = false
+		return false
+	}
+	return true
+}
@@ -0,0 +1,36 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/state"
+)
+
+func TestUpdateExitAnimation_RunsUntilDuration(t *testing.T) {
+	g := &state.Game{ExitAnimating: true, ExitAnimStartTime: 1000}
+	if !UpdateExitAnimation(g, 1000+state.ExitAnimDurationMs-1) {
+		t.Fatal("animation should still be playing before its duration")
+	}
+	if UpdateExitAnimation(g, 1000+state.ExitAnimDurationMs) || g.ExitAnimating {
+		t.Fatal("animation should end once its duration elapses")
+	}
+}
+
+func TestRequestExitAnimationSkip_EndsEarly(t *testing.T) {
+	g := &state.Game{ExitAnimating: true, ExitAnimStartTime: 1000}
+	RequestExitAnimationSkip(g)
+	if UpdateExitAnimation(g, 1001) || g.ExitAnimating {
+		t.Fatal("skip should end the animation on the next update")
+	}
+	if g.ExitAnimSkipRequested {
+		t.Error("skip flag should be cleared once the animation ends")
+	}
+}
+
+func TestRequestExitAnimationSkip_IgnoredWhenIdle(t *testing.T) {
+	g := &state.Game{}
+	RequestExitAnimationSkip(g)
+	if g.ExitAnimSkipRequested {
+		t.Error("skip should not be latched when no animation is playing")
+	}
+}
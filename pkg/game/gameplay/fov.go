@@ -2,6 +2,7 @@ package gameplay
 
 import (
 	engworld "darkstation/pkg/engine/world"
+	"darkstation/pkg/game/config"
 	"darkstation/pkg/game/setup"
 	"darkstation/pkg/game/state"
 	gameworld "darkstation/pkg/game/world"
@@ -19,3 +20,18 @@ func unpoweredDoorSightBlocker(g *state.Game) engworld.SightBlocker {
 		return !setup.CellHasLivePower(g, cell)
 	}
 }
+
+// revealPlayerFOV discovers what the player can see from cell. The sight radius comes
+// from config (unlimited by default); a limited radius shrinks in unpowered rooms. With
+// diagonal movement on, sight stops at wall corners just as diagonal steps do.
+func revealPlayerFOV(g *state.Game, cell *engworld.Cell) {
+	opts := engworld.DefaultFOVOptions(unpoweredDoorSightBlocker(g))
+	opts.DiagonalPeek = !config.Current().DiagonalMovement
+	radius := config.Current().FOVRadius
+	if radius > 0 {
+		opts.Dark = func(c *engworld.Cell) bool {
+			return !setup.CellHasLivePower(g, c)
+		}
+	}
+	engworld.RevealFOV(g.Grid, cell, radius, opts)
+}
@@ -0,0 +1,58 @@
+package gameplay
+
+import (
+	"log"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/state"
+)
+
+// TriggerGameOver ends the run in failure with a descriptive cause (e.g. from
+// state.GameOverCauseAsphyxiated). Every lethal system funnels through here; the first
+// cause wins and a finished run cannot fail.
+func TriggerGameOver(g *state.Game, cause string) {
+	if g == nil || g.GameOver || g.GameComplete {
+		return
+	}
+	log.Printf("[GameOver] %s", cause)
+	g.AutoExplore = nil
+	g.WalkTo = nil
+	g.ClearObjectiveRoute()
+	g.ExitAnimating = false
+	g.ExitAnimSkipRequested = false
+	renderer.SetAmbientHum(false)
+	g.RunStatsSnapshot = g.SnapshotFailedRunStats()
+	g.GameOverCause = cause
+	g.GameOver = true
+	LogRunState(g, "game.over")
+}
+
+// ProcessGameOverInput handles input on the game-over overlay: confirm or the reset
+// binding restarts the deck from its seed; quit, menu, or cancel returns to the title.
+// Everything else is ignored.
+func ProcessGameOverInput(g *state.Game, intent engineinput.Intent) {
+	if g == nil || !g.GameOver {
+		return
+	}
+	switch intent.Action {
+	case engineinput.ActionAction, engineinput.ActionInteract, engineinput.ActionResetLevel:
+		RestartDeckAfterGameOver(g)
+	case engineinput.ActionQuit, engineinput.ActionOpenMenu, engineinput.ActionCancel:
+		QuitToTitleMenu(g)
+	}
+}
+
+// RestartDeckAfterGameOver rebuilds the current deck from its seed and resumes play
+// from the deck start. Decks cleared earlier in the run are kept.
+func RestartDeckAfterGameOver(g *state.Game) {
+	if g == nil || !g.GameOver {
+		return
+	}
+	ResetLevel(g)
+}
+
+func clearGameOverState(g *state.Game) {
+	g.GameOver = false
+	g.GameOverCause = ""
+}
@@ -0,0 +1,64 @@
+package gameplay
+
+import (
+	"testing"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/state"
+)
+
+func TestTriggerGameOver_FirstCauseWins(t *testing.T) {
+	g := state.NewGame()
+	g.MovementCount = 12
+	g.RecordDeckCleared()
+
+	TriggerGameOver(g, state.GameOverCauseAsphyxiated("Cargo Bay"))
+	TriggerGameOver(g, state.GameOverCauseReactorMeltdown)
+
+	if !g.GameOver {
+		t.Fatal("GameOver should be true")
+	}
+	if g.GameOverCause != "Asphyxiated in Cargo Bay" {
+		t.Errorf("GameOverCause = %q, want the first cause", g.GameOverCause)
+	}
+	if g.RunStatsSnapshot.DecksCompleted != 1 || g.RunStatsSnapshot.Movements != 12 {
+		t.Errorf("RunStatsSnapshot = %+v, want 1 deck cleared and 12 movements", g.RunStatsSnapshot)
+	}
+}
+
+func TestTriggerGameOver_IgnoredAfterCompletion(t *testing.T) {
+	g := state.NewGame()
+	TriggerGameComplete(g)
+	TriggerGameOver(g, state.GameOverCauseReactorMeltdown)
+	if g.GameOver {
+		t.Error("a completed run should not fail")
+	}
+}
+
+func TestProcessGameOverInput_OnlyRestartOrTitle(t *testing.T) {
+	g := state.NewGame()
+	TriggerGameOver(g, state.GameOverCauseReactorMeltdown)
+
+	ProcessIntent(g, engineinput.Intent{Action: engineinput.ActionMoveNorth})
+	if !g.GameOver || g.QuitToTitle {
+		t.Fatal("movement should be ignored on the game-over screen")
+	}
+	ProcessIntent(g, engineinput.Intent{Action: engineinput.ActionQuit})
+	if !g.QuitToTitle {
+		t.Error("quit should return to title")
+	}
+}
+
+func TestRestartDeckAfterGameOver_ResumesPlay(t *testing.T) {
+	g := BuildGame(1)
+	TriggerGameOver(g, state.GameOverCauseAsphyxiated(""))
+
+	ProcessGameOverInput(g, engineinput.Intent{Action: engineinput.ActionAction})
+
+	if g.GameOver || g.GameOverCause != "" {
+		t.Fatalf("after restart GameOver = %v, cause %q; want cleared", g.GameOver, g.GameOverCause)
+	}
+	if g.Level != 1 || g.CurrentCell == nil {
+		t.Errorf("after restart level = %d, cell = %v; want deck 1 with a spawn cell", g.Level, g.CurrentCell)
+	}
+}
@@ -183,4 +183,5 @@ func completeHazardClearFix(g *state.Game, s *state.HazardClearSession) {
 		}
 		renderer.AddCallout(p.CalloutRow, p.CalloutCol, p.CalloutMessage, style, 0)
 	}
+	renderer.PlaySound(renderer.SoundHazardCleared)
 }
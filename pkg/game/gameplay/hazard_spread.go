@@ -0,0 +1,96 @@
+package gameplay
+
+import (
+	"github.com/zyedidia/generic/mapset"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/levelgen"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// hazardSpreadMoves is how many player moves pass between spreading-hazard ticks.
+const hazardSpreadMoves = 10
+
+// SpreadHazards lets each spreading gas or coolant leak creep into one more corridor
+// cell every hazardSpreadMoves moves. Called once per processed input from TickTurn.
+func SpreadHazards(g *state.Game) {
+	if g == nil || g.Grid == nil || g.CurrentCell == nil {
+		return
+	}
+	if g.MovementCount == 0 || g.MovementCount == g.HazardSpreadAt || g.MovementCount%hazardSpreadMoves != 0 {
+		return
+	}
+	g.HazardSpreadAt = g.MovementCount
+
+	// Spread cells share their leak's Hazard, so group cells by leak in grid order.
+	var leaks []*entities.Hazard
+	cells := make(map[*entities.Hazard][]*world.Cell)
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		h := gameworld.GetGameData(cell).Hazard
+		if h == nil || !h.Spreading || !h.IsBlocking() {
+			return
+		}
+		if _, ok := cells[h]; !ok {
+			leaks = append(leaks, h)
+		}
+		cells[h] = append(cells[h], cell)
+	})
+
+	for _, h := range leaks {
+		covered := mapset.New[*world.Cell]()
+		for _, cell := range cells[h] {
+			covered.Put(cell)
+		}
+		open := func(cell *world.Cell) bool {
+			return hazardCanSpreadInto(g, cell, &covered)
+		}
+		for _, cell := range cells[h] {
+			next := h.Tick(g.Grid, cell, open)
+			if next == nil {
+				continue
+			}
+			gameworld.GetGameData(next).Hazard = h
+			if next.Discovered {
+				logMessage(g, "The HAZARD{%s} creeps further along the corridor.", h.Name)
+			}
+			break
+		}
+	}
+}
+
+// hazardCanSpreadInto reports whether a leak covering covered may creep into cell: an
+// empty corridor cell away from the player and the exit whose loss seals nothing off.
+func hazardCanSpreadInto(g *state.Game, cell *world.Cell, covered *mapset.Set[*world.Cell]) bool {
+	if !cell.IsCorridor || !cellFreeForHazard(g, cell) {
+		return false
+	}
+	return spreadKeepsReachable(g, cell, covered)
+}
+
+// cellFreeForHazard reports whether cell is empty floor a new hazard may cover: not the
+// player's or the exit cell, with no items and no entity of any kind.
+func cellFreeForHazard(g *state.Game, cell *world.Cell) bool {
+	if cell == g.CurrentCell || cell.ExitCell || cell.ItemsOnFloor.Size() > 0 {
+		return false
+	}
+	data := gameworld.GetGameData(cell)
+	return data.Generator == nil && data.Door == nil && data.Terminal == nil && data.Puzzle == nil &&
+		data.Furniture == nil && data.Hazard == nil && data.HazardControl == nil &&
+		data.MaintenanceTerm == nil && data.PowerRelay == nil && data.RepairDevice == nil &&
+		data.RepairBlocker == nil && data.Survivor == nil && data.Patrol == nil
+}
+
+// spreadKeepsReachable reports whether covering cell leaves every other cell the player
+// can reach without crossing the leak (the exit, the leak's control, the rest of the
+// deck) still reachable. Other blockers count as passable: they are cleared on their
+// own, and clearing this leak clears every cell it spread into.
+func spreadKeepsReachable(g *state.Game, cell *world.Cell, covered *mapset.Set[*world.Cell]) bool {
+	before := levelgen.GetReachableCells(g.Grid, g.CurrentCell, covered)
+	if !before.Has(cell) {
+		return false
+	}
+	after := levelgen.GetReachableCellsExcluding(g.Grid, g.CurrentCell, covered, cell)
+	return after.Size() == before.Size()-1
+}
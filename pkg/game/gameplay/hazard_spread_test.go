@@ -0,0 +1,121 @@
+package gameplay
+
+import (
+	"testing"
+
+	"github.com/zyedidia/generic/mapset"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/levelgen"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// spreadLoop is two corridors joined by three rungs, so a leak on the middle rung has
+// room to creep without cutting the deck in two.
+var spreadLoop = []string{
+	"........",
+	".##.##..",
+	"........",
+}
+
+// makeSpreadTestGame places a spreading gas leak on the middle rung, its vent control
+// at (2,0) and the exit at (2,7), with the player at (0,0).
+func makeSpreadTestGame(t *testing.T) (*state.Game, *entities.Hazard) {
+	t.Helper()
+	g := makeExploreTestGame(t, spreadLoop)
+	hazard := entities.NewHazard(entities.HazardGas)
+	hazard.Spreading = true
+	gameworld.GetGameData(g.Grid.GetCell(1, 3)).Hazard = hazard
+	gameworld.GetGameData(g.Grid.GetCell(2, 0)).HazardControl = entities.NewHazardControl(entities.HazardGas, hazard)
+	g.Grid.GetCell(2, 7).ExitCell = true
+	return g, hazard
+}
+
+func tickHazardSpread(g *state.Game, ticks int) {
+	for i := 0; i < ticks; i++ {
+		g.MovementCount += hazardSpreadMoves
+		SpreadHazards(g)
+	}
+}
+
+func blockingHazardCells(g *state.Game) *mapset.Set[*world.Cell] {
+	blocked := mapset.New[*world.Cell]()
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if gameworld.HasBlockingHazard(cell) {
+			blocked.Put(cell)
+		}
+	})
+	return &blocked
+}
+
+func TestSpreadHazards_exitStaysReachableAfterManyTicks(t *testing.T) {
+	g, hazard := makeSpreadTestGame(t)
+	exit := g.Grid.GetCell(2, 7)
+	control := g.Grid.GetCell(2, 0)
+
+	for i := 0; i < 100; i++ {
+		tickHazardSpread(g, 1)
+		reach := levelgen.GetReachableCells(g.Grid, g.CurrentCell, blockingHazardCells(g))
+		if !reach.Has(exit) {
+			t.Fatalf("tick %d: exit sealed off by the spreading leak", i+1)
+		}
+		if !reach.Has(control) {
+			t.Fatalf("tick %d: vent control sealed off by the spreading leak", i+1)
+		}
+	}
+	if hazard.Spread == 0 {
+		t.Fatal("leak never spread")
+	}
+	if got := blockingHazardCells(g).Size(); got != 1+hazard.Spread || hazard.Spread > entities.MaxHazardSpread {
+		t.Errorf("leak covers %d cells after %d spreads (cap %d)", got, hazard.Spread, entities.MaxHazardSpread)
+	}
+
+	gameworld.GetGameData(control).HazardControl.Activate()
+	if got := blockingHazardCells(g).Size(); got != 0 {
+		t.Errorf("%d leak cells still blocking after the vent control was used", got)
+	}
+}
+
+func TestSpreadHazards_neverCoversPlayerOrChokepoint(t *testing.T) {
+	// A single corridor: the leak may only creep toward the dead end behind it.
+	g := makeExploreTestGame(t, []string{"........"})
+	hazard := entities.NewHazard(entities.HazardCoolant)
+	hazard.Spreading = true
+	gameworld.GetGameData(g.Grid.GetCell(0, 5)).Hazard = hazard
+	g.Grid.GetCell(0, 7).ExitCell = true
+	g.CurrentCell = g.Grid.GetCell(0, 2)
+
+	tickHazardSpread(g, 50)
+
+	for col := 0; col <= 2; col++ {
+		if gameworld.HasHazard(g.Grid.GetCell(0, col)) {
+			t.Errorf("leak crept to (0,%d), cutting the player off", col)
+		}
+	}
+	if gameworld.HasHazard(g.Grid.GetCell(0, 7)) {
+		t.Error("leak crept onto the exit")
+	}
+}
+
+func TestSpreadHazards_onlySpreadingLeaksMove(t *testing.T) {
+	g, hazard := makeSpreadTestGame(t)
+	hazard.Spreading = false
+
+	tickHazardSpread(g, 20)
+
+	if hazard.Spread != 0 || blockingHazardCells(g).Size() != 1 {
+		t.Errorf("non-spreading leak spread %d times", hazard.Spread)
+	}
+}
+
+func TestSpreadHazards_waitsForMoves(t *testing.T) {
+	g, hazard := makeSpreadTestGame(t)
+	g.MovementCount = hazardSpreadMoves
+	SpreadHazards(g)
+	SpreadHazards(g) // a second input without moving must not tick again
+	if hazard.Spread != 1 {
+		t.Errorf("Spread = %d after one tick, want 1", hazard.Spread)
+	}
+}
@@ -1,6 +1,7 @@
 package gameplay
 
 import (
+	"fmt"
 	"sort"
 	"time"
 
@@ -113,11 +114,27 @@ func StartExitHazardTour(g *state.Game) bool {
 	return true
 }
 
-// CheckAdjacentExitLiftAtCell starts a hazard tour when USE targets a blocked exit lift.
+// exitLiftBlockedByObjectives reports whether the lift has grid power but is held by
+// deck objectives or a shortfall of free watts for its motor.
+func exitLiftBlockedByObjectives(g *state.Game) bool {
+	switch setup.ExitLiftState(g) {
+	case state.ExitLiftLockedIncomplete, state.ExitLiftLockedLowPower:
+		return true
+	}
+	return false
+}
+
+// CheckAdjacentExitLiftAtCell explains a blocked exit lift when USE targets it: a motor
+// power shortfall, a hazard tour, or the outstanding objective.
 func CheckAdjacentExitLiftAtCell(g *state.Game, cell *world.Cell) bool {
 	if cell == nil || !cell.ExitCell {
 		return false
 	}
+	if setup.ExitLiftState(g) == state.ExitLiftLockedLowPower {
+		free, required := setup.ExitLiftPower(g)
+		renderer.AddCallout(cell.Row, cell.Col, fmt.Sprintf("UNPOWERED{Lift motor}\nNeeds: ACTION{%dw} free\nSUBTLE{Grid has %dw free — shed load or add a generator}", required, max(free, 0)), renderer.CalloutColorMaintenance, 0)
+		return true
+	}
 	if setup.ExitLiftState(g) != state.ExitLiftLockedIncomplete {
 		return false
 	}
@@ -128,6 +145,11 @@ func CheckAdjacentExitLiftAtCell(g *state.Game, cell *world.Cell) bool {
 		renderer.AddCallout(cell.Row, cell.Col, blockedLiftRepairCallout(g), renderer.CalloutColorMaintenance, 0)
 		return true
 	}
+	if g != nil && !g.SurvivorEscorted() {
+		_, survivor := g.DeckSurvivor()
+		renderer.AddCallout(cell.Row, cell.Col, "UNPOWERED{Lift locked}\nNeeds: ACTION{"+survivor.Name+"}\nSUBTLE{Escort them to the lift}", renderer.CalloutColorInfo, 0)
+		return true
+	}
 	return false
 }
 
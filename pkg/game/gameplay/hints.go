@@ -5,16 +5,40 @@ import (
 	"fmt"
 
 	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/debuglog"
 	"darkstation/pkg/game/renderer"
 	"darkstation/pkg/game/state"
 	gameworld "darkstation/pkg/game/world"
 )
 
+// tutorialHintActions is how many moves and interactions get tutorial prompts.
+const tutorialHintActions = 3
+
+// tutorialHintsFor reports whether the tutorial prompts still apply after count
+// actions. Once the TutorialHints setting is off, a level reset zeroing the counters
+// cannot bring them back.
+func tutorialHintsFor(count int) bool {
+	return config.Current().TutorialHints && count < tutorialHintActions
+}
+
+// RetireTutorialHints switches the tutorial prompts off for good once the run has
+// cleared a deck. The setting is saved, so later runs start quiet too. Creative runs
+// do not count.
+func RetireTutorialHints(g *state.Game) {
+	cfg := config.Current()
+	if g == nil || g.Creative() || !cfg.TutorialHints || len(g.DeckHistory) == 0 {
+		return
+	}
+	if err := cfg.SetTutorialHints(false); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+}
+
 // ShowMovementHint shows a callout hint next to the player for movement controls
-// Only shows hint if the player has moved fewer than 3 times
+// Only shows hint if tutorial hints are on and the player has moved fewer than 3 times
 func ShowMovementHint(g *state.Game) {
-	// Only show hint for the first 3 movements
-	if g.MovementCount >= 3 {
+	if !tutorialHintsFor(g.MovementCount) {
 		return
 	}
 
@@ -25,10 +49,9 @@ func ShowMovementHint(g *state.Game) {
 }
 
 // ShowInteractableHints shows callout hints for interactable objects adjacent to the player
-// Only shows hints if the player has interacted with fewer than 3 objects
+// Only shows hints if tutorial hints are on and the player has interacted with fewer than 3 objects
 func ShowInteractableHints(g *state.Game) {
-	// Only show hints for the first 3 interactions
-	if g.InteractionsCount >= 3 {
+	if !tutorialHintsFor(g.InteractionsCount) {
 		return
 	}
 	if g.CurrentCell == nil {
@@ -0,0 +1,48 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/state"
+)
+
+func TestTutorialHintsFor_respectsSetting(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	cfg := config.DefaultConfig()
+	config.SetCurrent(cfg)
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	if !tutorialHintsFor(0) || tutorialHintsFor(tutorialHintActions) {
+		t.Fatal("with the setting on, prompts should cover only the first few actions")
+	}
+	cfg.TutorialHints = false
+	if tutorialHintsFor(0) {
+		t.Fatal("a reset counter must not bring prompts back once the setting is off")
+	}
+}
+
+func TestRetireTutorialHints_afterFirstClearedDeck(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	g := makeTestGame(2, 2)
+	RetireTutorialHints(g)
+	if !config.Current().TutorialHints {
+		t.Fatal("prompts should stay on before any deck is cleared")
+	}
+
+	g.DeckHistory = append(g.DeckHistory, state.DeckClearRecord{DeckID: 0, Level: 1})
+	RetireTutorialHints(g)
+	if config.Current().TutorialHints {
+		t.Fatal("prompts should turn off after the first cleared deck")
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.TutorialHints {
+		t.Error("retired tutorial hints should be saved")
+	}
+}
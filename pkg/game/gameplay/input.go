@@ -2,15 +2,14 @@
 package gameplay
 
 import (
-	"fmt"
 	"log"
 	"math/rand"
-	"os"
 
 	"github.com/leonelquinteros/gotext"
 
 	engineinput "darkstation/pkg/engine/input"
 	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/config"
 	"darkstation/pkg/game/devtools"
 	"darkstation/pkg/game/entities"
 	gamemenu "darkstation/pkg/game/menu"
@@ -25,6 +24,16 @@ func ProcessIntent(g *state.Game, intent engineinput.Intent) {
 		ProcessCompletionInput(g, intent)
 		return
 	}
+	if g.GameOver {
+		ProcessGameOverInput(g, intent)
+		return
+	}
+
+	// Recenter also cuts a camera pan short, so it is handled before the cinematic block.
+	if intent.Action == engineinput.ActionRecenter {
+		RecenterCamera(g)
+		return
+	}
 
 	if IsGameplayCinematicActive(g) {
 		return
@@ -42,22 +51,93 @@ func ProcessIntent(g *state.Game, intent engineinput.Intent) {
 		gamemenu.RunInventoryMenu(g)
 		return
 
+	case engineinput.ActionMessageLog:
+		gamemenu.RunMessageLogMenu(g)
+		return
+
+	case engineinput.ActionAutoExplore:
+		StartAutoExplore(g)
+		return
+
+	case engineinput.ActionWalkTo:
+		StartWalkTo(g, intent.Row, intent.Col)
+		return
+
+	case engineinput.ActionInteractAt:
+		if !InteractAt(g, intent.Row, intent.Col) {
+			StartWalkTo(g, intent.Row, intent.Col)
+		}
+		return
+
+	case engineinput.ActionPowerDiagnostics:
+		TogglePowerDiagnostics(g)
+		return
+
+	case engineinput.ActionCycleObjectives:
+		CycleObjectiveFocus(g)
+		return
+
+	case engineinput.ActionPickUp:
+		PickUpItemsManually(g)
+		return
+
+	case engineinput.ActionDropItem:
+		DropItemFromMenu(g)
+		return
+
+	case engineinput.ActionUseItem:
+		if !UseEMPCharge(g) {
+			logMessage(g, "You have nothing to use.")
+		}
+		return
+
+	case engineinput.ActionPickLock:
+		if !TryPickLock(g) {
+			logMessage(g, "There is no lock here you can pick.")
+		}
+		return
+
 	case engineinput.ActionHint:
 		idx := rand.Intn(len(g.Hints))
 		logMessage(g, "%s", g.Hints[idx])
+		TraceObjectiveRoute(g)
 		return
 
 	case engineinput.ActionQuit:
-		if gamemenu.ConfirmQuitGame(g) {
-			fmt.Println(gotext.Get("GOODBYE"))
-			os.Exit(0)
-		}
+		RunPauseMenu(g)
+		return
 
 	case engineinput.ActionScreenshot:
-		filename := devtools.SaveScreenshotHTML(g)
+		filename := devtools.SaveScreenshotHTML(g, intent.Code == "deck")
 		logMessage(g, "Screenshot saved to ITEM{%s}", filename)
 		return
 
+	case engineinput.ActionExportMap:
+		path, err := devtools.ExportDeckMap(g, intent.Code == "full")
+		if err != nil {
+			logMessage(g, "Map export failed: %v", err)
+		} else {
+			logMessage(g, "Deck map exported to ITEM{%s}", path)
+		}
+		return
+
+	case engineinput.ActionExportDeck:
+		jsonPath, asciiPath, err := devtools.ExportDeck(g)
+		if err != nil {
+			logMessage(g, "Deck export failed: %v", err)
+		} else {
+			logMessage(g, "Deck layout exported to ITEM{%s} and ITEM{%s}", jsonPath, asciiPath)
+		}
+		return
+
+	case engineinput.ActionLoadDeck:
+		if err := devtools.LoadDeckFile(g, intent.Code); err != nil {
+			logMessage(g, "Deck load failed: %v", err)
+			return
+		}
+		UpdateLightingExploration(g)
+		return
+
 	case engineinput.ActionDevMenu:
 		RunDeveloperMenu(g)
 		return
@@ -74,6 +154,10 @@ func ProcessIntent(g *state.Game, intent engineinput.Intent) {
 		devtools.SwitchToPerfMap(g, intent.Code)
 		return
 
+	case engineinput.ActionRenderBenchmark:
+		StartRenderBenchmark(g, intent.Code)
+		return
+
 	case engineinput.ActionDebugMapDump:
 		path, err := devtools.DumpRevealedMapToFile(g)
 		if err != nil {
@@ -84,6 +168,10 @@ func ProcessIntent(g *state.Game, intent engineinput.Intent) {
 		return
 
 	case engineinput.ActionResetLevel:
+		if PlayerIsStuck(g) {
+			RerollLevel(g)
+			return
+		}
 		ResetLevel(g)
 		return
 
@@ -123,6 +211,15 @@ func ProcessIntent(g *state.Game, intent engineinput.Intent) {
 		MoveCell(g, g.CurrentCell.South)
 		return
 
+	case engineinput.ActionMoveNE, engineinput.ActionMoveNW, engineinput.ActionMoveSE, engineinput.ActionMoveSW:
+		if !config.Current().DiagonalMovement || g.CurrentCell == nil {
+			return
+		}
+		abandonCouplerCrankOnMove(g)
+		g.NavStyle = state.NavStyleNSEW
+		MoveCell(g, diagonalNeighbor(g.CurrentCell, intent.Action))
+		return
+
 	case engineinput.ActionInteract:
 		log.Printf("[Interact] ProcessIntent: ActionInteract (game loop tick)")
 		if cell, kind, ok := findAdjacentLongUseTarget(g); ok {
@@ -150,7 +247,7 @@ func ProcessIntent(g *state.Game, intent engineinput.Intent) {
 		}
 		interacted := CheckAdjacentInteractables(g)
 		log.Printf("[Interact] ProcessIntent: CheckAdjacentInteractables returned %v", interacted)
-		if !interacted {
+		if !interacted && !TryPickLock(g) {
 			logMessage(g, "Nothing to interact with here.")
 		}
 		return
@@ -169,6 +266,14 @@ func RunGameplayMenu(g *state.Game) {
 	switch handler.GetSelectedAction() {
 	case gamemenu.GameplayMenuActionInventory:
 		gamemenu.RunInventoryMenu(g)
+	case gamemenu.GameplayMenuActionDeckHistory:
+		gamemenu.RunDeckHistoryMenu(g)
+	case gamemenu.GameplayMenuActionMessageLog:
+		gamemenu.RunMessageLogMenu(g)
+	case gamemenu.GameplayMenuActionMapPins:
+		RunMapPinsMenu(g)
+	case gamemenu.GameplayMenuActionFloorGuide:
+		gamemenu.RunFloorGuideMenu(g)
 	case gamemenu.GameplayMenuActionSettings:
 		RunSettingsMenu(g, false)
 	case gamemenu.GameplayMenuActionQuitToTitle:
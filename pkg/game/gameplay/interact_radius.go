@@ -0,0 +1,61 @@
+package gameplay
+
+import (
+	"sort"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// maxInteractRadius bounds the scan for entities usable from beyond adjacency.
+const maxInteractRadius = 3
+
+// interactRadius returns how far (Chebyshev distance) the entity on cell can be used
+// from. Everything is adjacent-only (1) unless the entity sets InteractRadius.
+func interactRadius(cell *world.Cell) int {
+	data := gameworld.GetGameData(cell)
+	radius := 1
+	if data.Generator != nil && data.Generator.InteractRadius > radius {
+		radius = data.Generator.InteractRadius
+	}
+	if data.Terminal != nil && data.Terminal.InteractRadius > radius {
+		radius = data.Terminal.InteractRadius
+	}
+	return min(radius, maxInteractRadius)
+}
+
+// interactionNeighbors returns the cells an interaction press scans: the orthogonal
+// neighbors clockwise from facing, then cells further away whose entity's InteractRadius
+// reaches the player through a clear line of sight, nearest first.
+func interactionNeighbors(g *state.Game) []*world.Cell {
+	pc := g.CurrentCell
+	neighbors := state.AdjacentCellsClockwiseFromFacing(pc, g.PlayerFacing)
+	if g.Grid == nil || pc == nil {
+		return neighbors
+	}
+	var reach []*world.Cell
+	for dr := -maxInteractRadius; dr <= maxInteractRadius; dr++ {
+		for dc := -maxInteractRadius; dc <= maxInteractRadius; dc++ {
+			if absInt(dr)+absInt(dc) <= 1 {
+				continue // the player's cell and the orthogonal neighbors above
+			}
+			cell := g.Grid.GetCell(pc.Row+dr, pc.Col+dc)
+			if cell == nil || !cell.Room || interactRadius(cell) < max(absInt(dr), absInt(dc)) {
+				continue
+			}
+			if r, c, ok := world.RayCastEndpoint(g.Grid, pc.Row, pc.Col, cell.Row, cell.Col, nil); !ok || r != cell.Row || c != cell.Col {
+				continue
+			}
+			reach = append(reach, cell)
+		}
+	}
+	sort.SliceStable(reach, func(i, j int) bool {
+		return cellDistance(pc, reach[i]) < cellDistance(pc, reach[j])
+	})
+	return append(neighbors, reach...)
+}
+
+func cellDistance(a, b *world.Cell) int {
+	return max(absInt(a.Row-b.Row), absInt(a.Col-b.Col))
+}
@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"image/color"
 	"log"
+	"sort"
 	"strings"
 
+	engineinput "darkstation/pkg/engine/input"
 	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/debuglog"
 	"darkstation/pkg/game/entities"
 	"darkstation/pkg/game/features"
 	"darkstation/pkg/game/renderer"
@@ -68,7 +72,7 @@ func logInteractDebugSnapshot(g *state.Game, phase string) {
 	}
 }
 
-// countAdjacentInteractionCandidates returns how many orthogonal neighbors have at least one
+// countAdjacentInteractionCandidates returns how many scanned neighbors have at least one
 // interaction type that CheckAdjacentInteractables considers (same Has* gates as the scan).
 func countAdjacentInteractionCandidates(g *state.Game, neighbors []*world.Cell) int {
 	n := 0
@@ -84,7 +88,8 @@ func countAdjacentInteractionCandidates(g *state.Game, neighbors []*world.Cell)
 			gameworld.HasPowerRelay(cell) ||
 			gameworld.HasIncompleteRepairDevice(cell) ||
 			gameworld.HasMaintenanceTerminal(cell) ||
-			(cell.ExitCell && setup.ExitLiftState(g) == state.ExitLiftLockedIncomplete) {
+			gameworld.HasWaitingSurvivor(cell) ||
+			(cell.ExitCell && exitLiftBlockedByObjectives(g)) {
 			n++
 		}
 	}
@@ -94,7 +99,8 @@ func countAdjacentInteractionCandidates(g *state.Game, neighbors []*world.Cell)
 // CheckAdjacentInteractables checks adjacent cells for interactables.
 // Generators are handled in a first pass (any direction) so a generator is not skipped when
 // another direction has e.g. a maintenance terminal or CCTV that would come earlier in scan order.
-// Adjacent cells are scanned clockwise from the player's facing (facing cell first).
+// Adjacent cells are scanned clockwise from the player's facing (facing cell first), followed by
+// any entity further away whose InteractRadius reaches the player (see interactionNeighbors).
 // Cycles through interactables when player hasn't moved, skipping the previously interacted cell.
 // If there is only one adjacent interactable cell, last-interacted cycling is cleared so the first
 // scan always hits that target (no "empty" pass that relies on a second scan).
@@ -115,7 +121,7 @@ func CheckAdjacentInteractables(g *state.Game) bool {
 		g.InteractionPlayerCol = g.CurrentCell.Col
 	}
 
-	neighbors := state.AdjacentCellsClockwiseFromFacing(g.CurrentCell, g.PlayerFacing)
+	neighbors := interactionNeighbors(g)
 
 	if countAdjacentInteractionCandidates(g, neighbors) <= 1 {
 		g.LastInteractedRow = -1
@@ -126,14 +132,108 @@ func CheckAdjacentInteractables(g *state.Game) bool {
 
 	for _, honorLastSkip := range []bool{true, false} {
 		if tryAdjacentInteractableScan(g, neighbors, honorLastSkip) {
+			showInteractCycleNext(g)
 			return true
 		}
 	}
 
+	g.ClearInteractCycleNext()
 	log.Printf("[Interact] no handler matched (see before_scan neighbor lines)")
 	return false
 }
 
+// InteractAt interacts with the interactable at row, col when it is in reach (an orthogonal
+// neighbor or within an entity's InteractRadius), skipping the NSEW cycle. Clicking the lift
+// cell the player stands on uses the lift. Hold-to-use devices still need the interact key.
+// Returns false when nothing there could be used, so pointer callers can walk instead.
+func InteractAt(g *state.Game, row, col int) bool {
+	if g == nil || g.CurrentCell == nil || g.Grid == nil {
+		return false
+	}
+	target := g.Grid.GetCell(row, col)
+	if target == nil {
+		return false
+	}
+	if target == g.CurrentCell {
+		return target.ExitCell && TryUseLift(g)
+	}
+	for _, cell := range interactionNeighbors(g) {
+		if cell != target {
+			continue
+		}
+		if tryAdjacentInteractableScan(g, []*world.Cell{cell}, false) {
+			g.InteractionPlayerRow = g.CurrentCell.Row
+			g.InteractionPlayerCol = g.CurrentCell.Col
+			g.ClearInteractCycleNext()
+			return true
+		}
+		return false
+	}
+	return false
+}
+
+// interactCycleCalloutMs is how long the "press again" callout stays on the next target.
+const interactCycleCalloutMs = 3000
+
+// interactCycleTier returns the scan pass that would handle cell (lower runs first),
+// or -1 when cell is not an interaction candidate. Mirrors tryAdjacentInteractableScan.
+func interactCycleTier(g *state.Game, cell *world.Cell) int {
+	switch {
+	case cell == nil:
+		return -1
+	case gameworld.HasGenerator(cell):
+		return 0
+	case cell.ExitCell && (setup.ExitLiftReady(g) || exitLiftBlockedByObjectives(g)):
+		return 1
+	case gameworld.HasFurniture(cell),
+		gameworld.HasUnusedTerminal(cell),
+		gameworld.HasUnsolvedPuzzle(cell),
+		gameworld.HasInactiveHazardControl(cell),
+		gameworld.HasPowerRelay(cell),
+		gameworld.HasIncompleteRepairDevice(cell),
+		gameworld.HasMaintenanceTerminal(cell),
+		gameworld.HasWaitingSurvivor(cell):
+		return 2
+	}
+	return -1
+}
+
+// nextInteractCycleCell returns the neighbor the next press will hit: the earliest-pass
+// candidate in clockwise order, skipping the cell just interacted with.
+func nextInteractCycleCell(g *state.Game, neighbors []*world.Cell) *world.Cell {
+	var next *world.Cell
+	nextTier := -1
+	for _, cell := range neighbors {
+		if cell == nil || (cell.Row == g.LastInteractedRow && cell.Col == g.LastInteractedCol) {
+			continue
+		}
+		if tier := interactCycleTier(g, cell); tier >= 0 && (next == nil || tier < nextTier) {
+			next, nextTier = cell, tier
+		}
+	}
+	return next
+}
+
+// showInteractCycleNext highlights the next-in-cycle target after an interaction when the
+// player stands between several interactables, so repeated presses are not a surprise.
+// Neighbors are re-read because the interaction turned the player toward its target.
+func showInteractCycleNext(g *state.Game) {
+	neighbors := interactionNeighbors(g)
+	var next *world.Cell
+	if countAdjacentInteractionCandidates(g, neighbors) > 1 {
+		next = nextInteractCycleCell(g, neighbors)
+	}
+	if next == nil {
+		g.ClearInteractCycleNext()
+		return
+	}
+	if row, col, ok := g.InteractCycleNext(); ok && row == next.Row && col == next.Col {
+		return
+	}
+	g.SetInteractCycleNext(next.Row, next.Col)
+	renderer.AddCallout(next.Row, next.Col, engineinput.HintInteractAgain(), renderer.CalloutColorInfo, interactCycleCalloutMs)
+}
+
 // tryAdjacentInteractableScan runs the two-pass adjacent scan. When honorLastInteractedSkip is true,
 // the cell matching LastInteractedRow/Col is skipped so the player can cycle other adjacent targets.
 func tryAdjacentInteractableScan(g *state.Game, neighbors []*world.Cell, honorLastInteractedSkip bool) bool {
@@ -168,7 +268,7 @@ func tryAdjacentInteractableScan(g *state.Game, neighbors []*world.Cell, honorLa
 			continue
 		}
 		if cell != nil && cell.ExitCell {
-			if setup.ExitLiftReady(g) || setup.ExitLiftState(g) == state.ExitLiftLockedIncomplete {
+			if setup.ExitLiftReady(g) || exitLiftBlockedByObjectives(g) {
 				if TryUseLift(g) {
 					FaceTowardAdjacentCell(g, cell)
 					g.LastInteractedRow = cell.Row
@@ -195,7 +295,7 @@ func tryAdjacentInteractableScan(g *state.Game, neighbors []*world.Cell, honorLa
 		}
 	}
 
-	// Pass 4: furniture, terminals, puzzles, hazard controls, repairs, maintenance
+	// Pass 4: furniture, terminals, puzzles, hazard controls, repairs, maintenance, survivors
 	for _, cell := range neighbors {
 		if skipCell(cell) {
 			continue
@@ -272,6 +372,16 @@ func tryAdjacentInteractableScan(g *state.Game, neighbors []*world.Cell, honorLa
 				return true
 			}
 		}
+		if gameworld.HasWaitingSurvivor(cell) {
+			if CheckAdjacentSurvivorAtCell(g, cell) {
+				FaceTowardAdjacentCell(g, cell)
+				g.LastInteractedRow = cell.Row
+				g.LastInteractedCol = cell.Col
+				g.InteractionsCount++
+				log.Printf("[Interact] handled: survivor at (%d,%d)", cell.Row, cell.Col)
+				return true
+			}
+		}
 	}
 
 	return false
@@ -293,12 +403,21 @@ func CheckAdjacentGeneratorAtCell(g *state.Game, cell *world.Cell) bool {
 		return true
 	}
 
+	// Using a generator lifts the hold a battery withdrawal put on auto-insert.
+	gen.AutoInsertHeld = false
+
+	// A sequenced generator takes the tagged batteries the player carries, in order, on use.
+	insertSequencedBatteries(g, gen)
+
 	// Build tooltip message with generator status and power information (UNPOWERED{}/POWERED{} for headline + border tint).
 	var calloutText strings.Builder
 	if gen.IsPowered() {
 		calloutText.WriteString(fmt.Sprintf("POWERED{%s}\n", gen.Name))
 		calloutText.WriteString("SUBTLE{Status: }POWERED{Online}\n")
 		calloutText.WriteString(fmt.Sprintf("Batteries: ACTION{%d}/ACTION{%d}\n", gen.BatteriesInserted, gen.BatteriesRequired))
+		if g.Survival() && gen.Drains() {
+			calloutText.WriteString(fmt.Sprintf("Charge: ACTION{%d%%}\n", gen.ChargePercent()))
+		}
 	} else {
 		calloutText.WriteString(fmt.Sprintf("UNPOWERED{%s}\n", gen.Name))
 		if GeneratorNeedsLongUsePowerUp(gen) {
@@ -312,7 +431,8 @@ func CheckAdjacentGeneratorAtCell(g *state.Game, cell *world.Cell) bool {
 			calloutText.WriteString("SUBTLE{Status: }UNPOWERED{Unpowered}\n")
 		}
 		calloutText.WriteString(fmt.Sprintf("Batteries: ACTION{%d}/ACTION{%d}\n", gen.BatteriesInserted, gen.BatteriesRequired))
-		if gen.BatteriesNeeded() > 0 {
+		calloutText.WriteString(sequencedGeneratorCalloutLines(g, gen))
+		if gen.BatteriesNeeded() > 0 && !gen.IsSequenced() {
 			calloutText.WriteString(fmt.Sprintf("Needs: ACTION{%d} more batteries\n", gen.BatteriesNeeded()))
 		}
 	}
@@ -320,6 +440,7 @@ func CheckAdjacentGeneratorAtCell(g *state.Game, cell *world.Cell) bool {
 	_, gridUsed, _ := setup.GridPowerSummary(g, cell)
 	calloutText.WriteString("\n")
 	calloutText.WriteString(fmt.Sprintf("Generator output: %s\n", renderer.FormatPowerLoad(individual, gen.IsPowered(), false)))
+	calloutText.WriteString(fmt.Sprintf("SUBTLE{Rating: %s, %dw}\n", gen.TierName(), gen.RatedOutput()))
 	calloutText.WriteString("\n")
 	calloutText.WriteString(renderer.FormatPowerBarLine("Grid power", gridTotal, gridUsed))
 	calloutText.WriteString("\n")
@@ -342,10 +463,48 @@ func CheckAdjacentGeneratorAtCell(g *state.Game, cell *world.Cell) bool {
 	return true
 }
 
+// PickUpItemsOnFloor collects the items on the player's cell, or with manual pick-up
+// switched on (or on the cell the player just dropped something), prompts for the
+// pick-up key instead.
 func PickUpItemsOnFloor(g *state.Game) {
+	if g == nil || g.CurrentCell == nil {
+		return
+	}
+	if g.DroppedOnCell != nil {
+		if g.DroppedOnCell == g.CurrentCell {
+			showPickUpPrompt(g.CurrentCell)
+			return
+		}
+		g.DroppedOnCell = nil
+	}
+	if config.Current().ManualPickup {
+		showPickUpPrompt(g.CurrentCell)
+		return
+	}
 	pickUpItemsOnCell(g, g.CurrentCell)
 }
 
+// PickUpItemsManually collects the items on the player's cell (ActionPickUp).
+func PickUpItemsManually(g *state.Game) bool {
+	if g == nil || g.CurrentCell == nil || g.CurrentCell.ItemsOnFloor.Size() == 0 {
+		return false
+	}
+	pickUpItemsOnCell(g, g.CurrentCell)
+	return true
+}
+
+func showPickUpPrompt(cell *world.Cell) {
+	if cell.ItemsOnFloor.Size() == 0 {
+		return
+	}
+	var names []string
+	cell.ItemsOnFloor.Each(func(item *world.Item) {
+		names = append(names, "ITEM{"+item.Name+"}")
+	})
+	sort.Strings(names)
+	renderer.AddCallout(cell.Row, cell.Col, engineinput.HintPickUp()+" "+strings.Join(names, ", "), renderer.CalloutColorItem, 0)
+}
+
 // PickUpAdjacentFloorItemsOnBlockingDevices collects floor loot sitting on cells
 // blocked by repair housings (e.g. unlock keycards that spawned before drop-cell fix).
 func PickUpAdjacentFloorItemsOnBlockingDevices(g *state.Game) {
@@ -364,8 +523,17 @@ func pickUpItemsOnCell(g *state.Game, cell *world.Cell) {
 	if g == nil || cell == nil {
 		return
 	}
+	full, picked := false, false
 	cell.ItemsOnFloor.Each(func(item *world.Item) {
+		if inventoryFullFor(g, item) {
+			full = true
+			return
+		}
 		cell.ItemsOnFloor.Remove(item)
+		if !g.WasDropped(item) {
+			g.NoteItemCollected()
+		}
+		picked = true
 
 		if item.Name == "Map" {
 			g.HasMap = true
@@ -375,6 +543,10 @@ func pickUpItemsOnCell(g *state.Game, cell *world.Cell) {
 			g.AddRunKeycard(world.NewItem(item.Name))
 			msg := fmt.Sprintf("Picked up: KEYCARD{%s}", item.Name)
 			renderer.AddCallout(cell.Row, cell.Col, msg, renderer.CalloutColorKeycard, 0)
+		} else if isTaggedBattery(item) {
+			g.OwnedItems.Put(item)
+			msg := fmt.Sprintf("Picked up: BATTERY{%s}", item.Name)
+			renderer.AddCallout(cell.Row, cell.Col, msg, renderer.CalloutColorBattery, 0)
 		} else if strings.Contains(strings.ToLower(item.Name), "battery") {
 			g.AddBatteries(1)
 			msg := fmt.Sprintf("Picked up: BATTERY{%s}", item.Name)
@@ -385,6 +557,12 @@ func pickUpItemsOnCell(g *state.Game, cell *world.Cell) {
 			renderer.AddCallout(cell.Row, cell.Col, msg, c, 0)
 		}
 	})
+	if picked {
+		renderer.PlaySound(renderer.SoundPickup)
+	}
+	if full {
+		renderer.AddCallout(cell.Row, cell.Col, inventoryCalloutFull, renderer.CalloutColorWarning, 0)
+	}
 }
 
 // floorPickupOwnedItemCallout returns markup and AddCallout color for a carried item (not Map/Battery pickup paths).
@@ -392,6 +570,8 @@ func floorPickupOwnedItemCallout(itemName string) (string, color.RGBA) {
 	switch {
 	case state.IsRunWideKeycardName(itemName):
 		return fmt.Sprintf("Picked up: KEYCARD{%s}", itemName), renderer.CalloutColorKeycard
+	case itemName == entities.EMPChargeName:
+		return fmt.Sprintf("Picked up: ITEM{%s}\nSUBTLE{%s}", itemName, engineinput.HintUseItem()), renderer.CalloutColorItem
 	default:
 		return fmt.Sprintf("Picked up: ITEM{%s}", itemName), renderer.CalloutColorItem
 	}
@@ -417,6 +597,10 @@ func furnitureCalloutBody(text string) string {
 	return text
 }
 
+func furnitureCalloutFoundCode(code string) string {
+	return fmt.Sprintf("Code found: ACTION{%s}", code)
+}
+
 func furnitureCalloutFoundWithItem(itemName string) string {
 	return fmt.Sprintf("FURNITURE_CHECKED{Found: }%s!", furnitureFoundItemSegment(itemName))
 }
@@ -441,7 +625,7 @@ func CheckAdjacentGenerators(g *state.Game) {
 
 		gen := gameworld.GetGameData(cell).Generator
 		needed := gen.BatteriesNeeded()
-		if needed == 0 {
+		if needed == 0 || gen.IsSequenced() || gen.AutoInsertHeld {
 			continue
 		}
 
@@ -451,22 +635,27 @@ func CheckAdjacentGenerators(g *state.Game) {
 			toInsert = g.Batteries
 		}
 
+		liveBefore := liveRoomNames(g)
 		inserted := gen.InsertBatteries(g.UseBatteries(toInsert))
 		if inserted > 0 {
 			logMessage(g, "Inserted ACTION{%d} batteries into ROOM{%s}", inserted, gen.Name)
 			if gen.IsPowered() {
 				logMessage(g, "ITEM{%s} is now powered!", gen.Name)
+				debuglog.Info("generator.powered", "name", gen.Name, "row", cell.Row, "col", cell.Col)
 				renderer.AddCallout(cell.Row, cell.Col, fmt.Sprintf("POWERED{%s - online}", gen.Name), renderer.CalloutColorGeneratorOn, 0)
 				renderer.AddDevicePulse(cell.Row, cell.Col)
 				setup.NotifyPowerGridChanged(g)
 				UpdateLightingExploration(g)
+				animateGeneratorPowerUp(g, cell, liveBefore)
 				logMessage(g, "Power supply: %dw available", g.GetAvailablePower())
 			} else if GeneratorNeedsLongUsePowerUp(gen) {
 				logMessage(g, "%s is waiting for startup — hold USE to power it up", gen.Name)
 				renderer.AddCallout(cell.Row, cell.Col, fmt.Sprintf("UNPOWERED{%s — waiting for startup sequence}", gen.Name), renderer.CalloutColorGenerator, 0)
+				renderer.PlaySound(renderer.SoundBatteryInsert)
 			} else {
 				logMessage(g, "%s needs ACTION{%d} more batteries", gen.Name, gen.BatteriesNeeded())
 				renderer.AddCallout(cell.Row, cell.Col, fmt.Sprintf("UNPOWERED{+%d batteries - %d more needed}", inserted, gen.BatteriesNeeded()), renderer.CalloutColorGenerator, 0)
+				renderer.PlaySound(renderer.SoundBatteryInsert)
 			}
 		}
 	}
@@ -498,6 +687,7 @@ func CheckAdjacentTerminalsAtCell(g *state.Game, cell *world.Cell) bool {
 		renderer.AddCallout(cell.Row, cell.Col, fmt.Sprintf("TITLE{%s already explored}", targetRoom), renderer.CalloutColorTerminal, 0)
 	} else {
 		// Reveal the target room
+		markRoomSeenViaCamera(g.Grid, targetRoom)
 		if revealRoomByName(g.Grid, targetRoom) {
 			terminal.Activate()
 			logMessage(g, "Accessed %s - revealed ROOM{%s} on security feed!", terminal.Name, targetRoom)
@@ -563,41 +753,53 @@ func CheckAdjacentFurnitureAtCell(g *state.Game, cell *world.Cell) bool {
 
 	noteLinkageRelaysFromText(g, furniture.Description)
 
-	// Track if this is the first time checking this furniture
-	wasChecked := furniture.IsChecked()
-
 	// Check the furniture and get any contained item (if not already taken)
 	// Check() sets ContainedItem to nil after first check, preventing duplicate items
 	item := furniture.Check()
 
-	// Check if description contains a puzzle code (format: "Code: X-Y-Z" or "Sequence: 1-2-3")
-	// Only check for codes on first interaction
-	if !wasChecked {
-		CheckForPuzzleCode(g, furniture.Description)
-	}
+	// Puzzle codes in the description (format: "Code: X-Y-Z") are reported exactly once,
+	// independently of any hidden item, so the item callout never swallows the code.
+	code := revealFurnitureCode(g, furniture)
 
 	// If furniture contained an item, give it to the player and show callout
-	if item != nil {
-		if strings.Contains(strings.ToLower(item.Name), "battery") {
+	var calloutText string
+	if item != nil && inventoryFullFor(g, item) && g.CurrentCell != nil {
+		// No room to carry it: the find is set down at the player's feet instead.
+		g.CurrentCell.ItemsOnFloor.Put(item)
+		calloutText = fmt.Sprintf("%s\n%s\n%s: left at your feet", furnitureCalloutHeading(furniture.Name),
+			furnitureCalloutFoundWithItem(item.Name), inventoryCalloutFull)
+	} else if item != nil {
+		g.NoteItemCollected()
+		if isTaggedBattery(item) {
+			g.OwnedItems.Put(item)
+		} else if strings.Contains(strings.ToLower(item.Name), "battery") {
 			g.AddBatteries(1)
-			calloutText := fmt.Sprintf("%s\n%s", furnitureCalloutHeading(furniture.Name), furnitureCalloutFoundWithItem(item.Name))
-			renderer.AddCallout(cell.Row, cell.Col, calloutText, renderer.CalloutColorFurnitureChecked, 0)
 		} else if state.IsRunWideKeycardName(item.Name) {
 			g.AddRunKeycard(world.NewItem(item.Name))
-			calloutText := fmt.Sprintf("%s\n%s", furnitureCalloutHeading(furniture.Name), furnitureCalloutFoundWithItem(item.Name))
-			renderer.AddCallout(cell.Row, cell.Col, calloutText, renderer.CalloutColorFurnitureChecked, 0)
 		} else {
 			g.OwnedItems.Put(item)
-			calloutText := fmt.Sprintf("%s\n%s", furnitureCalloutHeading(furniture.Name), furnitureCalloutFoundWithItem(item.Name))
-			renderer.AddCallout(cell.Row, cell.Col, calloutText, renderer.CalloutColorFurnitureChecked, 0)
 		}
+		calloutText = fmt.Sprintf("%s\n%s", furnitureCalloutHeading(furniture.Name), furnitureCalloutFoundWithItem(item.Name))
 	} else {
-		calloutText := fmt.Sprintf("%s\n%s", furnitureCalloutHeading(furniture.Name), furnitureCalloutBody(furniture.Description))
-		renderer.AddCallout(cell.Row, cell.Col, calloutText, renderer.CalloutColorFurnitureChecked, 0)
+		calloutText = fmt.Sprintf("%s\n%s", furnitureCalloutHeading(furniture.Name), furnitureCalloutBody(furniture.Description))
 	}
+	if code != "" {
+		calloutText += "\n" + furnitureCalloutFoundCode(code)
+	}
+	renderer.AddCallout(cell.Row, cell.Col, calloutText, renderer.CalloutColorFurnitureChecked, 0)
 	return true
 }
 
+// revealFurnitureCode reports the puzzle code in furniture's description the first time
+// it is searched. Returns the code, or "" when there is none or it was already revealed.
+func revealFurnitureCode(g *state.Game, furniture *entities.Furniture) string {
+	if furniture == nil || furniture.CodeRevealed {
+		return ""
+	}
+	furniture.CodeRevealed = true
+	return CheckForPuzzleCode(g, furniture.Description)
+}
+
 // CheckAdjacentHazardControlsAtCell checks a specific cell for hazard controls and interacts with it
 // Returns true if a hazard control was interacted with
 func CheckAdjacentHazardControlsAtCell(g *state.Game, cell *world.Cell) bool {
@@ -618,6 +820,7 @@ func CheckAdjacentHazardControlsAtCell(g *state.Game, cell *world.Cell) bool {
 		info := entities.HazardTypes[control.Type]
 		logMessage(g, "Activated %s: %s", renderer.StyledHazardCtrl(control.Name), info.FixedMessage)
 		renderer.AddCallout(cell.Row, cell.Col, fmt.Sprintf("TITLE{%s activated!}", control.Name), renderer.CalloutColorHazardCtrl, 0)
+		renderer.PlaySound(renderer.SoundHazardCleared)
 	}
 	return true
 }
@@ -664,31 +867,16 @@ func CheckAdjacentMaintenanceTerminalAtCell(g *state.Game, cell *world.Cell) boo
 	return true
 }
 
-// CheckForPuzzleCode extracts puzzle codes from text and adds them to found codes
-func CheckForPuzzleCode(g *state.Game, text string) {
+// CheckForPuzzleCode extracts puzzle codes from text and adds them to found codes.
+// Returns the code found, or "" when text holds none.
+func CheckForPuzzleCode(g *state.Game, text string) string {
 	// Look for patterns like "Code: 1-2-3-4" or "Sequence: up-down-left-right"
-	// Simple pattern matching for codes
-	lowerText := strings.ToLower(text)
-
-	// Check for "code:" or "sequence:" followed by the actual code
-	codePrefixes := []string{"code:", "sequence:", "pattern:", "solution:"}
-	for _, prefix := range codePrefixes {
-		if idx := strings.Index(lowerText, prefix); idx != -1 {
-			// Extract the code after the prefix
-			codeStart := idx + len(prefix)
-			codeText := strings.TrimSpace(text[codeStart:])
-			// Take up to the next sentence or line break
-			if endIdx := strings.IndexAny(codeText, ".,;!?\n"); endIdx != -1 {
-				codeText = codeText[:endIdx]
-			}
-			codeText = strings.TrimSpace(codeText)
-			if codeText != "" {
-				g.AddFoundCode(codeText)
-				logMessage(g, "Discovered code: %s", codeText)
-			}
-			break
-		}
+	codeText := entities.ParseCode(text)
+	if codeText != "" {
+		g.AddFoundCode(codeText)
+		logMessage(g, "Discovered code: %s", codeText)
 	}
+	return codeText
 }
 
 // applyPuzzleReward applies the reward for solving a puzzle
@@ -743,6 +931,16 @@ func revealRoomByName(grid *world.Grid, roomName string) bool {
 	return revealed
 }
 
+// markRoomSeenViaCamera flags the room's cells the player has not seen lit in person, so
+// the map can tell the camera view apart from explored floor. Call before revealing it.
+func markRoomSeenViaCamera(grid *world.Grid, roomName string) {
+	grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell.Name == roomName && !(cell.Discovered && gameworld.IsLighted(cell)) {
+			gameworld.MarkSeenViaCamera(cell)
+		}
+	})
+}
+
 // isRoomFullyRevealed checks if all cells with the given room name are visited (or discovered when visited system is off).
 func isRoomFullyRevealed(grid *world.Grid, roomName string) bool {
 	allRevealed := true
@@ -6,6 +6,7 @@ import (
 
 	engineinput "darkstation/pkg/engine/input"
 	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/config"
 	"darkstation/pkg/game/entities"
 	"darkstation/pkg/game/state"
 	gameworld "darkstation/pkg/game/world"
@@ -51,6 +52,27 @@ func TestCheckAdjacentGenerators_InsertsBatteries(t *testing.T) {
 	}
 }
 
+func TestCheckAdjacentGenerators_SkipsWithdrawnUntilUsed(t *testing.T) {
+	g := makeTestGame(2, 2)
+	gen := entities.NewGenerator("G1", 2)
+	genCell := g.Grid.GetCell(0, 1)
+	gameworld.GetGameData(genCell).Generator = gen
+	g.AddGenerator(gen)
+	gen.InsertBatteriesAndStart(2)
+	g.AddBatteries(gen.WithdrawBatteries())
+
+	CheckAdjacentGenerators(g)
+	if gen.BatteriesInserted != 0 || g.Batteries != 2 {
+		t.Fatalf("auto-insert refilled a withdrawn generator: inserted=%d carried=%d", gen.BatteriesInserted, g.Batteries)
+	}
+
+	CheckAdjacentGeneratorAtCell(g, genCell)
+	CheckAdjacentGenerators(g)
+	if gen.BatteriesInserted != 2 {
+		t.Errorf("BatteriesInserted after use = %d, want 2", gen.BatteriesInserted)
+	}
+}
+
 func TestCheckAdjacentGenerators_UpdatesPowerSupply(t *testing.T) {
 	g := makeTestGame(2, 2)
 	g.CurrentDeckID = 0
@@ -247,6 +269,52 @@ func TestCheckAdjacentInteractables_facesCycledTarget(t *testing.T) {
 	}
 }
 
+func TestCheckAdjacentInteractables_highlightsNextInCycle(t *testing.T) {
+	g := makeTestGame(3, 3)
+	g.CurrentCell = g.Grid.GetCell(1, 1)
+	g.PlayerFacing = state.FaceNorth
+	gameworld.GetGameData(g.Grid.GetCell(1, 2)).Generator = entities.NewGenerator("East Gen", 0)
+	gameworld.GetGameData(g.Grid.GetCell(1, 0)).Furniture = entities.NewFurniture("West Shelf", "west", "F")
+	gameworld.GetGameData(g.Grid.GetCell(2, 1)).Furniture = entities.NewFurniture("South Shelf", "south", "F")
+
+	if !CheckAdjacentInteractables(g) {
+		t.Fatal("expected first interaction")
+	}
+	for press := 0; press < 4; press++ {
+		row, col, ok := g.InteractCycleNext()
+		if !ok {
+			t.Fatalf("press %d: no next-in-cycle target tracked", press)
+		}
+		if row == g.LastInteractedRow && col == g.LastInteractedCol {
+			t.Fatalf("press %d: highlight (%d,%d) repeats the target just used", press, row, col)
+		}
+		if !CheckAdjacentInteractables(g) {
+			t.Fatalf("press %d: expected interaction", press)
+		}
+		if g.LastInteractedRow != row || g.LastInteractedCol != col {
+			t.Fatalf("press %d: interacted (%d,%d), highlight promised (%d,%d)", press, g.LastInteractedRow, g.LastInteractedCol, row, col)
+		}
+	}
+
+	MoveCell(g, g.Grid.GetCell(0, 1))
+	if _, _, ok := g.InteractCycleNext(); ok {
+		t.Error("moving should clear the next-in-cycle highlight")
+	}
+}
+
+func TestCheckAdjacentInteractables_singleTargetHasNoCycleHighlight(t *testing.T) {
+	g := makeTestGame(3, 3)
+	g.CurrentCell = g.Grid.GetCell(1, 1)
+	gameworld.GetGameData(g.Grid.GetCell(1, 2)).Furniture = entities.NewFurniture("East Shelf", "east", "F")
+
+	if !CheckAdjacentInteractables(g) {
+		t.Fatal("expected interaction")
+	}
+	if _, _, ok := g.InteractCycleNext(); ok {
+		t.Error("a lone interactable should not get a cycle highlight")
+	}
+}
+
 func TestCheckAdjacentInteractables_prefersFacingDirection(t *testing.T) {
 	g := makeTestGame(3, 3)
 	g.CurrentCell = g.Grid.GetCell(1, 1)
@@ -265,6 +333,51 @@ func TestCheckAdjacentInteractables_prefersFacingDirection(t *testing.T) {
 	}
 }
 
+func TestCheckAdjacentInteractables_interactRadius(t *testing.T) {
+	tests := []struct {
+		name   string
+		radius int
+		wall   bool
+		want   bool
+	}{
+		{name: "default radius stays adjacent-only", radius: 0, want: false},
+		{name: "radius 2 reaches two cells away", radius: 2, want: true},
+		{name: "wall blocks the reach", radius: 2, wall: true, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := makeTestGame(2, 3)
+			if tt.wall {
+				g.Grid.GetCell(0, 1).Room = false
+			}
+			genCell := g.Grid.GetCell(0, 2)
+			gen := entities.NewGenerator("Big Gen", 2)
+			gen.InteractRadius = tt.radius
+			gameworld.GetGameData(genCell).Generator = gen
+
+			if got := CheckAdjacentInteractables(g); got != tt.want {
+				t.Fatalf("CheckAdjacentInteractables = %v, want %v", got, tt.want)
+			}
+			if tt.want && (g.LastInteractedRow != genCell.Row || g.LastInteractedCol != genCell.Col) {
+				t.Errorf("interacted (%d,%d), want generator at (0,2)", g.LastInteractedRow, g.LastInteractedCol)
+			}
+		})
+	}
+}
+
+func TestInteractionNeighbors_orthogonalFirst(t *testing.T) {
+	g := makeTestGame(1, 3)
+	far := g.Grid.GetCell(0, 2)
+	gen := entities.NewGenerator("Big Gen", 2)
+	gen.InteractRadius = 2
+	gameworld.GetGameData(far).Generator = gen
+
+	got := interactionNeighbors(g)
+	if len(got) != 5 || got[4] != far {
+		t.Fatalf("expected the four orthogonal slots followed by the far generator, got %d cells", len(got))
+	}
+}
+
 func TestPickUpItemsOnFloor_Battery(t *testing.T) {
 	g := makeTestGame(2, 2)
 	battery := world.NewItem("Battery")
@@ -332,6 +445,128 @@ func TestPickUpItemsOnFloor_ReactorAuthorization(t *testing.T) {
 	}
 }
 
+func TestPickUpItemsOnFloor_ManualPickupWaitsForAction(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	cfg := config.DefaultConfig()
+	cfg.ManualPickup = true
+	config.SetCurrent(cfg)
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	g := makeTestGame(2, 2)
+	g.CurrentCell.ItemsOnFloor.Put(world.NewItem("Battery"))
+
+	PickUpItemsOnFloor(g)
+	if g.Batteries != 0 || g.CurrentCell.ItemsOnFloor.Size() != 1 {
+		t.Fatalf("manual pick-up should leave the battery on the floor, batteries = %d", g.Batteries)
+	}
+
+	if !PickUpItemsManually(g) {
+		t.Fatal("pick-up action should collect the floor items")
+	}
+	if g.Batteries != 1 || g.CurrentCell.ItemsOnFloor.Size() != 0 {
+		t.Errorf("after pick-up action, batteries = %d, floor items = %d", g.Batteries, g.CurrentCell.ItemsOnFloor.Size())
+	}
+	if PickUpItemsManually(g) {
+		t.Error("pick-up action on an empty floor should report nothing collected")
+	}
+}
+
+func TestPickUpItemsOnFloor_InventoryCapLeavesItemOnFloor(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	cfg := config.DefaultConfig()
+	cfg.InventoryCap = 2
+	config.SetCurrent(cfg)
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	g := makeTestGame(2, 2)
+	g.OwnedItems.Put(world.NewItem("Patch Kit"))
+	g.OwnedItems.Put(world.NewItem("Crew Keycard"))
+	kit := world.NewItem("Patch Kit")
+	g.CurrentCell.ItemsOnFloor.Put(kit)
+	g.CurrentCell.ItemsOnFloor.Put(world.NewItem("Battery"))
+
+	PickUpItemsOnFloor(g)
+	if g.Batteries != 1 {
+		t.Errorf("batteries = %d, want 1 (batteries ignore the cap)", g.Batteries)
+	}
+	if !g.CurrentCell.ItemsOnFloor.Has(kit) || g.OwnedItems.Has(kit) {
+		t.Error("a full inventory should leave the patch kit on the floor")
+	}
+}
+
+func TestDropItem_keycardAndKitKeepIdentityWhenPickedUpAgain(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	g := makeTestGame(2, 2)
+	g.InitRunUnlocks(7)
+	auth := world.NewItem("Reactor Authorization — Observatory")
+	g.AddRunKeycard(auth)
+	kit := world.NewItem("Patch Kit")
+	g.OwnedItems.Put(kit)
+	here := g.CurrentCell
+
+	if !g.DropItem(auth) || !g.DropItem(kit) {
+		t.Fatal("carried items should drop")
+	}
+	if g.HasRunKeycard(auth.Name) || g.OwnedItems.Has(kit) {
+		t.Fatal("dropped items should leave the inventory")
+	}
+
+	// Auto pick-up leaves a fresh drop alone until the player steps away.
+	PickUpItemsOnFloor(g)
+	if here.ItemsOnFloor.Size() != 2 {
+		t.Fatalf("auto pick-up took items straight back, floor = %d", here.ItemsOnFloor.Size())
+	}
+	g.CurrentCell = g.Grid.GetCell(0, 1)
+	PickUpItemsOnFloor(g)
+	g.CurrentCell = here
+	PickUpItemsOnFloor(g)
+
+	if here.ItemsOnFloor.Size() != 0 {
+		t.Fatalf("returning should pick the items up, floor = %d", here.ItemsOnFloor.Size())
+	}
+	if !g.HasRunKeycard(auth.Name) {
+		t.Error("re-picked keycard should be a run-wide keycard again")
+	}
+	if !g.OwnedItems.Has(kit) {
+		t.Error("re-picked patch kit should be the same item")
+	}
+}
+
+func TestDropItem_rePickDoesNotCountAsNewFind(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	g := makeTestGame(2, 2)
+	here := g.CurrentCell
+	kit := world.NewItem("Patch Kit")
+	here.ItemsOnFloor.Put(kit)
+
+	PickUpItemsOnFloor(g)
+	if got := g.DeckItemsCollected[g.CurrentDeckID]; got != 1 {
+		t.Fatalf("first pick-up counted %d items, want 1", got)
+	}
+
+	for i := 0; i < 3; i++ {
+		if !g.DropItem(kit) {
+			t.Fatal("carried kit should drop")
+		}
+		g.CurrentCell = g.Grid.GetCell(0, 1)
+		PickUpItemsOnFloor(g)
+		g.CurrentCell = here
+		PickUpItemsOnFloor(g)
+		if !g.OwnedItems.Has(kit) {
+			t.Fatal("returning should pick the kit up again")
+		}
+	}
+	if got := g.DeckItemsCollected[g.CurrentDeckID]; got != 1 {
+		t.Errorf("drop and re-pick counted %d items, want 1", got)
+	}
+}
+
 // Integration: full lifecycle of picking up batteries and powering a generator.
 func TestIntegration_BatteryPickupAndGeneratorPower(t *testing.T) {
 	g := makeTestGame(3, 3)
@@ -476,6 +711,36 @@ func TestCheckAdjacentTerminalsAtCell_CCTVPowered(t *testing.T) {
 	}
 }
 
+func TestCheckAdjacentTerminalsAtCell_CCTVMarksRoomSeenViaCamera(t *testing.T) {
+	g := makeTestGame(1, 4)
+	g.Grid.GetCell(0, 2).Name = "Vault"
+	g.Grid.GetCell(0, 3).Name = "Vault"
+	termCell := g.Grid.GetCell(0, 1)
+	gameworld.GetGameData(termCell).Terminal = entities.NewCCTVTerminal("CCTV-1")
+	gameworld.GetGameData(termCell).Terminal.TargetRoom = "Vault"
+	g.RoomCCTVPowered = map[string]bool{"Room": true}
+	far := g.Grid.GetCell(0, 3)
+	gameworld.GetGameData(far).Hazard = entities.NewHazard(entities.HazardVacuum)
+
+	CheckAdjacentTerminalsAtCell(g, termCell)
+	if !far.Discovered || !gameworld.GetGameData(far).SeenViaCamera {
+		t.Fatal("CCTV should reveal the target room and mark it seen via camera")
+	}
+	if gameworld.CameraViewStale(far) {
+		t.Fatal("camera view should match the cell right after the feed")
+	}
+
+	gameworld.GetGameData(far).Hazard.Fix()
+	if !gameworld.CameraViewStale(far) {
+		t.Error("clearing the hazard remotely should make the camera view stale")
+	}
+
+	TeleportPlayerTo(g, far)
+	if gameworld.GetGameData(far).SeenViaCamera {
+		t.Error("standing on the cell should replace the camera view")
+	}
+}
+
 func TestCheckAdjacentHazardControlsAtCell_Unpowered(t *testing.T) {
 	g := makeTestGame(2, 2)
 	ctrlCell := g.Grid.GetCell(0, 1)
@@ -573,3 +838,36 @@ func TestCheckAdjacentMaintenanceTerminalAtCell_PoweredOpensMenu(t *testing.T) {
 		t.Error("powered maintenance terminal should open maintenance menu")
 	}
 }
+
+func TestProcessIntent_interactAtTargetsClickedCell(t *testing.T) {
+	g := makeTestGame(3, 3)
+	g.CurrentCell = g.Grid.GetCell(1, 1)
+	g.PlayerFacing = state.FaceWest
+	for r := 0; r < 3; r++ {
+		for c := 0; c < 3; c++ {
+			g.Grid.GetCell(r, c).Discovered = true
+		}
+	}
+	east := g.Grid.GetCell(1, 2)
+	west := g.Grid.GetCell(1, 0)
+	gameworld.GetGameData(east).Furniture = entities.NewFurniture("East Shelf", "east", "F")
+	gameworld.GetGameData(west).Furniture = entities.NewFurniture("West Shelf", "west", "F")
+
+	// The facing-first cycle would pick west; a click on east goes straight there.
+	ProcessIntent(g, engineinput.Intent{Action: engineinput.ActionInteractAt, Row: east.Row, Col: east.Col})
+	if g.LastInteractedRow != east.Row || g.LastInteractedCol != east.Col {
+		t.Fatalf("interacted with (%d,%d), want east (%d,%d)", g.LastInteractedRow, g.LastInteractedCol, east.Row, east.Col)
+	}
+	if g.PlayerFacing != state.FaceEast {
+		t.Errorf("facing = %v, want east after clicking the east shelf", g.PlayerFacing)
+	}
+	if IsWalkToActive(g) {
+		t.Error("an interaction in reach should not start a walk")
+	}
+
+	// Out of reach: falls back to click-to-walk.
+	ProcessIntent(g, engineinput.Intent{Action: engineinput.ActionInteractAt, Row: 2, Col: 2})
+	if !IsWalkToActive(g) {
+		t.Error("a click on a distant floor cell should start a walk")
+	}
+}
@@ -0,0 +1,34 @@
+package gameplay
+
+import (
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/config"
+	gamemenu "darkstation/pkg/game/menu"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/state"
+)
+
+// inventoryCalloutFull is shown where an item was left behind because the player's
+// inventory is at the configured cap.
+const inventoryCalloutFull = "Inventory full"
+
+// inventoryFullFor reports whether picking up item would exceed the inventory cap.
+// Batteries and the map are always accepted; the cap is off (0) unless configured.
+func inventoryFullFor(g *state.Game, item *world.Item) bool {
+	limit := config.Current().InventoryCap
+	if limit <= 0 || item == nil || !state.CountsTowardInventoryCap(item.Name) {
+		return false
+	}
+	return g.CarriedItemCount() >= limit
+}
+
+// DropItemFromMenu opens the drop menu (ActionDropItem) and announces the dropped item.
+func DropItemFromMenu(g *state.Game) {
+	item := gamemenu.RunDropItemMenu(g)
+	if item == nil || g.CurrentCell == nil {
+		return
+	}
+	segment := furnitureFoundItemSegment(item.Name)
+	renderer.AddCallout(g.CurrentCell.Row, g.CurrentCell.Col, "Dropped: "+segment, renderer.CalloutColorItem, 0)
+	logMessage(g, "Dropped %s.", segment)
+}
@@ -22,14 +22,18 @@ import (
 
 const levelGenTotalSteps = 13
 
-// GenerateGrid creates a new grid using the default generator and run deck theme.
+// GenerateGrid creates a new grid using the default generator, run deck theme and g's level RNG.
 func GenerateGrid(g *state.Game, level int) *world.Grid {
 	theme := deck.ThemeAirlock
 	if g != nil {
 		theme = g.ThemeForDeck(level - 1)
 	}
 	opts := generator.GenerateOptionsFromMode(g.Mode())
-	return generator.BSP.GenerateWithOptions(level, theme, opts)
+	if g.Mode().Endless && opts.LayoutLevel == 0 {
+		opts.LayoutLevel = generator.EndlessLayoutLevel(level)
+	}
+	opts.ShapeSeed = g.LevelSeed
+	return generator.BSP.GenerateWithOptions(level, theme, opts, g.RNG())
 }
 
 // BuildGame creates a new game instance with optional starting level (Phase 3.2, 3.4).
@@ -40,8 +44,16 @@ func BuildGame(startLevel int) *state.Game {
 
 // BuildGameWithMode creates a new game in the given mode.
 func BuildGameWithMode(startLevel int, modeID gamemode.ID) *state.Game {
+	return BuildGameWithOptions(startLevel, modeID, gamemode.RunOptions{})
+}
+
+// BuildGameWithOptions creates a new game in the given mode, tuned by the new-game
+// options (difficulty, deck size, and seed; a zero seed picks a random one). Any
+// starting items in opts are granted once the player has spawned.
+func BuildGameWithOptions(startLevel int, modeID gamemode.ID, opts gamemode.RunOptions) *state.Game {
 	g := state.NewGame()
 	g.SetMode(modeID)
+	g.GameMode = g.Mode().WithOptions(opts)
 
 	// Current deck by ID; Level = 1-based display (Phase 3.2)
 	if startLevel < 1 {
@@ -53,7 +65,10 @@ func BuildGameWithMode(startLevel int, modeID gamemode.ID) *state.Game {
 	g.CurrentDeckID = startLevel - 1
 	g.Level = g.CurrentDeckID + 1
 
-	seed := time.Now().UnixNano()
+	seed := opts.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
 	g.InitRunUnlocks(seed)
 
 	// Generate current deck on first entry (no stored state yet)
@@ -63,14 +78,23 @@ func BuildGameWithMode(startLevel int, modeID gamemode.ID) *state.Game {
 	} else {
 		SpawnOnDeckEntry(g, SpawnModeShip)
 	}
+	GrantStartingItems(g, opts.StartingItems)
 	UpdateLightingExploration(g)
 
 	InitRunTracking(g)
 	g.ClearMessages()
+	announceIfStuck(g)
 
 	return g
 }
 
+// DailySeed returns the run seed shared by everyone playing the daily station on
+// day's calendar date (UTC).
+func DailySeed(day time.Time) int64 {
+	y, m, d := day.UTC().Date()
+	return int64(y*10000 + int(m)*100 + d)
+}
+
 // maxLevelGenAttempts bounds the regenerate-and-retry loop when a generated deck
 // fails the simulated-playthrough acceptance gate. Retries derive their seed from
 // the level seed, so the loop is deterministic and seed-reproducible.
@@ -105,7 +129,7 @@ func generateLevel(g *state.Game, level int, seed int64) {
 			clearLevelProgress(g)
 			attemptReport = noProgress
 		}
-		levelrand.Seed(attemptSeed)
+		g.Rand = levelrand.New(attemptSeed)
 		g.Grid = GenerateGrid(g, level)
 		setupLevel(g, attemptReport)
 		g.LevelGenAttempts = attempt + 1
@@ -117,6 +141,8 @@ func generateLevel(g *state.Game, level int, seed int64) {
 			break
 		}
 	}
+	applyCreativeMode(g)
+	logDeckGenerated(g)
 }
 
 // RegenerateFromSeed rebuilds the current level from seed (for reset / debug reproduction).
@@ -158,6 +184,8 @@ func clearLevelProgress(g *state.Game) {
 	g.PowerSupply = 0
 	g.PowerConsumption = 0
 	g.PowerOverloadWarned = false
+	g.PowerSurge = state.PowerSurge{}
+	g.MapPins = nil
 	g.RoomDoorsPowered = make(map[string]bool)
 	g.RoomCCTVPowered = make(map[string]bool)
 	g.RoomLightsPowered = make(map[string]bool)
@@ -174,6 +202,19 @@ func clearLevelProgress(g *state.Game) {
 	g.LongUse = nil
 	g.HazardClear = nil
 	g.HazardTour = nil
+	g.ResetAmbientHazard()
+	g.ResetDeckRadiation()
+	g.ClearObjectiveRoute()
+	g.ObjectiveCycle = 0
+	g.SoftLockCheckedAt = 0
+	g.HazardSpreadAt = 0
+	g.PatrolStepAt = 0
+	g.OverloadStreak = 0
+	g.OverloadStreakAt = 0
+	g.SoftLockDismissed = false
+	g.LockAlarm = false
+	g.AutoExplore = nil
+	g.WalkTo = nil
 
 	g.MovementCount = 0
 	g.InteractionsCount = 0
@@ -181,13 +222,17 @@ func clearLevelProgress(g *state.Game) {
 	g.LastInteractedCol = -1
 	g.InteractionPlayerRow = -1
 	g.InteractionPlayerCol = -1
+	g.ClearInteractCycleNext()
+	g.BlockedMoveRow, g.BlockedMoveCol, g.BlockedMoveAtMs = -1, -1, 0
 	g.PlayerFacing = state.FaceNorth
 
 	g.InvalidateLivePowerCache()
 
 	g.ExitAnimating = false
 	g.ExitAnimStartTime = 0
+	g.ExitAnimSkipRequested = false
 	g.GameComplete = false
+	clearGameOverState(g)
 }
 
 // clearCrossDeckPowerState resets player-carried power state when entering a different deck.
@@ -203,6 +248,8 @@ func clearCrossDeckPowerState(g *state.Game) {
 	g.PowerSupply = 0
 	g.PowerConsumption = 0
 	g.PowerOverloadWarned = false
+	g.PowerSurge = state.PowerSurge{}
+	g.MapPins = nil
 	g.PowerPropPending = nil
 	g.RoomPowerOffPending = nil
 	g.GeneratorShutdownAt = 0
@@ -212,6 +259,19 @@ func clearCrossDeckPowerState(g *state.Game) {
 	g.LongUse = nil
 	g.HazardClear = nil
 	g.HazardTour = nil
+	g.ResetAmbientHazard()
+	g.ResetDeckRadiation()
+	g.ClearObjectiveRoute()
+	g.ObjectiveCycle = 0
+	g.SoftLockCheckedAt = 0
+	g.HazardSpreadAt = 0
+	g.PatrolStepAt = 0
+	g.OverloadStreak = 0
+	g.OverloadStreakAt = 0
+	g.SoftLockDismissed = false
+	g.LockAlarm = false
+	g.AutoExplore = nil
+	g.WalkTo = nil
 	ClearGeneratorPowerGridOverlay(g)
 }
 
@@ -243,7 +303,8 @@ func setupLevel(g *state.Game, report func(string)) {
 	}
 
 	report("Installing core systems")
-	config := setup.SetupLevel(g)
+	params := generator.DifficultyParamsFor(g.Mode().Difficulty)
+	config := setup.SetupLevel(g, params)
 	avoid := &config.Avoid
 	lockedDoorCells := &config.LockedDoorCells
 	if g.Level == 1 && g.LevelGen().BootstrapDeck1Ship {
@@ -252,8 +313,8 @@ func setupLevel(g *state.Game, report func(string)) {
 	minimalSystems := g.IsFinalDeckLevel(g.Level) // Final deck: minimal rooms/systems (GDD §10.2)
 
 	report("Placing environmental hazards")
-	if g.LevelGen().PlaceHazards && g.Level >= 2 && !minimalSystems {
-		levelgen.PlaceHazards(g, avoid, lockedDoorCells)
+	if g.LevelGen().PlaceHazards && params.GenerationLevel(g.Level) >= 2 && !minimalSystems {
+		levelgen.PlaceHazards(g, avoid, lockedDoorCells, params)
 		levelgen.EnsureHazardControlsSolvable(g)
 		levelgen.EnsureHazardSolutionsDisjoint(g)
 	}
@@ -267,6 +328,9 @@ func setupLevel(g *state.Game, report func(string)) {
 	if g.LevelGen().PlacePuzzles && g.Level >= 2 && !minimalSystems {
 		levelgen.PlacePuzzles(g, avoid)
 	}
+	if g.LevelGen().PlaceDoors && g.LevelGen().PlaceFurniture && !minimalSystems {
+		levelgen.PlaceVaultCode(g)
+	}
 
 	report("Routing maintenance")
 	if g.LevelGen().PlaceMaintenanceTerminals {
@@ -349,6 +413,19 @@ func setupLevel(g *state.Game, report func(string)) {
 		setup.EnsureExitGatingRepairReachability(g)
 	}
 	setup.EnsureFloorLootReachability(g)
+	setup.EnsureSpawnEgress(g)
+
+	// Ambient hazards are passable and never gate progress, so they go in last.
+	if g.LevelGen().PlaceHazards && !minimalSystems {
+		levelgen.PlaceAmbientHazards(g)
+	}
+	// Survivors route around ambient hazards, so they are placed after them.
+	if g.LevelGen().PlaceSurvivors && !minimalSystems {
+		levelgen.PlaceSurvivor(g, avoid)
+	}
+	if g.LevelGen().PlacePatrols && !minimalSystems {
+		levelgen.PlacePatrol(g, avoid)
+	}
 }
 
 func setupBatteryHuntLevel(g *state.Game, report func(string)) {
@@ -362,6 +439,7 @@ func setupBatteryHuntLevel(g *state.Game, report func(string)) {
 
 	report("Finalizing deck")
 	setup.EnsureFloorLootReachability(g)
+	setup.EnsureSpawnEgress(g)
 	_ = avoid
 }
 
@@ -381,17 +459,32 @@ func applyLoadedDeckFixups(g *state.Game) {
 
 // ResetLevel resets the current deck using the same seed; updates per-deck store (Phase 3.4).
 func ResetLevel(g *state.Game) {
+	seed := g.LevelSeed
+	if seed == 0 {
+		seed = int64(g.Level)
+	}
+	rebuildLevel(g, seed)
+	logMessage(g, "Level reset!")
+}
+
+// rerollSeedTag derives a reroll seed from the current level seed. Each reroll
+// updates LevelSeed, so repeated rerolls keep producing new layouts.
+const rerollSeedTag = 0x5eed
+
+// RerollLevel rebuilds the current deck from a fresh seed derived from the current one.
+// Offered when the player is boxed in, where a same-seed reset would not help.
+func RerollLevel(g *state.Game) {
+	seed := levelrand.NewDerived(g.LevelSeed, rerollSeedTag).Int63()
+	rebuildLevel(g, seed)
+	logMessage(g, "Deck rerolled.")
+}
+
+func rebuildLevel(g *state.Game, seed int64) {
 	currentLevel := g.Level
 
+	renderer.SetAmbientHum(false)
 	clearLevelProgress(g)
 
-	var seed int64
-	if g.LevelSeed != 0 {
-		seed = g.LevelSeed
-	} else {
-		seed = int64(currentLevel)
-	}
-
 	generateLevel(g, currentLevel, seed)
 
 	// Update store so revisit uses reset layout (Phase 3.4)
@@ -404,7 +497,6 @@ func ResetLevel(g *state.Game) {
 	SpawnOnDeckEntry(g, SpawnModeLiftShaft)
 
 	g.ClearMessages()
-	logMessage(g, "Level reset!")
 }
 
 // TriggerGameComplete is called when the player reaches the exit on the final deck.
@@ -446,7 +538,7 @@ func JumpToDeck(g *state.Game, targetLevel int) error {
 	} else {
 		g.CurrentDeckID = targetID
 		g.Level = targetLevel
-		seed := g.RunSeed + int64(targetID)*9973
+		seed := deckSeed(g, targetLevel)
 		if g.RunSeed == 0 {
 			seed = time.Now().UnixNano()
 		}
@@ -467,6 +559,7 @@ func clearCompletionState(g *state.Game) {
 		return
 	}
 	g.GameComplete = false
+	clearGameOverState(g)
 	g.CompletionPhase = state.CompletionPhaseSummary
 	g.CreditsLineIndex = 0
 	g.CreditsLineStartMs = 0
@@ -4,10 +4,12 @@ package gameplay
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"darkstation/pkg/engine/world"
 	"darkstation/pkg/game/deck"
 	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/gamemode"
 	"darkstation/pkg/game/menu"
 	"darkstation/pkg/game/setup"
 	"darkstation/pkg/game/state"
@@ -713,3 +715,29 @@ func TestAdvanceThroughAllDecks_FinalDeckReachable(t *testing.T) {
 		t.Error("TriggerGameComplete should set GameComplete on final deck")
 	}
 }
+
+func TestDailySeed_sameAllDay(t *testing.T) {
+	morning := time.Date(2026, 3, 7, 0, 5, 0, 0, time.UTC)
+	evening := time.Date(2026, 3, 7, 23, 55, 0, 0, time.UTC)
+	if DailySeed(morning) != 20260307 || DailySeed(evening) != DailySeed(morning) {
+		t.Fatalf("DailySeed = %d / %d, want 20260307 all day", DailySeed(morning), DailySeed(evening))
+	}
+	if DailySeed(morning.Add(24*time.Hour)) == DailySeed(morning) {
+		t.Fatal("DailySeed should change the next day")
+	}
+}
+
+func TestBuildGameWithOptions_fixedSeedIsReproducible(t *testing.T) {
+	opts := gamemode.RunOptions{Difficulty: gamemode.DifficultyHard, DeckSize: gamemode.DeckSizeCompact, Seed: 20260307}
+	a := BuildGameWithOptions(1, gamemode.SinglePlayerPuzzle, opts)
+	b := BuildGameWithOptions(1, gamemode.SinglePlayerPuzzle, opts)
+	if a.LevelSeed != opts.Seed || b.LevelSeed != opts.Seed {
+		t.Fatalf("LevelSeed = %d / %d, want %d", a.LevelSeed, b.LevelSeed, opts.Seed)
+	}
+	if a.Mode().Difficulty != gamemode.DifficultyHard || a.LevelGen().SizePercent != 75 {
+		t.Fatalf("mode options not applied: %+v", a.Mode())
+	}
+	if a.Grid.Rows() != b.Grid.Rows() || a.Grid.Cols() != b.Grid.Cols() {
+		t.Fatalf("grids differ: %dx%d vs %dx%d", a.Grid.Rows(), a.Grid.Cols(), b.Grid.Rows(), b.Grid.Cols())
+	}
+}
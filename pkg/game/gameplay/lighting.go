@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/renderer"
 	"darkstation/pkg/game/setup"
 	"darkstation/pkg/game/state"
 	gameworld "darkstation/pkg/game/world"
@@ -22,7 +23,8 @@ const HeadlampBackRadius = 1
 // UpdateLightingExploration recalculates power supply/consumption and applies
 // power-driven lighting: a cell is illuminated when it sits on a live conduit from a
 // powered generator (and, for named rooms, the room's lights circuit is enabled), or
-// when it is within the player's headlamp radius.
+// when it is within the player's headlamp radius. It also plays the overload warning
+// and keeps the ambient hum running while the deck has power.
 func UpdateLightingExploration(g *state.Game) {
 	if g.Grid == nil || g.CurrentCell == nil {
 		return
@@ -44,10 +46,15 @@ func UpdateLightingExploration(g *state.Game) {
 
 	if setup.AnyArmedGridOverloaded(g) && !g.PowerOverloadWarned {
 		logMessage(g, "WARNING: Power consumption exceeds supply on a power grid!")
+		renderer.PlaySound(renderer.SoundPowerWarning)
 		g.PowerOverloadWarned = true
 	} else if !setup.AnyArmedGridOverloaded(g) {
 		g.PowerOverloadWarned = false
 	}
+	if advanceOverloadStreak(g) {
+		setup.NotifyPowerGridChanged(g)
+	}
+	renderer.SetAmbientHum(g.GetAvailablePower() > 0)
 
 	applyPowerDrivenLighting(g)
 }
@@ -56,18 +63,22 @@ func UpdateLightingExploration(g *state.Game) {
 //
 // LightsOn is the live illumination state (recomputed every pass). Lighted is sticky:
 // it records that the player has seen this cell illuminated at least once, which the
-// renderer uses as the "remembered" knowledge tier.
+// renderer uses as the "remembered" knowledge tier. RoomLightLevel only tells the
+// renderer which lit rooms to draw dim on a strained grid; it never changes what is lit.
+// Partly fueled generators add a smaller brownout pool (setup.BrownoutLitCells).
 func applyPowerDrivenLighting(g *state.Game) {
 	if g.Grid == nil {
 		return
 	}
+	g.RoomLightLevel = setup.RoomLightLevels(g)
 	live := setup.CellsReachableFromPoweredGenerators(g)
+	brownout := setup.BrownoutLitCells(g)
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
 		if cell == nil || !cell.Room {
 			return
 		}
 		data := gameworld.GetGameData(cell)
-		data.GridLit = live.Has(cell) && roomLightsEnabled(g, cell)
+		data.GridLit = (live.Has(cell) || brownout.Has(cell)) && roomLightsEnabled(g, cell)
 		data.LightsOn = data.GridLit
 		if data.LightsOn && cell.Discovered {
 			data.Lighted = true
@@ -101,7 +112,7 @@ func RefreshHeadlampCone(g *state.Game) {
 // roomLightsEnabled reports whether the room's lights circuit allows illumination.
 // Corridors and unnamed cells light directly from live conduits (no toggle).
 func roomLightsEnabled(g *state.Game, cell *world.Cell) bool {
-	if cell.Name == "" || cell.Name == "Corridor" {
+	if cell.Name == "" || cell.IsCorridor {
 		return true
 	}
 	if g.RoomLightsPowered == nil {
@@ -136,6 +147,7 @@ func applyHeadlamp(g *state.Game) {
 			data := gameworld.GetGameData(cell)
 			data.LightsOn = true
 			data.Lighted = true
+			data.SeenViaCamera = false // in the player's own light now
 			cell.Discovered = true
 		}
 	}
@@ -165,18 +177,5 @@ func absInt(v int) int {
 
 // headlampReaches reports whether a short sight ray from center reaches target.
 func headlampReaches(grid *world.Grid, center, target *world.Cell, blocker world.SightBlocker) bool {
-	if center == target {
-		return true
-	}
-	endRow, endCol, ok := world.RayCastEndpoint(grid, center.Row, center.Col, target.Row, target.Col, blocker)
-	if !ok {
-		return false
-	}
-	if endRow == target.Row && endCol == target.Col {
-		return true
-	}
-	// Bresenham rays are asymmetric; accept the reverse ray too so the lamp lights
-	// diagonal corners consistently.
-	endRow, endCol, ok = world.RayCastEndpoint(grid, target.Row, target.Col, center.Row, center.Col, blocker)
-	return ok && endRow == center.Row && endCol == center.Col
+	return world.HasLineOfSight(grid, center, target, blocker)
 }
@@ -7,6 +7,7 @@ import (
 	"darkstation/pkg/engine/world"
 	"darkstation/pkg/game/devtools"
 	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/setup"
 	"darkstation/pkg/game/state"
 	gameworld "darkstation/pkg/game/world"
 )
@@ -158,6 +159,41 @@ func TestApplyHeadlamp_BackRadiusKeepsAdjacentGlow(t *testing.T) {
 	}
 }
 
+func TestApplyHeadlamp_DoesNotRevealSealedRoomThroughWallCorner(t *testing.T) {
+	// Two 2x2 rooms touching only at a wall corner: (1,1) and (2,2) are diagonal
+	// neighbours with walls at (1,2) and (2,1), and no door between them.
+	grid := world.NewGrid(5, 5)
+	for _, rc := range [][2]int{{0, 0}, {0, 1}, {1, 0}, {1, 1}} {
+		grid.MarkAsRoomWithName(rc[0], rc[1], "A", "desc")
+		gameworld.InitGameData(grid.GetCell(rc[0], rc[1]))
+	}
+	sealed := [][2]int{{2, 2}, {2, 3}, {3, 2}, {3, 3}}
+	for _, rc := range sealed {
+		grid.MarkAsRoomWithName(rc[0], rc[1], "B", "desc")
+		gameworld.InitGameData(grid.GetCell(rc[0], rc[1]))
+	}
+	grid.SetStartCellAt(0, 0)
+	grid.SetExitCellAt(3, 3)
+	grid.BuildAllCellConnections()
+
+	g := state.NewGame()
+	g.Grid = grid
+	g.CurrentCell = grid.GetCell(1, 1)
+	g.PlayerFacing = state.FaceSouth
+
+	applyHeadlamp(g)
+
+	if !grid.GetCell(0, 0).Discovered {
+		t.Error("cells of the player's own room should be lit by the headlamp")
+	}
+	for _, rc := range sealed {
+		cell := grid.GetCell(rc[0], rc[1])
+		if cell.Discovered || gameworld.GetGameData(cell).LightsOn {
+			t.Errorf("sealed room cell (%d,%d) should not be revealed through the wall corner", rc[0], rc[1])
+		}
+	}
+}
+
 func TestRefreshHeadlampCone_RestoresGridLitCells(t *testing.T) {
 	grid, g := makeLightingGrid()
 	g.Generators = nil
@@ -252,6 +288,38 @@ func TestUpdateLightingExploration_LiveConduitLightsRoom(t *testing.T) {
 	}
 }
 
+func TestUpdateLightingExploration_PartialGeneratorBrownoutRadius(t *testing.T) {
+	grid, g := makeLightingGrid()
+	g.Generators = nil
+	g.CurrentCell = grid.GetCell(0, 5) // right block: headlamp stays off the left one
+
+	genCell := grid.GetCell(5, 0)
+	gen := entities.NewGenerator("G-brownout", 3)
+	gen.InsertBatteries(1) // 33w of 100w
+	gameworld.GetGameData(genCell).Generator = gen
+	g.AddGenerator(gen)
+
+	near := grid.GetCell(2, 0) // three steps from the generator
+	far := grid.GetCell(1, 0)  // four steps
+	UpdateLightingExploration(g)
+
+	if g.PowerSupply != 33 {
+		t.Errorf("PowerSupply = %d, want 33 brownout watts", g.PowerSupply)
+	}
+	if !gameworld.GetGameData(near).LightsOn {
+		t.Error("cell within the brownout radius should be lit")
+	}
+	if gameworld.GetGameData(far).LightsOn {
+		t.Error("cell past the one-battery brownout radius should stay dark")
+	}
+
+	gen.InsertBatteries(1) // 66w: radius grows
+	UpdateLightingExploration(g)
+	if !gameworld.GetGameData(far).LightsOn {
+		t.Error("a second battery should widen the brownout pool")
+	}
+}
+
 func TestUpdateLightingExploration_RoomLightsToggleGatesConduitLighting(t *testing.T) {
 	grid, g := makeLightingGrid()
 	g.RoomLightsPowered = map[string]bool{"R": false}
@@ -330,3 +398,29 @@ func TestUpdateLightingExploration_entitiesGeneratorsPerfMapCompletesQuickly(t *
 		t.Fatalf("UpdateLightingExploration took %v, want under 2s on dense generator perf map", elapsed)
 	}
 }
+
+func TestUpdateLightingExploration_PowerLossLeavesLitCellRemembered(t *testing.T) {
+	grid, g := makeLightingGrid()
+	gen := entities.NewGenerator("G-grid", 1)
+	gen.InsertBatteriesAndStart(1)
+	gameworld.GetGameData(grid.GetCell(5, 0)).Generator = gen
+	g.Generators = []*entities.Generator{gen}
+
+	far := grid.GetCell(3, 1) // outside headlamp range, on the generator's conduit
+	far.Discovered = true
+	UpdateLightingExploration(g)
+	data := gameworld.GetGameData(far)
+	if !data.LightsOn || !data.Lighted {
+		t.Fatalf("powered: LightsOn=%v Lighted=%v, want live", data.LightsOn, data.Lighted)
+	}
+
+	gen.Trip()
+	setup.NotifyPowerGridChanged(g)
+	UpdateLightingExploration(g)
+	if data.LightsOn {
+		t.Error("cell should go dark once its generator trips")
+	}
+	if !data.Lighted || !far.Discovered {
+		t.Error("a cell seen lit must stay remembered after a blackout, not drop back to hidden")
+	}
+}
@@ -17,7 +17,7 @@ func TestCheckAdjacentPuzzlesAtCell_linkageGate(t *testing.T) {
 	}
 	grid.BuildAllCellConnections()
 
-	puz := entities.NewPuzzleTerminal("T", entities.PuzzleSequence, deck.MultiHopKeyedSequenceSolution, "hint", entities.RewardBattery, "desc")
+	puz := entities.NewPuzzleTerminal("T", entities.PuzzleSequence, "2-4-6-8", "hint", entities.RewardBattery, "desc")
 	puz.LinkageToken = deck.MultiHopLinkageToken
 	puzzleCell := grid.GetCell(3, 3)
 	playerCell := grid.GetCell(2, 3)
@@ -26,7 +26,7 @@ func TestCheckAdjacentPuzzlesAtCell_linkageGate(t *testing.T) {
 	g := state.NewGame()
 	g.Grid = grid
 	g.CurrentCell = playerCell
-	g.AddFoundCode(deck.MultiHopKeyedSequenceSolution)
+	g.AddFoundCode("2-4-6-8")
 
 	if !CheckAdjacentPuzzlesAtCell(g, puzzleCell) {
 		t.Fatal("expected interaction consumed")
@@ -0,0 +1,72 @@
+package gameplay
+
+import (
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	gamemenu "darkstation/pkg/game/menu"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// adjacentPickableDoor returns the first orthogonal neighbor, clockwise from the
+// player's facing, holding a locked door the player could pick but cannot open with a
+// keycard. Returns nil when there is none.
+func adjacentPickableDoor(g *state.Game) *world.Cell {
+	if g == nil || g.CurrentCell == nil {
+		return nil
+	}
+	for _, cell := range state.AdjacentCellsClockwiseFromFacing(g.CurrentCell, g.PlayerFacing) {
+		if cell == nil || !gameworld.HasLockedDoor(cell) {
+			continue
+		}
+		if door := gameworld.GetGameData(cell).Door; door.CanPick() && !g.CanUnlockDoor(door) {
+			return cell
+		}
+	}
+	return nil
+}
+
+// TryPickLock starts picking the lock of an adjacent pickable door (ActionPickLock, or
+// interact when nothing else is in reach). Returns false when no such door is adjacent.
+func TryPickLock(g *state.Game) bool {
+	cell := adjacentPickableDoor(g)
+	if cell == nil {
+		return false
+	}
+	FaceTowardAdjacentCell(g, cell)
+	if g.LockAlarm {
+		logMessage(g, "Security is on alert after the alarm; this lock will not give.")
+		return true
+	}
+	RunLockpickMenu(g, cell, gameworld.GetGameData(cell).Door)
+	return true
+}
+
+// RunLockpickMenu opens the lock-picking mini-game on a pickable door cell.
+func RunLockpickMenu(g *state.Game, cell *world.Cell, door *entities.Door) {
+	seed := entities.LockpickSeed(g.LevelSeed, cell.Row, cell.Col)
+	handler := gamemenu.NewLockpickMenuHandler(door, seed, func() {
+		pickLock(g, cell, door)
+	}, func() {
+		snapLockpick(g, cell, door)
+	})
+	gamemenu.RunMenuDynamic(g, handler)
+}
+
+// pickLock opens the one picked door cell; the room's other doors stay locked.
+func pickLock(g *state.Game, cell *world.Cell, door *entities.Door) {
+	door.Unlock()
+	g.InteractionsCount++
+	renderer.AddCallout(cell.Row, cell.Col, "Picked the lock on the "+door.DoorName()+"!", renderer.CalloutColorKeycard, 0)
+	renderer.PlaySound(renderer.SoundDoorUnlock)
+}
+
+// snapLockpick breaks the pick on a failed attempt: the door jams and the deck's alarm
+// goes up, so no lock on the deck can be picked for the rest of the visit.
+func snapLockpick(g *state.Game, cell *world.Cell, door *entities.Door) {
+	door.Pickable = false
+	g.LockAlarm = true
+	logMessage(g, "The pick snaps in the %s lock and an alarm sounds. You will need its keycard now.", renderer.StyledDoor(door.DoorName()))
+	renderer.AddCallout(cell.Row, cell.Col, "TITLE{Pick snapped}\nAlarm raised", renderer.CalloutColorDanger, 0)
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/debuglog"
 	"darkstation/pkg/game/entities"
 	"darkstation/pkg/game/renderer"
 	"darkstation/pkg/game/setup"
@@ -213,17 +214,21 @@ func completeGeneratorPowerUp(g *state.Game, cell *world.Cell) {
 	if !generatorNeedsLongUsePowerUp(gen) {
 		return
 	}
+	liveBefore := liveRoomNames(g)
 	if !gen.Restart() {
 		return
 	}
+	clearOverloadFaults(g, gen)
 	setup.NotifyPowerGridChanged(g)
 	setup.BootstrapPoweredGenerators(g, cell)
 	UpdateLightingExploration(g)
+	animateGeneratorPowerUp(g, cell, liveBefore)
 	renderer.AddDevicePulse(cell.Row, cell.Col)
 	renderer.AddCallout(cell.Row, cell.Col,
 		"POWERED{"+gen.Name+" - online}", renderer.CalloutColorGeneratorOn, 0)
 	logMessage(g, "ITEM{%s} is now powered!", gen.Name)
 	logMessage(g, "Power supply: %dw available", g.GetAvailablePower())
+	debuglog.Info("generator.restarted", "name", gen.Name, "row", cell.Row, "col", cell.Col, "available_w", g.GetAvailablePower())
 	ToggleGeneratorPowerGridOverlay(g, cell)
 }
 
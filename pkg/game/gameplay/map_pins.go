@@ -0,0 +1,65 @@
+package gameplay
+
+import (
+	"darkstation/pkg/engine/world"
+	gamemenu "darkstation/pkg/game/menu"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/state"
+)
+
+// MapPinTargetCell returns the cell a new pin goes on: the faced cell when it is a
+// discovered room cell, otherwise the player's own cell.
+func MapPinTargetCell(g *state.Game) *world.Cell {
+	if g == nil || g.CurrentCell == nil {
+		return nil
+	}
+	if faced := state.AdjacentCellsClockwiseFromFacing(g.CurrentCell, g.PlayerFacing)[0]; faced != nil && faced.Room && faced.Discovered {
+		return faced
+	}
+	return g.CurrentCell
+}
+
+// RunMapPinsMenu opens pin placement and the list of this deck's pins.
+func RunMapPinsMenu(g *state.Game) {
+	if g == nil || g.Grid == nil {
+		return
+	}
+	handler := gamemenu.NewMapPinsMenuHandler(g, MapPinTargetCell(g), func(row, col int) {
+		RouteToMapPin(g, row, col)
+	})
+	gamemenu.RunMenuDynamic(g, handler)
+}
+
+// RouteToMapPin traces the objective route to a pinned cell. Returns false when the pin
+// is gone or no route is known yet.
+func RouteToMapPin(g *state.Game, row, col int) bool {
+	if g == nil || g.Grid == nil || g.CurrentCell == nil {
+		return false
+	}
+	if _, ok := g.MapPinAt(row, col); !ok {
+		return false
+	}
+	g.ClearObjectiveRoute()
+	if route := routeTo(g, g.Grid.GetCell(row, col)); route != nil && len(route.Cells) > 0 {
+		g.ObjectiveRoute = route
+		return true
+	}
+	renderer.AddCallout(g.CurrentCell.Row, g.CurrentCell.Col, "SUBTLE{No route to that pin yet}", renderer.CalloutColorInfo, 3000)
+	return false
+}
+
+// ShowMapPinNotes shows the note of any pin under or next to the player.
+func ShowMapPinNotes(g *state.Game) {
+	if g == nil || g.CurrentCell == nil || len(g.MapPins) == 0 {
+		return
+	}
+	cells := append([]*world.Cell{g.CurrentCell}, g.CurrentCell.GetNeighbors()...)
+	for _, cell := range cells {
+		if cell == nil {
+			continue
+		}
+		if note, ok := g.MapPinAt(cell.Row, cell.Col); ok {
+			renderer.AddCallout(cell.Row, cell.Col, "SUBTLE{Pin:} "+note, renderer.CalloutColorInfo, 0)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/state"
+)
+
+func TestMapPinTargetCell_prefersDiscoveredFacedCell(t *testing.T) {
+	g := makeTestGame(3, 3)
+	g.CurrentCell = g.Grid.GetCell(1, 1)
+	g.PlayerFacing = state.FaceNorth
+
+	if got := MapPinTargetCell(g); got != g.CurrentCell {
+		t.Fatalf("undiscovered faced cell: target = %v, want player cell", got)
+	}
+	g.Grid.GetCell(0, 1).Discovered = true
+	if got := MapPinTargetCell(g); got != g.Grid.GetCell(0, 1) {
+		t.Errorf("discovered faced cell: target = %v, want (0,1)", got)
+	}
+}
+
+func TestRouteToMapPin_requiresPin(t *testing.T) {
+	g := makeTestGame(1, 4)
+	for c := 0; c < 4; c++ {
+		g.Grid.GetCell(0, c).Discovered = true
+	}
+	if RouteToMapPin(g, 0, 3) {
+		t.Fatal("routing to an unpinned cell should fail")
+	}
+	g.SetMapPin(0, 3, "locked vault")
+	if !RouteToMapPin(g, 0, 3) {
+		t.Fatal("expected a route to the pinned cell")
+	}
+	if route := g.ObjectiveRoute; route == nil || route.Cells[len(route.Cells)-1] != g.Grid.GetCell(0, 3) {
+		t.Errorf("route = %+v, want it to end on the pin", route)
+	}
+}
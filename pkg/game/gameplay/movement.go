@@ -4,10 +4,14 @@ package gameplay
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/zyedidia/generic/mapset"
 
+	engineinput "darkstation/pkg/engine/input"
 	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/debuglog"
 	"darkstation/pkg/game/entities"
 	"darkstation/pkg/game/features"
 	"darkstation/pkg/game/renderer"
@@ -25,8 +29,9 @@ func CanEnter(g *state.Game, r *world.Cell, logReason bool) (bool, *world.ItemSe
 	}
 
 	// Door checks: power first, then keycard. Keycard overrides unpowered locked doors;
-	// keycard-gated doors stay passable without power once unlocked.
-	if gameworld.HasDoor(r) {
+	// keycard-gated doors stay passable without power once unlocked. Creative runs
+	// ignore doors and hazards entirely.
+	if gameworld.HasDoor(r) && !g.Creative() {
 		rData := gameworld.GetGameData(r)
 		roomName := rData.Door.RoomName
 		unpowered := !setup.CellHasLivePower(g, r) && !manualEgressReleased(g, roomName)
@@ -84,7 +89,18 @@ func CanEnter(g *state.Game, r *world.Cell, logReason bool) (bool, *world.ItemSe
 		return false, &missingItems
 	}
 
-	if gameworld.HasBlockingRepairBlocker(r) {
+	// Check for a survivor waiting to be recruited (blocks movement until they follow)
+	if gameworld.HasWaitingSurvivor(r) {
+		return false, &missingItems
+	}
+
+	// Check for a patrol bot (blocks movement even while stunned or cooling down, so the
+	// player can never share its cell)
+	if gameworld.HasPatrol(r) {
+		return false, &missingItems
+	}
+
+	if gameworld.HasBlockingRepairBlocker(r) && !g.Creative() {
 		if logReason {
 			repair := gameworld.GetGameData(r).RepairBlocker
 			renderer.AddCallout(r.Row, r.Col, repairBlockerCallout(g, repair), renderer.CalloutColorHazard, 0)
@@ -93,7 +109,7 @@ func CanEnter(g *state.Game, r *world.Cell, logReason bool) (bool, *world.ItemSe
 	}
 
 	// Check for environmental hazard
-	if gameworld.HasBlockingHazard(r) {
+	if gameworld.HasBlockingHazard(r) && !g.Creative() {
 		hazard := gameworld.GetGameData(r).Hazard
 		if hazard.RequiresItem() {
 			// Check if player has the required item
@@ -113,6 +129,7 @@ func CanEnter(g *state.Game, r *world.Cell, logReason bool) (bool, *world.ItemSe
 				g.OwnedItems.Remove(fixItem)
 				info := entities.HazardTypes[hazard.Type]
 				logMessage(g, "%s", info.FixedMessage)
+				renderer.PlaySound(renderer.SoundHazardCleared)
 			} else {
 				if logReason {
 					// Show hazard description as 2-line callout: first line in hazard color, second line with hint in normal color
@@ -133,44 +150,117 @@ func CanEnter(g *state.Game, r *world.Cell, logReason bool) (bool, *world.ItemSe
 	}
 
 	// Check for exit lift readiness (only for exit cell)
-	if r.ExitCell && !setup.ExitLiftReady(g) {
-		if logReason {
-			switch setup.ExitLiftState(g) {
-			case state.ExitLiftLockedUnpowered:
-				exit := setup.ExitCell(g)
-				roomName := ""
-				if exit != nil {
-					roomName = exit.Name
-				}
-				if roomName != "" && g.RoomDoorsPowered != nil && g.RoomDoorsPowered[roomName] {
-					logMessage(g, "The lift has no routing power.")
-				} else {
-					logMessage(g, "The lift room has no door power.")
-				}
-			case state.ExitLiftLockedIncomplete:
-				numHazards := 0
-				g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
-					if gameworld.HasBlockingHazard(cell) {
-						numHazards++
-					}
-				})
-				repairs := g.IncompleteRepairCount()
-				if numHazards > 0 {
-					logMessage(g, "The lift requires all environmental hazards to be cleared!")
-					logMessage(g, "ACTION{%d} environmental hazard(s) remain.", numHazards)
-				}
-				if repairs > 0 {
-					logMessage(g, "The lift is locked until deck repairs are complete.")
-					logMessage(g, "ACTION{%d} repair objective(s) remain.", repairs)
-				}
+	if r.ExitCell {
+		gates := setup.ReadExitLiftGates(g)
+		if lift := gates.State(); lift != state.ExitLiftReady {
+			if logReason {
+				logExitLiftBlocked(g, gates, lift)
+				renderer.AddCallout(r.Row, r.Col, exitLiftMissingCallout(gates), renderer.CalloutColorInfo, 0)
 			}
+			return false, &missingItems
 		}
-		return false, &missingItems
 	}
 
 	return true, &missingItems
 }
 
+// CanEnterFrom is CanEnter for a step from one cell to an adjacent one. A diagonal step
+// is refused unless both cells it passes between could be walked onto, so it cannot cut
+// a wall corner or squeeze past a hazard, locked door, blocker or patrol.
+func CanEnterFrom(g *state.Game, from, to *world.Cell, logReason bool) (bool, *world.ItemSet) {
+	if diagonalCutsCorner(from, to) {
+		missingItems := mapset.New[*world.Item]()
+		return false, &missingItems
+	}
+	return CanEnter(g, to, logReason)
+}
+
+// diagonalCutsCorner reports whether to is a diagonal neighbor of from with either
+// orthogonal cell between them a wall or otherwise blocked to walking.
+func diagonalCutsCorner(from, to *world.Cell) bool {
+	dir, ok := from.DiagonalDirectionTo(to)
+	if !ok {
+		return false
+	}
+	vertical, horizontal, _ := dir.Orthogonals()
+	blocked := func(c *world.Cell) bool {
+		return c == nil || !c.Room || gameworld.BlocksWalking(c) || gameworld.HasPatrol(c)
+	}
+	return blocked(from.GetNeighbor(vertical)) || blocked(from.GetNeighbor(horizontal))
+}
+
+// diagonalNeighbor returns the cell a diagonal move action steps to.
+func diagonalNeighbor(cell *world.Cell, action engineinput.Action) *world.Cell {
+	switch action {
+	case engineinput.ActionMoveNE:
+		return cell.NE
+	case engineinput.ActionMoveNW:
+		return cell.NW
+	case engineinput.ActionMoveSE:
+		return cell.SE
+	case engineinput.ActionMoveSW:
+		return cell.SW
+	default:
+		return nil
+	}
+}
+
+// logExitLiftBlocked explains in the message log why the lift would not take the player.
+func logExitLiftBlocked(g *state.Game, gates setup.ExitLiftGates, lift state.ExitLiftState) {
+	switch lift {
+	case state.ExitLiftLockedUnpowered:
+		exit := setup.ExitCell(g)
+		roomName := ""
+		if exit != nil {
+			roomName = exit.Name
+		}
+		if roomName != "" && g.RoomDoorsPowered != nil && g.RoomDoorsPowered[roomName] {
+			logMessage(g, "The lift has no routing power.")
+		} else {
+			logMessage(g, "The lift room has no door power.")
+		}
+	case state.ExitLiftLockedIncomplete:
+		if gates.Hazards > 0 {
+			logMessage(g, "The lift requires all environmental hazards to be cleared!")
+			logMessage(g, "ACTION{%d} environmental hazard(s) remain.", gates.Hazards)
+		}
+		if gates.Repairs > 0 {
+			logMessage(g, "The lift is locked until deck repairs are complete.")
+			logMessage(g, "ACTION{%d} repair objective(s) remain.", gates.Repairs)
+		}
+		if gates.Survivor != "" {
+			logMessage(g, "The lift won't leave without ACTION{%s}.", gates.Survivor)
+		}
+	case state.ExitLiftLockedLowPower:
+		logMessage(g, "The lift motor needs ACTION{%dw} free on its grid; only ACTION{%dw} available.", gates.MotorWatts, max(gates.FreeWatts, 0))
+		logMessage(g, "Shed load at a maintenance terminal or bring another generator online.")
+	}
+}
+
+// exitLiftMissingCallout lists every gate still holding the lift, not just the first,
+// so a powered lift with hazards left reads as partly ready rather than unlocked.
+func exitLiftMissingCallout(gates setup.ExitLiftGates) string {
+	lines := []string{"UNPOWERED{Lift locked}"}
+	if gates.Powered {
+		lines[0] = "ACTION{Lift partly ready}"
+	} else {
+		lines = append(lines, "Needs: POWERED{power} in the lift room")
+	}
+	if gates.Hazards > 0 {
+		lines = append(lines, fmt.Sprintf("Needs: ACTION{%d} hazard(s) cleared", gates.Hazards))
+	}
+	if gates.Repairs > 0 {
+		lines = append(lines, fmt.Sprintf("Needs: ACTION{%d} repair(s) finished", gates.Repairs))
+	}
+	if gates.Survivor != "" {
+		lines = append(lines, fmt.Sprintf("Needs: ACTION{%s} escorted", gates.Survivor))
+	}
+	if gates.Powered && gates.MotorShort() {
+		lines = append(lines, fmt.Sprintf("Needs: ACTION{%dw} free for the motor SUBTLE{(%dw free)}", gates.MotorWatts, max(gates.FreeWatts, 0)))
+	}
+	return strings.Join(lines, "\n")
+}
+
 func showUnpoweredDoorCallout(g *state.Game, r *world.Cell, rData *gameworld.GameCellData, roomName string) {
 	msg := "UNPOWERED{Unpowered door}"
 	if g.RoomDoorsPowered[roomName] {
@@ -179,40 +269,88 @@ func showUnpoweredDoorCallout(g *state.Game, r *world.Cell, rData *gameworld.Gam
 	renderer.AddCallout(r.Row, r.Col, fmt.Sprintf("%s\n%s\nSUBTLE{Hold USE — manual egress release}", msg, rData.Door.DoorName()), renderer.CalloutColorDoor, 0)
 }
 
-// unlockDoorWithKeycard unlocks all doors for the keycard on r when the player has it.
-// Returns false when the door is locked and the player lacks the keycard.
+// unlockDoorWithKeycard unlocks all doors for the keycard on r when the player has it
+// (and, for vault doors, has found the access code). Returns false when the door is
+// locked and the player is missing either requirement.
 func unlockDoorWithKeycard(g *state.Game, r *world.Cell, rData *gameworld.GameCellData, logReason bool) bool {
 	keycardName := rData.Door.KeycardName()
 
-	if !g.HasKeycardNamed(keycardName) {
+	if !g.CanUnlockDoor(rData.Door) {
 		if logReason {
-			logMessage(g, "This door requires a %s", renderer.StyledKeycard(keycardName))
-			renderer.AddCallout(r.Row, r.Col, fmt.Sprintf("TITLE{Door Locked}\nNeeds: KEYCARD{%s}", keycardName), renderer.CalloutColorDoor, 0)
+			if rData.Door.IsVault() {
+				logMessage(g, "This vault door requires a %s and an access code", renderer.StyledKeycard(keycardName))
+			} else {
+				logMessage(g, "This door requires a %s", renderer.StyledKeycard(keycardName))
+			}
+			renderer.AddCallout(r.Row, r.Col, lockedDoorCallout(g, rData.Door), renderer.CalloutColorDoor, 0)
 		}
 		return false
 	}
 
+	// A master keycard opens every door to the room in one swipe, like the room's own card.
+	used := g.DoorKeycard(rData.Door)
 	doorsUnlocked := 0
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
 		cellData := gameworld.GetGameData(cell)
-		if gameworld.HasLockedDoor(cell) && cellData.Door.KeycardName() == keycardName {
+		if gameworld.HasLockedDoor(cell) && cellData.Door.KeycardName() == keycardName && g.CanUnlockDoor(cellData.Door) {
 			cellData.Door.Unlock()
 			doorsUnlocked++
 		}
 	})
+	if g.UseMasterKeycard(used, rData.Door) {
+		logMessage(g, "The KEYCARD{%s} is spent.", used)
+	}
 
 	var calloutMsg string
-	if doorsUnlocked > 1 {
-		calloutMsg = fmt.Sprintf("Used KEYCARD{%s} to unlock ACTION{%d} doors to ROOM{%s}!", keycardName, doorsUnlocked, rData.Door.RoomName)
+	if rData.Door.IsVault() {
+		calloutMsg = fmt.Sprintf("Used KEYCARD{%s} and the access code to open the vault in ROOM{%s}!", used, rData.Door.RoomName)
+	} else if doorsUnlocked > 1 {
+		calloutMsg = fmt.Sprintf("Used KEYCARD{%s} to unlock ACTION{%d} doors to ROOM{%s}!", used, doorsUnlocked, rData.Door.RoomName)
 	} else {
-		calloutMsg = fmt.Sprintf("Used KEYCARD{%s} to unlock the %s!", keycardName, rData.Door.DoorName())
+		calloutMsg = fmt.Sprintf("Used KEYCARD{%s} to unlock the %s!", used, rData.Door.DoorName())
 	}
 	renderer.AddCallout(r.Row, r.Col, calloutMsg, renderer.CalloutColorKeycard, 0)
+	renderer.PlaySound(renderer.SoundDoorUnlock)
 	return true
 }
 
+// lockedDoorCallout describes what a locked door needs. Vault doors list both the
+// keycard and the access code, marking which of the two the player already has.
+func lockedDoorCallout(g *state.Game, door *entities.Door) string {
+	keycardName := door.KeycardName()
+	if !door.IsVault() {
+		if door.CanPick() && !g.LockAlarm {
+			return fmt.Sprintf("TITLE{Door Locked}\nNeeds: KEYCARD{%s}\nSUBTLE{%s}", keycardName, engineinput.HintPickLock())
+		}
+		return fmt.Sprintf("TITLE{Door Locked}\nNeeds: KEYCARD{%s}", keycardName)
+	}
+	keycardStatus := "SUBTLE{missing}"
+	if g.DoorKeycard(door) != "" {
+		keycardStatus = "POWERED{held}"
+	}
+	codeStatus := "SUBTLE{not found}"
+	if g.HasFoundCode(door.RequiresCode) {
+		codeStatus = "POWERED{found}"
+	}
+	return fmt.Sprintf("TITLE{Vault Door Locked}\nNeeds: KEYCARD{%s} %s\nNeeds: ACTION{access code} %s", keycardName, keycardStatus, codeStatus)
+}
+
+// riskyMoveHeldMs separates a held movement key from a fresh press when confirming a
+// risky move. Repeats arrive faster than this and keep the move held back; a new press
+// after a pause commits it.
+const riskyMoveHeldMs = 250
+
+// blockedMoveQuietMs is how long after a refused move repeated attempts at the same cell
+// only animate. Each attempt restarts the window, so a held key (or gamepad repeat) never
+// re-logs; releasing for longer than this explains the block again.
+const blockedMoveQuietMs = 600
+
 // MoveCell moves the player to a new cell
 func MoveCell(g *state.Game, requestedCell *world.Cell) {
+	moveCell(g, requestedCell, time.Now().UnixMilli())
+}
+
+func moveCell(g *state.Game, requestedCell *world.Cell, nowMs int64) {
 	// Determine direction for debounce animation and facing
 	var direction string
 	if g.CurrentCell != nil {
@@ -224,6 +362,8 @@ func MoveCell(g *state.Game, requestedCell *world.Cell) {
 			direction = "east"
 		} else if requestedCell == g.CurrentCell.West {
 			direction = "west"
+		} else if dir, ok := g.CurrentCell.DiagonalDirectionTo(requestedCell); ok {
+			direction = strings.ToLower(dir.String())
 		}
 	}
 	turned := false
@@ -233,12 +373,27 @@ func MoveCell(g *state.Game, requestedCell *world.Cell) {
 		turned = g.PlayerFacing != facingBefore
 	}
 
-	if res, _ := CanEnter(g, requestedCell, true); res {
+	quiet := repeatedBlockedMove(g, requestedCell, nowMs)
+	if res, _ := CanEnterFrom(g, g.CurrentCell, requestedCell, !quiet); res {
+		g.BlockedMoveRow, g.BlockedMoveCol, g.BlockedMoveAtMs = -1, -1, 0
+		if holdRiskyMove(g, requestedCell, nowMs) {
+			return
+		}
+		if ambientWadeConsumesMove(g) {
+			// Flooded floor: this step is spent wading; the next one goes through.
+			if direction != "" {
+				renderer.SetDebounceAnimation(direction)
+			}
+			return
+		}
 		if g.CurrentCell != nil &&
 			(g.CurrentCell.Row != requestedCell.Row || g.CurrentCell.Col != requestedCell.Col) {
 			g.MovementCount++
 		}
+		prior := g.CurrentCell
 		landPlayerOnCell(g, requestedCell)
+		revealEnteredRoom(g, prior, requestedCell)
+		followSurvivor(g, prior)
 	} else {
 		// Movement failed - trigger debounce animation
 		if direction != "" {
@@ -248,7 +403,66 @@ func MoveCell(g *state.Game, requestedCell *world.Cell) {
 			// The player turned in place: swing the headlamp cone.
 			RefreshHeadlampCone(g)
 		}
+		if requestedCell != nil {
+			g.BlockedMoveRow, g.BlockedMoveCol, g.BlockedMoveAtMs = requestedCell.Row, requestedCell.Col, nowMs
+		}
+		if !quiet {
+			announceIfStuck(g)
+		}
+	}
+}
+
+// revealEnteredRoom discovers every cell of a named room when the player steps into it from
+// elsewhere and the "reveal room on entry" setting is on. Corridors keep revealing by FOV.
+func revealEnteredRoom(g *state.Game, prior, cell *world.Cell) {
+	if !config.Current().RevealRoomOnEntry || cell.IsCorridor || cell.Name == "" {
+		return
 	}
+	if prior != nil && prior.Name == cell.Name {
+		return
+	}
+	revealRoomByName(g.Grid, cell.Name)
+}
+
+// isRiskyMove reports whether stepping onto cell would walk the player into a hazard
+// they are not already standing in. Moving around inside the same hazard is not risky.
+func isRiskyMove(g *state.Game, cell *world.Cell) bool {
+	hazard := gameworld.AmbientHazardAt(cell)
+	return hazard != nil && hazard != gameworld.AmbientHazardAt(g.CurrentCell)
+}
+
+// holdRiskyMove holds back a move onto risky floor while the "confirm risky moves"
+// setting is on. The first attempt warns with a callout; moving onto the same cell again
+// after releasing the key commits. Key repeats from a held key stay held back.
+func holdRiskyMove(g *state.Game, cell *world.Cell, nowMs int64) bool {
+	if !config.Current().ConfirmRiskyMoves || !isRiskyMove(g, cell) {
+		return false
+	}
+	pending := cell.Row == g.RiskyMoveRow && cell.Col == g.RiskyMoveCol
+	if pending && nowMs-g.RiskyMoveAtMs >= riskyMoveHeldMs {
+		return false
+	}
+	g.RiskyMoveRow, g.RiskyMoveCol, g.RiskyMoveAtMs = cell.Row, cell.Col, nowMs
+	if !pending {
+		hazard := gameworld.AmbientHazardAt(cell)
+		renderer.AddCallout(cell.Row, cell.Col,
+			fmt.Sprintf("HAZARD{%s}\nSUBTLE{%s}\nSUBTLE{Move again to enter}", hazard.Name, entities.HazardTypes[hazard.Type].EntryMessage),
+			renderer.CalloutColorWarning, 0)
+	}
+	return true
+}
+
+// riskyMovePending reports whether a move onto cell is waiting for confirmation.
+func riskyMovePending(g *state.Game, cell *world.Cell) bool {
+	return cell != nil && cell.Row == g.RiskyMoveRow && cell.Col == g.RiskyMoveCol
+}
+
+// repeatedBlockedMove reports whether cell is the one the last refused move targeted,
+// recently enough that the reason has already been shown.
+func repeatedBlockedMove(g *state.Game, cell *world.Cell, nowMs int64) bool {
+	return cell != nil && g.BlockedMoveAtMs > 0 &&
+		cell.Row == g.BlockedMoveRow && cell.Col == g.BlockedMoveCol &&
+		nowMs-g.BlockedMoveAtMs < blockedMoveQuietMs
 }
 
 // TeleportPlayerTo places the player on a cell without movement checks (lift routing, etc.).
@@ -267,13 +481,16 @@ func landPlayerOnCell(g *state.Game, cell *world.Cell) {
 	cellData := gameworld.GetGameData(cell)
 	cellData.LightsOn = true
 	cellData.Lighted = true
-	world.RevealFOVDefault(g.Grid, cell, unpoweredDoorSightBlocker(g))
+	cellData.SeenViaCamera = false
+	revealPlayerFOV(g, cell)
 	UpdateLightingExploration(g)
+	g.RiskyMoveRow, g.RiskyMoveCol = -1, -1
 	if g.CurrentCell == nil || g.CurrentCell.Row != cell.Row || g.CurrentCell.Col != cell.Col {
 		g.LastInteractedRow = -1
 		g.LastInteractedCol = -1
 		g.InteractionPlayerRow = cell.Row
 		g.InteractionPlayerCol = cell.Col
+		g.ClearInteractCycleNext()
 		ClearGeneratorPowerGridOverlay(g)
 	}
 	g.CurrentCell = cell
@@ -283,6 +500,7 @@ func landPlayerOnCell(g *state.Game, cell *world.Cell) {
 	}
 	maybeAnnounceObservationCueOnMove(g, cell)
 	maybeAnnounceLinkageCueOnMove(g, cell)
+	ShowMapPinNotes(g)
 	if features.VisitedSystemEnabled() {
 		noteLinkageTagFromVisitedCell(g, cell)
 	}
@@ -298,6 +516,11 @@ func setPlayerFacingFromDirection(g *state.Game, direction string) {
 		g.PlayerFacing = state.FaceEast
 	case "west":
 		g.PlayerFacing = state.FaceWest
+	case "northeast", "northwest":
+		// The headlamp has four facings; diagonal steps keep it pointing up or down the map.
+		g.PlayerFacing = state.FaceNorth
+	case "southeast", "southwest":
+		g.PlayerFacing = state.FaceSouth
 	}
 }
 
@@ -402,5 +625,6 @@ func hazardControlSeen(g *state.Game, hazard *entities.Hazard) bool {
 // logMessage adds a formatted message to the game's message log
 func logMessage(g *state.Game, msg string, a ...any) {
 	formatted := renderer.ApplyMarkup(msg, a...)
+	debuglog.Debug("message", "text", formatted)
 	g.AddMessage(formatted)
 }
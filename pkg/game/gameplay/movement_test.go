@@ -2,12 +2,14 @@
 package gameplay
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/zyedidia/generic/mapset"
 
 	engineinput "darkstation/pkg/engine/input"
 	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/config"
 	"darkstation/pkg/game/entities"
 	"darkstation/pkg/game/setup"
 	"darkstation/pkg/game/state"
@@ -213,6 +215,153 @@ func TestCanEnter_KeycardUnlockedDoorAllowsPassageWhenUnpowered(t *testing.T) {
 	}
 }
 
+func TestCanEnter_VaultDoorNeedsKeycardAndCode(t *testing.T) {
+	g, _, doorCell := makeMinimalGameWithGrid(t)
+	door := entities.NewDoor("Vault")
+	door.RequiresCode = "4-1-7-3"
+	gameworld.GetGameData(doorCell).Door = door
+	g.RoomDoorsPowered["Vault"] = true
+	g.OwnedItems.Put(world.NewItem("Vault Keycard"))
+
+	if ok, _ := CanEnter(g, doorCell, false); ok {
+		t.Fatal("vault door should stay shut with only the keycard")
+	}
+	if !door.Locked {
+		t.Fatal("vault door should remain locked until the code is found")
+	}
+
+	g.AddFoundCode("4-1-7-3")
+	if ok, _ := CanEnter(g, doorCell, false); !ok {
+		t.Fatal("vault door should open with keycard and code")
+	}
+	if door.Locked {
+		t.Fatal("vault door should unlock once both requirements are met")
+	}
+}
+
+func TestCanEnter_MasterKeycardOpensLowerTierDoor(t *testing.T) {
+	g, _, doorCell := makeMinimalGameWithGrid(t)
+	door := entities.NewDoor("Lab")
+	gameworld.GetGameData(doorCell).Door = door
+	g.RoomDoorsPowered["Lab"] = true
+	red := entities.MasterKeycardName(entities.KeycardTierRed)
+	g.AddRunKeycard(world.NewItem(red))
+
+	if ok, _ := CanEnter(g, doorCell, false); !ok {
+		t.Fatal("a Red master keycard should open a Green door")
+	}
+	if door.Locked {
+		t.Fatal("door should be unlocked by the master keycard")
+	}
+	if !g.HasKeycardNamed(red) {
+		t.Fatal("a higher-tier master keycard should not be consumed")
+	}
+}
+
+func TestCanEnter_ExactTierMasterKeycardIsConsumed(t *testing.T) {
+	g, _, doorCell := makeMinimalGameWithGrid(t)
+	door := entities.NewDoor("Lab")
+	door.Tier = entities.KeycardTierBlue
+	gameworld.GetGameData(doorCell).Door = door
+	g.RoomDoorsPowered["Lab"] = true
+	blue := entities.MasterKeycardName(entities.KeycardTierBlue)
+	g.AddRunKeycard(world.NewItem(blue))
+
+	if ok, _ := CanEnter(g, doorCell, false); !ok {
+		t.Fatal("a Blue master keycard should open a Blue door")
+	}
+	if g.HasKeycardNamed(blue) {
+		t.Fatal("an exact-tier master keycard should be used up")
+	}
+}
+
+func TestPickLock_opensOnlyThePickedDoorCell(t *testing.T) {
+	g, left, doorCell := makeMinimalGameWithGrid(t)
+	g.CurrentCell = left
+	door := entities.NewDoor("Lab")
+	door.Pickable = true
+	door.PickDifficulty = 3
+	other := entities.NewDoor("Lab")
+	gameworld.GetGameData(doorCell).Door = door
+
+	if got := adjacentPickableDoor(g); got != doorCell {
+		t.Fatalf("adjacentPickableDoor = %v, want the door cell", got)
+	}
+	pickLock(g, doorCell, door)
+	if door.Locked {
+		t.Fatal("picked door should be unlocked")
+	}
+	if !other.Locked {
+		t.Fatal("picking one cell must not unlock the room's other doors")
+	}
+	if adjacentPickableDoor(g) != nil {
+		t.Fatal("an unlocked door has no lock to pick")
+	}
+}
+
+func TestPickLock_snapRaisesAlarm(t *testing.T) {
+	g, left, doorCell := makeMinimalGameWithGrid(t)
+	g.CurrentCell = left
+	door := entities.NewDoor("Lab")
+	door.Pickable = true
+	gameworld.GetGameData(doorCell).Door = door
+
+	snapLockpick(g, doorCell, door)
+	if !g.LockAlarm {
+		t.Fatal("a snapped pick should raise the alarm")
+	}
+	if !door.Locked || door.CanPick() {
+		t.Fatal("a snapped lock stays locked and can no longer be picked")
+	}
+	if TryPickLock(g) {
+		t.Fatal("no pickable door should remain next to the player")
+	}
+}
+
+func TestPickLock_notOfferedWhenKeycardHeld(t *testing.T) {
+	g, left, doorCell := makeMinimalGameWithGrid(t)
+	g.CurrentCell = left
+	door := entities.NewDoor("Lab")
+	door.Pickable = true
+	gameworld.GetGameData(doorCell).Door = door
+	g.AddRunKeycard(world.NewItem("Lab Keycard"))
+
+	if adjacentPickableDoor(g) != nil {
+		t.Fatal("the keycard is the way in; picking should not be offered")
+	}
+}
+
+func TestLockedDoorCallout_VaultListsBothRequirements(t *testing.T) {
+	g := state.NewGame()
+	door := entities.NewDoor("Vault")
+	door.RequiresCode = "4-1-7-3"
+	g.OwnedItems.Put(world.NewItem("Vault Keycard"))
+
+	got := lockedDoorCallout(g, door)
+	want := "TITLE{Vault Door Locked}\nNeeds: KEYCARD{Vault Keycard} POWERED{held}\nNeeds: ACTION{access code} SUBTLE{not found}"
+	if got != want {
+		t.Errorf("callout = %q, want %q", got, want)
+	}
+	if strings.Contains(got, door.RequiresCode) {
+		t.Error("callout must not reveal the access code")
+	}
+}
+
+func TestExitLiftMissingCallout_listsEveryGate(t *testing.T) {
+	gates := setup.ExitLiftGates{Powered: true, Hazards: 2, Survivor: "Dr. Okafor", FreeWatts: 10, MotorWatts: 30}
+
+	got := exitLiftMissingCallout(gates)
+	want := "ACTION{Lift partly ready}\nNeeds: ACTION{2} hazard(s) cleared\nNeeds: ACTION{Dr. Okafor} escorted\nNeeds: ACTION{30w} free for the motor SUBTLE{(10w free)}"
+	if got != want {
+		t.Errorf("callout = %q, want %q", got, want)
+	}
+
+	gates = setup.ExitLiftGates{Repairs: 1}
+	if got := exitLiftMissingCallout(gates); !strings.HasPrefix(got, "UNPOWERED{Lift locked}\nNeeds: POWERED{power}") || !strings.Contains(got, "ACTION{1} repair(s)") {
+		t.Errorf("unpowered callout = %q, want power first, then the repair", got)
+	}
+}
+
 func TestCanEnter_UnpoweredNonKeycardDoorStillBlocks(t *testing.T) {
 	g, _, doorCell := makeMinimalGameWithGrid(t)
 	gameworld.GetGameData(doorCell).Door = entities.NewUnlockedDoor("Hall")
@@ -312,6 +461,43 @@ func TestMoveCell_UpdatesFacingOnMove(t *testing.T) {
 	}
 }
 
+func TestMoveCell_throttlesRepeatedBlockedMessages(t *testing.T) {
+	tests := []struct {
+		name     string
+		gapsMs   []int64 // delay before each attempt after the first
+		wantLogs int
+	}{
+		{"single attempt", nil, 1},
+		{"held key stays quiet", []int64{140, 140, 140, 140}, 1},
+		{"held past the window stays quiet", []int64{400, 400, 400}, 1},
+		{"release and retry logs again", []int64{140, blockedMoveQuietMs}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, left, right := makeMinimalGameWithGrid(t)
+			gameworld.GetGameData(right).Door = &entities.Door{RoomName: "Vault", Locked: true}
+			g.CurrentCell = left
+
+			now := int64(10_000)
+			moveCell(g, right, now)
+			for _, gap := range tt.gapsMs {
+				now += gap
+				moveCell(g, right, now)
+			}
+
+			logs := 0
+			for _, m := range g.Messages {
+				if strings.Contains(m.Text, "This door requires") {
+					logs++
+				}
+			}
+			if logs != tt.wantLogs {
+				t.Errorf("locked-door messages = %d, want %d", logs, tt.wantLogs)
+			}
+		})
+	}
+}
+
 func TestMoveCell_UpdatesFacingOnBlockedMove(t *testing.T) {
 	g, left, right := makeMinimalGameWithGrid(t)
 	data := gameworld.GetGameData(right)
@@ -328,3 +514,145 @@ func TestMoveCell_UpdatesFacingOnBlockedMove(t *testing.T) {
 		t.Errorf("PlayerFacing = %v, want FaceEast after attempting to move east", g.PlayerFacing)
 	}
 }
+
+func TestMoveCell_revealRoomOnEntry(t *testing.T) {
+	// Corridor (0,0)-(0,1) leads into Lab at (0,2); the rest of Lab sits behind a wall row.
+	build := func(on bool) (*state.Game, *world.Cell) {
+		t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+		cfg := config.DefaultConfig()
+		cfg.RevealRoomOnEntry = on
+		config.SetCurrent(cfg)
+		t.Cleanup(func() { config.SetCurrent(nil) })
+
+		g := state.NewGame()
+		grid := world.NewGrid(3, 3)
+		grid.MarkAsCorridor(0, 0, "")
+		grid.MarkAsCorridor(0, 1, "")
+		grid.MarkAsRoomWithName(0, 2, "Lab", "")
+		for c := 0; c < 3; c++ {
+			grid.MarkAsRoomWithName(2, c, "Lab", "")
+		}
+		grid.BuildAllCellConnections()
+		grid.ForEachCell(func(row, col int, cell *world.Cell) { gameworld.InitGameData(cell) })
+		g.Grid = grid
+		g.CurrentCell = grid.GetCell(0, 0)
+		return g, grid.GetCell(2, 0)
+	}
+
+	g, hidden := build(false)
+	MoveCell(g, g.Grid.GetCell(0, 1))
+	MoveCell(g, g.Grid.GetCell(0, 2))
+	if hidden.Discovered {
+		t.Fatal("with the setting off, cells out of sight should stay undiscovered")
+	}
+
+	g, hidden = build(true)
+	MoveCell(g, g.Grid.GetCell(0, 1))
+	if hidden.Discovered {
+		t.Fatal("walking a corridor should not reveal the room ahead")
+	}
+	MoveCell(g, g.Grid.GetCell(0, 2))
+	if g.CurrentCell.Name != "Lab" || !hidden.Discovered {
+		t.Fatal("entering Lab should discover all of its cells")
+	}
+}
+
+func TestMoveCell_confirmRiskyMoves(t *testing.T) {
+	// Corridor (0,0) leads into Reactor (0,1)-(0,2), which is bleeding power.
+	build := func(on bool) *state.Game {
+		t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+		cfg := config.DefaultConfig()
+		cfg.ConfirmRiskyMoves = on
+		config.SetCurrent(cfg)
+		t.Cleanup(func() { config.SetCurrent(nil) })
+
+		g := state.NewGame()
+		grid := world.NewGrid(1, 3)
+		grid.MarkAsCorridor(0, 0, "")
+		grid.MarkAsRoomWithName(0, 1, "Reactor", "")
+		grid.MarkAsRoomWithName(0, 2, "Reactor", "")
+		grid.BuildAllCellConnections()
+		grid.ForEachCell(func(row, col int, cell *world.Cell) { gameworld.InitGameData(cell) })
+		hazard := entities.NewHazard(entities.HazardPowerBleed)
+		gameworld.GetGameData(grid.GetCell(0, 1)).AmbientHazard = hazard
+		gameworld.GetGameData(grid.GetCell(0, 2)).AmbientHazard = hazard
+		g.Grid = grid
+		g.CurrentCell = grid.GetCell(0, 0)
+		return g
+	}
+
+	g := build(false)
+	moveCell(g, g.Grid.GetCell(0, 1), 1000)
+	if g.CurrentCell != g.Grid.GetCell(0, 1) {
+		t.Fatal("with the setting off, moving onto a hazard should not be held back")
+	}
+
+	g = build(true)
+	risky := g.Grid.GetCell(0, 1)
+	moveCell(g, risky, 1000)
+	if g.CurrentCell == risky {
+		t.Fatal("the first move onto a hazard should be held back")
+	}
+	moveCell(g, risky, 1000+riskyMoveHeldMs/2)
+	if g.CurrentCell == risky {
+		t.Fatal("a key repeat should not confirm the risky move")
+	}
+	moveCell(g, risky, 1000+riskyMoveHeldMs/2+riskyMoveHeldMs)
+	if g.CurrentCell != risky {
+		t.Fatal("moving again after a pause should enter the hazard")
+	}
+	moveCell(g, g.Grid.GetCell(0, 2), 5000)
+	if g.CurrentCell != g.Grid.GetCell(0, 2) {
+		t.Fatal("moving within the same hazard should not be held back")
+	}
+}
+
+func TestProcessIntent_diagonalMovement(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	cfg := config.DefaultConfig()
+	config.SetCurrent(cfg)
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	g := makeTestGame(4, 4)
+	g.Grid.GetCell(1, 2).Room = false
+	g.Grid.GetCell(2, 1).Room = false
+	moveSE := engineinput.Intent{Action: engineinput.ActionMoveSE}
+
+	ProcessIntent(g, moveSE)
+	if g.CurrentCell != g.Grid.GetCell(0, 0) {
+		t.Fatal("diagonal moves should be ignored while the setting is off")
+	}
+
+	cfg.DiagonalMovement = true
+	ProcessIntent(g, moveSE)
+	if g.CurrentCell != g.Grid.GetCell(1, 1) {
+		t.Fatalf("player at (%d,%d), want (1,1)", g.CurrentCell.Row, g.CurrentCell.Col)
+	}
+	if g.PlayerFacing != state.FaceSouth {
+		t.Errorf("facing = %v, want south after a south-east step", g.PlayerFacing)
+	}
+
+	ProcessIntent(g, moveSE)
+	if g.CurrentCell != g.Grid.GetCell(1, 1) {
+		t.Fatal("a diagonal step between two walls should be refused")
+	}
+
+	g.Grid.GetCell(1, 2).Room = true
+	ProcessIntent(g, moveSE)
+	if g.CurrentCell != g.Grid.GetCell(1, 1) {
+		t.Fatal("a diagonal step past one wall should be refused")
+	}
+
+	g.Grid.GetCell(2, 1).Room = true
+	gameworld.GetGameData(g.Grid.GetCell(2, 1)).Hazard = entities.NewHazard(entities.HazardVacuum)
+	ProcessIntent(g, moveSE)
+	if g.CurrentCell != g.Grid.GetCell(1, 1) {
+		t.Fatal("a diagonal step past a blocking hazard should be refused")
+	}
+
+	gameworld.GetGameData(g.Grid.GetCell(2, 1)).Hazard = nil
+	ProcessIntent(g, moveSE)
+	if g.CurrentCell != g.Grid.GetCell(2, 2) {
+		t.Fatal("a diagonal step with both sides open should go through")
+	}
+}
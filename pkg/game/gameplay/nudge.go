@@ -0,0 +1,158 @@
+package gameplay
+
+import (
+	"math/rand"
+	"time"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/gamemode"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/setup"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// Stuck nudge thresholds: the player must have both moved this much and waited this
+// long since the last objective progress. Easy nudges sooner; Hard never nudges.
+const (
+	stuckNudgeMoves         = 80
+	stuckNudgeMovesEasy     = 40
+	stuckNudgeDelayMs       = 90_000
+	stuckNudgeDelayMsEasy   = 45_000
+	stuckNudgeCalloutMs     = 6000
+	stuckNudgeCalloutPrefix = "SUBTLE{Stuck?} "
+)
+
+// UpdateStuckNudge resets the stuck timer whenever the player makes objective progress
+// (new room, generator online, hazard cleared, item picked up) and, after a long stretch
+// without any, traces a route to the most relevant objective once.
+func UpdateStuckNudge(g *state.Game) {
+	updateStuckNudge(g, time.Now().UnixMilli())
+}
+
+func updateStuckNudge(g *state.Game, nowMs int64) {
+	if g == nil || g.Grid == nil || g.CurrentCell == nil {
+		return
+	}
+	sig := progressSignature(g)
+	if g.Progress.AtMs == 0 || sig != g.Progress.Signature {
+		g.Progress = state.ProgressTracker{Signature: sig, Moves: g.MovementCount, AtMs: nowMs}
+		return
+	}
+	moves, delayMs, ok := stuckNudgeThresholds(g)
+	if !ok || g.Progress.Nudged {
+		return
+	}
+	if g.MovementCount-g.Progress.Moves < moves || nowMs-g.Progress.AtMs < delayMs {
+		return
+	}
+	g.Progress.Nudged = true
+	showStuckNudge(g)
+}
+
+// stuckNudgeThresholds returns the moves and delay before a nudge, or false when
+// nudges are off (hint setting disabled or Hard difficulty).
+func stuckNudgeThresholds(g *state.Game) (int, int64, bool) {
+	if !config.Current().HintsEnabled {
+		return 0, 0, false
+	}
+	switch g.Mode().Difficulty {
+	case gamemode.DifficultyHard:
+		return 0, 0, false
+	case gamemode.DifficultyEasy:
+		return stuckNudgeMovesEasy, stuckNudgeDelayMsEasy, true
+	default:
+		return stuckNudgeMoves, stuckNudgeDelayMs, true
+	}
+}
+
+// progressSignature snapshots the counters that only move when the player advances.
+func progressSignature(g *state.Game) state.ProgressSignature {
+	sig := state.ProgressSignature{DeckID: g.CurrentDeckID, ItemsHeld: g.Batteries + g.OwnedItems.Size()}
+	for _, gen := range g.Generators {
+		if gen != nil && gen.IsPowered() {
+			sig.GeneratorsOnline++
+		}
+	}
+	rooms := make(map[string]bool)
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell.Visited && cell.Room && !cell.IsCorridor {
+			rooms[cell.Name] = true
+		}
+		if gameworld.HasBlockingHazard(cell) {
+			sig.HazardsBlocking++
+		}
+	})
+	sig.RoomsVisited = len(rooms)
+	return sig
+}
+
+// showStuckNudge points at the nearest reachable objective, then at a keycard for a
+// locked door, and otherwise falls back to a general deck hint.
+func showStuckNudge(g *state.Game) {
+	row, col := g.CurrentCell.Row, g.CurrentCell.Col
+	for _, targets := range objectiveTargetTiers(g) {
+		if route := shortestObjectiveRoute(g, targets); route != nil {
+			g.ObjectiveRoute = route
+			renderer.AddCallout(row, col, stuckNudgeCalloutPrefix+nudgeTargetLabel(g, route.Target), renderer.CalloutColorInfo, stuckNudgeCalloutMs)
+			return
+		}
+	}
+	if route, name := neededKeycardRoute(g); route != nil {
+		g.ObjectiveRoute = route
+		renderer.AddCallout(row, col, stuckNudgeCalloutPrefix+"Find the KEYCARD{"+name+"}", renderer.CalloutColorKeycard, stuckNudgeCalloutMs)
+		return
+	}
+	if len(g.Hints) > 0 {
+		logMessage(g, "%s", g.Hints[rand.Intn(len(g.Hints))])
+		renderer.AddCallout(row, col, stuckNudgeCalloutPrefix+"Check the message log", renderer.CalloutColorInfo, stuckNudgeCalloutMs)
+	}
+}
+
+// nudgeTargetLabel names an objective cell for the nudge callout.
+func nudgeTargetLabel(g *state.Game, cell *world.Cell) string {
+	switch {
+	case unpoweredGeneratorAt(cell):
+		return "Power the generator"
+	case gameworld.HasBlockingHazard(cell):
+		return "Clear the HAZARD{" + gameworld.GetGameData(cell).Hazard.Name + "}"
+	case incompleteRepairAt(cell):
+		return "Finish the repair"
+	case cell == setup.ExitCell(g):
+		return "Head for the lift"
+	}
+	return "Follow the route"
+}
+
+// neededKeycardRoute routes to the nearest discovered keycard on the floor that opens a
+// discovered locked door the player cannot open yet.
+func neededKeycardRoute(g *state.Game) (*state.ObjectiveRoute, string) {
+	needed := make(map[string]bool)
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell.Discovered && gameworld.HasLockedDoor(cell) {
+			if door := gameworld.GetGameData(cell).Door; g.DoorKeycard(door) == "" {
+				needed[door.KeycardName()] = true
+			}
+		}
+	})
+	if len(needed) == 0 {
+		return nil, ""
+	}
+	var best *state.ObjectiveRoute
+	bestName := ""
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if !cell.Discovered {
+			return
+		}
+		cell.ItemsOnFloor.Each(func(item *world.Item) {
+			if item == nil || !needed[item.Name] {
+				return
+			}
+			if route := routeTo(g, cell); route != nil && (best == nil || len(route.Cells) < len(best.Cells)) {
+				best, bestName = route, item.Name
+			}
+		})
+	})
+	return best, bestName
+}
@@ -0,0 +1,111 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/gamemode"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func TestUpdateStuckNudge(t *testing.T) {
+	tests := []struct {
+		name       string
+		difficulty gamemode.Difficulty
+		hintsOff   bool
+		moves      int
+		waitMs     int64
+		progress   bool
+		want       bool
+	}{
+		{"normal after threshold", gamemode.DifficultyNormal, false, stuckNudgeMoves, stuckNudgeDelayMs, false, true},
+		{"normal too soon", gamemode.DifficultyNormal, false, stuckNudgeMoves, stuckNudgeDelayMs - 1, false, false},
+		{"normal too few moves", gamemode.DifficultyNormal, false, stuckNudgeMovesEasy, stuckNudgeDelayMs, false, false},
+		{"easy nudges sooner", gamemode.DifficultyEasy, false, stuckNudgeMovesEasy, stuckNudgeDelayMsEasy, false, true},
+		{"hard never nudges", gamemode.DifficultyHard, false, stuckNudgeMoves * 10, stuckNudgeDelayMs * 10, false, false},
+		{"hints disabled", gamemode.DifficultyNormal, true, stuckNudgeMoves, stuckNudgeDelayMs, false, false},
+		{"progress resets timer", gamemode.DifficultyNormal, false, stuckNudgeMoves, stuckNudgeDelayMs, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+			cfg := config.DefaultConfig()
+			cfg.HintsEnabled = !tt.hintsOff
+			config.SetCurrent(cfg)
+			t.Cleanup(func() { config.SetCurrent(nil) })
+
+			g := makeTestGame(3, 3)
+			g.GameMode.Difficulty = tt.difficulty
+			genCell := g.Grid.GetCell(1, 1)
+			genCell.Discovered = true
+			gen := entities.NewGenerator("G1", 2)
+			gameworld.GetGameData(genCell).Generator = gen
+			g.AddGenerator(gen)
+
+			updateStuckNudge(g, 1000)
+			g.MovementCount += tt.moves
+			if tt.progress {
+				g.Batteries++
+			}
+			updateStuckNudge(g, 1000+tt.waitMs)
+
+			if got := g.ObjectiveRoute != nil; got != tt.want {
+				t.Fatalf("nudged = %v, want %v", got, tt.want)
+			}
+			if tt.want && g.ObjectiveRoute.Target != genCell {
+				t.Errorf("route target = (%d,%d), want the unpowered generator", g.ObjectiveRoute.Target.Row, g.ObjectiveRoute.Target.Col)
+			}
+		})
+	}
+}
+
+func TestUpdateStuckNudge_onlyOncePerStall(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	g := makeTestGame(3, 3)
+	genCell := g.Grid.GetCell(1, 1)
+	genCell.Discovered = true
+	gen := entities.NewGenerator("G1", 2)
+	gameworld.GetGameData(genCell).Generator = gen
+	g.AddGenerator(gen)
+
+	updateStuckNudge(g, 1000)
+	g.MovementCount += stuckNudgeMoves
+	updateStuckNudge(g, 1000+stuckNudgeDelayMs)
+	if g.ObjectiveRoute == nil {
+		t.Fatal("expected a nudge after the stall")
+	}
+	g.ClearObjectiveRoute()
+	g.MovementCount += stuckNudgeMoves
+	updateStuckNudge(g, 1000+2*stuckNudgeDelayMs)
+	if g.ObjectiveRoute != nil {
+		t.Error("nudge repeated without progress in between")
+	}
+}
+
+func TestProgressSignature_changesOnProgress(t *testing.T) {
+	g := makeTestGame(3, 3)
+	gen := entities.NewGenerator("G1", 1)
+	gameworld.GetGameData(g.Grid.GetCell(1, 1)).Generator = gen
+	g.AddGenerator(gen)
+	base := progressSignature(g)
+
+	g.Grid.GetCell(2, 2).Visited = true
+	g.Grid.GetCell(2, 2).Name = "Galley"
+	if progressSignature(g) == base {
+		t.Error("visiting a new room should change the signature")
+	}
+	base = progressSignature(g)
+	gen.InsertBatteriesAndStart(1)
+	if progressSignature(g) == base {
+		t.Error("powering a generator should change the signature")
+	}
+	base = progressSignature(g)
+	g.MovementCount += 10
+	if progressSignature(g) != base {
+		t.Error("moving alone should not count as progress")
+	}
+}
@@ -0,0 +1,69 @@
+package gameplay
+
+import (
+	"time"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/setup"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// CycleObjectiveFocus pans the camera to the next discovered objective, in the same order
+// as the objectives panel (generators, hazards, repairs, then the lift), and back to the
+// player. Each press moves on to the next one, wrapping at the end of the list.
+func CycleObjectiveFocus(g *state.Game) bool {
+	if g == nil || g.Grid == nil || g.CurrentCell == nil || IsGameplayCinematicActive(g) {
+		return false
+	}
+	targets := objectiveCycleTargets(g)
+	if len(targets) == 0 {
+		renderer.AddCallout(g.CurrentCell.Row, g.CurrentCell.Col, "SUBTLE{No objectives found yet}", renderer.CalloutColorInfo, 3000)
+		return false
+	}
+	idx := g.ObjectiveCycle % len(targets)
+	g.ObjectiveCycle = idx + 1
+	target := targets[idx]
+
+	label := objectiveCycleLabel(g, target)
+	logMessage(g, "Objective %d/%d: ACTION{%s}", idx+1, len(targets), label)
+	// Hazards get their usual callout when the pan arrives (showHazardTourCallout).
+	if !gameworld.HasBlockingHazard(target) {
+		renderer.AddCallout(target.Row, target.Col, "ACTION{"+label+"}", renderer.CalloutColorInfo,
+			state.HazardClearPanMs+state.HazardTourHighlightMs)
+	}
+
+	g.HazardTour = &state.HazardTourSession{
+		Targets:      []state.HazardTourTarget{{Row: target.Row, Col: target.Col}},
+		ReturnCamRow: float64(g.CurrentCell.Row),
+		ReturnCamCol: float64(g.CurrentCell.Col),
+		Phase:        state.HazardTourPanTo,
+		PhaseStartMs: time.Now().UnixMilli(),
+	}
+	return true
+}
+
+// objectiveCycleTargets flattens the objective tiers into the order the cycle visits them.
+func objectiveCycleTargets(g *state.Game) []*world.Cell {
+	var targets []*world.Cell
+	for _, tier := range objectiveTargetTiers(g) {
+		targets = append(targets, tier...)
+	}
+	return targets
+}
+
+func objectiveCycleLabel(g *state.Game, cell *world.Cell) string {
+	data := gameworld.GetGameData(cell)
+	switch {
+	case unpoweredGeneratorAt(cell):
+		return data.Generator.Name
+	case gameworld.HasBlockingHazard(cell):
+		return data.Hazard.Name
+	case incompleteRepairAt(cell):
+		return data.RepairDevice.Name
+	case cell == setup.ExitCell(g):
+		return "Exit lift"
+	}
+	return cell.Name
+}
@@ -0,0 +1,37 @@
+package gameplay
+
+import "testing"
+
+func TestCycleObjectiveFocus_visitsObjectivesInPanelOrder(t *testing.T) {
+	g := makeRouteTestGame(t)
+	g.Grid.SetExitCellAt(0, 2)
+	g.Grid.GetCell(0, 2).Discovered = true
+
+	want := [][2]int{{0, 4}, {0, 2}, {0, 4}} // generator, then the lift, then wrap
+	for i, rc := range want {
+		if !CycleObjectiveFocus(g) {
+			t.Fatalf("press %d: expected a focus pan", i+1)
+		}
+		if g.HazardTour == nil || len(g.HazardTour.Targets) != 1 {
+			t.Fatalf("press %d: expected a single-target camera pan", i+1)
+		}
+		if got := g.HazardTour.Targets[0]; got.Row != rc[0] || got.Col != rc[1] {
+			t.Errorf("press %d: focused (%d,%d), want (%d,%d)", i+1, got.Row, got.Col, rc[0], rc[1])
+		}
+		if CycleObjectiveFocus(g) {
+			t.Fatalf("press %d: a second press during the pan should be ignored", i+1)
+		}
+		g.HazardTour = nil // pan finished
+	}
+}
+
+func TestCycleObjectiveFocus_nothingDiscovered(t *testing.T) {
+	g := makeRouteTestGame(t)
+	g.Grid.GetCell(0, 4).Discovered = false
+	if CycleObjectiveFocus(g) {
+		t.Error("undiscovered objectives should not be focused")
+	}
+	if g.HazardTour != nil {
+		t.Error("no camera pan expected without a known objective")
+	}
+}
@@ -0,0 +1,158 @@
+package gameplay
+
+import (
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/setup"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// TraceObjectiveRoute traces a path to the nearest discovered objective, in the same
+// priority order as the objectives panel (generators, hazards, repairs, then the lift).
+// The route is kept on g and redrawn each turn until the player arrives.
+func TraceObjectiveRoute(g *state.Game) {
+	if g == nil || g.Grid == nil || g.CurrentCell == nil {
+		return
+	}
+	g.ClearObjectiveRoute()
+	for _, targets := range objectiveTargetTiers(g) {
+		if route := shortestObjectiveRoute(g, targets); route != nil {
+			g.ObjectiveRoute = route
+			return
+		}
+	}
+	renderer.AddCallout(g.CurrentCell.Row, g.CurrentCell.Col, "SUBTLE{No route yet}", renderer.CalloutColorInfo, 3000)
+}
+
+// UpdateObjectiveRoute re-plans the active route from the player's current cell so it
+// follows the player and reroutes as doors and hazards change. The route is dropped
+// once the player stands next to the target or the target is no longer an objective.
+func UpdateObjectiveRoute(g *state.Game) {
+	if g == nil || g.ObjectiveRoute == nil || g.CurrentCell == nil {
+		return
+	}
+	target := g.ObjectiveRoute.Target
+	if !isObjectiveTarget(g, target) {
+		g.ClearObjectiveRoute()
+		return
+	}
+	route := routeTo(g, target)
+	if route == nil || len(route.Cells) <= 1 {
+		g.ClearObjectiveRoute()
+		return
+	}
+	g.ObjectiveRoute = route
+}
+
+// objectiveTargetTiers groups discovered objective cells by panel priority.
+func objectiveTargetTiers(g *state.Game) [][]*world.Cell {
+	var generators, hazards, repairs []*world.Cell
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if !cell.Discovered {
+			return
+		}
+		switch {
+		case unpoweredGeneratorAt(cell):
+			generators = append(generators, cell)
+		case gameworld.HasBlockingHazard(cell):
+			hazards = append(hazards, cell)
+		case incompleteRepairAt(cell):
+			repairs = append(repairs, cell)
+		}
+	})
+	tiers := [][]*world.Cell{generators, hazards, repairs}
+	if exit := setup.ExitCell(g); exit != nil && exit.Discovered {
+		tiers = append(tiers, []*world.Cell{exit})
+	}
+	return tiers
+}
+
+func isObjectiveTarget(g *state.Game, cell *world.Cell) bool {
+	if cell == nil {
+		return false
+	}
+	return unpoweredGeneratorAt(cell) || gameworld.HasBlockingHazard(cell) ||
+		incompleteRepairAt(cell) || cell == setup.ExitCell(g)
+}
+
+func unpoweredGeneratorAt(cell *world.Cell) bool {
+	return gameworld.HasGenerator(cell) && !gameworld.GetGameData(cell).Generator.IsPowered()
+}
+
+func incompleteRepairAt(cell *world.Cell) bool {
+	if !gameworld.HasRepairDevice(cell) {
+		return false
+	}
+	repair := gameworld.GetGameData(cell).RepairDevice
+	return repair != nil && !repair.SkipExitGate && !repair.IsComplete()
+}
+
+// shortestObjectiveRoute returns the shortest route to any of targets, or nil.
+func shortestObjectiveRoute(g *state.Game, targets []*world.Cell) *state.ObjectiveRoute {
+	var best *state.ObjectiveRoute
+	for _, target := range targets {
+		route := routeTo(g, target)
+		if route != nil && (best == nil || len(route.Cells) < len(best.Cells)) {
+			best = route
+		}
+	}
+	return best
+}
+
+func routeTo(g *state.Game, target *world.Cell) *state.ObjectiveRoute {
+	path := world.FindPath(g.CurrentCell, target, func(cell *world.Cell) bool {
+		return routePassable(g, cell)
+	})
+	if path == nil {
+		return nil
+	}
+	return &state.ObjectiveRoute{Target: target, Cells: path[1:]}
+}
+
+// routePassable mirrors CanEnter without its side effects (keycard unlocks, hazard
+// clearing, callouts). Doors the player holds a keycard (and any vault code) for and hazards the player
+// carries the fix for count as passable, since walking into them clears the way.
+func routePassable(g *state.Game, cell *world.Cell) bool {
+	if cell == nil || !cell.Room {
+		return false
+	}
+	if gameworld.HasDoor(cell) {
+		door := gameworld.GetGameData(cell).Door
+		unpowered := !setup.CellHasLivePower(g, cell) && !manualEgressReleased(g, door.RoomName)
+		if gameworld.HasLockedDoor(cell) {
+			if !g.CanUnlockDoor(door) {
+				return false
+			}
+		} else if unpowered && !door.KeycardGated {
+			return false
+		}
+	}
+	if gameworld.HasGenerator(cell) || gameworld.FurnitureBlocksMovement(cell) ||
+		gameworld.HasTerminal(cell) || gameworld.HasPuzzle(cell) ||
+		gameworld.HasMaintenanceTerminal(cell) || gameworld.RepairDeviceBlocksMovement(cell) ||
+		gameworld.HasHazardControl(cell) || gameworld.HasBlockingRepairBlocker(cell) ||
+		gameworld.HasWaitingSurvivor(cell) {
+		return false
+	}
+	if gameworld.HasBlockingHazard(cell) {
+		hazard := gameworld.GetGameData(cell).Hazard
+		if !hazard.RequiresItem() || !ownsItemNamed(g, hazard.RequiredItemName()) {
+			return false
+		}
+	}
+	if cell.ExitCell && !setup.ExitLiftReady(g) {
+		return false
+	}
+	return true
+}
+
+func ownsItemNamed(g *state.Game, name string) bool {
+	found := false
+	g.OwnedItems.Each(func(item *world.Item) {
+		if item != nil && item.Name == name {
+			found = true
+		}
+	})
+	return found
+}
@@ -0,0 +1,74 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// makeRouteTestGame builds a 1x5 strip: player at (0,0), corridor, unpowered generator at (0,4).
+func makeRouteTestGame(t *testing.T) *state.Game {
+	t.Helper()
+	g := state.NewGame()
+	grid := world.NewGrid(1, 5)
+	grid.MarkAsRoomWithName(0, 0, "RoomA", "")
+	for col := 1; col <= 3; col++ {
+		grid.MarkAsRoomWithName(0, col, "Corridor", "")
+	}
+	grid.MarkAsRoomWithName(0, 4, "Gen", "")
+	grid.BuildAllCellConnections()
+	grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		gameworld.InitGameData(cell)
+		cell.Discovered = true
+	})
+	gameworld.GetGameData(grid.GetCell(0, 4)).Generator = entities.NewGenerator("G", 1)
+	g.Grid = grid
+	g.CurrentCell = grid.GetCell(0, 0)
+	return g
+}
+
+func TestTraceObjectiveRoute_findsGenerator(t *testing.T) {
+	g := makeRouteTestGame(t)
+	TraceObjectiveRoute(g)
+	if g.ObjectiveRoute == nil {
+		t.Fatal("expected a route to the unpowered generator")
+	}
+	if got := g.ObjectiveRoute.Target; got != g.Grid.GetCell(0, 4) {
+		t.Errorf("target = (%d,%d), want generator at (0,4)", got.Row, got.Col)
+	}
+	if len(g.ObjectiveRoute.Cells) != 4 {
+		t.Errorf("route length = %d, want 4", len(g.ObjectiveRoute.Cells))
+	}
+}
+
+func TestTraceObjectiveRoute_unpoweredDoorBlocks(t *testing.T) {
+	g := makeRouteTestGame(t)
+	gameworld.GetGameData(g.Grid.GetCell(0, 2)).Door = &entities.Door{RoomName: "Gen"}
+	TraceObjectiveRoute(g)
+	if g.ObjectiveRoute != nil {
+		t.Fatal("route should not pass through an unpowered door")
+	}
+	g.ManualEgressReleased["Gen"] = true
+	TraceObjectiveRoute(g)
+	if g.ObjectiveRoute == nil {
+		t.Fatal("route should pass a manually released door")
+	}
+}
+
+func TestUpdateObjectiveRoute_clearsOnArrival(t *testing.T) {
+	g := makeRouteTestGame(t)
+	TraceObjectiveRoute(g)
+	g.CurrentCell = g.Grid.GetCell(0, 2)
+	UpdateObjectiveRoute(g)
+	if g.ObjectiveRoute == nil || len(g.ObjectiveRoute.Cells) != 2 {
+		t.Fatalf("route after moving = %+v, want 2 remaining cells", g.ObjectiveRoute)
+	}
+	g.CurrentCell = g.Grid.GetCell(0, 3)
+	UpdateObjectiveRoute(g)
+	if g.ObjectiveRoute != nil {
+		t.Error("route should clear once the player is next to the target")
+	}
+}
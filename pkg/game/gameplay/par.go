@@ -0,0 +1,27 @@
+package gameplay
+
+import (
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/debuglog"
+	"darkstation/pkg/game/devtools"
+	"darkstation/pkg/game/state"
+)
+
+// recordDeckPar stores the current deck's action count as its par when no par exists
+// for this level, seed and variant, or when the run beat it. Returns true only when an existing
+// par was beaten. Creative runs, dev maps and decks already cleared are skipped.
+func recordDeckPar(g *state.Game) bool {
+	if g == nil || g.Creative() || devtools.IsDevLevel(g.Level) || g.HasClearedDeck(g.CurrentDeckID) {
+		return false
+	}
+	actions := g.DeckActions()
+	cfg := config.Current()
+	par, hadPar := cfg.GetPar(g.Level, g.LevelSeed, g.ParVariant())
+	if hadPar && actions >= par {
+		return false
+	}
+	if err := cfg.SetPar(g.Level, g.LevelSeed, g.ParVariant(), actions); err != nil {
+		debuglog.Warnf("could not save deck par: %v", err)
+	}
+	return hadPar
+}
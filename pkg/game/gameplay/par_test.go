@@ -0,0 +1,82 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/devtools"
+	"darkstation/pkg/game/gamemode"
+)
+
+func TestRecordDeckPar_keepsFewestActions(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	defer config.SetCurrent(nil)
+
+	g := makeTestGame(1, 4)
+	g.Level, g.CurrentDeckID, g.LevelSeed = 2, 1, 42
+	g.MovementCount, g.InteractionsCount = 30, 5
+	g.MarkDeckEntry()
+	g.MovementCount += 20
+	g.InteractionsCount += 4
+
+	if recordDeckPar(g) {
+		t.Error("the first clear sets the par but is not a new best")
+	}
+	if par, ok := config.Current().GetPar(2, 42, g.ParVariant()); !ok || par != 24 {
+		t.Fatalf("GetPar = %d,%v, want 24,true", par, ok)
+	}
+
+	g.MarkDeckEntry()
+	g.MovementCount += 30
+	if recordDeckPar(g) {
+		t.Error("a slower run should not beat the par")
+	}
+	g.MarkDeckEntry()
+	g.MovementCount += 10
+	if !recordDeckPar(g) {
+		t.Error("a faster run should beat the par")
+	}
+	if par, _ := config.Current().GetPar(2, 42, g.ParVariant()); par != 10 {
+		t.Errorf("par = %d, want 10", par)
+	}
+	if _, ok := config.Current().GetPar(2, 43, g.ParVariant()); ok {
+		t.Error("a different seed should have no par")
+	}
+
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if par, ok := loaded.GetPar(2, 42, g.ParVariant()); !ok || par != 10 {
+		t.Errorf("reloaded par = %d,%v, want 10,true", par, ok)
+	}
+
+	normal := g.ParVariant()
+	g.GameMode = g.Mode()
+	g.GameMode.Difficulty = gamemode.DifficultyHard
+	if normal == g.ParVariant() {
+		t.Fatal("difficulty should change the par variant")
+	}
+	if _, ok := config.Current().GetPar(2, 42, g.ParVariant()); ok {
+		t.Error("a different difficulty should not share the par")
+	}
+	g.GameMode.DeckSize = gamemode.DeckSizeLarge
+	g.MarkDeckEntry()
+	g.MovementCount += 40
+	if recordDeckPar(g) {
+		t.Error("the first clear at a new deck size sets the par but is not a new best")
+	}
+	if par, _ := config.Current().GetPar(2, 42, normal); par != 10 {
+		t.Errorf("normal-difficulty par = %d after a large-deck run, want 10", par)
+	}
+
+	g.Level = devtools.DevMapLevel
+	g.MarkDeckEntry()
+	if recordDeckPar(g) {
+		t.Error("dev map runs should never record a par")
+	}
+	if _, ok := config.Current().GetPar(devtools.DevMapLevel, 42, g.ParVariant()); ok {
+		t.Error("dev map run stored a par")
+	}
+}
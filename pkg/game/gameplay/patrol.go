@@ -0,0 +1,118 @@
+package gameplay
+
+import (
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/gamemode"
+	"darkstation/pkg/game/levelrand"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// patrolCatchSeedTag derives the stream that picks which item a patrol knocks loose.
+const patrolCatchSeedTag = 0x9A7C47
+
+// MovePatrol steps the deck's maintenance bot along the corridors once per player move
+// and catches the player when they are in its sight (see gameworld.PatrolSeesPlayer).
+// Called once per processed input from TickTurn.
+func MovePatrol(g *state.Game) {
+	if g == nil || g.Grid == nil || g.CurrentCell == nil {
+		return
+	}
+	if g.MovementCount == 0 || g.MovementCount == g.PatrolStepAt {
+		return
+	}
+	g.PatrolStepAt = g.MovementCount
+	cell, patrol := g.DeckPatrol()
+	if patrol == nil {
+		return
+	}
+	// The player may have walked into its view; it catches them before moving on.
+	if patrolCatches(g, cell) {
+		catchPlayer(g, cell)
+		return
+	}
+	wasStunned := patrol.IsStunned()
+	next := patrol.Step(g.Grid, func(c *world.Cell) bool { return patrolCanEnter(g, c) })
+	if wasStunned {
+		if !patrol.IsStunned() {
+			logMessage(g, "The %s whirs back to life.", patrol.Name)
+		}
+		return
+	}
+	if next == nil {
+		return
+	}
+	gameworld.GetGameData(cell).Patrol = nil
+	gameworld.GetGameData(next).Patrol = patrol
+	if patrolCatches(g, next) {
+		catchPlayer(g, next)
+	}
+}
+
+// patrolCanEnter reports whether the bot may roll onto cell: an unlocked corridor cell
+// clear of the player, the lift, blocking hazards and the survivor.
+func patrolCanEnter(g *state.Game, cell *world.Cell) bool {
+	if cell == nil || !cell.Room || !cell.IsCorridor || cell == g.CurrentCell || cell.ExitCell {
+		return false
+	}
+	data := gameworld.GetGameData(cell)
+	if data.Door != nil && data.Door.Locked {
+		return false
+	}
+	return !gameworld.HasBlockingHazard(cell) && data.Survivor == nil && data.Patrol == nil &&
+		data.RepairBlocker == nil
+}
+
+// patrolCatches reports whether the bot on cell can see the player and is not cooling
+// down from its last catch. Standing beside or behind it is safe. Creative runs are never
+// caught.
+func patrolCatches(g *state.Game, cell *world.Cell) bool {
+	patrol := gameworld.GetGameData(cell).Patrol
+	if patrol == nil || patrol.Cooldown > 0 || g.Creative() {
+		return false
+	}
+	return gameworld.PatrolSeesPlayer(g.Grid, patrol, g.CurrentCell)
+}
+
+// catchPlayer applies a patrol encounter. Survival and hard runs reset the deck; other
+// runs have the bot's grapple snatch a random carried item onto its own cell and roll away.
+func catchPlayer(g *state.Game, cell *world.Cell) {
+	patrol := gameworld.GetGameData(cell).Patrol
+	if g.Survival() || g.Mode().Difficulty == gamemode.DifficultyHard {
+		logMessage(g, "The %s spots you and raises the alarm - you are hauled back to the lift!", patrol.Name)
+		ResetLevel(g)
+		return
+	}
+	patrol.Cooldown = entities.PatrolCaughtCooldown
+	patrol.Heading = patrol.Heading.Opposite()
+	items := g.DroppableItems()
+	if len(items) == 0 {
+		logMessage(g, "The %s spots you, but its grapple finds nothing to grab. It rolls away.", patrol.Name)
+		renderer.AddCallout(cell.Row, cell.Col, "Spotted by the bot!", renderer.CalloutColorDanger, 0)
+		return
+	}
+	rng := levelrand.NewDerived(g.LevelSeed, patrolCatchSeedTag+uint64(g.MovementCount))
+	item := items[rng.Intn(len(items))]
+	if g.OwnedItems.Has(item) {
+		g.OwnedItems.Remove(item)
+	} else {
+		g.RunInventory.Remove(item)
+	}
+	cell.ItemsOnFloor.Put(item)
+	g.NoteItemDropped(item)
+	logMessage(g, "The %s spots you and its grapple snatches ITEM{%s} out of your hands!", patrol.Name, item.Name)
+	renderer.AddCallout(cell.Row, cell.Col, "Spotted by the bot!\nSUBTLE{Dropped "+item.Name+"}", renderer.CalloutColorDanger, 0)
+}
+
+// notePatrolInView updates *inView with whether the deck's patrol bot is in the player's
+// line of sight, and reports whether it has just come into view.
+func notePatrolInView(g *state.Game, inView *bool) bool {
+	cell, patrol := g.DeckPatrol()
+	now := patrol != nil && g.CurrentCell != nil &&
+		world.HasLineOfSight(g.Grid, g.CurrentCell, cell, unpoweredDoorSightBlocker(g))
+	spotted := now && !*inView
+	*inView = now
+	return spotted
+}
@@ -0,0 +1,176 @@
+package gameplay
+
+import (
+	"testing"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/gamemode"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// makePatrolTestGame lays out one unlit corridor row (0,0)..(0,5) with the lift at (0,5),
+// the player at (0,playerCol) and a bot at (0,4) heading West.
+func makePatrolTestGame(t *testing.T, playerCol int) (*state.Game, *entities.Patrol) {
+	t.Helper()
+	g := state.NewGame()
+	grid := world.NewGrid(1, 6)
+	for c := 0; c < 6; c++ {
+		grid.MarkAsRoomWithName(0, c, world.CorridorName, "")
+		gameworld.InitGameData(grid.GetCell(0, c))
+	}
+	grid.BuildAllCellConnections()
+	grid.SetStartCellAt(0, 0)
+	grid.SetExitCellAt(0, 5)
+	g.Grid = grid
+	g.CurrentCell = grid.GetCell(0, playerCol)
+	patrol := entities.NewPatrol("Maintenance Bot", 0, 4, world.West)
+	gameworld.GetGameData(grid.GetCell(0, 4)).Patrol = patrol
+	return g, patrol
+}
+
+func TestMovePatrol_stepsOncePerMove(t *testing.T) {
+	g, patrol := makePatrolTestGame(t, 0)
+	g.MovementCount = 1
+	MovePatrol(g)
+	MovePatrol(g)
+	if patrol.Col != 3 {
+		t.Fatalf("bot at col %d after one move, want 3", patrol.Col)
+	}
+	if cell, p := g.DeckPatrol(); p != patrol || cell.Col != 3 {
+		t.Fatalf("grid holds the bot at %v, want (0,3)", cell)
+	}
+}
+
+func TestMovePatrol_avoidsLockedDoorsAndTheLift(t *testing.T) {
+	g, patrol := makePatrolTestGame(t, 0)
+	door := entities.NewDoor("R")
+	door.Locked = true
+	gameworld.GetGameData(g.Grid.GetCell(0, 3)).Door = door
+	g.MovementCount = 1
+	MovePatrol(g)
+	if patrol.Col != 4 {
+		t.Fatalf("bot moved to col %d; locked door west and lift east should hold it at 4", patrol.Col)
+	}
+}
+
+func TestMovePatrol_catchKnocksItemLoose(t *testing.T) {
+	// Three cells off in the dark the bot cannot see the player; one step closer it can.
+	g, patrol := makePatrolTestGame(t, 1)
+	kit := world.NewItem("Patch Kit")
+	g.OwnedItems.Put(kit)
+	g.MovementCount = 1
+	MovePatrol(g)
+
+	botCell := g.Grid.GetCell(0, 3)
+	if g.OwnedItems.Has(kit) || !botCell.ItemsOnFloor.Has(kit) {
+		t.Fatal("caught player should drop the Patch Kit on the bot's cell")
+	}
+	if patrol.Cooldown != entities.PatrolCaughtCooldown || patrol.Heading != world.East {
+		t.Fatalf("bot = %+v, want cooling down and turned away", patrol)
+	}
+
+	// Cooling down, the bot rolls away instead of catching again.
+	g.MovementCount = 2
+	MovePatrol(g)
+	if patrol.Col != 4 {
+		t.Fatalf("bot at col %d, want it back at 4", patrol.Col)
+	}
+}
+
+func TestMovePatrol_litCorridorSpotsFromFurther(t *testing.T) {
+	g, _ := makePatrolTestGame(t, 0)
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		gameworld.GetGameData(cell).GridLit = true
+	})
+	kit := world.NewItem("Patch Kit")
+	g.OwnedItems.Put(kit)
+	g.MovementCount = 1
+	MovePatrol(g)
+	if !g.Grid.GetCell(0, 4).ItemsOnFloor.Has(kit) {
+		t.Fatal("a lit corridor should let the bot spot the player four cells away before it moves")
+	}
+}
+
+func TestMovePatrol_standingBehindIsSafe(t *testing.T) {
+	g, patrol := makePatrolTestGame(t, 3)
+	patrol.Heading = world.East
+	kit := world.NewItem("Patch Kit")
+	g.OwnedItems.Put(kit)
+	g.MovementCount = 1
+	MovePatrol(g)
+	if !g.OwnedItems.Has(kit) || patrol.Cooldown != 0 {
+		t.Fatal("a player right behind the bot is out of its sight")
+	}
+}
+
+func TestPatrolSeesPlayer_wallsBlockTheCone(t *testing.T) {
+	// 3x3 lit room with a pillar at (1,1); the bot at (0,1) looks South at (2,1) behind it.
+	g := state.NewGame()
+	grid := world.NewGrid(3, 3)
+	for r := 0; r < 3; r++ {
+		for c := 0; c < 3; c++ {
+			if r == 1 && c == 1 {
+				continue
+			}
+			grid.MarkAsRoomWithName(r, c, "R", "desc")
+			gameworld.GetGameData(grid.GetCell(r, c)).GridLit = true
+		}
+	}
+	grid.BuildAllCellConnections()
+	g.Grid = grid
+	patrol := entities.NewPatrol("Maintenance Bot", 0, 1, world.South)
+
+	if gameworld.PatrolSeesPlayer(grid, patrol, grid.GetCell(2, 1)) {
+		t.Fatal("the pillar should hide (2,1) from the bot")
+	}
+	if !gameworld.PatrolSeesPlayer(grid, patrol, grid.GetCell(1, 0)) {
+		t.Fatal("(1,0) is in the cone with a clear line of sight")
+	}
+	if gameworld.PatrolSeesPlayer(grid, patrol, grid.GetCell(0, 0)) {
+		t.Fatal("cells beside the bot are outside its cone")
+	}
+
+	grid.MarkAsRoomWithName(1, 1, "R", "desc")
+	gameworld.GetGameData(grid.GetCell(1, 1)).GridLit = true
+	if !gameworld.PatrolSeesPlayer(grid, patrol, grid.GetCell(2, 1)) {
+		t.Fatal("without the pillar the bot should see straight down to (2,1)")
+	}
+}
+
+func TestMovePatrol_neverCatchesInCreative(t *testing.T) {
+	g, patrol := makePatrolTestGame(t, 2)
+	g.GameMode = g.Mode().WithOptions(gamemode.RunOptions{Creative: true})
+	kit := world.NewItem("Patch Kit")
+	g.OwnedItems.Put(kit)
+	g.MovementCount = 1
+	MovePatrol(g)
+	if !g.OwnedItems.Has(kit) || patrol.Cooldown != 0 {
+		t.Fatal("creative runs should never be caught")
+	}
+}
+
+func TestCanEnter_patrolBlocksWhileStunnedOrCoolingDown(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		set  func(*entities.Patrol)
+	}{
+		{"stunned", func(p *entities.Patrol) { p.Stun(entities.EMPStunSteps) }},
+		{"cooling down", func(p *entities.Patrol) { p.Cooldown = entities.PatrolCaughtCooldown }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			g, patrol := makePatrolTestGame(t, 3)
+			tc.set(patrol)
+			g.MovementCount = 1
+			ProcessIntent(g, engineinput.Intent{Action: engineinput.ActionMoveEast})
+			if g.CurrentCell.Col != 3 {
+				t.Fatalf("player stepped to col %d, onto the bot at col 4", g.CurrentCell.Col)
+			}
+			if ok, _ := CanEnter(g, g.Grid.GetCell(0, 4), false); ok {
+				t.Error("CanEnter allowed the bot's cell")
+			}
+		})
+	}
+}
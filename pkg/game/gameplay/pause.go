@@ -0,0 +1,71 @@
+package gameplay
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/leonelquinteros/gotext"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/debuglog"
+	gamemenu "darkstation/pkg/game/menu"
+	"darkstation/pkg/game/state"
+)
+
+// PauseForIntent opens the pause menu when intent is the Escape (quit) shortcut during
+// play and reports whether it did. The main loop skips its per-input ticks (hazards,
+// patrols, drains) for a pause, so opening and closing the menu costs no turn.
+func PauseForIntent(g *state.Game, intent engineinput.Intent) bool {
+	if g == nil || intent.Action != engineinput.ActionQuit || g.GameComplete || g.GameOver ||
+		IsGameplayCinematicActive(g) {
+		return false
+	}
+	RunPauseMenu(g)
+	return true
+}
+
+// RunPauseMenu presents the pause menu (Resume, Settings, Restart Deck, Quit to Title,
+// Quit Game) and applies the chosen row. The timed surge and stuck-nudge clocks are held
+// for as long as the menu was open.
+func RunPauseMenu(g *state.Game) {
+	openedMs := time.Now().UnixMilli()
+	handler := gamemenu.NewPauseMenuHandler()
+	gamemenu.RunMenu(g, handler.GetMenuItems(), handler)
+
+	switch handler.GetSelectedAction() {
+	case gamemenu.PauseMenuActionSettings:
+		RunSettingsMenu(g, false)
+	case gamemenu.PauseMenuActionRestartDeck:
+		if gamemenu.RunConfirmDialog(g, gamemenu.ConfirmOptions{
+			Title:   "Restart deck?",
+			Message: "Progress on this deck will be lost.",
+		}) {
+			ResetLevel(g)
+		}
+	case gamemenu.PauseMenuActionQuitToTitle:
+		QuitToTitleMenu(g)
+	case gamemenu.PauseMenuActionQuit:
+		if gamemenu.ConfirmQuitGame(g) {
+			fmt.Println(gotext.Get("GOODBYE"))
+			LogRunState(g, "quit")
+			debuglog.Close()
+			os.Exit(0)
+		}
+	}
+	holdClocks(g, time.Now().UnixMilli()-openedMs)
+}
+
+// holdClocks pushes the game's wall-clock deadlines back by pausedMs so time spent in the
+// pause menu neither brings a power surge closer nor counts toward the stuck nudge.
+func holdClocks(g *state.Game, pausedMs int64) {
+	if g == nil || pausedMs <= 0 {
+		return
+	}
+	s := &g.PowerSurge
+	for _, at := range []*int64{&s.WarnAtMs, &s.StartMs, &s.EndMs, &g.Progress.AtMs} {
+		if *at != 0 {
+			*at += pausedMs
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package gameplay
+
+import (
+	"testing"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/state"
+)
+
+func TestPauseForIntent_onlyEscapeDuringPlay(t *testing.T) {
+	g := state.NewGame()
+	if PauseForIntent(g, engineinput.Intent{Action: engineinput.ActionOpenMenu}) {
+		t.Fatal("only the Escape shortcut should pause")
+	}
+	g.GameOver = true
+	if PauseForIntent(g, engineinput.Intent{Action: engineinput.ActionQuit}) {
+		t.Fatal("the game over screen handles Escape itself")
+	}
+}
+
+func TestHoldClocks_pushesBackOnlyScheduledDeadlines(t *testing.T) {
+	g := state.NewGame()
+	g.PowerSurge = state.PowerSurge{WarnAtMs: 1000}
+	g.Progress.AtMs = 500
+	holdClocks(g, 250)
+	if g.PowerSurge.WarnAtMs != 1250 || g.Progress.AtMs != 750 {
+		t.Fatalf("surge warn = %d, progress = %d; want 1250 and 750", g.PowerSurge.WarnAtMs, g.Progress.AtMs)
+	}
+	if g.PowerSurge.StartMs != 0 || g.PowerSurge.EndMs != 0 {
+		t.Fatalf("unscheduled surge times moved: %+v", g.PowerSurge)
+	}
+}
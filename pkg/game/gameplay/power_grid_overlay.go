@@ -30,3 +30,16 @@ func ToggleGeneratorPowerGridOverlay(g *state.Game, cell *world.Cell) {
 	g.PowerGridOverlaySeedRow = cell.Row
 	g.PowerGridOverlaySeedCol = cell.Col
 }
+
+// TogglePowerDiagnostics shows or hides the deck-wide power diagnostics view.
+func TogglePowerDiagnostics(g *state.Game) {
+	if g == nil {
+		return
+	}
+	g.PowerDiagnosticsActive = !g.PowerDiagnosticsActive
+	if g.PowerDiagnosticsActive {
+		logMessage(g, "Power diagnostics on: %dw available", g.GetAvailablePower())
+		return
+	}
+	logMessage(g, "Power diagnostics off")
+}
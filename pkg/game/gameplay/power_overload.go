@@ -0,0 +1,122 @@
+package gameplay
+
+import (
+	"github.com/zyedidia/generic/mapset"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/levelrand"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// overloadTripMoves is how many consecutive moves a grid may stay overloaded before a
+// running generator blows out.
+const overloadTripMoves = 8
+
+// overloadSeedTag derives the stream that picks which generator blows and where it sparks.
+const overloadSeedTag = 0x0B1057
+
+// overloadCountdownMoves is how many moves before the blowout the countdown warning starts.
+const overloadCountdownMoves = 3
+
+// advanceOverloadStreak counts the moves the player has spent with an overloaded grid
+// (the PowerOverloadWarned state), warning as the limit nears. At overloadTripMoves a
+// random running generator blows out and sparks an electrical fault next to it. Counts
+// at most once per move however often lighting is refreshed. Returns true when a
+// generator blew, so the caller recomputes power.
+func advanceOverloadStreak(g *state.Game) bool {
+	if !g.PowerOverloadWarned || g.Creative() {
+		g.OverloadStreak = 0
+		return false
+	}
+	if g.MovementCount == 0 || g.MovementCount == g.OverloadStreakAt {
+		return false
+	}
+	g.OverloadStreakAt = g.MovementCount
+	g.OverloadStreak++
+	if left := overloadTripMoves - g.OverloadStreak; left > 0 {
+		if left <= overloadCountdownMoves {
+			logMessage(g, "WARNING: Grid overload critical - a generator will blow in %d %s. Reduce load!", left, pluralMoves(left))
+			renderer.PlaySound(renderer.SoundPowerWarning)
+		}
+		return false
+	}
+	g.OverloadStreak = 0
+	return blowOverloadedGenerator(g)
+}
+
+func pluralMoves(n int) string {
+	if n == 1 {
+		return "move"
+	}
+	return "moves"
+}
+
+// blowOverloadedGenerator blows out one running generator (see Generator.Blowout) and
+// sparks an electrical fault on a free neighboring cell whose loss seals nothing off. Both
+// picks come from a stream derived from the deck seed and move count, so a replayed deck
+// blows the same way. Returns false when no generator can blow.
+func blowOverloadedGenerator(g *state.Game) bool {
+	var cells []*world.Cell
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		gen := gameworld.GetGameData(cell).Generator
+		if gen != nil && gen.IsPowered() && !gen.Permanent && !gen.IsSequenced() {
+			cells = append(cells, cell)
+		}
+	})
+	if len(cells) == 0 {
+		return false
+	}
+	rng := levelrand.NewDerived(g.LevelSeed, overloadSeedTag+uint64(g.MovementCount))
+	cell := cells[rng.Intn(len(cells))]
+	gen := gameworld.GetGameData(cell).Generator
+	if !gen.Blowout() {
+		return false
+	}
+	logMessage(g, "OVERLOAD: ITEM{%s} blows out! One of its batteries is burned.", gen.Name)
+	renderer.AddCallout(cell.Row, cell.Col, "UNPOWERED{"+gen.Name+" - blown}", renderer.CalloutColorWarning, 0)
+
+	if open := overloadFaultCells(g, cell); len(open) > 0 {
+		spark := open[rng.Intn(len(open))]
+		hazard := entities.NewHazard(entities.HazardElectrical)
+		hazard.Source = gen
+		hazard.Description = "Sparks arc from the blown generator. Restart it to clear the fault."
+		gameworld.GetGameData(spark).Hazard = hazard
+		logMessage(g, "Sparks arc out of the generator. Restart ITEM{%s} to clear the fault.", gen.Name)
+	}
+	return true
+}
+
+// overloadFaultCells lists the free room cells next to the blown generator's cell that a
+// fault can cover without cutting the player off from anything (the exit included).
+func overloadFaultCells(g *state.Game, genCell *world.Cell) []*world.Cell {
+	if g.CurrentCell == nil {
+		return nil
+	}
+	var open []*world.Cell
+	noHazards := mapset.New[*world.Cell]()
+	for _, dir := range []world.Direction{world.North, world.East, world.South, world.West} {
+		next := g.Grid.GetCellRelative(genCell, dir)
+		if next != nil && next.Room && cellFreeForHazard(g, next) && spreadKeepsReachable(g, next, &noHazards) {
+			open = append(open, next)
+		}
+	}
+	return open
+}
+
+// clearOverloadFaults fixes the electrical faults gen's blowout sparked, once it runs again.
+func clearOverloadFaults(g *state.Game, gen *entities.Generator) {
+	cleared := false
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		h := gameworld.GetGameData(cell).Hazard
+		if h != nil && h.Source == gen && !h.Fixed {
+			h.Fix()
+			cleared = true
+		}
+	})
+	if cleared {
+		logMessage(g, "The sparking around ITEM{%s} stops.", gen.Name)
+	}
+}
@@ -0,0 +1,172 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// overloadTestGame builds a 3x3 open room with the player at (0,0), the exit at (2,2) and a
+// running generator in the centre.
+func overloadTestGame(t *testing.T) (*state.Game, *world.Cell) {
+	t.Helper()
+	g := state.NewGame()
+	grid := world.NewGrid(3, 3)
+	for r := 0; r < 3; r++ {
+		for c := 0; c < 3; c++ {
+			grid.MarkAsRoomWithName(r, c, "R", "desc")
+			gameworld.InitGameData(grid.GetCell(r, c))
+		}
+	}
+	grid.SetStartCellAt(0, 0)
+	grid.SetExitCellAt(2, 2)
+	grid.BuildAllCellConnections()
+	g.Grid = grid
+	g.CurrentCell = grid.GetCell(0, 0)
+
+	genCell := grid.GetCell(1, 1)
+	gen := entities.NewGenerator("Generator #1", 2)
+	gen.InsertBatteriesAndStart(2)
+	gameworld.GetGameData(genCell).Generator = gen
+	g.AddGenerator(gen)
+	g.PowerOverloadWarned = true
+	return g, genCell
+}
+
+func TestAdvanceOverloadStreak_countsOncePerMoveAndResets(t *testing.T) {
+	g, _ := overloadTestGame(t)
+	g.MovementCount = 1
+	advanceOverloadStreak(g)
+	advanceOverloadStreak(g)
+	if g.OverloadStreak != 1 {
+		t.Fatalf("streak = %d after two refreshes on one move, want 1", g.OverloadStreak)
+	}
+	g.MovementCount = 2
+	advanceOverloadStreak(g)
+	if g.OverloadStreak != 2 {
+		t.Fatalf("streak = %d, want 2", g.OverloadStreak)
+	}
+
+	g.PowerOverloadWarned = false
+	g.MovementCount = 3
+	advanceOverloadStreak(g)
+	if g.OverloadStreak != 0 {
+		t.Fatalf("streak = %d once the overload clears, want 0", g.OverloadStreak)
+	}
+}
+
+func TestAdvanceOverloadStreak_warnsBeforeTheTrip(t *testing.T) {
+	g, _ := overloadTestGame(t)
+	for move := 1; move < overloadTripMoves-overloadCountdownMoves; move++ {
+		g.MovementCount = move
+		advanceOverloadStreak(g)
+	}
+	if len(g.Messages) != 0 {
+		t.Fatalf("no countdown expected yet, got %v", g.Messages)
+	}
+	g.MovementCount = overloadTripMoves - overloadCountdownMoves
+	advanceOverloadStreak(g)
+	if len(g.Messages) != 1 {
+		t.Fatalf("messages = %v, want one countdown warning", g.Messages)
+	}
+}
+
+func TestAdvanceOverloadStreak_blowsGeneratorAndSparksFault(t *testing.T) {
+	g, genCell := overloadTestGame(t)
+	gen := gameworld.GetGameData(genCell).Generator
+	blew := false
+	for move := 1; move <= overloadTripMoves; move++ {
+		g.MovementCount = move
+		blew = advanceOverloadStreak(g)
+	}
+	if !blew {
+		t.Fatal("generator should blow once the streak reaches the limit")
+	}
+	if gen.IsPowered() || !gen.Tripped || gen.BatteriesInserted != 1 {
+		t.Fatalf("generator = %+v, want tripped with one battery burned", gen)
+	}
+	if g.OverloadStreak != 0 {
+		t.Fatalf("streak = %d after the blowout, want 0", g.OverloadStreak)
+	}
+
+	var fault *world.Cell
+	for _, dir := range []world.Direction{world.North, world.East, world.South, world.West} {
+		next := g.Grid.GetCellRelative(genCell, dir)
+		if h := gameworld.GetGameData(next).Hazard; h != nil {
+			if h.Type != entities.HazardElectrical || h.Source != gen || !h.IsBlocking() {
+				t.Fatalf("fault = %+v, want a blocking electrical fault sourced from the generator", h)
+			}
+			fault = next
+		}
+	}
+	if fault == nil {
+		t.Fatal("expected an electrical fault next to the blown generator")
+	}
+
+	gen.InsertBatteries(1)
+	if !gen.Restart() {
+		t.Fatal("refueled generator should restart")
+	}
+	clearOverloadFaults(g, gen)
+	if gameworld.GetGameData(fault).Hazard.IsBlocking() {
+		t.Fatal("restarting the generator should clear its fault")
+	}
+}
+
+func TestBlowOverloadedGenerator_faultNeverSealsTheExit(t *testing.T) {
+	// Player (0,0) - generator room cell (0,1) - corridor (0,2) - exit (0,3): every
+	// neighbour of the generator is the player's cell or the only way to the exit.
+	g := state.NewGame()
+	grid := world.NewGrid(1, 4)
+	for c := 0; c < 4; c++ {
+		grid.MarkAsRoomWithName(0, c, "R", "desc")
+		gameworld.InitGameData(grid.GetCell(0, c))
+	}
+	grid.SetStartCellAt(0, 0)
+	grid.SetExitCellAt(0, 3)
+	grid.BuildAllCellConnections()
+	g.Grid = grid
+	g.CurrentCell = grid.GetCell(0, 0)
+	gen := entities.NewGenerator("Generator #1", 1)
+	gen.InsertBatteriesAndStart(1)
+	gameworld.GetGameData(grid.GetCell(0, 1)).Generator = gen
+
+	if !blowOverloadedGenerator(g) {
+		t.Fatal("the generator should still blow")
+	}
+	grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if h := gameworld.GetGameData(cell).Hazard; h != nil {
+			t.Fatalf("fault at (%d,%d) would seal the exit", row, col)
+		}
+	})
+}
+
+func TestBlowOverloadedGenerator_faultCellFollowsDeckSeed(t *testing.T) {
+	faultAt := func(seed int64) *world.Cell {
+		g, _ := overloadTestGame(t)
+		g.LevelSeed = seed
+		g.MovementCount = 12
+		if !blowOverloadedGenerator(g) {
+			t.Fatal("the generator should blow")
+		}
+		var at *world.Cell
+		g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+			if gameworld.GetGameData(cell).Hazard != nil {
+				at = cell
+			}
+		})
+		if at == nil {
+			t.Fatal("expected a fault next to the generator")
+		}
+		return at
+	}
+	for seed := int64(1); seed <= 5; seed++ {
+		first, again := faultAt(seed), faultAt(seed)
+		if first.Row != again.Row || first.Col != again.Col {
+			t.Fatalf("seed %d sparked at (%d,%d) then (%d,%d)", seed, first.Row, first.Col, again.Row, again.Col)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package gameplay
+
+import (
+	"math/rand"
+	"time"
+
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/setup"
+	"darkstation/pkg/game/state"
+)
+
+// Power surge timing. Surges start on deep decks and come more often the deeper the
+// player goes; each is telegraphed before the extra load hits.
+const (
+	PowerSurgeMinLevel           = 6
+	powerSurgeIntervalMs         = 180_000
+	powerSurgeIntervalStepMs     = 15_000 // shaved off the interval per level past the minimum
+	powerSurgeIntervalFloorMs    = 75_000
+	powerSurgeWarningMs          = 4000
+	powerSurgeDurationMs         = 6000
+	powerSurgeWattsBase          = 20
+	powerSurgeWattsPerLevel      = 5
+	powerSurgeCalloutMs          = 4000
+	powerSurgeLoopSleepMs        = 33
+	powerSurgeIntervalJitterFrac = 4 // ±1/4 of the interval
+)
+
+// UpdatePowerSurge advances the power surge schedule: warn, spike consumption (shedding
+// load on the player's grid if it overloads), then recover and schedule the next one.
+func UpdatePowerSurge(g *state.Game) {
+	updatePowerSurge(g, time.Now().UnixMilli())
+}
+
+// IsPowerSurgeActive reports whether a surge is telegraphed or live, so the main loop
+// keeps ticking without waiting for input.
+func IsPowerSurgeActive(g *state.Game) bool {
+	return g.PowerSurgeInProgress()
+}
+
+// PollDuringPowerSurge runs one non-blocking main-loop pass while a surge is in progress.
+func PollDuringPowerSurge(g *state.Game) {
+	if intent, ok := renderer.TryGetIntent(); ok {
+		if PauseForIntent(g, intent) {
+			return
+		}
+		ProcessIntent(g, intent)
+		TickTurn(g)
+		return
+	}
+	time.Sleep(powerSurgeLoopSleepMs * time.Millisecond)
+	UpdatePowerSurge(g)
+}
+
+func updatePowerSurge(g *state.Game, nowMs int64) {
+	if g == nil || g.Grid == nil || g.CurrentCell == nil {
+		return
+	}
+	if !powerSurgesEnabled(g) {
+		if g.PowerSurge.Watts > 0 {
+			g.PowerSurge.Watts = 0
+			UpdateLightingExploration(g)
+		}
+		g.PowerSurge = state.PowerSurge{}
+		return
+	}
+	s := &g.PowerSurge
+	if s.WarnAtMs == 0 {
+		schedulePowerSurge(g, nowMs)
+		return
+	}
+	if !s.Warned {
+		if nowMs < s.WarnAtMs {
+			return
+		}
+		// The loop may have been idle past WarnAtMs; always give the full warning.
+		s.Warned = true
+		s.StartMs = nowMs + powerSurgeWarningMs
+		s.EndMs = s.StartMs + powerSurgeDurationMs
+		logMessage(g, "WARNING: Power fluctuation detected. Brace for a grid surge!")
+		renderer.AddCallout(g.CurrentCell.Row, g.CurrentCell.Col, "Power surge incoming!", renderer.CalloutColorWarning, powerSurgeCalloutMs)
+		return
+	}
+	if nowMs >= s.EndMs {
+		recoverPowerSurge(g)
+		schedulePowerSurge(g, nowMs)
+		return
+	}
+	if s.Watts == 0 && nowMs >= s.StartMs {
+		startPowerSurge(g)
+	}
+}
+
+// powerSurgesEnabled reports whether surges happen on this deck: the player setting is on,
+// the deck is deep enough, and there is a generator grid to surge.
+func powerSurgesEnabled(g *state.Game) bool {
+	return config.Current().PowerSurges && g.Level >= PowerSurgeMinLevel && len(g.Generators) > 0
+}
+
+// powerSurgeInterval returns the base time between surges on this deck.
+func powerSurgeInterval(level int) int64 {
+	interval := int64(powerSurgeIntervalMs - powerSurgeIntervalStepMs*(level-PowerSurgeMinLevel))
+	if interval < powerSurgeIntervalFloorMs {
+		interval = powerSurgeIntervalFloorMs
+	}
+	return interval
+}
+
+// powerSurgeWatts returns the extra load a surge adds on this deck.
+func powerSurgeWatts(level int) int {
+	return powerSurgeWattsBase + powerSurgeWattsPerLevel*(level-PowerSurgeMinLevel)
+}
+
+func schedulePowerSurge(g *state.Game, nowMs int64) {
+	interval := powerSurgeInterval(g.Level)
+	jitter := interval / powerSurgeIntervalJitterFrac
+	warnAt := nowMs + interval - jitter + rand.Int63n(2*jitter+1)
+	g.PowerSurge = state.PowerSurge{WarnAtMs: warnAt}
+}
+
+// startPowerSurge spikes consumption and sheds load on the player's grid if it overloads.
+func startPowerSurge(g *state.Game) {
+	g.PowerSurge.Watts = powerSurgeWatts(g.Level)
+	g.PowerConsumption = g.CalculatePowerConsumption()
+	logMessage(g, "Power surge! Grid load up %dw.", g.PowerSurge.Watts)
+	if setup.ShortOutIfOverload(g, g.CurrentCell.Name) {
+		setup.SchedulePowerPropagation(g, setup.PowerNowMs())
+		setup.ApplyGridConductivePower(g)
+		logMessage(g, "Load-shedding tripped: rooms on this grid have gone dark.")
+	}
+	UpdateLightingExploration(g)
+}
+
+// recoverPowerSurge drops the extra load once the surge passes.
+func recoverPowerSurge(g *state.Game) {
+	if g.PowerSurge.Watts == 0 {
+		return
+	}
+	g.PowerSurge.Watts = 0
+	logMessage(g, "Grid stabilised. Power surge has passed.")
+	UpdateLightingExploration(g)
+}
@@ -0,0 +1,143 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// makeSurgeTestGame builds rooms A and B on one 30w generator grid with both door
+// circuits armed (20w), so a level-6 surge overloads it. The player stands in B.
+func makeSurgeTestGame(t *testing.T, surgesOn bool, level int) *state.Game {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	cfg := config.DefaultConfig()
+	cfg.PowerSurges = surgesOn
+	config.SetCurrent(cfg)
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	grid := world.NewGrid(1, 6)
+	for c := 0; c < 6; c++ {
+		room := "A"
+		if c >= 3 {
+			room = "B"
+		}
+		grid.MarkAsRoomWithName(0, c, room, "desc")
+		gameworld.InitGameData(grid.GetCell(0, c))
+	}
+	grid.SetStartCellAt(0, 5)
+	grid.SetExitCellAt(0, 4)
+	grid.BuildAllCellConnections()
+	gameworld.GetGameData(grid.GetCell(0, 0)).Door = &entities.Door{RoomName: "A", Locked: false}
+	gameworld.GetGameData(grid.GetCell(0, 3)).Door = &entities.Door{RoomName: "B", Locked: false}
+	gen := entities.NewGenerator("G1", 1)
+	gen.OutputWatts = 30
+	gen.InsertBatteriesAndStart(1)
+	gameworld.GetGameData(grid.GetCell(0, 1)).Generator = gen
+
+	g := state.NewGame()
+	g.Grid = grid
+	g.CurrentCell = grid.GetCell(0, 5)
+	g.Level = level
+	g.AddGenerator(gen)
+	g.RoomDoorsPowered = map[string]bool{"A": true, "B": true}
+	g.RoomCCTVPowered = map[string]bool{}
+	g.RoomPowerOnline = map[string]bool{"A": true, "B": true}
+	return g
+}
+
+func TestUpdatePowerSurge_disabled(t *testing.T) {
+	tests := []struct {
+		name       string
+		surgesOn   bool
+		level      int
+		generators bool
+	}{
+		{"setting off", false, PowerSurgeMinLevel + 2, true},
+		{"shallow deck", true, PowerSurgeMinLevel - 1, true},
+		{"no generators", true, PowerSurgeMinLevel, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := makeSurgeTestGame(t, tt.surgesOn, tt.level)
+			if !tt.generators {
+				g.Generators = nil
+			}
+			updatePowerSurge(g, 1000)
+			updatePowerSurge(g, 1000+powerSurgeIntervalMs*2)
+			if g.PowerSurge != (state.PowerSurge{}) {
+				t.Errorf("PowerSurge = %+v, want none scheduled", g.PowerSurge)
+			}
+		})
+	}
+}
+
+func TestUpdatePowerSurge_warnsSpikesAndRecovers(t *testing.T) {
+	g := makeSurgeTestGame(t, true, PowerSurgeMinLevel)
+
+	updatePowerSurge(g, 1000)
+	warnAt := g.PowerSurge.WarnAtMs
+	interval := powerSurgeInterval(g.Level)
+	jitter := interval / powerSurgeIntervalJitterFrac
+	if warnAt < 1000+interval-jitter || warnAt > 1000+interval+jitter {
+		t.Fatalf("WarnAtMs = %d, want within %d±%d", warnAt, 1000+interval, jitter)
+	}
+
+	updatePowerSurge(g, warnAt-1)
+	if g.PowerSurgeInProgress() {
+		t.Fatal("surge should not be telegraphed before WarnAtMs")
+	}
+
+	// Arriving late still gives the full warning before the load hits.
+	now := warnAt + 500
+	updatePowerSurge(g, now)
+	if !g.PowerSurge.Warned || g.PowerSurge.StartMs != now+powerSurgeWarningMs {
+		t.Fatalf("after warning: %+v, want Warned with StartMs %d", g.PowerSurge, now+powerSurgeWarningMs)
+	}
+	if g.PowerSurge.Watts != 0 {
+		t.Fatal("load should not spike during the warning")
+	}
+
+	updatePowerSurge(g, g.PowerSurge.StartMs)
+	if g.SurgeWatts() != powerSurgeWatts(g.Level) {
+		t.Fatalf("SurgeWatts = %d, want %d", g.SurgeWatts(), powerSurgeWatts(g.Level))
+	}
+	if g.RoomDoorsPowered["A"] {
+		t.Error("surge overload should shed room A")
+	}
+	if !g.RoomDoorsPowered["B"] {
+		t.Error("the player's room should stay powered")
+	}
+
+	end := g.PowerSurge.EndMs
+	updatePowerSurge(g, end)
+	if g.PowerSurgeInProgress() {
+		t.Fatalf("surge should be over at EndMs: %+v", g.PowerSurge)
+	}
+	if g.PowerSurge.WarnAtMs <= end {
+		t.Errorf("next surge WarnAtMs = %d, want after %d", g.PowerSurge.WarnAtMs, end)
+	}
+}
+
+func TestPowerSurgeScaling(t *testing.T) {
+	tests := []struct {
+		level        int
+		wantInterval int64
+		wantWatts    int
+	}{
+		{PowerSurgeMinLevel, powerSurgeIntervalMs, powerSurgeWattsBase},
+		{PowerSurgeMinLevel + 4, powerSurgeIntervalMs - 4*powerSurgeIntervalStepMs, powerSurgeWattsBase + 4*powerSurgeWattsPerLevel},
+		{PowerSurgeMinLevel + 20, powerSurgeIntervalFloorMs, powerSurgeWattsBase + 20*powerSurgeWattsPerLevel},
+	}
+	for _, tt := range tests {
+		if got := powerSurgeInterval(tt.level); got != tt.wantInterval {
+			t.Errorf("powerSurgeInterval(%d) = %d, want %d", tt.level, got, tt.wantInterval)
+		}
+		if got := powerSurgeWatts(tt.level); got != tt.wantWatts {
+			t.Errorf("powerSurgeWatts(%d) = %d, want %d", tt.level, got, tt.wantWatts)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package gameplay
+
+import (
+	"sort"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/setup"
+	"darkstation/pkg/game/state"
+)
+
+// liveRoomNames returns the rooms that currently have at least one live-powered cell.
+func liveRoomNames(g *state.Game) map[string]bool {
+	rooms := map[string]bool{}
+	setup.CellsReachableFromPoweredGenerators(g).Each(func(c *world.Cell) {
+		if c != nil && c.Room && c.Name != "" {
+			rooms[c.Name] = true
+		}
+	})
+	return rooms
+}
+
+// animateGeneratorPowerUp plays the power-up effect for the generator at cell, sweeping
+// light through rooms that were not live in before, with the generator sound. Purely cosmetic.
+func animateGeneratorPowerUp(g *state.Game, cell *world.Cell, before map[string]bool) {
+	var rooms []string
+	for name := range liveRoomNames(g) {
+		if !before[name] {
+			rooms = append(rooms, name)
+		}
+	}
+	sort.Strings(rooms)
+	renderer.AddPowerUp(cell.Row, cell.Col, rooms)
+	renderer.PlaySound(renderer.SoundGeneratorOn)
+}
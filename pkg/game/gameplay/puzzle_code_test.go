@@ -0,0 +1,60 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func TestCheckAdjacentFurnitureAtCell_revealsCodeOnce(t *testing.T) {
+	tests := []struct {
+		name string
+		item *world.Item
+	}{
+		{"description only", nil},
+		{"with hidden item", world.NewItem("Wrench")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := makeTestGame(2, 3)
+			furniture := entities.NewFurniture("Desk", "A cluttered desk. Code: 1-2-3-4", "D")
+			furniture.ContainedItem = tt.item
+			gameworld.GetGameData(g.Grid.GetCell(0, 1)).Furniture = furniture
+
+			if !CheckAdjacentFurnitureAtCell(g, g.Grid.GetCell(0, 1)) {
+				t.Fatal("expected furniture interaction")
+			}
+			if !furniture.CodeRevealed {
+				t.Error("CodeRevealed = false after first search")
+			}
+			if !g.HasFoundCode("1-2-3-4") {
+				t.Error("code not added to found codes")
+			}
+			if got := revealFurnitureCode(g, furniture); got != "" {
+				t.Errorf("second reveal = %q, want empty", got)
+			}
+		})
+	}
+}
+
+func TestFurnitureCode_solvesMatchingPuzzle(t *testing.T) {
+	g := makeTestGame(2, 3)
+	furniture := entities.NewFurniture("Locker", "Dented locker. Code: 9-8-7-6", "L")
+	gameworld.GetGameData(g.Grid.GetCell(0, 1)).Furniture = furniture
+	puzzle := entities.NewPuzzleTerminal("Security Terminal #1", entities.PuzzleSequence, "9-8-7-6",
+		"", entities.RewardNone, "A security terminal requiring an access code.")
+	gameworld.GetGameData(g.Grid.GetCell(1, 0)).Puzzle = puzzle
+
+	CheckAdjacentPuzzlesAtCell(g, g.Grid.GetCell(1, 0))
+	if puzzle.IsSolved() {
+		t.Fatal("puzzle solved before the code was found")
+	}
+
+	CheckAdjacentFurnitureAtCell(g, g.Grid.GetCell(0, 1))
+	CheckAdjacentPuzzlesAtCell(g, g.Grid.GetCell(1, 0))
+	if !puzzle.IsSolved() {
+		t.Error("puzzle not solved after finding its code in furniture")
+	}
+}
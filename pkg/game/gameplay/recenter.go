@@ -0,0 +1,22 @@
+package gameplay
+
+import (
+	"time"
+
+	"darkstation/pkg/game/state"
+)
+
+// RecenterCamera brings the camera back to the player after browsing: it drops any room
+// focus and cuts an objective or hazard pan short with its usual smooth pan back. The
+// objective cycle starts over from the first objective. Returns true if the camera was
+// looking elsewhere.
+func RecenterCamera(g *state.Game) bool {
+	if g == nil || g.CurrentCell == nil {
+		return false
+	}
+	moved := g.MaintenanceMenuRoom != "" || (g.HazardTour != nil && g.HazardTour.Phase != state.HazardTourPanBack)
+	g.MaintenanceMenuRoom = ""
+	g.ObjectiveCycle = 0
+	g.HazardTour.ReturnNow(time.Now().UnixMilli())
+	return moved
+}
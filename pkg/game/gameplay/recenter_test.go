@@ -0,0 +1,29 @@
+package gameplay
+
+import (
+	"testing"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/state"
+)
+
+func TestRecenterCamera_cutsObjectivePanShort(t *testing.T) {
+	g := makeRouteTestGame(t)
+	if !CycleObjectiveFocus(g) {
+		t.Fatal("expected a focus pan")
+	}
+	g.MaintenanceMenuRoom = "Room"
+
+	// Recenter must get through while the pan blocks other input.
+	ProcessIntent(g, engineinput.Intent{Action: engineinput.ActionRecenter})
+
+	if g.HazardTour == nil || g.HazardTour.Phase != state.HazardTourPanBack {
+		t.Fatal("recenter should start the pan back to the player")
+	}
+	if g.MaintenanceMenuRoom != "" || g.ObjectiveCycle != 0 {
+		t.Error("recenter should clear the room focus and restart the objective cycle")
+	}
+	if RecenterCamera(g) {
+		t.Error("a second recenter while already panning back should report nothing to do")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/debuglog"
 	"darkstation/pkg/game/entities"
 	"darkstation/pkg/game/levelgen"
 	"darkstation/pkg/game/renderer"
@@ -160,6 +161,7 @@ func completeRepair(g *state.Game, repair *entities.RepairObjective, cell *world
 	}
 	now := time.Now().UnixMilli()
 	repair.BeginTimedCompletion(now)
+	debuglog.Info("repair.completed", "id", repair.ID, "name", repair.Name, "room", repair.RoomName, "draining", repair.IsDraining())
 	if repair.IsComplete() {
 		g.OnRoutingRepairComplete(repair.ID)
 	}
@@ -0,0 +1,88 @@
+// Package gameplay provides core game logic for player movement and interactions.
+package gameplay
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/debuglog"
+	"darkstation/pkg/game/levelseed"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// LayoutSignature hashes the current deck's walkable cells, room names and placed
+// entities. Matching signatures mean two generations produced the same deck, so a bug
+// report shows at a glance whether its seed reproduces the reporter's layout.
+func LayoutSignature(g *state.Game) string {
+	if g == nil || g.Grid == nil {
+		return ""
+	}
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%dx%d", g.Grid.Rows(), g.Grid.Cols())
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell == nil || !cell.Room {
+			return
+		}
+		data := gameworld.GetGameData(cell)
+		flags := 0
+		for i, placed := range []bool{
+			data.Door != nil, data.Generator != nil, data.MaintenanceTerm != nil,
+			data.Furniture != nil, data.Puzzle != nil, data.Terminal != nil,
+			data.Hazard != nil, data.RepairDevice != nil, cell.ItemsOnFloor.Size() > 0,
+		} {
+			if placed {
+				flags |= 1 << i
+			}
+		}
+		fmt.Fprintf(h, "|%d,%d,%s,%d", row, col, cell.Name, flags)
+	})
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// runLogContext identifies the run and deck being played for the debug log.
+func runLogContext(g *state.Game) []any {
+	mode := g.Mode()
+	return []any{
+		"mode", mode.ID,
+		"difficulty", mode.Difficulty,
+		"deck_size", mode.DeckSize,
+		"creative", mode.Creative,
+		"survival", mode.Survival,
+		"run_seed", levelseed.Format(g.RunSeed),
+		"level", g.Level,
+		"level_seed", levelseed.Format(g.LevelSeed),
+	}
+}
+
+// logDeckGenerated records a freshly generated deck and makes it the crash context.
+func logDeckGenerated(g *state.Game) {
+	if !debuglog.Enabled() {
+		return
+	}
+	debuglog.SetContext(runLogContext(g)...)
+	debuglog.Info("deck.generated", append(runLogContext(g),
+		"attempts", g.LevelGenAttempts,
+		"layout", LayoutSignature(g))...)
+}
+
+// LogRunState writes the player's standing (deck, moves, inventory, outcome) to the
+// debug log under event, e.g. when the run ends or the game quits.
+func LogRunState(g *state.Game, event string) {
+	if g == nil || !debuglog.Enabled() {
+		return
+	}
+	args := runLogContext(g)
+	if g.CurrentCell != nil {
+		args = append(args, "row", g.CurrentCell.Row, "col", g.CurrentCell.Col, "room", g.CurrentCell.Name)
+	}
+	args = append(args,
+		"moves", g.MovementCount,
+		"batteries", g.Batteries,
+		"decks_cleared", len(g.DeckHistory),
+		"game_over", g.GameOverCause,
+		"complete", g.GameComplete,
+		"layout", LayoutSignature(g))
+	debuglog.Info(event, args...)
+}
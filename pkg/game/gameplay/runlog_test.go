@@ -0,0 +1,22 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/entities"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func TestLayoutSignature_tracksPlacedEntities(t *testing.T) {
+	a, b := makeTestGame(3, 3), makeTestGame(3, 3)
+	if LayoutSignature(a) != LayoutSignature(b) {
+		t.Fatal("identical decks should share a signature")
+	}
+	gameworld.GetGameData(b.Grid.GetCell(1, 1)).Generator = entities.NewGenerator("G1", 1)
+	if LayoutSignature(a) == LayoutSignature(b) {
+		t.Fatal("placing a generator should change the signature")
+	}
+	if LayoutSignature(nil) != "" {
+		t.Error("a game without a deck has no signature")
+	}
+}
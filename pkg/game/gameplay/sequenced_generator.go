@@ -0,0 +1,96 @@
+package gameplay
+
+import (
+	"fmt"
+	"strings"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/debuglog"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/state"
+)
+
+// isTaggedBattery reports whether item is a sequenced-generator battery; these stay in
+// OwnedItems rather than the plain battery count.
+func isTaggedBattery(item *world.Item) bool {
+	return item != nil && item.Tag != "" && strings.Contains(strings.ToLower(item.Name), "battery")
+}
+
+// ownedTaggedBattery returns the carried battery with tag, or nil.
+func ownedTaggedBattery(g *state.Game, tag string) *world.Item {
+	var found *world.Item
+	g.OwnedItems.Each(func(item *world.Item) {
+		if found == nil && isTaggedBattery(item) && item.Tag == tag {
+			found = item
+		}
+	})
+	return found
+}
+
+// insertSequencedBatteries feeds a sequenced generator every carried tagged battery it
+// takes next, stopping at the first tag in the sequence the player does not hold.
+// Returns how many went in.
+func insertSequencedBatteries(g *state.Game, gen *entities.Generator) int {
+	if g == nil || !gen.IsSequenced() || gen.IsPowered() {
+		return 0
+	}
+	inserted := 0
+	for {
+		item := ownedTaggedBattery(g, gen.NextBatteryTag())
+		if item == nil || !gen.InsertTaggedBattery(item.Tag) {
+			break
+		}
+		g.OwnedItems.Remove(item)
+		inserted++
+		logMessage(g, "Inserted BATTERY{%s} into ROOM{%s}", item.Name, gen.Name)
+	}
+	if inserted > 0 {
+		renderer.PlaySound(renderer.SoundBatteryInsert)
+		debuglog.Info("generator.sequence", "name", gen.Name, "inserted", gen.BatteriesInserted, "required", gen.BatteriesRequired)
+		if next := gen.NextBatteryTag(); next != "" {
+			logMessage(g, "%s takes the BATTERY{%s} next", gen.Name, entities.TaggedBatteryName(next))
+		}
+	}
+	return inserted
+}
+
+// ownsOutOfOrderTaggedBattery reports whether the player carries a battery gen still
+// needs, just not the one it takes next.
+func ownsOutOfOrderTaggedBattery(g *state.Game, gen *entities.Generator) bool {
+	for _, tag := range gen.BatterySequence[gen.BatteriesInserted:] {
+		if tag != gen.NextBatteryTag() && ownedTaggedBattery(g, tag) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// sequencedGeneratorCalloutLines explains a sequenced generator's order in its callout:
+// inserted tags are marked powered, the next one is highlighted, and a carried battery
+// that does not fit yet is called out.
+func sequencedGeneratorCalloutLines(g *state.Game, gen *entities.Generator) string {
+	if !gen.IsSequenced() {
+		return ""
+	}
+	steps := make([]string, len(gen.BatterySequence))
+	for i, tag := range gen.BatterySequence {
+		switch {
+		case i < gen.BatteriesInserted:
+			steps[i] = fmt.Sprintf("POWERED{%s}", tag)
+		case i == gen.BatteriesInserted:
+			steps[i] = fmt.Sprintf("BATTERY{%s}", tag)
+		default:
+			steps[i] = fmt.Sprintf("SUBTLE{%s}", tag)
+		}
+	}
+	text := "SUBTLE{Sequenced: tagged batteries only, in order}\n"
+	text += "Order: " + strings.Join(steps, " → ") + "\n"
+	if next := gen.NextBatteryTag(); next != "" {
+		text += fmt.Sprintf("Next: BATTERY{%s}\n", entities.TaggedBatteryName(next))
+		if ownsOutOfOrderTaggedBattery(g, gen) {
+			text += "UNPOWERED{Wrong order — find the next battery first}\n"
+		}
+	}
+	return text
+}
@@ -0,0 +1,51 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func TestSequencedGenerator_PickUpAndInsertInOrder(t *testing.T) {
+	g := makeTestGame(2, 2)
+	genCell := g.Grid.GetCell(0, 1)
+	gen := entities.NewSequencedGenerator("G1", []string{"Red", "Blue"})
+	gameworld.GetGameData(genCell).Generator = gen
+	g.AddGenerator(gen)
+	g.CurrentCell.ItemsOnFloor.Put(world.NewTaggedItem(entities.TaggedBatteryName("Blue"), "Blue"))
+
+	pickUpItemsOnCell(g, g.CurrentCell)
+	if g.Batteries != 0 || ownedTaggedBattery(g, "Blue") == nil {
+		t.Fatalf("Blue battery went to the plain count (batteries=%d) instead of the carried items", g.Batteries)
+	}
+
+	CheckAdjacentGeneratorAtCell(g, genCell)
+	if gen.BatteriesInserted != 0 || ownedTaggedBattery(g, "Blue") == nil {
+		t.Fatal("Blue battery was inserted before Red")
+	}
+
+	g.OwnedItems.Put(world.NewTaggedItem(entities.TaggedBatteryName("Red"), "Red"))
+	CheckAdjacentGeneratorAtCell(g, genCell)
+	if gen.BatteriesInserted != 2 || g.OwnedItems.Size() != 0 {
+		t.Fatalf("inserted=%d carried=%d, want both batteries inserted", gen.BatteriesInserted, g.OwnedItems.Size())
+	}
+	if !GeneratorNeedsLongUsePowerUp(gen) {
+		t.Error("full sequence should leave the generator waiting for hold-to-use startup")
+	}
+}
+
+func TestCheckAdjacentGenerators_SkipsSequencedGenerator(t *testing.T) {
+	g := makeTestGame(2, 2)
+	gen := entities.NewSequencedGenerator("G1", []string{"Red", "Blue"})
+	gameworld.GetGameData(g.Grid.GetCell(0, 1)).Generator = gen
+	g.AddGenerator(gen)
+	g.Batteries = 3
+
+	CheckAdjacentGenerators(g)
+
+	if g.Batteries != 3 || gen.BatteriesInserted != 0 {
+		t.Errorf("batteries=%d inserted=%d, want plain batteries kept out of a sequenced generator", g.Batteries, gen.BatteriesInserted)
+	}
+}
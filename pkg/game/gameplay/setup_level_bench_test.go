@@ -0,0 +1,21 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/gamemode"
+	"darkstation/pkg/game/state"
+)
+
+// BenchmarkGenerateLevelLargeDeck times full deck generation (layout, setupLevel and the
+// simulated-playthrough gate) for a late, large deck with a fixed seed.
+func BenchmarkGenerateLevelLargeDeck(b *testing.B) {
+	const seed = int64(20260417)
+	const level = 8
+	for i := 0; i < b.N; i++ {
+		g := state.NewGame()
+		g.GameMode = g.Mode().WithOptions(gamemode.RunOptions{DeckSize: gamemode.DeckSizeLarge})
+		g.InitRunUnlocks(seed)
+		generateLevel(g, level, seed)
+	}
+}
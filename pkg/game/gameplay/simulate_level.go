@@ -0,0 +1,67 @@
+package gameplay
+
+import (
+	"fmt"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/gamemode"
+	"darkstation/pkg/game/setup"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// SolveReport is the outcome of SimulateLevel: what the generated deck holds and
+// whether the simulated player could finish it.
+type SolveReport struct {
+	Level       int
+	Seed        int64
+	Doors       int // Room doors, locked or not
+	LockedDoors int
+	Hazards     int // Blocking hazards
+	Generators  int
+	Solvable    bool
+	Failures    []string // setup.SimReport failures; empty when Solvable
+}
+
+// SimulateLevel generates deck level from seed the way a new run does, with no
+// renderer, and runs setup.SimulatePlaythrough on it: a greedy player collects
+// keycards and batteries, powers generators, clears hazards and completes repairs
+// until the exit lift is reachable and ready, or no progress is left. It is meant
+// for balance sweeps over many seeds; the same level and seed always give the same
+// report.
+func SimulateLevel(level int, seed int64) (SolveReport, error) {
+	g := state.NewGame()
+	g.SetMode(gamemode.SinglePlayerPuzzle)
+	if level < 1 || level > g.TotalDecks() {
+		return SolveReport{}, fmt.Errorf("level %d is out of range 1-%d", level, g.TotalDecks())
+	}
+	g.GameMode = g.Mode().WithOptions(gamemode.RunOptions{Seed: seed})
+	g.CurrentDeckID = level - 1
+	g.Level = level
+	g.InitRunUnlocks(seed)
+	LoadLevelFromSeed(g, seed)
+	if g.Grid == nil {
+		return SolveReport{}, fmt.Errorf("level %d seed %d generated no deck", level, seed)
+	}
+
+	report := SolveReport{Level: level, Seed: seed}
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		data := gameworld.GetGameData(cell)
+		if data.Door != nil {
+			report.Doors++
+		}
+		if gameworld.HasLockedDoor(cell) {
+			report.LockedDoors++
+		}
+		if gameworld.HasBlockingHazard(cell) {
+			report.Hazards++
+		}
+		if data.Generator != nil {
+			report.Generators++
+		}
+	})
+	sim := setup.SimulatePlaythrough(g)
+	report.Solvable = sim.Solvable
+	report.Failures = sim.Failures
+	return report, nil
+}
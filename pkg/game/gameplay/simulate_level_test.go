@@ -0,0 +1,70 @@
+package gameplay
+
+import (
+	"math/rand"
+	"os"
+	"strconv"
+	"testing"
+)
+
+// balanceSeedsEnv sets how many random seeds TestSimulateLevel_randomSeedsSolvable
+// tries per deck, e.g. DARKSTATION_BALANCE_SEEDS=1000 for a full balance sweep.
+const balanceSeedsEnv = "DARKSTATION_BALANCE_SEEDS"
+
+func TestSimulateLevel_reportsDeckContents(t *testing.T) {
+	report, err := SimulateLevel(3, 1779797637817431329)
+	if err != nil {
+		t.Fatalf("SimulateLevel: %v", err)
+	}
+	if !report.Solvable {
+		t.Fatalf("deck unsolvable: %v", report.Failures)
+	}
+	if report.Generators == 0 || report.Doors == 0 {
+		t.Errorf("report = %+v, want generators and doors on deck 3", report)
+	}
+	if report.LockedDoors > report.Doors {
+		t.Errorf("%d locked doors out of %d doors", report.LockedDoors, report.Doors)
+	}
+
+	again, err := SimulateLevel(3, 1779797637817431329)
+	if err != nil {
+		t.Fatalf("SimulateLevel: %v", err)
+	}
+	if again.Doors != report.Doors || again.Hazards != report.Hazards || again.Generators != report.Generators {
+		t.Errorf("same seed gave %+v then %+v", report, again)
+	}
+}
+
+func TestSimulateLevel_rejectsUnknownDeck(t *testing.T) {
+	for _, level := range []int{0, 11} {
+		if _, err := SimulateLevel(level, 1); err == nil {
+			t.Errorf("SimulateLevel(%d) succeeded, want an out-of-range error", level)
+		}
+	}
+}
+
+// TestSimulateLevel_randomSeedsSolvable checks random seeds on every deck. It tries one
+// seed per deck by default; set DARKSTATION_BALANCE_SEEDS for a larger sweep.
+func TestSimulateLevel_randomSeedsSolvable(t *testing.T) {
+	perLevel := 1
+	if v := os.Getenv(balanceSeedsEnv); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			t.Fatalf("%s=%q: want a positive seed count", balanceSeedsEnv, v)
+		}
+		perLevel = n
+	}
+	for level := 1; level <= 10; level++ {
+		rng := rand.New(rand.NewSource(int64(level)))
+		for i := 0; i < perLevel; i++ {
+			seed := rng.Int63()
+			report, err := SimulateLevel(level, seed)
+			if err != nil {
+				t.Fatalf("level %d seed %d: %v", level, seed, err)
+			}
+			if !report.Solvable {
+				t.Errorf("level %d seed %d unsolvable: %v", level, seed, report.Failures)
+			}
+		}
+	}
+}
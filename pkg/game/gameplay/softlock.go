@@ -0,0 +1,62 @@
+package gameplay
+
+import (
+	"log"
+	"strings"
+
+	"darkstation/pkg/game/config"
+	gamemenu "darkstation/pkg/game/menu"
+	"darkstation/pkg/game/setup"
+	"darkstation/pkg/game/state"
+)
+
+// softLockCheckMoves is how many steps pass between soft-lock checks. Each check runs the
+// whole-deck simulation, so it stays off the per-step path.
+const softLockCheckMoves = 15
+
+var runSoftLockMenu = gamemenu.RunSoftLockMenu
+
+// CheckSoftLock re-runs the solvability simulation against the player's current position,
+// inventory and deck every few steps. When the deck can no longer be finished it offers a
+// reset (same seed) or a reroll. Returns true when the offer was shown.
+func CheckSoftLock(g *state.Game) bool {
+	if !softLockCheckDue(g) {
+		return false
+	}
+	g.SoftLockCheckedAt = g.MovementCount
+	if !DeckSoftLocked(g) {
+		return false
+	}
+	logMessage(g, "Nothing left on this deck can get you to the lift.")
+	switch runSoftLockMenu(g) {
+	case gamemenu.SoftLockReset:
+		ResetLevel(g)
+	case gamemenu.SoftLockReroll:
+		RerollLevel(g)
+	default:
+		g.SoftLockDismissed = true
+		logMessage(g, "Press F5 to reset this deck at any time.")
+	}
+	return true
+}
+
+func softLockCheckDue(g *state.Game) bool {
+	if g == nil || g.Grid == nil || g.CurrentCell == nil || g.SoftLockDismissed || g.Creative() {
+		return false
+	}
+	if g.GameComplete || g.ExitAnimating || IsGameplayCinematicActive(g) || !config.Current().SoftLockCheck {
+		return false
+	}
+	return g.MovementCount-g.SoftLockCheckedAt >= softLockCheckMoves
+}
+
+// DeckSoftLocked reports whether the deck's objectives can no longer be completed from
+// where the player stands with what they carry.
+func DeckSoftLocked(g *state.Game) bool {
+	report := setup.SimulateFromCurrentState(g)
+	if report.Solvable {
+		return false
+	}
+	log.Printf("[SoftLock] deck %d unfinishable: %s", g.Level, strings.Join(report.Failures, "; "))
+	return true
+}
@@ -0,0 +1,56 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/entities"
+	gamemenu "darkstation/pkg/game/menu"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func TestCheckSoftLock_offersResetEveryFewStepsUntilDismissed(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	cfg := config.DefaultConfig()
+	config.SetCurrent(cfg)
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	shown := 0
+	orig := runSoftLockMenu
+	runSoftLockMenu = func(*state.Game) gamemenu.SoftLockChoice {
+		shown++
+		return gamemenu.SoftLockKeepPlaying
+	}
+	t.Cleanup(func() { runSoftLockMenu = orig })
+
+	// A vacuum breach between the player and the lift, with no Patch Kit anywhere.
+	g := makeTestGame(1, 3)
+	gameworld.GetGameData(g.Grid.GetCell(0, 1)).Hazard = entities.NewHazard(entities.HazardVacuum)
+	if !DeckSoftLocked(g) {
+		t.Fatal("deck with an unfixable breach should be soft-locked")
+	}
+
+	cfg.SoftLockCheck = false
+	g.MovementCount = softLockCheckMoves
+	if CheckSoftLock(g) || shown != 0 {
+		t.Fatal("check must not run with the setting off")
+	}
+	cfg.SoftLockCheck = true
+
+	g.MovementCount = softLockCheckMoves - 1
+	if CheckSoftLock(g) || shown != 0 {
+		t.Fatal("check must wait for the step interval")
+	}
+	g.MovementCount = softLockCheckMoves
+	if !CheckSoftLock(g) || shown != 1 {
+		t.Fatalf("soft-lock offer shown %d times, want 1", shown)
+	}
+	if !g.SoftLockDismissed {
+		t.Fatal("keeping playing should dismiss further offers on this deck")
+	}
+	g.MovementCount = 3 * softLockCheckMoves
+	if CheckSoftLock(g) || shown != 1 {
+		t.Fatal("a dismissed offer must not come back on the same deck")
+	}
+}
@@ -0,0 +1,65 @@
+package gameplay
+
+import (
+	"fmt"
+	"strings"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+)
+
+// StartingItemNames lists the items -give / GIVE may grant at the start of a run.
+// "Battery" may repeat to grant several.
+func StartingItemNames() []string {
+	return []string{
+		"Map",
+		"Battery",
+		entities.HazardTypes[entities.HazardVacuum].ItemName,
+		entities.CrewOverrideItemName,
+	}
+}
+
+// ParseStartingItems splits a comma-separated item list and resolves each entry to a
+// known item name (case-insensitive). Empty entries are ignored; unknown names are an error.
+func ParseStartingItems(spec string) ([]string, error) {
+	known := StartingItemNames()
+	var names []string
+	for _, raw := range strings.Split(spec, ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		name := ""
+		for _, k := range known {
+			if strings.EqualFold(raw, k) {
+				name = k
+				break
+			}
+		}
+		if name == "" {
+			return nil, fmt.Errorf("unknown item %q (known items: %s)", raw, strings.Join(known, ", "))
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// GrantStartingItems gives the player items from ParseStartingItems: the Map reveals the
+// station layout for the run, each Battery adds one to the battery count, and anything
+// else goes into deck inventory.
+func GrantStartingItems(g *state.Game, names []string) {
+	if g == nil {
+		return
+	}
+	for _, name := range names {
+		switch name {
+		case "Map":
+			g.HasMap = true
+		case "Battery":
+			g.AddBatteries(1)
+		default:
+			g.PickUpItem(world.NewItem(name))
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package gameplay
+
+import (
+	"strings"
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/gamemode"
+)
+
+func TestParseStartingItems_normalizesNames(t *testing.T) {
+	names, err := ParseStartingItems(" map, battery ,Battery,,crew override authorization")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"Map", "Battery", "Battery", entities.CrewOverrideItemName}
+	if strings.Join(names, "|") != strings.Join(want, "|") {
+		t.Fatalf("names = %v, want %v", names, want)
+	}
+}
+
+func TestParseStartingItems_rejectsUnknown(t *testing.T) {
+	_, err := ParseStartingItems("Map,Jetpack")
+	if err == nil || !strings.Contains(err.Error(), `"Jetpack"`) {
+		t.Fatalf("err = %v, want unknown item error naming Jetpack", err)
+	}
+}
+
+func TestBuildGameWithOptions_grantsStartingItems(t *testing.T) {
+	g := BuildGameWithOptions(1, gamemode.SinglePlayerPuzzle, gamemode.RunOptions{
+		Seed:          42,
+		StartingItems: []string{"Map", "Battery", "Battery", "Patch Kit"},
+	})
+	if !g.HasMap {
+		t.Error("expected map granted")
+	}
+	base := BuildGameWithOptions(1, gamemode.SinglePlayerPuzzle, gamemode.RunOptions{Seed: 42})
+	if g.Batteries != base.Batteries+2 {
+		t.Errorf("batteries = %d, want %d", g.Batteries, base.Batteries+2)
+	}
+	found := false
+	g.OwnedItems.Each(func(item *world.Item) {
+		if item.Name == "Patch Kit" {
+			found = true
+		}
+	})
+	if !found {
+		t.Error("expected Patch Kit in deck inventory")
+	}
+}
@@ -0,0 +1,46 @@
+package gameplay
+
+import (
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// PlayerIsStuck reports whether the player has no way forward: no neighbour can be
+// entered (counting keycards and carried hazard fixes) and nothing adjacent can be used.
+func PlayerIsStuck(g *state.Game) bool {
+	if g == nil || g.CurrentCell == nil {
+		return false
+	}
+	for _, n := range g.CurrentCell.GetNeighbors() {
+		if routePassable(g, n) || stuckEscapeAt(g, n) {
+			return false
+		}
+	}
+	return true
+}
+
+// stuckEscapeAt reports whether cell holds something the player could use to open a way.
+func stuckEscapeAt(g *state.Game, cell *world.Cell) bool {
+	if !cell.Room {
+		return false
+	}
+	if gameworld.HasDoor(cell) && !gameworld.HasLockedDoor(cell) {
+		return true // Unpowered doors can be released by hand
+	}
+	return gameworld.HasGenerator(cell) || gameworld.HasTerminal(cell) ||
+		gameworld.HasUnsolvedPuzzle(cell) || gameworld.HasMaintenanceTerminal(cell) ||
+		gameworld.HasHazardControl(cell) || gameworld.HasIncompleteRepairDevice(cell) ||
+		gameworld.HasWaitingSurvivor(cell) ||
+		gameworld.HasFurniture(cell) && !gameworld.GetGameData(cell).Furniture.IsChecked()
+}
+
+// announceIfStuck tells a boxed-in player how to get out instead of leaving them guessing.
+func announceIfStuck(g *state.Game) {
+	if !PlayerIsStuck(g) {
+		return
+	}
+	logMessage(g, "There is no way out of here. Press F5 to reroll this deck.")
+	renderer.AddCallout(g.CurrentCell.Row, g.CurrentCell.Col, "HAZARD{Boxed in}\nSUBTLE{F5 rerolls this deck}", renderer.CalloutColorInfo, 0)
+}
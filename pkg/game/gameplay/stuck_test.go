@@ -0,0 +1,34 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func TestPlayerIsStuck(t *testing.T) {
+	wall := func(g *state.Game, row, col int) { g.Grid.GetCell(row, col).Room = false }
+	tests := []struct {
+		name  string
+		setup func(g *state.Game)
+		want  bool
+	}{
+		{"walled in", func(g *state.Game) { wall(g, 0, 1); wall(g, 1, 0) }, true},
+		{"open neighbour", func(g *state.Game) { wall(g, 0, 1) }, false},
+		{"unchecked furniture", func(g *state.Game) {
+			wall(g, 0, 1)
+			gameworld.GetGameData(g.Grid.GetCell(1, 0)).Furniture = entities.NewFurniture("Desk", "desc", "D")
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := makeTestGame(3, 3)
+			tt.setup(g)
+			if got := PlayerIsStuck(g); got != tt.want {
+				t.Errorf("PlayerIsStuck = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
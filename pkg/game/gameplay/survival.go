@@ -0,0 +1,40 @@
+package gameplay
+
+import (
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/setup"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// generatorDrainMoves is how many player moves pass between survival drain ticks.
+const generatorDrainMoves = 5
+
+// DrainGenerators takes a tick of charge from every running generator on the deck
+// every generatorDrainMoves moves in a survival run. A generator whose battery runs
+// flat goes dark until the player refuels and restarts it. Called once per processed
+// input from TickTurn.
+func DrainGenerators(g *state.Game) {
+	if g == nil || g.Grid == nil || !g.Survival() || g.Creative() {
+		return
+	}
+	if g.MovementCount == 0 || g.MovementCount == g.GeneratorDrainAt || g.MovementCount%generatorDrainMoves != 0 {
+		return
+	}
+	g.GeneratorDrainAt = g.MovementCount
+
+	dark := 0
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		gen := gameworld.GetGameData(cell).Generator
+		if !gen.Drain() {
+			return
+		}
+		dark++
+		logMessage(g, "ROOM{%s} has run its battery flat and gone dark.", gen.Name)
+	})
+	if dark == 0 {
+		return
+	}
+	setup.NotifyPowerGridChanged(g)
+	UpdateLightingExploration(g)
+}
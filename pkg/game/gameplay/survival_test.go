@@ -0,0 +1,68 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/gamemode"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// makeSurvivalTestGame places a running one-battery generator at (0,2).
+func makeSurvivalTestGame(t *testing.T, survival bool) (*state.Game, *entities.Generator) {
+	t.Helper()
+	g := makeExploreTestGame(t, []string{"....."})
+	g.GameMode = gamemode.Get(gamemode.SinglePlayerPuzzle).WithOptions(gamemode.RunOptions{Survival: survival})
+	gen := entities.NewGenerator("Generator A", 1)
+	gen.InsertBatteriesAndStart(1)
+	gameworld.GetGameData(g.Grid.GetCell(0, 2)).Generator = gen
+	g.Generators = []*entities.Generator{gen}
+	return g, gen
+}
+
+func tickGeneratorDrain(g *state.Game, ticks int) {
+	for i := 0; i < ticks; i++ {
+		g.MovementCount += generatorDrainMoves
+		DrainGenerators(g)
+	}
+}
+
+func TestDrainGenerators_schedule(t *testing.T) {
+	g, gen := makeSurvivalTestGame(t, true)
+
+	g.MovementCount = generatorDrainMoves - 1
+	DrainGenerators(g)
+	if gen.Spent != 0 {
+		t.Fatalf("drained before %d moves", generatorDrainMoves)
+	}
+	g.MovementCount = generatorDrainMoves
+	DrainGenerators(g)
+	DrainGenerators(g)
+	if gen.Spent != 1 {
+		t.Fatalf("Spent = %d after one tick, want 1 (no double drain on the same move)", gen.Spent)
+	}
+
+	tickGeneratorDrain(g, entities.BatteryCharge-2)
+	if !gen.IsPowered() || !g.AllGeneratorsPowered() {
+		t.Fatal("generator went dark a tick early")
+	}
+	tickGeneratorDrain(g, 1)
+	if gen.IsPowered() || g.AllGeneratorsPowered() {
+		t.Fatalf("generator still running after %d moves", entities.BatteryCharge*generatorDrainMoves)
+	}
+
+	gen.InsertBatteries(1)
+	gen.BringOnline()
+	if !g.AllGeneratorsPowered() {
+		t.Error("refuelling and restarting should power the deck again")
+	}
+}
+
+func TestDrainGenerators_standardRunDoesNotDrain(t *testing.T) {
+	g, gen := makeSurvivalTestGame(t, false)
+	tickGeneratorDrain(g, entities.BatteryCharge)
+	if !gen.IsPowered() || gen.Spent != 0 {
+		t.Errorf("standard run drained the generator: powered=%v spent=%d", gen.IsPowered(), gen.Spent)
+	}
+}
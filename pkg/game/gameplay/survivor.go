@@ -0,0 +1,69 @@
+package gameplay
+
+import (
+	"fmt"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// CheckAdjacentSurvivorAtCell recruits a waiting survivor so they follow the player.
+// Returns true if a survivor was interacted with
+func CheckAdjacentSurvivorAtCell(g *state.Game, cell *world.Cell) bool {
+	if cell == nil || !gameworld.HasWaitingSurvivor(cell) {
+		return false
+	}
+	survivor := gameworld.GetGameData(cell).Survivor
+	survivor.Recruit()
+	logMessage(g, "%s will follow you to the lift. Keep them clear of hazards.", survivor.Name)
+	renderer.AddCallout(cell.Row, cell.Col, fmt.Sprintf("TITLE{%s}\nSUBTLE{Following you}", survivor.Name), renderer.CalloutColorSuccess, 0)
+	return true
+}
+
+// followSurvivor moves a recruited survivor one step toward prior, the cell the player
+// just left. Survivors never step into hazards; when no safe step exists they wait.
+func followSurvivor(g *state.Game, prior *world.Cell) {
+	cell, survivor := g.DeckSurvivor()
+	if !survivor.IsFollowing() || prior == nil || cell == prior {
+		return
+	}
+	// If the player stepped onto the survivor they swap places; otherwise close the gap.
+	next := prior
+	if cell != g.CurrentCell {
+		path := world.FindPath(cell, prior, func(c *world.Cell) bool {
+			return c != g.CurrentCell && survivorPassable(g, c)
+		})
+		if len(path) < 2 || !survivorPassable(g, path[1]) {
+			if !survivor.Stalled {
+				survivor.Stalled = true
+				logMessage(g, "%s won't follow you through there.", survivor.Name)
+			}
+			return
+		}
+		next = path[1]
+	}
+	survivor.Stalled = false
+	gameworld.GetGameData(cell).Survivor = nil
+	gameworld.GetGameData(next).Survivor = survivor
+}
+
+// survivorPassable reports whether a survivor may step onto cell: anywhere the player
+// could walk, except hazards (blocking or ambient) and the lift itself.
+func survivorPassable(g *state.Game, cell *world.Cell) bool {
+	if cell == nil || cell.ExitCell || gameworld.HasBlockingHazard(cell) || gameworld.AmbientHazardAt(cell) != nil {
+		return false
+	}
+	return routePassable(g, cell)
+}
+
+// rescueEscortedSurvivor takes an escorted survivor off the deck as the lift departs.
+func rescueEscortedSurvivor(g *state.Game) {
+	cell, survivor := g.DeckSurvivor()
+	if !survivor.IsFollowing() || !g.SurvivorEscorted() {
+		return
+	}
+	gameworld.GetGameData(cell).Survivor = nil
+	logMessage(g, "%s is safe aboard the lift.", survivor.Name)
+}
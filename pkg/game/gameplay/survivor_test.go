@@ -0,0 +1,103 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func placeTestSurvivor(g *state.Game, row, col int) *entities.Survivor {
+	s := entities.NewSurvivor("Ensign Vale")
+	gameworld.GetGameData(g.Grid.GetCell(row, col)).Survivor = s
+	return s
+}
+
+func TestWaitingSurvivor_blocksUntilRecruited(t *testing.T) {
+	g := makeTestGame(1, 3)
+	s := placeTestSurvivor(g, 0, 1)
+	cell := g.Grid.GetCell(0, 1)
+
+	if ok, _ := CanEnter(g, cell, false); ok {
+		t.Fatal("waiting survivor should block movement")
+	}
+	if !CheckAdjacentInteractables(g) {
+		t.Fatal("expected the adjacent survivor to be interactable")
+	}
+	if !s.IsFollowing() {
+		t.Fatal("interacting should recruit the survivor")
+	}
+	if ok, _ := CanEnter(g, cell, false); !ok {
+		t.Error("following survivor should not block movement")
+	}
+}
+
+func TestFollowSurvivor(t *testing.T) {
+	tests := []struct {
+		name         string
+		survivor     [2]int
+		moves        [][2]int
+		ambient      [2]int // {-1,-1} for none
+		wantSurvivor [2]int
+	}{
+		{"follows to prior cell", [2]int{0, 0}, [][2]int{{0, 1}, {0, 2}}, [2]int{-1, -1}, [2]int{0, 1}},
+		{"swaps when stepped on", [2]int{0, 1}, [][2]int{{0, 1}}, [2]int{-1, -1}, [2]int{0, 0}},
+		{"paths around to close the gap", [2]int{1, 0}, [][2]int{{0, 1}}, [2]int{-1, -1}, [2]int{0, 0}},
+		{"refuses ambient hazard", [2]int{0, 0}, [][2]int{{0, 1}, {0, 2}}, [2]int{0, 1}, [2]int{0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := makeTestGame(2, 4)
+			if tt.ambient[0] >= 0 {
+				gameworld.GetGameData(g.Grid.GetCell(tt.ambient[0], tt.ambient[1])).AmbientHazard = entities.NewHazard(entities.HazardPowerBleed)
+			}
+			s := placeTestSurvivor(g, tt.survivor[0], tt.survivor[1])
+			s.Recruit()
+
+			for _, m := range tt.moves {
+				MoveCell(g, g.Grid.GetCell(m[0], m[1]))
+			}
+
+			cell, got := g.DeckSurvivor()
+			if got != s {
+				t.Fatal("survivor lost from the deck")
+			}
+			if cell.Row != tt.wantSurvivor[0] || cell.Col != tt.wantSurvivor[1] {
+				t.Errorf("survivor at (%d,%d), want (%d,%d)", cell.Row, cell.Col, tt.wantSurvivor[0], tt.wantSurvivor[1])
+			}
+		})
+	}
+}
+
+func TestSurvivorEscorted(t *testing.T) {
+	g := makeTestGame(1, 4)
+	if !g.SurvivorEscorted() {
+		t.Fatal("a deck without a survivor needs no escort")
+	}
+	s := placeTestSurvivor(g, 0, 1)
+	if g.SurvivorEscorted() {
+		t.Error("waiting survivor should not count as escorted")
+	}
+	s.Recruit()
+	if !g.SurvivorEscorted() {
+		t.Error("adjacent following survivor should count as escorted")
+	}
+	g.CurrentCell = g.Grid.GetCell(0, 3)
+	if g.SurvivorEscorted() {
+		t.Error("survivor left behind should not count as escorted")
+	}
+
+	g.CurrentCell = g.Grid.GetCell(0, 2)
+	rescueEscortedSurvivor(g)
+	if _, left := g.DeckSurvivor(); left != nil {
+		t.Error("escorted survivor should leave the deck with the lift")
+	}
+}
+
+func TestSurvivorPassable_excludesLift(t *testing.T) {
+	g := makeTestGame(2, 2)
+	if survivorPassable(g, g.Grid.ExitCell()) {
+		t.Error("survivor should never step onto the lift")
+	}
+}
@@ -22,6 +22,7 @@ const (
 )
 
 // TravelToDeck saves the current deck and moves the player to targetLevel (1-based).
+// Entering a deck for the first time writes the autosave checkpoint.
 func TravelToDeck(g *state.Game, targetLevel int) error {
 	if g == nil {
 		return fmt.Errorf("no game state")
@@ -37,10 +38,19 @@ func TravelToDeck(g *state.Game, targetLevel int) error {
 		return fmt.Errorf("%s", g.DeckTravelBlockReason(targetID))
 	}
 
+	// Descending past a deck means its unlock gate was satisfied: log it as cleared.
+	clearedLevel, newPar := g.Level, false
+	if targetID > g.CurrentDeckID {
+		newPar = recordDeckPar(g)
+		g.RecordDeckCleared()
+	}
 	g.SaveCurrentDeckState()
+	// Drop the old deck's hum; lighting restarts it if the new deck has power.
+	renderer.SetAmbientHum(false)
 	clearCrossDeckPowerState(g)
 	clearCompletionState(g)
 
+	generated := false
 	if ds := g.DeckStates[targetID]; ds != nil && ds.Grid != nil {
 		g.LoadDeckState(targetID)
 		applyLoadedDeckFixups(g)
@@ -49,16 +59,32 @@ func TravelToDeck(g *state.Game, targetLevel int) error {
 	} else {
 		g.CurrentDeckID = targetID
 		g.Level = targetLevel
-		seed := g.RunSeed + int64(targetID)*9973
-		generateLevel(g, targetLevel, seed)
+		if !adoptPreviewDeck(g, targetID) {
+			generateLevel(g, targetLevel, deckSeed(g, targetLevel))
+		}
+		looted := g.LootedDecks[targetID]
+		if looted {
+			lootRebuiltDeck(g)
+			delete(g.LootedDecks, targetID)
+		}
 		refreshDeckPower(g)
 		g.SaveCurrentDeckState()
 		UpdateLightingExploration(g)
+		generated = !looted
 	}
 
 	spawnOnDeckEntry(g, SpawnModeLiftShaft)
+	renderer.PlaySound(renderer.SoundExit)
 	g.ClearMessages()
 	logMessage(g, "Lift routing: deck %d.", g.Level)
+	if newPar && g.CurrentCell != nil {
+		logMessage(g, "New best for Deck %d!", clearedLevel)
+		renderer.AddCallout(g.CurrentCell.Row, g.CurrentCell.Col, fmt.Sprintf("TITLE{New best for Deck %d!}", clearedLevel), renderer.CalloutColorInfo, 3000)
+	}
+	announceIfStuck(g)
+	if generated {
+		writeAutosave(g)
+	}
 	return nil
 }
 
@@ -71,6 +97,7 @@ func spawnOnDeckEntry(g *state.Game, mode SpawnMode) {
 	if g == nil || g.Grid == nil {
 		return
 	}
+	g.MarkDeckEntry()
 	if mode == SpawnModeShip {
 		if start := g.Grid.StartCell(); start != nil && start.Name == generator.ShipRoomName {
 			TeleportPlayerTo(g, start)
@@ -106,19 +133,25 @@ func TryUseLift(g *state.Game) bool {
 	}
 
 	switch setup.ExitLiftState(g) {
-	case state.ExitLiftLockedUnpowered, state.ExitLiftLockedIncomplete:
+	case state.ExitLiftLockedUnpowered, state.ExitLiftLockedIncomplete, state.ExitLiftLockedLowPower:
 		CheckAdjacentExitLiftAtCell(g, cell)
 		return true
 	}
 	if !setup.ExitLiftReady(g) {
 		return false
 	}
+	rescueEscortedSurvivor(g)
 
+	if g.Mode().Endless {
+		DescendEndless(g)
+		return true
+	}
 	if g.IsFinalDeckLevel(g.Level) {
 		TriggerGameComplete(g)
 		return true
 	}
 
+	previewNextDeck(g)
 	targetLevel, ok := gamemenu.RunLiftMenu(g)
 	if !ok || targetLevel <= 0 {
 		return true
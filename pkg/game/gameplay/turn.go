@@ -0,0 +1,19 @@
+package gameplay
+
+import "darkstation/pkg/game/state"
+
+// TickTurn runs the world's per-input updates after the player has acted: ambient
+// hazard costs, hazard spread, the patrol, generator drain, deck radiation, the
+// objective route, the stuck nudge and the power surge schedule. Every path in the main
+// loop that moves the player (keys, auto-explore, click-to-walk, surge polling) calls it
+// once per step so none of them skips a tick.
+func TickTurn(g *state.Game) {
+	ApplyAmbientHazards(g)
+	SpreadHazards(g)
+	MovePatrol(g)
+	DrainGenerators(g)
+	UpdateDeckRadiation(g)
+	UpdateObjectiveRoute(g)
+	UpdateStuckNudge(g)
+	UpdatePowerSurge(g)
+}
@@ -0,0 +1,24 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/config"
+)
+
+func TestTickTurn_runsEveryPerTurnUpdate(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	g, patrol := makePatrolTestGame(t, 0)
+	g.MovementCount = 1
+	TickTurn(g)
+
+	if patrol.Col != 3 {
+		t.Errorf("bot at col %d after a tick, want 3", patrol.Col)
+	}
+	if g.Progress.AtMs == 0 {
+		t.Error("a tick should start the stuck-nudge clock")
+	}
+}
@@ -0,0 +1,115 @@
+package gameplay
+
+import (
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// IsWalkToActive reports whether the player is walking to a clicked cell.
+func IsWalkToActive(g *state.Game) bool {
+	return g != nil && g.WalkTo != nil
+}
+
+// StartWalkTo begins walking the player to the cell at row, col along a known route
+// (see gameworld.ComputePath). Doors and hazards already discovered are noted so only
+// newly spotted ones stop the walk.
+func StartWalkTo(g *state.Game, row, col int) {
+	if g == nil || g.Grid == nil || g.CurrentCell == nil {
+		return
+	}
+	target := g.Grid.GetCell(row, col)
+	if target == nil || target == g.CurrentCell || !target.Discovered {
+		return
+	}
+	if len(gameworld.ComputePath(g.Grid, g.CurrentCell, target)) < 2 {
+		logMessage(g, "No known route there.")
+		return
+	}
+	StopAutoExplore(g, "")
+	g.WalkTo = state.NewWalkToSession(target)
+	noteWalkToStop(g)
+}
+
+// StopWalkTo ends the walk, logging reason when non-empty.
+func StopWalkTo(g *state.Game, reason string) {
+	if g == nil || g.WalkTo == nil {
+		return
+	}
+	g.WalkTo = nil
+	if reason != "" {
+		logMessage(g, "Walk stopped: %s", reason)
+	}
+}
+
+// StepWalkTo moves the player one step along the route to the walk's target. The
+// route is recomputed each step, so doors that lock or hazards that appear are routed
+// around; the walk stops on arrival, when no route is left, when a move fails, when
+// the patrol bot blocks the next step, or when the bot, a door or a hazard comes into
+// view.
+func StepWalkTo(g *state.Game) {
+	if !IsWalkToActive(g) || g.CurrentCell == nil {
+		return
+	}
+	session := g.WalkTo
+	start := g.CurrentCell
+	path := gameworld.ComputePath(g.Grid, start, session.Target)
+	if len(path) < 2 {
+		StopWalkTo(g, "no route from here.")
+		return
+	}
+	if gameworld.HasPatrol(path[1]) {
+		StopWalkTo(g, "patrol ahead.")
+		return
+	}
+
+	MoveCell(g, path[1])
+	if g.WalkTo != session {
+		return // the move ended the walk (deck change, game over)
+	}
+	if g.CurrentCell == start && riskyMovePending(g, path[1]) {
+		StopWalkTo(g, "hazard ahead.")
+		return
+	}
+	if g.CurrentCell == start && !g.AmbientHazard.Wading {
+		StopWalkTo(g, "the way is blocked.")
+		return
+	}
+	if reason := noteWalkToStop(g); reason != "" {
+		StopWalkTo(g, reason)
+		return
+	}
+	if g.CurrentCell == session.Target {
+		StopWalkTo(g, "")
+	}
+}
+
+// noteWalkToStop marks discovered doors and hazards as seen and returns a stop reason
+// for the first one that was not seen before, or "". The patrol bot coming into view
+// takes precedence.
+func noteWalkToStop(g *state.Game) string {
+	session := g.WalkTo
+	reason := ""
+	if notePatrolInView(g, &session.PatrolInView) {
+		reason = "patrol spotted."
+	}
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if !cell.Discovered || session.Seen[cell] {
+			return
+		}
+		why := ""
+		switch {
+		case gameworld.HasBlockingHazard(cell):
+			why = "hazard spotted."
+		case gameworld.HasDoor(cell):
+			why = "door spotted."
+		default:
+			return
+		}
+		session.Seen[cell] = true
+		if reason == "" {
+			reason = why
+		}
+	})
+	return reason
+}
@@ -0,0 +1,131 @@
+package gameplay
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+var walkToCorridor = []string{
+	".....",
+	".....",
+}
+
+func makeWalkToTestGame(t *testing.T) *state.Game {
+	t.Helper()
+	g := makeExploreTestGame(t, walkToCorridor)
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell.Room {
+			cell.Discovered = true
+		}
+	})
+	return g
+}
+
+func TestComputePath_routesAroundLockedDoor(t *testing.T) {
+	g := makeWalkToTestGame(t)
+	door := g.Grid.GetCell(0, 2)
+	gameworld.GetGameData(door).Door = entities.NewDoor("Vault")
+
+	path := gameworld.ComputePath(g.Grid, g.CurrentCell, g.Grid.GetCell(0, 4))
+	if len(path) != 7 {
+		t.Fatalf("path length = %d, want 7 (detour through row 1)", len(path))
+	}
+	for _, cell := range path {
+		if cell == door {
+			t.Fatal("path crosses the locked door")
+		}
+	}
+
+	gameworld.GetGameData(door).Door.Unlock()
+	if path := gameworld.ComputePath(g.Grid, g.CurrentCell, g.Grid.GetCell(0, 4)); len(path) != 5 {
+		t.Errorf("path length through unlocked door = %d, want 5", len(path))
+	}
+}
+
+func TestComputePath_noRouteThroughBlockingHazards(t *testing.T) {
+	g := makeWalkToTestGame(t)
+	gameworld.GetGameData(g.Grid.GetCell(0, 2)).Door = entities.NewDoor("Vault")
+	gameworld.GetGameData(g.Grid.GetCell(1, 2)).Hazard = entities.NewHazard(entities.HazardElectrical)
+
+	if path := gameworld.ComputePath(g.Grid, g.CurrentCell, g.Grid.GetCell(0, 4)); path != nil {
+		t.Errorf("ComputePath = %d cells, want no route", len(path))
+	}
+}
+
+func TestComputePath_undiscoveredTarget(t *testing.T) {
+	g := makeWalkToTestGame(t)
+	target := g.Grid.GetCell(1, 4)
+	target.Discovered = false
+
+	if path := gameworld.ComputePath(g.Grid, g.CurrentCell, target); path != nil {
+		t.Errorf("ComputePath to undiscovered cell = %d cells, want nil", len(path))
+	}
+	StartWalkTo(g, 1, 4)
+	if IsWalkToActive(g) {
+		t.Error("walk started toward an undiscovered cell")
+	}
+}
+
+func TestWalkTo_arrivesAndStops(t *testing.T) {
+	g := makeWalkToTestGame(t)
+	StartWalkTo(g, 1, 4)
+	if !IsWalkToActive(g) {
+		t.Fatal("walk did not start")
+	}
+	for steps := 0; IsWalkToActive(g) && steps < 20; steps++ {
+		StepWalkTo(g)
+	}
+	if IsWalkToActive(g) {
+		t.Fatal("walk still active after step budget")
+	}
+	if g.CurrentCell != g.Grid.GetCell(1, 4) {
+		t.Errorf("stopped at (%d,%d), want (1,4)", g.CurrentCell.Row, g.CurrentCell.Col)
+	}
+}
+
+func TestWalkTo_stopsWhenNewHazardSpotted(t *testing.T) {
+	g := makeWalkToTestGame(t)
+	hazard := g.Grid.GetCell(1, 2)
+	hazard.Discovered = false
+	gameworld.GetGameData(hazard).Hazard = entities.NewHazard(entities.HazardElectrical)
+
+	StartWalkTo(g, 0, 4)
+	if !IsWalkToActive(g) {
+		t.Fatal("walk did not start")
+	}
+	hazard.Discovered = true
+	StepWalkTo(g)
+	if IsWalkToActive(g) {
+		t.Error("walk kept going after a new hazard came into view")
+	}
+}
+
+func TestWalkTo_stopsForPatrol(t *testing.T) {
+	g := makeWalkToTestGame(t)
+	StartWalkTo(g, 0, 4)
+	if !IsWalkToActive(g) {
+		t.Fatal("walk did not start")
+	}
+	// The bot rolls in after the walk began.
+	bot := g.Grid.GetCell(1, 3)
+	gameworld.GetGameData(bot).Patrol = entities.NewPatrol("Maintenance Bot", 1, 3, world.West)
+	StepWalkTo(g)
+	if IsWalkToActive(g) {
+		t.Fatal("walk kept going after the patrol came into view")
+	}
+
+	// With the bot already in view the walk may start, but it never steps into the bot.
+	StartWalkTo(g, 0, 4)
+	gameworld.GetGameData(bot).Patrol = nil
+	next := g.Grid.GetCell(0, 2)
+	gameworld.GetGameData(next).Patrol = entities.NewPatrol("Maintenance Bot", 0, 2, world.West)
+	from := g.CurrentCell
+	StepWalkTo(g)
+	if IsWalkToActive(g) || g.CurrentCell != from {
+		t.Errorf("walk should stop short of a patrol on the next cell; at (%d,%d)", g.CurrentCell.Row, g.CurrentCell.Col)
+	}
+}
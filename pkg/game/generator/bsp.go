@@ -1,8 +1,8 @@
 package generator
 
 import (
-	"darkstation/pkg/game/levelrand"
 	"fmt"
+	"math/rand"
 
 	"darkstation/pkg/engine/world"
 	"darkstation/pkg/game/deck"
@@ -27,10 +27,21 @@ type bspNode struct {
 type bspRoom struct {
 	x, y, width, height int
 	name, description   string
+
+	// Non-rectangular shape (see room_shapes.go); zero value is a full rectangle.
+	shape          roomShape
+	notchCorner    roomCorner
+	notchW, notchH int
 }
 
-// Global room counter for unique names
-var roomCounter int
+// bspBuild is the state of one GenerateWithOptions call: its RNG, the room shape seed
+// and the counter that keeps repeated room names unique. Keeping it per call lets decks
+// generate concurrently.
+type bspBuild struct {
+	rng         *rand.Rand
+	shapeSeed   int64
+	roomCounter int
+}
 
 // Constants for BSP generation
 const (
@@ -40,18 +51,16 @@ const (
 	corridorWidth = 3 // Width of corridors (wall + path + wall)
 )
 
-// Generate creates a new grid using BSP algorithm.
+// Generate creates a new grid using BSP algorithm, drawing every random choice from rng.
 // Deck theme drives room naming; final deck uses minimal layout.
-func (g *BSPGenerator) Generate(level int, theme deck.Theme) *world.Grid {
-	return g.GenerateWithOptions(level, theme, GenerateOptions{})
+func (g *BSPGenerator) Generate(level int, theme deck.Theme, rng *rand.Rand) *world.Grid {
+	return g.GenerateWithOptions(level, theme, GenerateOptions{}, rng)
 }
 
 // GenerateWithOptions creates a grid with mode-specific layout overrides.
-func (g *BSPGenerator) GenerateWithOptions(level int, theme deck.Theme, opts GenerateOptions) *world.Grid {
+func (g *BSPGenerator) GenerateWithOptions(level int, theme deck.Theme, opts GenerateOptions, rng *rand.Rand) *world.Grid {
 	grid := &world.Grid{}
-
-	// Reset room counter for this level
-	roomCounter = 0
+	b := &bspBuild{rng: rng, shapeSeed: opts.ShapeSeed}
 
 	roomBases, roomAdjectives := deck.RoomNamesForTheme(theme)
 
@@ -63,6 +72,9 @@ func (g *BSPGenerator) GenerateWithOptions(level int, theme deck.Theme, opts Gen
 	// Grid size: airlock, bull-curve middle decks, minimal final deck.
 	isFinal := deck.IsFinalDeck(layoutLevel)
 	rows, cols := deckGridDimensions(layoutLevel)
+	if level != 1 && !isFinal {
+		rows, cols = scaleDeckDimensions(rows, cols, opts.SizePercent)
+	}
 	if opts.PlayRows > 0 && opts.PlayCols > 0 {
 		const wallBorder = 2
 		rows = opts.PlayRows + wallBorder
@@ -94,10 +106,10 @@ func (g *BSPGenerator) GenerateWithOptions(level int, theme deck.Theme, opts Gen
 	if isFinal && opts.PlayRows == 0 && opts.PlayCols == 0 {
 		minSize = 7 // 14×8 playable area can split into 2–3 rooms; 14 prevented any split
 	}
-	splitBSP(root, minSize)
+	b.splitBSP(root, minSize)
 
 	usedRoomNames := make(map[string]bool)
-	createRooms(root, roomBases, roomAdjectives, usedRoomNames)
+	b.createRooms(root, roomBases, roomAdjectives, usedRoomNames)
 
 	// Carve rooms into the grid (the shaft is carved as a regular room)
 	carveRooms(grid, root)
@@ -107,7 +119,10 @@ func (g *BSPGenerator) GenerateWithOptions(level int, theme deck.Theme, opts Gen
 	}
 
 	// Connect rooms with corridors (after deck 1 west overlay is carved as rooms).
-	connectRooms(grid, root)
+	b.connectRooms(grid, root)
+
+	// Pillars go in after corridors so they never block a corridor or doorway.
+	carveRoomPillars(grid, root)
 
 	if level == 1 && !opts.SkipDeck1ShipOverlay {
 		// Corridors may intrude into the overlay pocket; restore the fixed ship layout.
@@ -147,11 +162,11 @@ func (g *BSPGenerator) GenerateWithOptions(level int, theme deck.Theme, opts Gen
 }
 
 // splitBSP recursively splits a BSP node
-func splitBSP(node *bspNode, minSize int) {
+func (b *bspBuild) splitBSP(node *bspNode, minSize int) {
 	// Descend through forced splits (e.g. the reserved shaft region).
 	if node.left != nil || node.right != nil {
-		splitBSP(node.left, minSize)
-		splitBSP(node.right, minSize)
+		b.splitBSP(node.left, minSize)
+		b.splitBSP(node.right, minSize)
 		return
 	}
 	if node.room != nil {
@@ -168,7 +183,7 @@ func splitBSP(node *bspNode, minSize int) {
 	} else if node.height > node.width && node.height >= minSize*2 {
 		splitHorizontal = true // Split horizontally
 	} else if node.width >= minSize*2 && node.height >= minSize*2 {
-		splitHorizontal = levelrand.Intn(2) == 0
+		splitHorizontal = b.rng.Intn(2) == 0
 	} else if node.width >= minSize*2 {
 		splitHorizontal = false
 	} else if node.height >= minSize*2 {
@@ -179,7 +194,7 @@ func splitBSP(node *bspNode, minSize int) {
 
 	if splitHorizontal {
 		// Split horizontally (top and bottom)
-		splitPoint := minSize + levelrand.Intn(node.height-minSize*2+1)
+		splitPoint := minSize + b.rng.Intn(node.height-minSize*2+1)
 		node.left = &bspNode{
 			x:      node.x,
 			y:      node.y,
@@ -194,7 +209,7 @@ func splitBSP(node *bspNode, minSize int) {
 		}
 	} else {
 		// Split vertically (left and right)
-		splitPoint := minSize + levelrand.Intn(node.width-minSize*2+1)
+		splitPoint := minSize + b.rng.Intn(node.width-minSize*2+1)
 		node.left = &bspNode{
 			x:      node.x,
 			y:      node.y,
@@ -210,19 +225,19 @@ func splitBSP(node *bspNode, minSize int) {
 	}
 
 	// Recursively split children
-	splitBSP(node.left, minSize)
-	splitBSP(node.right, minSize)
+	b.splitBSP(node.left, minSize)
+	b.splitBSP(node.right, minSize)
 }
 
 // createRooms creates rooms in leaf nodes using thematic bases and adjectives.
 // Each room name is unique within the generated deck (usedRoomNames tracks assignments).
-func createRooms(node *bspNode, bases, adjectives []string, usedRoomNames map[string]bool) {
+func (b *bspBuild) createRooms(node *bspNode, bases, adjectives []string, usedRoomNames map[string]bool) {
 	if node.left != nil || node.right != nil {
 		if node.left != nil {
-			createRooms(node.left, bases, adjectives, usedRoomNames)
+			b.createRooms(node.left, bases, adjectives, usedRoomNames)
 		}
 		if node.right != nil {
-			createRooms(node.right, bases, adjectives, usedRoomNames)
+			b.createRooms(node.right, bases, adjectives, usedRoomNames)
 		}
 		return
 	}
@@ -230,8 +245,8 @@ func createRooms(node *bspNode, bases, adjectives []string, usedRoomNames map[st
 		return // Reserved leaf (lift shaft) already has its room
 	}
 
-	roomWidth := minRoomSize + levelrand.Intn(node.width-minRoomSize-roomPadding+1)
-	roomHeight := minRoomSize + levelrand.Intn(node.height-minRoomSize-roomPadding+1)
+	roomWidth := minRoomSize + b.rng.Intn(node.width-minRoomSize-roomPadding+1)
+	roomHeight := minRoomSize + b.rng.Intn(node.height-minRoomSize-roomPadding+1)
 
 	if roomWidth > node.width-roomPadding {
 		roomWidth = node.width - roomPadding
@@ -240,22 +255,22 @@ func createRooms(node *bspNode, bases, adjectives []string, usedRoomNames map[st
 		roomHeight = node.height - roomPadding
 	}
 
-	roomX := node.x + levelrand.Intn(node.width-roomWidth)
-	roomY := node.y + levelrand.Intn(node.height-roomHeight)
+	roomX := node.x + b.rng.Intn(node.width-roomWidth)
+	roomY := node.y + b.rng.Intn(node.height-roomHeight)
 
-	roomCounter++
+	b.roomCounter++
 	if len(adjectives) == 0 {
 		adjectives = []string{"Emergency"}
 	}
 	if len(bases) == 0 {
 		bases = []string{"Section"}
 	}
-	adjective := adjectives[levelrand.Intn(len(adjectives))]
-	baseName := bases[levelrand.Intn(len(bases))]
+	adjective := adjectives[b.rng.Intn(len(adjectives))]
+	baseName := bases[b.rng.Intn(len(bases))]
 	name := fmt.Sprintf("%s %s", adjective, baseName)
 	for usedRoomNames[name] {
-		roomCounter++
-		name = fmt.Sprintf("%s %s %d", adjective, baseName, roomCounter)
+		b.roomCounter++
+		name = fmt.Sprintf("%s %s %d", adjective, baseName, b.roomCounter)
 	}
 	usedRoomNames[name] = true
 	description := fmt.Sprintf("ROOM_%s", baseName)
@@ -268,6 +283,7 @@ func createRooms(node *bspNode, bases, adjectives []string, usedRoomNames map[st
 		name:        name,
 		description: description,
 	}
+	assignRoomShape(node.room, b.shapeSeed)
 }
 
 // carveRooms marks room cells as walkable in the grid.
@@ -275,6 +291,9 @@ func carveRooms(grid *world.Grid, node *bspNode) {
 	if node.room != nil {
 		for row := node.room.y; row < node.room.y+node.room.height; row++ {
 			for col := node.room.x; col < node.room.x+node.room.width; col++ {
+				if node.room.inNotch(row, col) {
+					continue
+				}
 				grid.MarkAsRoomWithName(row, col, node.room.name, node.room.description)
 			}
 		}
@@ -289,14 +308,14 @@ func carveRooms(grid *world.Grid, node *bspNode) {
 }
 
 // connectRooms connects rooms with corridors
-func connectRooms(grid *world.Grid, node *bspNode) {
+func (b *bspBuild) connectRooms(grid *world.Grid, node *bspNode) {
 	if node.left == nil || node.right == nil {
 		return
 	}
 
 	// Get a room from each subtree
-	leftRoom := getRoom(node.left)
-	rightRoom := getRoom(node.right)
+	leftRoom := b.getRoom(node.left)
+	rightRoom := b.getRoom(node.right)
 
 	if leftRoom != nil && rightRoom != nil {
 		// Get center points of each room
@@ -306,7 +325,7 @@ func connectRooms(grid *world.Grid, node *bspNode) {
 		rightCenterY := rightRoom.y + rightRoom.height/2
 
 		// Create L-shaped corridor with 3-cell width
-		if levelrand.Intn(2) == 0 {
+		if b.rng.Intn(2) == 0 {
 			// Horizontal first, then vertical
 			carveCorridorHorizontal(grid, leftCenterY, leftCenterX, rightCenterX)
 			carveCorridorVertical(grid, rightCenterX, leftCenterY, rightCenterY)
@@ -318,8 +337,8 @@ func connectRooms(grid *world.Grid, node *bspNode) {
 	}
 
 	// Recursively connect subtrees
-	connectRooms(grid, node.left)
-	connectRooms(grid, node.right)
+	b.connectRooms(grid, node.left)
+	b.connectRooms(grid, node.right)
 }
 
 // carveCorridorHorizontal carves a horizontal corridor (3 cells wide)
@@ -332,7 +351,7 @@ func carveCorridorHorizontal(grid *world.Grid, row, startCol, endCol int) {
 		// Only mark as corridor if not already a room (don't overwrite room names)
 		cell := grid.GetCell(row, col)
 		if cell != nil && !cell.Room {
-			grid.MarkAsRoomWithName(row, col, "Corridor", "ROOM_CORRIDOR")
+			grid.MarkAsCorridor(row, col, "ROOM_CORRIDOR")
 		}
 	}
 }
@@ -347,27 +366,27 @@ func carveCorridorVertical(grid *world.Grid, col, startRow, endRow int) {
 		// Only mark as corridor if not already a room (don't overwrite room names)
 		cell := grid.GetCell(row, col)
 		if cell != nil && !cell.Room {
-			grid.MarkAsRoomWithName(row, col, "Corridor", "ROOM_CORRIDOR")
+			grid.MarkAsCorridor(row, col, "ROOM_CORRIDOR")
 		}
 	}
 }
 
 // getRoom returns a room from a subtree (picks randomly from leaves)
-func getRoom(node *bspNode) *bspRoom {
+func (b *bspBuild) getRoom(node *bspNode) *bspRoom {
 	if node.room != nil {
 		return node.room
 	}
 
 	var leftRoom, rightRoom *bspRoom
 	if node.left != nil {
-		leftRoom = getRoom(node.left)
+		leftRoom = b.getRoom(node.left)
 	}
 	if node.right != nil {
-		rightRoom = getRoom(node.right)
+		rightRoom = b.getRoom(node.right)
 	}
 
 	if leftRoom != nil && rightRoom != nil {
-		if levelrand.Intn(2) == 0 {
+		if b.rng.Intn(2) == 0 {
 			return leftRoom
 		}
 		return rightRoom
@@ -431,7 +450,7 @@ func findFurthestCell(grid *world.Grid, start *world.Cell) *world.Cell {
 
 		// Track the furthest cell, preferring actual rooms over corridors
 		if current.dist > maxDist ||
-			(current.dist == maxDist && current.cell.Name != "Corridor" && (furthestCell == nil || furthestCell.Name == "Corridor")) {
+			(current.dist == maxDist && !current.cell.IsCorridor && (furthestCell == nil || furthestCell.IsCorridor)) {
 			maxDist = current.dist
 			furthestCell = current.cell
 		}
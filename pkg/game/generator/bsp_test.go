@@ -4,7 +4,9 @@ package generator
 
 import (
 	"darkstation/pkg/game/levelrand"
+	"fmt"
 	"strings"
+	"sync"
 	"testing"
 
 	"darkstation/pkg/engine/world"
@@ -44,8 +46,7 @@ func countRoomCells(grid *world.Grid) int {
 }
 
 func TestBSPGenerate_HasNamedRooms(t *testing.T) {
-	levelrand.Seed(1)
-	grid := DefaultGenerator.Generate(1, testThemeForLevel(1))
+	grid := DefaultGenerator.Generate(1, testThemeForLevel(1), levelrand.New(1))
 	if grid == nil {
 		t.Fatal("Generate(1) returned nil")
 	}
@@ -62,8 +63,7 @@ func TestBSPGenerate_HasNamedRooms(t *testing.T) {
 }
 
 func TestBSPGenerate_HasCorridors(t *testing.T) {
-	levelrand.Seed(2)
-	grid := DefaultGenerator.Generate(1, testThemeForLevel(1))
+	grid := DefaultGenerator.Generate(1, testThemeForLevel(1), levelrand.New(2))
 	if grid == nil {
 		t.Fatal("Generate(1) returned nil")
 	}
@@ -83,8 +83,7 @@ func TestBSPGenerate_HasCorridors(t *testing.T) {
 }
 
 func TestBSPGenerate_AllRoomsReachable(t *testing.T) {
-	levelrand.Seed(3)
-	grid := DefaultGenerator.Generate(1, testThemeForLevel(1))
+	grid := DefaultGenerator.Generate(1, testThemeForLevel(1), levelrand.New(3))
 	if grid == nil {
 		t.Fatal("Generate(1) returned nil")
 	}
@@ -108,8 +107,7 @@ func TestBSPGenerate_DeckThemeNaming(t *testing.T) {
 	if len(bases) == 0 || len(adjectives) == 0 {
 		t.Fatal("RoomNamesForTheme returned empty; deck theme not configured")
 	}
-	levelrand.Seed(4)
-	grid := DefaultGenerator.Generate(1, theme)
+	grid := DefaultGenerator.Generate(1, theme, levelrand.New(4))
 	if grid == nil {
 		t.Fatal("Generate(1) returned nil")
 	}
@@ -143,8 +141,7 @@ const midDeckLevelForTest = 3
 
 func TestBSPGenerate_UniqueRoomNames(t *testing.T) {
 	for seed := int64(1); seed <= 200; seed++ {
-		levelrand.Seed(seed)
-		grid := DefaultGenerator.Generate(7, testThemeForLevel(7))
+		grid := DefaultGenerator.Generate(7, testThemeForLevel(7), levelrand.New(seed))
 		if grid == nil {
 			t.Fatalf("Generate(7) seed %d returned nil", seed)
 		}
@@ -218,8 +215,7 @@ func componentAdjacentToShaft(component []*world.Cell) bool {
 
 func TestBSPGenerate_StartAndExitSet(t *testing.T) {
 	// Generator sets start and exit cells; start is in a room; exit is marked ExitCell.
-	levelrand.Seed(7)
-	grid := DefaultGenerator.Generate(1, testThemeForLevel(1))
+	grid := DefaultGenerator.Generate(1, testThemeForLevel(1), levelrand.New(7))
 	if grid == nil {
 		t.Fatal("Generate(1) returned nil")
 	}
@@ -250,10 +246,8 @@ func TestBSPGenerate_FinalDeckMinimalLayout(t *testing.T) {
 	if !deck.IsFinalDeck(deck.TotalDecks) {
 		t.Fatal("TotalDecks should be final deck level")
 	}
-	levelrand.Seed(5)
-	gridFinal := DefaultGenerator.Generate(deck.TotalDecks, testThemeForLevel(deck.TotalDecks))
-	levelrand.Seed(6)
-	gridMid := DefaultGenerator.Generate(midDeckLevelForTest, testThemeForLevel(midDeckLevelForTest))
+	gridFinal := DefaultGenerator.Generate(deck.TotalDecks, testThemeForLevel(deck.TotalDecks), levelrand.New(5))
+	gridMid := DefaultGenerator.Generate(midDeckLevelForTest, testThemeForLevel(midDeckLevelForTest), levelrand.New(6))
 	if gridFinal == nil || gridMid == nil {
 		t.Fatal("Generate returned nil")
 	}
@@ -270,8 +264,7 @@ func TestBSPGenerate_FinalDeckMinimalLayout(t *testing.T) {
 }
 
 func TestBSPGenerate_FinalDeckHasMultipleRooms(t *testing.T) {
-	levelrand.Seed(42)
-	grid := DefaultGenerator.Generate(deck.TotalDecks, testThemeForLevel(deck.TotalDecks))
+	grid := DefaultGenerator.Generate(deck.TotalDecks, testThemeForLevel(deck.TotalDecks), levelrand.New(42))
 	if grid == nil {
 		t.Fatal("Generate returned nil")
 	}
@@ -285,3 +278,34 @@ func TestBSPGenerate_FinalDeckHasMultipleRooms(t *testing.T) {
 		t.Fatalf("final deck should have at least 2 named rooms, got %d", len(names))
 	}
 }
+
+// gridLayout renders grid's room cells and names as one string for comparing layouts.
+func gridLayout(grid *world.Grid) string {
+	var b strings.Builder
+	grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell != nil && cell.Room {
+			fmt.Fprintf(&b, "%d,%d=%s;", row, col, cell.Name)
+		}
+	})
+	return b.String()
+}
+
+func TestBSPGenerate_ConcurrentSameSeedIdentical(t *testing.T) {
+	// Each generation draws from its own RNG, so parallel builds of one seed match.
+	const workers = 4
+	layouts := make([]string, workers)
+	var wg sync.WaitGroup
+	for i := range layouts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			layouts[i] = gridLayout(DefaultGenerator.Generate(6, testThemeForLevel(6), levelrand.New(0x5eed)))
+		}()
+	}
+	wg.Wait()
+	for i := 1; i < workers; i++ {
+		if layouts[i] != layouts[0] {
+			t.Fatalf("generation %d differs from generation 0 for the same seed", i)
+		}
+	}
+}
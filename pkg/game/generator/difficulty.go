@@ -0,0 +1,68 @@
+package generator
+
+import "darkstation/pkg/game/gamemode"
+
+// DifficultyParams scales the per-deck numbers setupLevel places: locked rooms, the spawn
+// generator's battery rating and blocking hazards. The Normal preset reproduces the
+// standard numbers exactly.
+type DifficultyParams struct {
+	// LockedRoomsDelta is added to every deck that has locked rooms (never below one).
+	LockedRoomsDelta int
+	// BatteriesRequiredMin and BatteriesRequiredMax bound the spawn generator's battery
+	// rating from deck 3 (decks 1–2 always need one).
+	BatteriesRequiredMin int
+	BatteriesRequiredMax int
+	// HazardDelta is added to every deck's blocking hazard count (never below one).
+	HazardDelta int
+	// SimpleFirstDeck keeps deck 1 free of locked rooms and hazards. When false, deck 1
+	// gets deck 2's numbers.
+	SimpleFirstDeck bool
+}
+
+// DifficultyParamsFor returns the generation numbers for a difficulty preset. Easy locks
+// fewer rooms, rates the spawn generator lower and places fewer hazards; Hard does the
+// opposite and skips the gentle first deck.
+func DifficultyParamsFor(d gamemode.Difficulty) DifficultyParams {
+	switch d {
+	case gamemode.DifficultyEasy:
+		return DifficultyParams{LockedRoomsDelta: -1, BatteriesRequiredMin: 1, BatteriesRequiredMax: 2, HazardDelta: -1, SimpleFirstDeck: true}
+	case gamemode.DifficultyHard:
+		return DifficultyParams{LockedRoomsDelta: 1, BatteriesRequiredMin: 2, BatteriesRequiredMax: 3, HazardDelta: 1}
+	default:
+		return DifficultyParams{BatteriesRequiredMin: 1, BatteriesRequiredMax: 3, SimpleFirstDeck: true}
+	}
+}
+
+// GenerationLevel returns the deck whose numbers level uses: deck 1 borrows deck 2's
+// unless the preset keeps it simple.
+func (p DifficultyParams) GenerationLevel(level int) int {
+	if level == 1 && !p.SimpleFirstDeck {
+		return 2
+	}
+	return level
+}
+
+// LockedRooms scales a deck's base locked-room count. Decks without locked rooms keep none.
+func (p DifficultyParams) LockedRooms(base int) int {
+	if base <= 0 {
+		return 0
+	}
+	return max(1, base+p.LockedRoomsDelta)
+}
+
+// Hazards scales a deck's base blocking hazard count.
+func (p DifficultyParams) Hazards(base int) int {
+	if base <= 0 {
+		return 0
+	}
+	return max(1, base+p.HazardDelta)
+}
+
+// BatteriesRequiredRoll returns a battery rating within the preset's bounds.
+func (p DifficultyParams) BatteriesRequiredRoll(intn func(n int) int) int {
+	lo, hi := max(1, p.BatteriesRequiredMin), p.BatteriesRequiredMax
+	if hi <= lo || intn == nil {
+		return lo
+	}
+	return lo + intn(hi-lo+1)
+}
@@ -0,0 +1,42 @@
+package generator
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/gamemode"
+)
+
+func TestDifficultyParamsFor_normalKeepsStandardNumbers(t *testing.T) {
+	p := DifficultyParamsFor(gamemode.DifficultyNormal)
+	for base := 0; base <= 4; base++ {
+		if p.LockedRooms(base) != base || p.Hazards(base) != base {
+			t.Fatalf("normal scales base %d to %d locked rooms / %d hazards", base, p.LockedRooms(base), p.Hazards(base))
+		}
+	}
+	if p.GenerationLevel(1) != 1 {
+		t.Fatal("normal should keep deck 1 simple")
+	}
+	var spans []int
+	roll := p.BatteriesRequiredRoll(func(n int) int { spans = append(spans, n); return n - 1 })
+	if roll != 3 || len(spans) != 1 || spans[0] != 3 {
+		t.Fatalf("normal rating roll = %d (intn calls %v), want 1 + Intn(3) topping out at 3", roll, spans)
+	}
+}
+
+func TestDifficultyParamsFor_easyAndHardPullApart(t *testing.T) {
+	easy := DifficultyParamsFor(gamemode.DifficultyEasy)
+	hard := DifficultyParamsFor(gamemode.DifficultyHard)
+	if easy.LockedRooms(2) != 1 || hard.LockedRooms(2) != 3 || easy.LockedRooms(0) != 0 || hard.LockedRooms(0) != 0 {
+		t.Fatalf("locked rooms for base 2/0: easy %d/%d, hard %d/%d", easy.LockedRooms(2), easy.LockedRooms(0), hard.LockedRooms(2), hard.LockedRooms(0))
+	}
+	if easy.Hazards(1) != 1 || hard.Hazards(1) != 2 {
+		t.Fatalf("hazards for base 1: easy %d, hard %d; easy never drops below one", easy.Hazards(1), hard.Hazards(1))
+	}
+	if easy.GenerationLevel(1) != 1 || hard.GenerationLevel(1) != 2 {
+		t.Fatal("only hard should generate deck 1 with deck 2's numbers")
+	}
+	first := func(n int) int { return 0 }
+	if easy.BatteriesRequiredRoll(first) != 1 || hard.BatteriesRequiredRoll(first) != 2 {
+		t.Fatal("hard should rate the spawn generator higher than easy")
+	}
+}
@@ -2,6 +2,31 @@ package generator
 
 import "darkstation/pkg/game/deck"
 
+// EndlessLayoutLevel maps an endless-mode level onto the decks 2–9 size band, so decks
+// past the finale keep full layouts instead of the minimal final deck.
+func EndlessLayoutLevel(level int) int {
+	if level < deck.TotalDecks {
+		return level
+	}
+	return 2 + (level-2)%(deck.TotalDecks-2)
+}
+
+// scaleDeckDimensions scales the playable area inside the wall border by percent,
+// never shrinking below what the centered lift shaft needs. Zero or 100 is a no-op.
+func scaleDeckDimensions(rows, cols, percent int) (int, int) {
+	const wallBorder = 2
+	const minPlayRows, minPlayCols = 8, 20
+	if percent <= 0 || percent == 100 {
+		return rows, cols
+	}
+	playRows := max(minPlayRows, (rows-wallBorder)*percent/100)
+	playCols := max(minPlayCols, (cols-wallBorder)*percent/100)
+	return playRows + wallBorder, playCols + wallBorder
+}
+
+// LargestDeckLevel is the deck the middle-deck bull curve peaks at.
+const LargestDeckLevel = 5
+
 // deckGridDimensions returns outer grid rows/cols for a deck level.
 // Deck 1 is a small airlock; decks 2–9 follow a bull curve (largest at deck 5);
 // the final deck is minimal.
@@ -29,7 +54,7 @@ func deckGridDimensions(level int) (rows, cols int) {
 		maxPlayCols = 40
 		minPlayRows = 14
 		minPlayCols = 26
-		centerLevel = 5
+		centerLevel = LargestDeckLevel
 		maxDist     = 3 // |2-5| and |9-5|
 	)
 	dist := centerLevel - level
@@ -1,13 +1,16 @@
 package generator
 
 import (
+	"math/rand"
+
 	"darkstation/pkg/engine/world"
 	"darkstation/pkg/game/deck"
 )
 
-// GridGenerator is an interface for map generation algorithms
+// GridGenerator is an interface for map generation algorithms. Generate draws every
+// random choice from rng, so the same seed always gives the same grid.
 type GridGenerator interface {
-	Generate(level int, theme deck.Theme) *world.Grid
+	Generate(level int, theme deck.Theme, rng *rand.Rand) *world.Grid
 	Name() string
 }
 
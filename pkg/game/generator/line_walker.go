@@ -1,10 +1,10 @@
 package generator
 
 import (
-	"darkstation/pkg/game/deck"
-	"darkstation/pkg/game/levelrand"
+	"math/rand"
 
 	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/deck"
 )
 
 // LineWalkerGenerator generates maps by walking lines in random directions
@@ -16,8 +16,8 @@ func (g *LineWalkerGenerator) Name() string {
 	return "Line Walker"
 }
 
-// Generate creates a new grid for the given level
-func (g *LineWalkerGenerator) Generate(level int, theme deck.Theme) *world.Grid {
+// Generate creates a new grid for the given level, drawing every random choice from rng
+func (g *LineWalkerGenerator) Generate(level int, theme deck.Theme, rng *rand.Rand) *world.Grid {
 	_ = theme
 	grid := &world.Grid{}
 
@@ -48,19 +48,19 @@ func (g *LineWalkerGenerator) Generate(level int, theme deck.Theme) *world.Grid
 	maxDist := 4 + (level / 2)
 
 	// Build main corridors in all four directions
-	g.buildLineOfRooms(grid, row, col, world.North, branchProb, minDist, maxDist)
-	g.buildLineOfRooms(grid, row, col, world.East, branchProb, minDist, maxDist)
-	g.buildLineOfRooms(grid, row, col, world.South, branchProb, minDist, maxDist)
-	exitCellRow, exitCellCol := g.buildLineOfRooms(grid, row, col, world.West, branchProb, minDist, maxDist)
+	g.buildLineOfRooms(rng, grid, row, col, world.North, branchProb, minDist, maxDist)
+	g.buildLineOfRooms(rng, grid, row, col, world.East, branchProb, minDist, maxDist)
+	g.buildLineOfRooms(rng, grid, row, col, world.South, branchProb, minDist, maxDist)
+	exitCellRow, exitCellCol := g.buildLineOfRooms(rng, grid, row, col, world.West, branchProb, minDist, maxDist)
 
 	// Add extra corridors at higher levels for more complexity
 	extraCorridors := level / 2
 	for i := 0; i < extraCorridors; i++ {
 		// Start from a random position near center
-		randRow := row + levelrand.Intn(5) - 2
-		randCol := col + levelrand.Intn(5) - 2
+		randRow := row + rng.Intn(5) - 2
+		randCol := col + rng.Intn(5) - 2
 		if grid.IsPlayablePosition(randRow, randCol) {
-			g.buildLineOfRoomsRandom(grid, randRow, randCol, branchProb, minDist, maxDist)
+			g.buildLineOfRoomsRandom(rng, grid, randRow, randCol, branchProb, minDist, maxDist)
 		}
 	}
 
@@ -77,25 +77,25 @@ func (g *LineWalkerGenerator) Generate(level int, theme deck.Theme) *world.Grid
 }
 
 // randomDirection returns a random cardinal direction
-func (g *LineWalkerGenerator) randomDirection() world.Direction {
-	return world.Direction(levelrand.Intn(4))
+func (g *LineWalkerGenerator) randomDirection(rng *rand.Rand) world.Direction {
+	return world.Direction(rng.Intn(4))
 }
 
 // buildLineOfRoomsRandom creates a line of rooms in a random direction
-func (g *LineWalkerGenerator) buildLineOfRoomsRandom(grid *world.Grid, row, col int, branchProbability float32, minDist, maxDist int) (int, int) {
-	return g.buildLineOfRooms(grid, row, col, g.randomDirection(), branchProbability, minDist, maxDist)
+func (g *LineWalkerGenerator) buildLineOfRoomsRandom(rng *rand.Rand, grid *world.Grid, row, col int, branchProbability float32, minDist, maxDist int) (int, int) {
+	return g.buildLineOfRooms(rng, grid, row, col, g.randomDirection(rng), branchProbability, minDist, maxDist)
 }
 
 // buildLineOfRooms creates a line of rooms starting from (row, col) in the given direction
 // Rooms are only placed within the playable area (not on the perimeter)
-func (g *LineWalkerGenerator) buildLineOfRooms(grid *world.Grid, row, col int, dir world.Direction, branchProbability float32, minDist, maxDist int) (int, int) {
+func (g *LineWalkerGenerator) buildLineOfRooms(rng *rand.Rand, grid *world.Grid, row, col int, dir world.Direction, branchProbability float32, minDist, maxDist int) (int, int) {
 	if !dir.IsValid() {
-		dir = g.randomDirection()
+		dir = g.randomDirection(rng)
 	}
 
 	rowDelta, colDelta := dir.Delta()
 
-	distance := minDist + levelrand.Intn(maxDist-minDist+1)
+	distance := minDist + rng.Intn(maxDist-minDist+1)
 
 	for segment := 0; segment < distance; segment++ {
 		// Only mark as room if within playable area (not on perimeter)
@@ -108,8 +108,8 @@ func (g *LineWalkerGenerator) buildLineOfRooms(grid *world.Grid, row, col int, d
 			return row, col
 		}
 
-		if levelrand.Float32() < branchProbability {
-			g.buildLineOfRoomsRandom(grid, row, col, branchProbability-.1, minDist, maxDist)
+		if rng.Float32() < branchProbability {
+			g.buildLineOfRoomsRandom(rng, grid, row, col, branchProbability-.1, minDist, maxDist)
 		}
 
 		row += rowDelta
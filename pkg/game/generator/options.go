@@ -11,8 +11,13 @@ type GenerateOptions struct {
 	// LayoutLevel drives BSP split density when PlayRows/PlayCols are zero.
 	// Zero uses the requested level.
 	LayoutLevel int
+	// SizePercent scales deckGridDimensions for middle decks (zero = 100).
+	SizePercent int
 	// SkipDeck1ShipOverlay omits the fixed deck-1 Ship room overlay.
 	SkipDeck1ShipOverlay bool
+	// ShapeSeed is mixed into each room's shape roll (normally the level seed), so the
+	// same BSP rectangle is not always carved the same way on every run and deck.
+	ShapeSeed int64
 }
 
 // GenerateOptionsFromMode builds layout options from a game mode.
@@ -22,6 +27,7 @@ func GenerateOptionsFromMode(m gamemode.Mode) GenerateOptions {
 		PlayRows:             lg.PlayRows,
 		PlayCols:             lg.PlayCols,
 		LayoutLevel:          lg.LayoutLevel,
+		SizePercent:          lg.SizePercent,
 		SkipDeck1ShipOverlay: !lg.BootstrapDeck1Ship,
 	}
 }
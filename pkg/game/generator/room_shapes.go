@@ -0,0 +1,124 @@
+package generator
+
+import (
+	"darkstation/pkg/game/levelrand"
+
+	"darkstation/pkg/engine/world"
+)
+
+// roomShape selects how a BSP room is carved inside its bounding rectangle.
+type roomShape int
+
+const (
+	roomShapeRect    roomShape = iota // Full rectangle (classic layout)
+	roomShapeL                        // One corner notched out, leaving an L
+	roomShapePillars                  // Rectangle with isolated interior pillars
+)
+
+// roomCorner identifies which corner of an L-shaped room is notched.
+type roomCorner int
+
+const (
+	cornerTopLeft roomCorner = iota
+	cornerTopRight
+	cornerBottomLeft
+	cornerBottomRight
+)
+
+const (
+	minLShapeSide  = 5          // Both sides must be at least this long for an L notch
+	minPillarSide  = 6          // Both sides must be at least this long for interior pillars
+	pillarSpacing  = 3          // Pillars sit on a lattice this many cells apart
+	pillarInset    = 2          // First pillar row/col offset from the room edge
+	roomShapeTagID = 0x524f4f4d // "ROOM": derived-stream tag for shape rolls
+)
+
+// assignRoomShape picks a shape for room. Shape rolls use a stream derived from
+// levelSeed and the room rectangle so the main level RNG sequence (splits, sizes, names)
+// is unchanged.
+func assignRoomShape(room *bspRoom, levelSeed int64) {
+	if room == nil {
+		return
+	}
+	seed := levelSeed ^ int64(room.x)<<48 ^ int64(room.y)<<32 ^ int64(room.width)<<16 ^ int64(room.height)
+	rng := levelrand.NewDerived(seed, roomShapeTagID)
+
+	var options []roomShape
+	options = append(options, roomShapeRect, roomShapeRect)
+	if room.width >= minLShapeSide && room.height >= minLShapeSide {
+		options = append(options, roomShapeL)
+	}
+	if room.width >= minPillarSide && room.height >= minPillarSide {
+		options = append(options, roomShapePillars)
+	}
+	room.shape = options[rng.Intn(len(options))]
+
+	if room.shape == roomShapeL {
+		// Notch at most (side-1)/2 so the room center (used for corridors and the
+		// start cell) always stays inside the remaining L.
+		maxW := (room.width - 1) / 2
+		maxH := (room.height - 1) / 2
+		room.notchW = 2 + rng.Intn(maxW-1)
+		room.notchH = 2 + rng.Intn(maxH-1)
+		room.notchCorner = roomCorner(rng.Intn(4))
+	}
+}
+
+// inNotch reports whether (row, col) falls inside the room's L-shape notch.
+func (r *bspRoom) inNotch(row, col int) bool {
+	if r.shape != roomShapeL {
+		return false
+	}
+	var inRows, inCols bool
+	switch r.notchCorner {
+	case cornerTopLeft, cornerTopRight:
+		inRows = row < r.y+r.notchH
+	default:
+		inRows = row >= r.y+r.height-r.notchH
+	}
+	switch r.notchCorner {
+	case cornerTopLeft, cornerBottomLeft:
+		inCols = col < r.x+r.notchW
+	default:
+		inCols = col >= r.x+r.width-r.notchW
+	}
+	return inRows && inCols
+}
+
+// carveRoomPillars turns isolated interior cells of pillared rooms back into walls.
+// Runs after corridors so a pillar never lands on a corridor or doorway. A pillar is
+// only placed where all eight neighbours are cells of the same room, so every pillar
+// is ringed by walkable floor and room connectivity cannot change.
+func carveRoomPillars(grid *world.Grid, node *bspNode) {
+	for _, room := range collectRooms(node) {
+		if room.shape != roomShapePillars {
+			continue
+		}
+		centerRow := room.y + room.height/2
+		centerCol := room.x + room.width/2
+		for row := room.y + pillarInset; row < room.y+room.height-pillarInset; row += pillarSpacing {
+			for col := room.x + pillarInset; col < room.x+room.width-pillarInset; col += pillarSpacing {
+				if row == centerRow && col == centerCol {
+					continue
+				}
+				if !pillarFits(grid, room.name, row, col) {
+					continue
+				}
+				markAsWall(grid, row, col)
+			}
+		}
+	}
+}
+
+// pillarFits reports whether (row, col) and its 8-neighbourhood are all floor of roomName.
+func pillarFits(grid *world.Grid, roomName string, row, col int) bool {
+	for dr := -1; dr <= 1; dr++ {
+		for dc := -1; dc <= 1; dc++ {
+			cell := grid.GetCell(row+dr, col+dc)
+			if cell == nil || !cell.Room || cell.Name != roomName || cell.ExitCell {
+				return false
+			}
+		}
+	}
+	return true
+}
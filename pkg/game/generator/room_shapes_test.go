@@ -0,0 +1,84 @@
+package generator
+
+import (
+	"darkstation/pkg/game/levelrand"
+	"testing"
+
+	"darkstation/pkg/engine/world"
+)
+
+func TestBSPGenerate_ShapedRoomsStayConnected(t *testing.T) {
+	for seed := int64(1); seed <= 40; seed++ {
+		for _, level := range []int{2, 5, 8} {
+			grid := DefaultGenerator.Generate(level, testThemeForLevel(level), levelrand.New(seed))
+			if got, want := countReachableRoomCells(grid, grid.ExitCell()), countRoomCells(grid); got != want {
+				t.Fatalf("seed %d level %d: reachable room cells %d != total %d", seed, level, got, want)
+			}
+			if start := grid.StartCell(); start == nil || !start.Room {
+				t.Fatalf("seed %d level %d: start cell not on floor", seed, level)
+			}
+		}
+	}
+}
+
+func TestCreateRooms_AssignsVariedShapes(t *testing.T) {
+	seen := map[roomShape]int{}
+	for seed := int64(1); seed <= 40; seed++ {
+		root := &bspNode{x: 1, y: 1, width: 60, height: 40}
+		b := &bspBuild{rng: levelrand.New(seed)}
+		b.splitBSP(root, 8)
+		b.createRooms(root, []string{"Bay"}, []string{"Aft"}, map[string]bool{})
+		for _, room := range collectRooms(root) {
+			seen[room.shape]++
+		}
+	}
+	for _, shape := range []roomShape{roomShapeRect, roomShapeL, roomShapePillars} {
+		if seen[shape] == 0 {
+			t.Errorf("shape %d never assigned across seed sweep", shape)
+		}
+	}
+}
+
+func TestAssignRoomShape_sameRectVariesWithLevelSeed(t *testing.T) {
+	seen := map[roomShape]bool{}
+	for seed := int64(1); seed <= 40; seed++ {
+		room := &bspRoom{x: 5, y: 7, width: 9, height: 9}
+		assignRoomShape(room, seed)
+		seen[room.shape] = true
+	}
+	if len(seen) < 2 {
+		t.Fatalf("one room rectangle got shapes %v across 40 level seeds, want variety", seen)
+	}
+}
+
+func TestRoomShape_LNotchKeepsCenter(t *testing.T) {
+	for w := minLShapeSide; w <= 12; w++ {
+		for h := minLShapeSide; h <= 12; h++ {
+			for corner := cornerTopLeft; corner <= cornerBottomRight; corner++ {
+				room := &bspRoom{x: 3, y: 4, width: w, height: h, shape: roomShapeL,
+					notchCorner: corner, notchW: (w - 1) / 2, notchH: (h - 1) / 2}
+				if room.inNotch(room.y+h/2, room.x+w/2) {
+					t.Fatalf("%dx%d corner %d: notch covers room center", w, h, corner)
+				}
+			}
+		}
+	}
+}
+
+func TestCarveRoomPillars_SkipsCellsNextToCorridors(t *testing.T) {
+	grid := &world.Grid{}
+	grid.Build(12, 12)
+	room := &bspRoom{x: 1, y: 1, width: 8, height: 8, name: "Hall", description: "ROOM_HALL", shape: roomShapePillars}
+	node := &bspNode{room: room}
+	carveRooms(grid, node)
+	// Corridor cell bordering the first pillar lattice point.
+	grid.MarkAsRoomWithName(2, 3, "Corridor", "ROOM_CORRIDOR")
+	carveRoomPillars(grid, node)
+
+	if cell := grid.GetCell(3, 3); !cell.Room {
+		t.Error("pillar placed next to a corridor cell")
+	}
+	if cell := grid.GetCell(6, 6); cell.Room {
+		t.Error("expected interior pillar at (6,6)")
+	}
+}
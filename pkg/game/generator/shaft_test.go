@@ -21,6 +21,28 @@ func TestDeckGridDimensions_BullCurve(t *testing.T) {
 	}
 }
 
+func TestScaleDeckDimensions(t *testing.T) {
+	tests := []struct {
+		name                string
+		rows, cols, percent int
+		wantRows, wantCols  int
+	}{
+		{"unset", 26, 42, 0, 26, 42},
+		{"standard", 26, 42, 100, 26, 42},
+		{"compact", 26, 42, 75, 20, 32},
+		{"large", 26, 42, 125, 32, 52},
+		{"shaft floor", 10, 22, 50, 10, 22},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, cols := scaleDeckDimensions(tt.rows, tt.cols, tt.percent)
+			if rows != tt.wantRows || cols != tt.wantCols {
+				t.Errorf("scaleDeckDimensions = %dx%d, want %dx%d", rows, cols, tt.wantRows, tt.wantCols)
+			}
+		})
+	}
+}
+
 func TestShaftBounds_Centered(t *testing.T) {
 	rows, cols := 40, 60
 	top, left, bottom, right := ShaftBounds(rows, cols)
@@ -50,7 +72,7 @@ func TestShaftBoundsForLevel_AlwaysFiveByFive(t *testing.T) {
 }
 
 func TestGenerate_Deck1_NoAnnexExplosion(t *testing.T) {
-	grid := DefaultGenerator.Generate(1, deck.ThemeAirlock)
+	grid := DefaultGenerator.Generate(1, deck.ThemeAirlock, levelrand.New(1))
 	names := make(map[string]int)
 	grid.ForEachCell(func(row, col int, cell *world.Cell) {
 		if cell == nil || !cell.Room || cell.Name == "" || cell.Name == "Corridor" {
@@ -69,8 +91,7 @@ func TestGenerate_Deck1_NoAnnexExplosion(t *testing.T) {
 }
 
 func TestGenerate_NoShaftSplitFarNames(t *testing.T) {
-	levelrand.Seed(0x18B7D890DF002802)
-	grid := DefaultGenerator.Generate(2, deck.ThemeCargoLogistics)
+	grid := DefaultGenerator.Generate(2, deck.ThemeCargoLogistics, levelrand.New(0x18B7D890DF002802))
 	if grid == nil {
 		t.Fatal("Generate(2) returned nil")
 	}
@@ -93,7 +114,7 @@ func roomNamesOnGrid(grid *world.Grid) map[string]int {
 }
 
 func TestGenerate_IncludesShaftExit(t *testing.T) {
-	grid := DefaultGenerator.Generate(3, deck.ThemeAirlock)
+	grid := DefaultGenerator.Generate(3, deck.ThemeAirlock, levelrand.New(1))
 	exit := grid.ExitCell()
 	if exit == nil || !exit.ExitCell {
 		t.Fatal("expected exit cell in shaft")
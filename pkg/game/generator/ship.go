@@ -80,12 +80,14 @@ func reserveDeck1WestOverlayLeaf(root *bspNode, rows, cols int) *bspNode {
 	return right
 }
 
-func markDeck1OverlayWall(grid *world.Grid, row, col int) {
+// markAsWall reverts a carved cell to an unnamed wall cell.
+func markAsWall(grid *world.Grid, row, col int) {
 	cell := grid.GetCell(row, col)
 	if cell == nil {
 		return
 	}
 	cell.Room = false
+	cell.IsCorridor = false
 	cell.ExitCell = false
 	cell.Name = fmt.Sprintf("%v:%v", row, col)
 	cell.Description = world.GenerateCellDescription()
@@ -99,7 +101,7 @@ func CarveDeck1ShipAndDock(grid *world.Grid) {
 
 	for row := deck1OverlayStartRow; row <= deck1OverlayEndRow; row++ {
 		for col := deck1OverlayStartCol; col <= deck1WestOverlayRightCol; col++ {
-			markDeck1OverlayWall(grid, row, col)
+			markAsWall(grid, row, col)
 		}
 	}
 
@@ -111,10 +113,10 @@ func CarveDeck1ShipAndDock(grid *world.Grid) {
 
 	for row := deck1ShipStartRow; row <= deck1ShipEndRow; row++ {
 		if row == deck1ShipDoorRow {
-			grid.MarkAsRoomWithName(row, deck1ShipEastWallCol, "Corridor", "ROOM_SHIP_AIRLOCK")
+			grid.MarkAsCorridor(row, deck1ShipEastWallCol, "ROOM_SHIP_AIRLOCK")
 			continue
 		}
-		markDeck1OverlayWall(grid, row, deck1ShipEastWallCol)
+		markAsWall(grid, row, deck1ShipEastWallCol)
 	}
 
 	grid.SetStartCellAt(deck1ShipStartRowCenter, deck1ShipStartColCenter)
@@ -62,8 +62,7 @@ func TestCarveDeck1ShipAndDock_fixedLayout(t *testing.T) {
 
 func TestBSPGenerate_deck1WestOverlayNoBSPBleed(t *testing.T) {
 	for _, seed := range []int64{1, 2, 42, 100, 999, 424242} {
-		levelrand.Seed(seed)
-		grid := BSP.Generate(1, deck.ThemeAirlock)
+		grid := BSP.Generate(1, deck.ThemeAirlock, levelrand.New(seed))
 		grid.ForEachCell(func(row, col int, cell *world.Cell) {
 			if cell == nil || !cell.Room {
 				return
@@ -81,8 +80,7 @@ func TestBSPGenerate_deck1WestOverlayNoBSPBleed(t *testing.T) {
 
 func TestBSPGenerate_deck1HasShipConnectedToShaft(t *testing.T) {
 	for _, seed := range []int64{1, 2, 42, 999, 424242} {
-		levelrand.Seed(seed)
-		grid := BSP.Generate(1, deck.ThemeAirlock)
+		grid := BSP.Generate(1, deck.ThemeAirlock, levelrand.New(seed))
 		if grid == nil {
 			t.Fatal("nil grid")
 		}
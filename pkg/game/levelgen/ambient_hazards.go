@@ -0,0 +1,88 @@
+package levelgen
+
+import (
+	"strings"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/generator"
+	"darkstation/pkg/game/levelrand"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// AmbientHazardMinLevel is the first deck with ambient (non-blocking) room hazards.
+const AmbientHazardMinLevel = 2
+
+// maxAmbientHazardRooms caps how many rooms per deck carry an ambient hazard.
+const maxAmbientHazardRooms = 2
+
+// ambientRoomKeywords maps ambient hazard types to room-name fragments that suit them,
+// so the effect reads as part of the room's identity (reactor bays bleed power,
+// reclamation tanks flood).
+var ambientRoomKeywords = map[entities.HazardType][]string{
+	entities.HazardPowerBleed: {"Reactor", "Plasma", "Core", "Fuel Rod", "Neutron", "Particle", "Accelerator"},
+	entities.HazardFlooding:   {"Irrigation", "Tank", "Water", "Reclamation", "Condensate", "Sludge", "Hydroponic", "Potable"},
+}
+
+// PlaceAmbientHazards tags whole rooms with a passable ambient hazard. Ambient hazards
+// never block movement, conduction, or the exit lift, so this pass runs after all
+// blocking placement. Reactor bleed drains carried batteries, but only spares beyond
+// what the deck's generators still need (see gameplay.ApplyAmbientHazards), so it
+// cannot strand the deck either.
+func PlaceAmbientHazards(g *state.Game) {
+	if g == nil || g.Grid == nil || g.Level < AmbientHazardMinLevel {
+		return
+	}
+
+	roomCells := make(map[string][]*world.Cell)
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell.Room && !cell.IsCorridor && cell.Name != "" {
+			roomCells[cell.Name] = append(roomCells[cell.Name], cell)
+		}
+	})
+
+	type candidate struct {
+		room   string
+		hazard entities.HazardType
+	}
+	var candidates []candidate
+	for _, roomName := range SortedRoomMapKeys(roomCells) {
+		if generator.IsPlacementExcludedRoom(roomName) || roomName == generator.ShaftRoomName {
+			continue
+		}
+		if ht, ok := ambientHazardForRoom(roomName); ok {
+			candidates = append(candidates, candidate{room: roomName, hazard: ht})
+		}
+	}
+	if len(candidates) == 0 {
+		return
+	}
+
+	rng := levelrand.NewDerived(g.LevelSeed, 0xA3B1E7)
+	rng.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	if len(candidates) > maxAmbientHazardRooms {
+		candidates = candidates[:maxAmbientHazardRooms]
+	}
+
+	for _, c := range candidates {
+		hazard := entities.NewHazard(c.hazard)
+		for _, cell := range roomCells[c.room] {
+			gameworld.GetGameData(cell).AmbientHazard = hazard
+		}
+	}
+}
+
+// ambientHazardForRoom returns the ambient hazard type suited to a room name.
+func ambientHazardForRoom(roomName string) (entities.HazardType, bool) {
+	for _, ht := range []entities.HazardType{entities.HazardPowerBleed, entities.HazardFlooding} {
+		for _, kw := range ambientRoomKeywords[ht] {
+			if strings.Contains(roomName, kw) {
+				return ht, true
+			}
+		}
+	}
+	return 0, false
+}
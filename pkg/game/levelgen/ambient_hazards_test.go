@@ -0,0 +1,25 @@
+package levelgen
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/entities"
+)
+
+func TestAmbientHazardForRoom(t *testing.T) {
+	tests := []struct {
+		room   string
+		want   entities.HazardType
+		wantOK bool
+	}{
+		{"Reactor Control", entities.HazardPowerBleed, true},
+		{"Water Reclamation", entities.HazardFlooding, true},
+		{"Crew Quarters", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := ambientHazardForRoom(tt.room)
+		if ok != tt.wantOK || (ok && got != tt.want) {
+			t.Errorf("ambientHazardForRoom(%q) = %v, %v; want %v, %v", tt.room, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
@@ -1,9 +1,6 @@
 package levelgen
 
-import (
-	"darkstation/pkg/game/deck"
-	"darkstation/pkg/game/levelrand"
-)
+import "math/rand"
 
 // ExitGateKind identifies a physical puzzle that may block access to the lift shaft.
 type ExitGateKind string
@@ -15,17 +12,18 @@ const (
 	ExitGateSlime ExitGateKind = "slime"
 )
 
-// PickExitGateKind chooses an exit-gate puzzle for this deck using the level RNG.
-func PickExitGateKind(level int) ExitGateKind {
-	pool := exitGatePoolForLevel(level)
+// PickExitGateKind chooses an exit-gate puzzle for this deck using rng.
+// minimalSystems marks the final deck, which never gets an exit gate.
+func PickExitGateKind(rng *rand.Rand, level int, minimalSystems bool) ExitGateKind {
+	pool := exitGatePoolForLevel(level, minimalSystems)
 	if len(pool) == 0 {
 		return ExitGateNone
 	}
-	return pool[levelrand.Intn(len(pool))]
+	return pool[rng.Intn(len(pool))]
 }
 
-func exitGatePoolForLevel(level int) []ExitGateKind {
-	if level < 2 || deck.IsFinalDeck(level) {
+func exitGatePoolForLevel(level int, minimalSystems bool) []ExitGateKind {
+	if level < 2 || minimalSystems {
 		return nil
 	}
 	// Add new exit-gate puzzle types here as they are implemented.
@@ -9,12 +9,10 @@ import (
 
 func TestPickExitGateKind_deck1AndFinalNeverSlime(t *testing.T) {
 	for seed := int64(0); seed < 50; seed++ {
-		levelrand.Seed(seed)
-		if got := PickExitGateKind(1); got != ExitGateNone {
+		if got := PickExitGateKind(levelrand.New(seed), 1, false); got != ExitGateNone {
 			t.Fatalf("deck 1 seed %d: got %q, want none", seed, got)
 		}
-		levelrand.Seed(seed + 999)
-		if got := PickExitGateKind(deck.TotalDecks); got != ExitGateNone {
+		if got := PickExitGateKind(levelrand.New(seed+999), deck.TotalDecks, true); got != ExitGateNone {
 			t.Fatalf("final deck seed %d: got %q, want none", seed, got)
 		}
 	}
@@ -24,8 +22,7 @@ func TestPickExitGateKind_midDecksUsePool(t *testing.T) {
 	sawNone := false
 	sawSlime := false
 	for seed := int64(0); seed < 200; seed++ {
-		levelrand.Seed(seed)
-		switch PickExitGateKind(5) {
+		switch PickExitGateKind(levelrand.New(seed), 5, false) {
 		case ExitGateNone:
 			sawNone = true
 		case ExitGateSlime:
@@ -41,8 +38,7 @@ func TestPickExitGateKind_midDecksUsePool(t *testing.T) {
 
 func seedForExitGate(level int, want ExitGateKind) int64 {
 	for seed := int64(0); seed < 500; seed++ {
-		levelrand.Seed(seed)
-		if PickExitGateKind(level) == want {
+		if PickExitGateKind(levelrand.New(seed), level, false) == want {
 			return seed
 		}
 	}
@@ -6,7 +6,6 @@ import (
 	"github.com/zyedidia/generic/mapset"
 
 	"darkstation/pkg/engine/world"
-	"darkstation/pkg/game/deck"
 	"darkstation/pkg/game/entities"
 	"darkstation/pkg/game/levelrand"
 	"darkstation/pkg/game/setup"
@@ -25,7 +24,7 @@ func PlaceConduitFaults(g *state.Game, avoid *mapset.Set[*world.Cell]) {
 	if g == nil || g.Grid == nil || setup.PlayerEntryCell(g) == nil {
 		return
 	}
-	count := conduitFaultCount(g.Level)
+	count := conduitFaultCount(g.Level, g.IsFinalDeckLevel(g.Level))
 	if count == 0 {
 		return
 	}
@@ -66,13 +65,14 @@ func PlaceConduitFaults(g *state.Game, avoid *mapset.Set[*world.Cell]) {
 }
 
 // conduitFaultCount scales faults with depth; deck 1 and the final deck stay clean.
-func conduitFaultCount(level int) int {
-	if level < 2 || deck.IsFinalDeck(level) {
+// Endless decks keep adding a fault every third deck past deck 8.
+func conduitFaultCount(level int, minimalSystems bool) int {
+	if level < 2 || minimalSystems {
 		return 0
 	}
 	switch {
 	case level >= 8:
-		return 3
+		return 3 + (level-8)/3
 	case level >= 5:
 		return 2
 	default:
@@ -102,7 +102,7 @@ func collectConduitFaultCandidates(g *state.Game, avoid *mapset.Set[*world.Cell]
 }
 
 func validConduitFaultCell(g *state.Game, cell *world.Cell, avoid *mapset.Set[*world.Cell]) bool {
-	if cell == nil || !cell.Room || cell.Name != "Corridor" || avoid.Has(cell) {
+	if cell == nil || !cell.Room || !cell.IsCorridor || avoid.Has(cell) {
 		return false
 	}
 	if cell == setup.PlayerEntryCell(g) || cell.ExitCell {
@@ -2,7 +2,7 @@
 package levelgen
 
 import (
-	"darkstation/pkg/game/levelrand"
+	"math/rand"
 
 	"github.com/zyedidia/generic/mapset"
 
@@ -20,7 +20,7 @@ func PlaceFurniture(g *state.Game, avoid *mapset.Set[*world.Cell]) {
 	// Collect all unique rooms and their cells
 	roomCells := make(map[string][]*world.Cell)
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
-		if cell.Room && cell.Name != "Corridor" && cell.Name != "" {
+		if cell.Room && !cell.IsCorridor && cell.Name != "" {
 			roomCells[cell.Name] = append(roomCells[cell.Name], cell)
 		}
 	})
@@ -40,7 +40,7 @@ func PlaceFurniture(g *state.Game, avoid *mapset.Set[*world.Cell]) {
 		}
 
 		// Shuffle templates for variety
-		levelrand.Shuffle(len(templates), func(i, j int) {
+		g.RNG().Shuffle(len(templates), func(i, j int) {
 			templates[i], templates[j] = templates[j], templates[i]
 		})
 
@@ -85,7 +85,7 @@ func PlaceFurniture(g *state.Game, avoid *mapset.Set[*world.Cell]) {
 		}
 
 		// Shuffle valid cells
-		levelrand.Shuffle(len(validCells), func(i, j int) {
+		g.RNG().Shuffle(len(validCells), func(i, j int) {
 			validCells[i], validCells[j] = validCells[j], validCells[i]
 		})
 
@@ -156,7 +156,7 @@ func placeFallbackFurniture(g *state.Game, avoid *mapset.Set[*world.Cell]) {
 	template := templates[0]
 	var fallback *world.Cell
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
-		if fallback != nil || cell == nil || !cell.Room || cell.Name == "" || cell.Name == "Corridor" ||
+		if fallback != nil || cell == nil || !cell.Room || cell.Name == "" || cell.IsCorridor ||
 			generator.IsPlacementExcludedRoom(cell.Name) || cell.ExitCell {
 			return
 		}
@@ -184,7 +184,8 @@ func placeFallbackFurniture(g *state.Game, avoid *mapset.Set[*world.Cell]) {
 	}
 }
 
-// hideItemsInFurniture moves items from floor cells into furniture with a chance
+// hideItemsInFurniture moves items from floor cells into furniture with a chance, then
+// stocks any furniture left empty from the room's loot table (entities.RoomLoot).
 func hideItemsInFurniture(g *state.Game, roomCells []*world.Cell, furniture []*entities.Furniture, roomName string) {
 	prefs := g.ItemPlacement()
 	if !prefs.HideItemsInFurniture {
@@ -194,21 +195,27 @@ func hideItemsInFurniture(g *state.Game, roomCells []*world.Cell, furniture []*e
 	if chance <= 0 {
 		return
 	}
-	// Find items on the floor in this room (keycards, patch kits - not batteries or maps)
+	// Find items on the floor in this room that its loot table lets furniture hide
 	for _, cell := range roomCells {
 		if cell.ItemsOnFloor.Size() == 0 {
 			continue
 		}
 
-		var itemsToMove []*world.Item
+		// By default only keycards and patch kits - items that are part of puzzles.
+		// Sorted so the hide rolls consume the level RNG in a seed-stable order.
+		var hideable []*world.Item
 		cell.ItemsOnFloor.Each(func(item *world.Item) {
-			// Only hide keycards and patch kits - items that are part of puzzles
-			if ContainsSubstring(item.Name, "Keycard") || item.Name == "Patch Kit" {
-				if levelrand.Intn(100) < chance {
-					itemsToMove = append(itemsToMove, item)
-				}
+			if entities.FurnitureHideWeight(roomName, item.Name) > 0 {
+				hideable = append(hideable, item)
 			}
 		})
+		setup.SortItemsByName(hideable)
+		var itemsToMove []*world.Item
+		for _, item := range hideable {
+			if g.RNG().Intn(100) < chance*entities.FurnitureHideWeight(roomName, item.Name)/100 {
+				itemsToMove = append(itemsToMove, item)
+			}
+		}
 
 		// Move items to furniture
 		for _, item := range itemsToMove {
@@ -225,6 +232,23 @@ func hideItemsInFurniture(g *state.Game, roomCells []*world.Cell, furniture []*e
 			}
 		}
 	}
+
+	stockFurniture(g.RNG(), furniture, roomName)
+}
+
+// stockFurniture gives empty furniture a chance at a fresh item from the room's loot
+// table, e.g. a spare battery in an Engineering locker. Each piece holds at most one.
+func stockFurniture(rng *rand.Rand, furniture []*entities.Furniture, roomName string) {
+	for _, loot := range entities.GetLootForRoom(roomName) {
+		if loot.Stock <= 0 {
+			continue
+		}
+		for _, f := range furniture {
+			if f.ContainedItem == nil && rng.Intn(100) < loot.Stock {
+				f.ContainedItem = world.NewItem(loot.Item)
+			}
+		}
+	}
 }
 
 // updateHintForFurnitureItem updates the hint for an item to mention it's in furniture
@@ -0,0 +1,62 @@
+package levelgen
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/levelrand"
+	"darkstation/pkg/game/state"
+)
+
+func TestHideItemsInFurniture_roomLootBias(t *testing.T) {
+	g := state.NewGame()
+	g.Rand = levelrand.New(1)
+	grid := world.NewGrid(1, 1)
+	grid.MarkAsRoomWithName(0, 0, "Armory", "")
+	cell := grid.GetCell(0, 0)
+	g.Grid = grid
+	keycard := world.NewItem("Armory Keycard")
+	cell.ItemsOnFloor.Put(keycard)
+	locker := entities.NewFurniture("Weapon Locker", "", "▣")
+
+	hideItemsInFurniture(g, []*world.Cell{cell}, []*entities.Furniture{locker}, "Armory")
+
+	if locker.ContainedItem != keycard || cell.ItemsOnFloor.Has(keycard) {
+		t.Fatal("Armory furniture should always hide a keycard at the mode's hide chance")
+	}
+	if w := entities.FurnitureHideWeight("Med Bay 2", "Patch Kit"); w <= entities.FurnitureHideWeight("Cargo Bay", "Patch Kit") {
+		t.Fatalf("Med Bay should favour hiding patch kits, got weight %d", w)
+	}
+	if entities.FurnitureHideWeight("Engineering", "Battery") != 0 {
+		t.Fatal("floor batteries should never be hidden")
+	}
+}
+
+func TestStockFurniture_engineeringSpareBatteries(t *testing.T) {
+	var furniture []*entities.Furniture
+	for i := 0; i < 40; i++ {
+		furniture = append(furniture, entities.NewFurniture("Tool Bench", "", "╤"))
+	}
+
+	stockFurniture(levelrand.New(1), furniture, "Engineering")
+
+	stocked := 0
+	for _, f := range furniture {
+		if f.ContainedItem != nil {
+			if f.ContainedItem.Name != "Battery" {
+				t.Fatalf("Engineering stocked %q, want Battery", f.ContainedItem.Name)
+			}
+			stocked++
+		}
+	}
+	if stocked == 0 || stocked == len(furniture) {
+		t.Fatalf("stocked %d of %d pieces; want some but not all", stocked, len(furniture))
+	}
+
+	lab := []*entities.Furniture{entities.NewFurniture("Microscope", "", "○")}
+	stockFurniture(levelrand.New(1), lab, "Lab")
+	if lab[0].ContainedItem != nil {
+		t.Fatal("rooms without a stock line should not gain items")
+	}
+}
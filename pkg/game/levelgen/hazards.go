@@ -2,12 +2,13 @@
 package levelgen
 
 import (
-	"darkstation/pkg/game/levelrand"
 	"fmt"
+	"math/rand"
 
 	"github.com/zyedidia/generic/mapset"
 
 	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/deck"
 	"darkstation/pkg/game/entities"
 	"darkstation/pkg/game/gamemode"
 	"darkstation/pkg/game/generator"
@@ -19,14 +20,15 @@ import (
 
 // PlaceHazards places environmental hazards on corridor chokepoints or room passages.
 // Each hazard's fix (control panel or item) is always placed in the area reachable before
-// crossing the hazard, so the puzzle remains solvable.
-func PlaceHazards(g *state.Game, avoid *mapset.Set[*world.Cell], lockedDoorCells *mapset.Set[*world.Cell]) {
+// crossing the hazard, so the puzzle remains solvable. params scales the hazard count for
+// the run's difficulty.
+func PlaceHazards(g *state.Game, avoid *mapset.Set[*world.Cell], lockedDoorCells *mapset.Set[*world.Cell], params generator.DifficultyParams) {
 	if g == nil || g.Grid == nil || setup.PlayerEntryCell(g) == nil {
 		return
 	}
 
-	numHazards := hazardCountForLevel(g.Level)
-	hazardTypes := filterHazardTypesForMode(hazardTypesForLevel(g.Level), g.ItemPlacement())
+	numHazards := hazardCount(g.RNG(), g.Level, params)
+	hazardTypes := filterHazardTypesForMode(hazardTypesForLevel(params.GenerationLevel(g.Level)), g.ItemPlacement())
 	if len(hazardTypes) == 0 {
 		return
 	}
@@ -34,6 +36,7 @@ func PlaceHazards(g *state.Game, avoid *mapset.Set[*world.Cell], lockedDoorCells
 	blocked := mapset.New[*world.Cell]()
 	lockedDoorCells.Each(func(c *world.Cell) { blocked.Put(c) })
 
+	placedTypes := make(map[entities.HazardType]int)
 	hazardsPlaced := 0
 	for hazardsPlaced < numHazards {
 		candidates := collectHazardCandidateCells(g, lockedDoorCells, &blocked)
@@ -42,7 +45,7 @@ func PlaceHazards(g *state.Game, avoid *mapset.Set[*world.Cell], lockedDoorCells
 		}
 		placed := false
 		for _, cell := range candidates {
-			if tryPlaceHazardAt(g, cell, hazardTypes, lockedDoorCells, &blocked, avoid) {
+			if tryPlaceHazardAt(g, cell, hazardTypes, placedTypes, lockedDoorCells, &blocked, avoid) {
 				hazardsPlaced++
 				placed = true
 				break
@@ -54,12 +57,23 @@ func PlaceHazards(g *state.Game, avoid *mapset.Set[*world.Cell], lockedDoorCells
 	}
 }
 
-func hazardCountForLevel(level int) int {
+// spreadingHazardMinLevel is the first deck whose gas and coolant leaks creep along
+// corridors during play.
+const spreadingHazardMinLevel = 5
+
+// hazardCount rolls how many blocking hazards a deck asks for under params. Placement
+// can fall short of it when the layout runs out of chokepoints.
+func hazardCount(rng *rand.Rand, level int, params generator.DifficultyParams) int {
+	return params.Hazards(hazardCountForLevel(rng, params.GenerationLevel(level)))
+}
+
+func hazardCountForLevel(rng *rand.Rand, level int) int {
 	if level >= 4 {
-		return 2 + levelrand.Intn(2)
+		// Endless decks past the finale add another hazard every other deck.
+		return 2 + rng.Intn(2) + max(0, level-deck.TotalDecks+1)/2
 	}
 	if level >= 3 {
-		return 1 + levelrand.Intn(2)
+		return 1 + rng.Intn(2)
 	}
 	return 1
 }
@@ -79,6 +93,36 @@ func hazardTypesForLevel(level int) []entities.HazardType {
 	return types
 }
 
+// pickHazardType draws a blocking hazard type by SpawnWeight. Types already on the deck are
+// skipped while an unused one is left, so a deck repeats a hazard only when it runs out.
+func pickHazardType(rng *rand.Rand, types []entities.HazardType, placed map[entities.HazardType]int) entities.HazardType {
+	fewest := -1
+	for _, ht := range types {
+		if fewest < 0 || placed[ht] < fewest {
+			fewest = placed[ht]
+		}
+	}
+	var pool []entities.HazardType
+	total := 0
+	for _, ht := range types {
+		if placed[ht] == fewest {
+			pool = append(pool, ht)
+			total += hazardSpawnWeight(ht)
+		}
+	}
+	roll := rng.Intn(total)
+	for _, ht := range pool {
+		if roll -= hazardSpawnWeight(ht); roll < 0 {
+			return ht
+		}
+	}
+	return pool[len(pool)-1]
+}
+
+func hazardSpawnWeight(ht entities.HazardType) int {
+	return max(1, entities.HazardTypes[ht].SpawnWeight)
+}
+
 func filterHazardTypesForMode(types []entities.HazardType, prefs gamemode.ItemPlacementPrefs) []entities.HazardType {
 	if prefs.PlaceHazardSolutionItems {
 		return types
@@ -107,17 +151,17 @@ func collectHazardCandidateCells(g *state.Game, lockedDoorCells, blocked *mapset
 		if !setup.InitProgressPreserved(g, cell) {
 			return
 		}
-		if cell.Name == "Corridor" {
+		if cell.IsCorridor {
 			corridorCandidates = append(corridorCandidates, cell)
 		} else {
 			roomCandidates = append(roomCandidates, cell)
 		}
 	})
 
-	levelrand.Shuffle(len(corridorCandidates), func(i, j int) {
+	g.RNG().Shuffle(len(corridorCandidates), func(i, j int) {
 		corridorCandidates[i], corridorCandidates[j] = corridorCandidates[j], corridorCandidates[i]
 	})
-	levelrand.Shuffle(len(roomCandidates), func(i, j int) {
+	g.RNG().Shuffle(len(roomCandidates), func(i, j int) {
 		roomCandidates[i], roomCandidates[j] = roomCandidates[j], roomCandidates[i]
 	})
 
@@ -156,7 +200,7 @@ func reachableWithoutCells(grid *world.Grid, start *world.Cell, blocked *mapset.
 	return GetReachableCells(grid, start, blocked)
 }
 
-func tryPlaceHazardAt(g *state.Game, cell *world.Cell, hazardTypes []entities.HazardType, lockedDoorCells, blocked, avoid *mapset.Set[*world.Cell]) bool {
+func tryPlaceHazardAt(g *state.Game, cell *world.Cell, hazardTypes []entities.HazardType, placedTypes map[entities.HazardType]int, lockedDoorCells, blocked, avoid *mapset.Set[*world.Cell]) bool {
 	testBlocked := mapset.New[*world.Cell]()
 	blocked.Each(func(c *world.Cell) { testBlocked.Put(c) })
 	testBlocked.Put(cell)
@@ -164,7 +208,7 @@ func tryPlaceHazardAt(g *state.Game, cell *world.Cell, hazardTypes []entities.Ha
 	reachableBefore := reachableWithoutCells(g.Grid, setup.PlayerEntryCell(g), blocked)
 	reachableWithHazard := reachableWithoutCells(g.Grid, setup.PlayerEntryCell(g), &testBlocked)
 
-	hazardType := hazardTypes[levelrand.Intn(len(hazardTypes))]
+	hazardType := pickHazardType(g.RNG(), hazardTypes, placedTypes)
 	hazard := entities.NewHazard(hazardType)
 	info := entities.HazardTypes[hazardType]
 
@@ -175,8 +219,10 @@ func tryPlaceHazardAt(g *state.Game, cell *world.Cell, hazardTypes []entities.Ha
 		return false
 	}
 
+	hazard.Spreading = info.Spreadable && g.Level >= spreadingHazardMinLevel
 	blocked.Put(cell)
 	avoid.Put(cell)
+	placedTypes[hazardType]++
 	addHazardHint(g, cell, info)
 	return true
 }
@@ -224,7 +270,7 @@ func findHazardControlCell(g *state.Game, hazardCell *world.Cell, lockedDoorCell
 		return nil
 	}
 	setup.SortCellsByPosition(candidates)
-	levelrand.Shuffle(len(candidates), func(i, j int) {
+	g.RNG().Shuffle(len(candidates), func(i, j int) {
 		candidates[i], candidates[j] = candidates[j], candidates[i]
 	})
 	for _, cell := range candidates {
@@ -239,7 +285,9 @@ func hazardControlCandidates(g *state.Game, hazardCell *world.Cell, lockedDoorCe
 	var preferred, fallback []*world.Cell
 	placement := setup.NewBlockingPlacementValidator(g)
 	canPlaceCache := make(map[*world.Cell]bool)
-	noLockedDoors := mapset.New[*world.Cell]()
+	start := setup.PlayerEntryCell(g)
+	lockedCuts := setup.NewCutIndex(start, lockedDoorCells)
+	openCuts := setup.NewCutIndex(start, nil)
 	addCandidate := func(cell *world.Cell) {
 		if cell == nil {
 			return
@@ -262,17 +310,17 @@ func hazardControlCandidates(g *state.Game, hazardCell *world.Cell, lockedDoorCe
 		if !canPlace {
 			return
 		}
-		if IsArticulationPoint(g.Grid, setup.PlayerEntryCell(g), cell, lockedDoorCells) {
+		if lockedCuts.IsArticulationPoint(cell) {
 			return
 		}
 		// Also reject chokepoints of the door-openable graph: locked doors open later
 		// (keycards are placed on-deck), and a permanent blocker in front of a door's
 		// only approach cell would wall that room off forever (e.g. rooms hosting
 		// exit-gating repairs, soft-locking the deck).
-		if IsArticulationPoint(g.Grid, setup.PlayerEntryCell(g), cell, &noLockedDoors) {
+		if openCuts.IsArticulationPoint(cell) {
 			return
 		}
-		if cell.Name != "Corridor" {
+		if !cell.IsCorridor {
 			preferred = append(preferred, cell)
 		} else {
 			fallback = append(fallback, cell)
@@ -421,7 +469,7 @@ func canReachWithoutHazardWithGame(g *state.Game, grid *world.Grid, from, to, ha
 }
 
 func addHazardHint(g *state.Game, cell *world.Cell, info entities.HazardInfo) {
-	if cell.Name == "Corridor" {
+	if cell.IsCorridor {
 		g.AddHint(fmt.Sprintf("A %s blocks a corridor passage", info.Name))
 		return
 	}
@@ -513,7 +561,7 @@ func findHazardItemCell(g *state.Game, hazardCell *world.Cell, lockedDoorCells,
 			continue
 		}
 		setup.SortCellsByPosition(candidates)
-		levelrand.Shuffle(len(candidates), func(i, j int) {
+		g.RNG().Shuffle(len(candidates), func(i, j int) {
 			candidates[i], candidates[j] = candidates[j], candidates[i]
 		})
 		return candidates[0]
@@ -568,6 +616,7 @@ func relocateHazardSolutionItems(g *state.Game, conflictCell *world.Cell, locked
 			items = append(items, item)
 		}
 	})
+	setup.SortItemsByName(items)
 	for _, item := range items {
 		conflictCell.ItemsOnFloor.Remove(item)
 		dest := findHazardItemRelocationCell(g, conflictCell, lockedDoorCells, avoid)
@@ -619,7 +668,7 @@ func findHazardItemRelocationCell(g *state.Game, near *world.Cell, lockedDoorCel
 		return nil
 	}
 	setup.SortCellsByPosition(candidates)
-	levelrand.Shuffle(len(candidates), func(i, j int) {
+	g.RNG().Shuffle(len(candidates), func(i, j int) {
 		candidates[i], candidates[j] = candidates[j], candidates[i]
 	})
 	return candidates[0]
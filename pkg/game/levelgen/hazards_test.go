@@ -0,0 +1,116 @@
+package levelgen
+
+import (
+	"testing"
+
+	"github.com/zyedidia/generic/mapset"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/gamemode"
+	"darkstation/pkg/game/generator"
+	"darkstation/pkg/game/levelrand"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// hazardVarietyTestGame builds four 3x3 rooms in a row joined by one-cell corridors, so
+// every corridor is a chokepoint that can host a hazard:
+//
+//	cols: 0-2  3  4-6  7  8-10  11  12-14
+//	      R0   C  R1   C  R2    C   R3
+func hazardVarietyTestGame() *state.Game {
+	g := state.NewGame()
+	g.Level = 5
+	grid := world.NewGrid(3, 15)
+	for room := 0; room < 4; room++ {
+		for r := 0; r < 3; r++ {
+			for c := 0; c < 3; c++ {
+				grid.MarkAsRoomWithName(r, room*4+c, "Room "+string(rune('A'+room)), "room")
+			}
+		}
+		if room < 3 {
+			grid.MarkAsRoomWithName(1, room*4+3, "Corridor", "corridor")
+			grid.GetCell(1, room*4+3).IsCorridor = true
+		}
+	}
+	grid.BuildAllCellConnections()
+	grid.SetStartCellAt(1, 0)
+	grid.SetExitCellAt(1, 14)
+	grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell != nil && cell.Room {
+			gameworld.InitGameData(cell)
+		}
+	})
+	g.Grid = grid
+	return g
+}
+
+func TestPlaceHazards_avoidsRepeatingTypesOnADeck(t *testing.T) {
+	for seed := int64(1); seed <= 30; seed++ {
+		g := hazardVarietyTestGame()
+		g.Rand = levelrand.New(seed)
+		avoid := mapset.New[*world.Cell]()
+		locked := mapset.New[*world.Cell]()
+		PlaceHazards(g, &avoid, &locked, generator.DifficultyParamsFor(g.Mode().Difficulty))
+
+		seen := map[entities.HazardType]bool{}
+		count := 0
+		g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+			if h := gameworld.GetGameData(cell).Hazard; cell.Room && h != nil {
+				if seen[h.Type] {
+					t.Errorf("seed %d: %s placed twice on one deck", seed, h.Name)
+				}
+				seen[h.Type] = true
+				count++
+			}
+		})
+		if count < 2 {
+			t.Fatalf("seed %d: placed %d hazards, want at least 2 on a deck with three chokepoints", seed, count)
+		}
+	}
+}
+
+func TestPickHazardType_keepsRareTypesRareAndRepeatsOnlyWhenForced(t *testing.T) {
+	rng := levelrand.New(7)
+	types := []entities.HazardType{entities.HazardCoolant, entities.HazardRadiation}
+
+	radiation := 0
+	for i := 0; i < 500; i++ {
+		if pickHazardType(rng, types, nil) == entities.HazardRadiation {
+			radiation++
+		}
+	}
+	if radiation == 0 || radiation > 200 {
+		t.Errorf("radiation picked %d/500 times, want rare but possible", radiation)
+	}
+
+	placed := map[entities.HazardType]int{entities.HazardCoolant: 1}
+	if got := pickHazardType(rng, types, placed); got != entities.HazardRadiation {
+		t.Errorf("picked %v with an unused type left, want radiation", got)
+	}
+	placed[entities.HazardRadiation] = 1
+	pickHazardType(rng, types, placed) // both used: any repeat is allowed, must not panic
+}
+
+func TestHazardCount_easyNeverAsksForMoreThanHard(t *testing.T) {
+	easy := generator.DifficultyParamsFor(gamemode.DifficultyEasy)
+	normal := generator.DifficultyParamsFor(gamemode.DifficultyNormal)
+	hard := generator.DifficultyParamsFor(gamemode.DifficultyHard)
+	for seed := int64(1); seed <= 200; seed++ {
+		for level := 1; level <= 12; level++ {
+			e := hazardCount(levelrand.New(seed), level, easy)
+			n := hazardCount(levelrand.New(seed), level, normal)
+			h := hazardCount(levelrand.New(seed), level, hard)
+			if e > n || n > h {
+				t.Fatalf("seed %d deck %d: easy %d, normal %d, hard %d hazards", seed, level, e, n, h)
+			}
+			if want := hazardCountForLevel(levelrand.New(seed), level); level >= 2 && n != want {
+				t.Fatalf("seed %d deck %d: normal asks for %d hazards, want the standard %d", seed, level, n, want)
+			}
+		}
+	}
+	if hazardCount(levelrand.New(1), 1, hard) == 0 {
+		t.Fatal("hard should not keep deck 1 hazard-free")
+	}
+}
@@ -2,7 +2,6 @@
 package levelgen
 
 import (
-	"darkstation/pkg/game/levelrand"
 	"fmt"
 
 	"github.com/zyedidia/generic/mapset"
@@ -20,7 +19,7 @@ func PlaceMaintenanceTerminals(g *state.Game, avoid *mapset.Set[*world.Cell]) {
 	// Collect all unique rooms
 	roomCells := make(map[string][]*world.Cell)
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
-		if cell.Room && cell.Name != "Corridor" && cell.Name != "" {
+		if cell.Room && !cell.IsCorridor && cell.Name != "" {
 			roomCells[cell.Name] = append(roomCells[cell.Name], cell)
 		}
 	})
@@ -139,7 +138,7 @@ func PlaceMaintenanceTerminals(g *state.Game, avoid *mapset.Set[*world.Cell]) {
 		candidates := connectedCandidates
 		setup.SortCellsByPosition(candidates)
 
-		selectedCell := candidates[levelrand.Intn(len(candidates))]
+		selectedCell := candidates[g.RNG().Intn(len(candidates))]
 		maintenanceTerm := entities.NewMaintenanceTerminal(fmt.Sprintf("Maintenance Terminal - %s", roomName), roomName)
 		gameworld.GetGameData(selectedCell).MaintenanceTerm = maintenanceTerm
 		avoid.Put(selectedCell)
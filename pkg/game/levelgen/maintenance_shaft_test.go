@@ -16,7 +16,7 @@ import (
 func TestPlaceMaintenanceTerminals_LiftShaftUsesEastOfBottomLeft(t *testing.T) {
 	g := state.NewGame()
 	g.Level = 2
-	g.Grid = generator.DefaultGenerator.Generate(2, deck.ThemeAirlock)
+	g.Grid = generator.DefaultGenerator.Generate(2, deck.ThemeAirlock, g.RNG())
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
 		if cell != nil {
 			gameworld.InitGameData(cell)
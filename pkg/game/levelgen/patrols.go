@@ -0,0 +1,108 @@
+package levelgen
+
+import (
+	"github.com/zyedidia/generic/mapset"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/levelrand"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/setup"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// PatrolMinLevel is the first deck that may have a maintenance bot on patrol.
+const PatrolMinLevel = 4
+
+// patrolChancePct is the percent chance an eligible deck has a patrol.
+const patrolChancePct = 50
+
+// patrolMinEntryDistance keeps the bot's start this many cells (Manhattan) from the
+// player's entry, so it is never on top of the player as the deck opens.
+const patrolMinEntryDistance = 10
+
+// PlacePatrol may place a malfunctioning maintenance bot on an empty corridor cell, facing
+// along the corridor, and an EMP Charge to deal with it. Placement is fixed by the level seed.
+func PlacePatrol(g *state.Game, avoid *mapset.Set[*world.Cell]) {
+	if g == nil || g.Grid == nil || g.Level < PatrolMinLevel || g.IsFinalDeckLevel(g.Level) {
+		return
+	}
+	entry := setup.PlayerEntryCell(g)
+	if entry == nil {
+		return
+	}
+	rng := levelrand.NewDerived(g.LevelSeed, 0x9A7B07)
+	if rng.Intn(100) >= patrolChancePct {
+		return
+	}
+
+	var candidates []*world.Cell
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if !patrolStartCell(cell) || (avoid != nil && avoid.Has(cell)) {
+			return
+		}
+		if manhattan(cell, entry) < patrolMinEntryDistance {
+			return
+		}
+		candidates = append(candidates, cell)
+	})
+	if len(candidates) == 0 {
+		return
+	}
+	setup.SortCellsByPosition(candidates)
+	cell := candidates[rng.Intn(len(candidates))]
+
+	dirs := world.AllDirections()
+	rng.Shuffle(len(dirs), func(i, j int) { dirs[i], dirs[j] = dirs[j], dirs[i] })
+	heading := dirs[0]
+	for _, dir := range dirs {
+		if next := g.Grid.GetCellRelative(cell, dir); next != nil && next.IsCorridor {
+			heading = dir
+			break
+		}
+	}
+	gameworld.GetGameData(cell).Patrol = entities.NewPatrol("Maintenance Bot", cell.Row, cell.Col, heading)
+	placeEMPCharge(g, avoid)
+}
+
+// placeEMPCharge drops one EMP Charge on floor the player can reach from the start. Only
+// decks with a patrol get one, so it is never dead weight.
+func placeEMPCharge(g *state.Game, avoid *mapset.Set[*world.Cell]) {
+	var candidates []*world.Cell
+	setup.InitialReachableCells(g).Each(func(cell *world.Cell) {
+		if setup.ValidFloorLootPlacementCell(g, cell, avoid) && !gameworld.HasSurvivor(cell) {
+			candidates = append(candidates, cell)
+		}
+	})
+	if len(candidates) == 0 {
+		return
+	}
+	setup.SortCellsByPosition(candidates)
+	rng := levelrand.NewDerived(g.LevelSeed, 0xE3B0C4)
+	cell := candidates[rng.Intn(len(candidates))]
+	cell.ItemsOnFloor.Put(world.NewItem(entities.EMPChargeName))
+	if avoid != nil {
+		avoid.Put(cell)
+	}
+	g.AddHint("An " + renderer.StyledItem(entities.EMPChargeName) + " is in " + renderer.StyledCell(cell.Name))
+}
+
+// patrolStartCell reports whether a patrol may start on cell: an empty corridor cell with
+// at least one corridor neighbor to move along.
+func patrolStartCell(cell *world.Cell) bool {
+	if cell == nil || !cell.Room || !cell.IsCorridor || cell.ExitCell || cell.ItemsOnFloor.Size() > 0 {
+		return false
+	}
+	data := gameworld.GetGameData(cell)
+	if data.Door != nil || data.Hazard != nil || data.AmbientHazard != nil || data.Survivor != nil ||
+		data.PowerRelay != nil || data.RepairDevice != nil || data.RepairBlocker != nil || data.Generator != nil {
+		return false
+	}
+	for _, n := range cell.GetNeighbors() {
+		if n.IsCorridor {
+			return true
+		}
+	}
+	return false
+}
@@ -160,9 +160,8 @@ func TestPlaceRepairObjectives_slimeExitGate_placesChainAndBlocker(t *testing.T)
 	if seed < 0 {
 		t.Fatal("could not find seed that picks slime exit gate")
 	}
-	levelrand.Seed(seed)
-
 	g := state.NewGame()
+	g.Rand = levelrand.New(seed)
 	g.Level = 6
 	g.CurrentDeckID = 5
 	grid := world.NewGrid(5, 5)
@@ -249,9 +248,8 @@ func TestPlaceRepairObjectives_noSlimeExitGate_omitsWastePump(t *testing.T) {
 	if seed < 0 {
 		t.Fatal("could not find seed that picks no exit gate")
 	}
-	levelrand.Seed(seed)
-
 	g := state.NewGame()
+	g.Rand = levelrand.New(seed)
 	g.Level = 6
 	g.CurrentDeckID = 5
 	grid := world.NewGrid(5, 5)
@@ -67,7 +67,7 @@ func pickShedFirstRoom(g *state.Game, rng *rand.Rand) string {
 		entryRoom = entry.Name
 	}
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
-		if cell == nil || !cell.Room || cell.Name == "" || cell.Name == "Corridor" ||
+		if cell == nil || !cell.Room || cell.Name == "" || cell.IsCorridor ||
 			generator.IsPlacementExcludedRoom(cell.Name) || cell.Name == entryRoom || seen[cell.Name] {
 			return
 		}
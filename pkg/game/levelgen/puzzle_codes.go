@@ -0,0 +1,99 @@
+package levelgen
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"darkstation/pkg/game/entities"
+)
+
+// PuzzleCode is a generated access code and the puzzle type that checks it.
+type PuzzleCode struct {
+	Solution string
+	Type     entities.PuzzleType
+}
+
+// puzzleCodeFormat is one family of generated access codes.
+type puzzleCodeFormat int
+
+const (
+	codeNumeric      puzzleCodeFormat = iota // "3-17-42-8"
+	codeDirectional                          // "up-left-up-down" or "north-east-south-west"
+	codeAlphanumeric                         // "K7-Q2-X9"
+	numCodeFormats
+)
+
+// Code alphabets. Letters skip I and O so they cannot be misread as 1 and 0.
+var (
+	codeDirections = [][]string{
+		{"up", "down", "left", "right"},
+		{"north", "south", "east", "west"},
+	}
+	codeLetters = "ABCDEFGHJKLMNPQRSTUVWXYZ"
+)
+
+// maxCodeAttempts bounds the retries for one code before it is lengthened.
+const maxCodeAttempts = 32
+
+// GeneratePuzzleCodes returns n distinct access codes drawn from rng, so a deck seeded
+// the same way always gets the same codes. Formats rotate from a random start, mixing
+// numeric sequences, direction patterns and alphanumerics on decks with several
+// terminals. Codes hold only letters, digits and '-', so entities.ParseCode reads
+// them back whole from "Code: ..." text.
+func GeneratePuzzleCodes(rng *rand.Rand, n int) []PuzzleCode {
+	if n <= 0 {
+		return nil
+	}
+	codes := make([]PuzzleCode, 0, n)
+	seen := make(map[string]bool, n)
+	start := rng.Intn(int(numCodeFormats))
+	for i := 0; i < n; i++ {
+		format := puzzleCodeFormat((start + i) % int(numCodeFormats))
+		// Lengthen the code whenever a format runs short of fresh values.
+		for extra := 0; ; extra++ {
+			code, ok := uniquePuzzleCode(rng, format, extra, seen)
+			if ok {
+				seen[code.Solution] = true
+				codes = append(codes, code)
+				break
+			}
+		}
+	}
+	return codes
+}
+
+// uniquePuzzleCode draws codes of format until one is not in seen, giving up after
+// maxCodeAttempts. extra adds segments to the format's usual length.
+func uniquePuzzleCode(rng *rand.Rand, format puzzleCodeFormat, extra int, seen map[string]bool) (PuzzleCode, bool) {
+	for attempt := 0; attempt < maxCodeAttempts; attempt++ {
+		code := randomPuzzleCode(rng, format, extra)
+		if !seen[code.Solution] {
+			return code, true
+		}
+	}
+	return PuzzleCode{}, false
+}
+
+// randomPuzzleCode builds one code of format with its usual segment count plus extra.
+func randomPuzzleCode(rng *rand.Rand, format puzzleCodeFormat, extra int) PuzzleCode {
+	var parts []string
+	switch format {
+	case codeDirectional:
+		words := codeDirections[rng.Intn(len(codeDirections))]
+		n := 4 + rng.Intn(3) + extra
+		for i := 0; i < n; i++ {
+			parts = append(parts, words[rng.Intn(len(words))])
+		}
+		return PuzzleCode{Solution: strings.Join(parts, "-"), Type: entities.PuzzlePattern}
+	case codeAlphanumeric:
+		for i := 0; i < 3+extra; i++ {
+			parts = append(parts, string(codeLetters[rng.Intn(len(codeLetters))])+strconv.Itoa(1+rng.Intn(9)))
+		}
+	default:
+		for i := 0; i < 4+extra; i++ {
+			parts = append(parts, strconv.Itoa(1+rng.Intn(99)))
+		}
+	}
+	return PuzzleCode{Solution: strings.Join(parts, "-"), Type: entities.PuzzleSequence}
+}
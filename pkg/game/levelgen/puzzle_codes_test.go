@@ -0,0 +1,56 @@
+package levelgen
+
+import (
+	"reflect"
+	"testing"
+
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/levelrand"
+)
+
+func TestGeneratePuzzleCodes_uniqueAndSeeded(t *testing.T) {
+	const n = 200 // far past the old five-code pool
+	codes := GeneratePuzzleCodes(levelrand.NewDerived(424242, puzzleCodeSeedTag), n)
+	if len(codes) != n {
+		t.Fatalf("got %d codes, want %d", len(codes), n)
+	}
+	seen := make(map[string]bool)
+	types := make(map[entities.PuzzleType]bool)
+	for _, c := range codes {
+		if seen[c.Solution] {
+			t.Fatalf("duplicate code %q", c.Solution)
+		}
+		seen[c.Solution] = true
+		types[c.Type] = true
+	}
+	if !types[entities.PuzzleSequence] || !types[entities.PuzzlePattern] {
+		t.Errorf("codes should mix sequence and pattern puzzles, got %v", types)
+	}
+
+	again := GeneratePuzzleCodes(levelrand.NewDerived(424242, puzzleCodeSeedTag), n)
+	if !reflect.DeepEqual(codes, again) {
+		t.Error("the same seed produced different codes")
+	}
+	other := GeneratePuzzleCodes(levelrand.NewDerived(171717, puzzleCodeSeedTag), n)
+	if reflect.DeepEqual(codes, other) {
+		t.Error("different seeds produced the same codes")
+	}
+}
+
+func TestGeneratePuzzleCodes_parseRoundTrip(t *testing.T) {
+	for _, c := range GeneratePuzzleCodes(levelrand.NewDerived(99, puzzleCodeSeedTag), 60) {
+		for _, text := range []string{
+			"A cluttered desk. Code: " + c.Solution,
+			"Code: " + c.Solution + ". Relay: LINK-MHOP-A",
+			"Sequence: " + c.Solution + "\nSigned, the night shift",
+		} {
+			if got := entities.ParseCode(text); got != c.Solution {
+				t.Fatalf("ParseCode(%q) = %q, want %q", text, got, c.Solution)
+			}
+		}
+		puzzle := entities.NewPuzzleTerminal("T", c.Type, c.Solution, "", entities.RewardNone, "")
+		if !puzzle.CheckSolution(c.Solution) {
+			t.Errorf("terminal rejects its own code %q", c.Solution)
+		}
+	}
+}
@@ -4,7 +4,6 @@ package levelgen
 import (
 	"darkstation/pkg/game/levelrand"
 	"fmt"
-	"strings"
 
 	"github.com/zyedidia/generic/mapset"
 
@@ -16,6 +15,9 @@ import (
 	gameworld "darkstation/pkg/game/world"
 )
 
+// puzzleCodeSeedTag derives the access code stream from the deck seed.
+const puzzleCodeSeedTag = 0xC0DE5
+
 // PlacePuzzles places puzzle terminals that require codes found in furniture
 func PlacePuzzles(g *state.Game, avoid *mapset.Set[*world.Cell]) {
 	// Place 1-2 puzzles per level (level 2+)
@@ -26,21 +28,14 @@ func PlacePuzzles(g *state.Game, avoid *mapset.Set[*world.Cell]) {
 		numPuzzles = 2
 	}
 
-	// Generate puzzle solutions (Story 5.2: leading numeric sequences must stay aligned
-	// with deck.ObservationSeqPlaqueMsgID for corridor junction stamp fingerprints).
-	// Story 5.3: second numeric solution must stay aligned with deck.MultiHopKeyedSequenceSolution (+ linkage tier).
-	puzzleSolutions := []string{
-		"1-2-3-4",
-		"2-4-6-8",
-		"up-down-left-right",
-		"north-south-east-west",
-		"alpha-beta-gamma-delta",
-	}
+	// Codes come from their own stream off the deck seed, so they are stable per deck
+	// without shifting the layout RNG.
+	codes := GeneratePuzzleCodes(levelrand.NewDerived(g.LevelSeed, puzzleCodeSeedTag), numPuzzles)
 
 	lockedDoors := mapset.New[*world.Cell]() // no doors yet when placing puzzles
 	roomEntries := setup.FindRoomEntryPoints(g.Grid)
 
-	for i := 0; i < numPuzzles && i < len(puzzleSolutions); i++ {
+	for i, code := range codes {
 		// Find a room for the puzzle
 		puzzleRoom := FindRoom(g, setup.PlayerEntryCell(g), avoid)
 		if puzzleRoom == nil {
@@ -48,7 +43,7 @@ func PlacePuzzles(g *state.Game, avoid *mapset.Set[*world.Cell]) {
 		}
 
 		// Place on a cell that is not an articulation point (global) and does not disconnect the room (R8)
-		placeCell := FindNonArticulationCellInRoom(g.Grid, setup.PlayerEntryCell(g), puzzleRoom, avoid, &lockedDoors)
+		placeCell := FindNonArticulationCellInRoom(g.RNG(), g.Grid, setup.PlayerEntryCell(g), puzzleRoom, avoid, &lockedDoors)
 		if placeCell == nil {
 			placeCell = puzzleRoom
 		}
@@ -63,11 +58,7 @@ func PlacePuzzles(g *state.Game, avoid *mapset.Set[*world.Cell]) {
 			continue
 		}
 
-		solution := puzzleSolutions[i]
-		puzzleType := entities.PuzzleSequence
-		if strings.Contains(solution, "-") && !strings.ContainsAny(solution, "0123456789") {
-			puzzleType = entities.PuzzlePattern
-		}
+		solution := code.Solution
 
 		// Create puzzle with appropriate reward based on level
 		reward := entities.RewardBattery
@@ -81,9 +72,11 @@ func PlacePuzzles(g *state.Game, avoid *mapset.Set[*world.Cell]) {
 			reward = entities.RewardKeycard
 		}
 
+		// The fallback hint names the code itself; it is replaced below once the code is
+		// written into a piece of furniture.
 		puzzle := entities.NewPuzzleTerminal(
 			fmt.Sprintf("Security Terminal #%d", i+1),
-			puzzleType,
+			code.Type,
 			solution,
 			fmt.Sprintf("Find the code in logs or furniture descriptions. Look for: Code: %s", solution),
 			reward,
@@ -96,24 +89,45 @@ func PlacePuzzles(g *state.Game, avoid *mapset.Set[*world.Cell]) {
 		// Place the code in a furniture description in a different room
 		codeRoom := FindRoom(g, setup.PlayerEntryCell(g), avoid)
 		if codeRoom != nil && codeRoom != puzzleRoom {
-			// Find furniture in this room and add code to its description
-			roomCells := []*world.Cell{}
-			g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
-				if cell.Room && cell.Name == codeRoom.Name && gameworld.HasFurniture(cell) {
-					roomCells = append(roomCells, cell)
-				}
-			})
-
-			if len(roomCells) > 0 {
-				// Pick a random furniture in this room
-				furnitureCell := roomCells[levelrand.Intn(len(roomCells))]
-				furniture := gameworld.GetGameData(furnitureCell).Furniture
-				// Append code to description
-				furniture.Description += fmt.Sprintf(" Code: %s", solution)
-			}
 			avoid.Put(codeRoom)
 		}
+		if furnitureCell := pickCodeFurniture(g, codeRoom, puzzleRoom); furnitureCell != nil {
+			furniture := gameworld.GetGameData(furnitureCell).Furniture
+			// Append code to description
+			furniture.Description += fmt.Sprintf(" Code: %s", solution)
+			// Point the terminal (and the hint list) at the hiding spot, not the code itself.
+			puzzle.Hint = fmt.Sprintf("The access code is noted on the %s in %s.", furniture.Name, furnitureCell.Name)
+			g.AddHint(fmt.Sprintf("The code for %s is on the %s in %s",
+				puzzle.Name, renderer.StyledFurniture(furniture.Name), renderer.StyledCell(furnitureCell.Name)))
+		}
 
 		g.AddHint(fmt.Sprintf("A puzzle terminal is in %s", renderer.StyledCell(placeCell.Name)))
 	}
 }
+
+// pickCodeFurniture chooses the furniture cell that will carry a puzzle code: one in
+// codeRoom when that room has any, otherwise one in any other room apart from the
+// puzzle's own. It returns nil when no such furniture exists.
+func pickCodeFurniture(g *state.Game, codeRoom, puzzleRoom *world.Cell) *world.Cell {
+	var preferred, others []*world.Cell
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell == nil || !cell.Room || !gameworld.HasFurniture(cell) {
+			return
+		}
+		if puzzleRoom != nil && cell.Name == puzzleRoom.Name {
+			return
+		}
+		if codeRoom != nil && codeRoom != puzzleRoom && cell.Name == codeRoom.Name {
+			preferred = append(preferred, cell)
+		} else {
+			others = append(others, cell)
+		}
+	})
+	if len(preferred) == 0 {
+		preferred = others
+	}
+	if len(preferred) == 0 {
+		return nil
+	}
+	return preferred[g.RNG().Intn(len(preferred))]
+}
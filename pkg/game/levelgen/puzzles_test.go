@@ -0,0 +1,109 @@
+package levelgen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zyedidia/generic/mapset"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/levelrand"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// puzzleTestGame builds a corridor along row 0 with rooms A (cols 0-1) and B (cols 3-4)
+// below it, the player starting at the corridor's west end.
+func puzzleTestGame(t *testing.T) *state.Game {
+	t.Helper()
+	g := state.NewGame()
+	g.Level = 1
+	g.LevelSeed = 7
+	g.Rand = levelrand.New(7)
+	grid := world.NewGrid(3, 5)
+	for c := 0; c < 5; c++ {
+		grid.MarkAsRoomWithName(0, c, "Corridor", "corridor")
+	}
+	for r := 1; r < 3; r++ {
+		grid.MarkAsRoomWithName(r, 0, "A", "room")
+		grid.MarkAsRoomWithName(r, 1, "A", "room")
+		grid.MarkAsRoomWithName(r, 3, "B", "room")
+		grid.MarkAsRoomWithName(r, 4, "B", "room")
+	}
+	grid.BuildAllCellConnections()
+	grid.SetStartCellAt(0, 0)
+	g.Grid = grid
+	grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell != nil {
+			gameworld.InitGameData(cell)
+		}
+	})
+	return g
+}
+
+func placedPuzzle(g *state.Game) (*world.Cell, *entities.PuzzleTerminal) {
+	var at *world.Cell
+	var puzzle *entities.PuzzleTerminal
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell != nil && gameworld.GetGameData(cell).Puzzle != nil {
+			at, puzzle = cell, gameworld.GetGameData(cell).Puzzle
+		}
+	})
+	return at, puzzle
+}
+
+func TestPlacePuzzles_noFurnitureKeepsCodeInHint(t *testing.T) {
+	g := puzzleTestGame(t)
+	avoid := mapset.New[*world.Cell]()
+
+	PlacePuzzles(g, &avoid)
+
+	_, puzzle := placedPuzzle(g)
+	if puzzle == nil {
+		t.Fatal("expected a puzzle terminal")
+	}
+	if !strings.Contains(puzzle.Hint, "Code: "+puzzle.Solution) {
+		t.Errorf("with no furniture to hold the code, the hint should carry it; got %q", puzzle.Hint)
+	}
+}
+
+func TestPlacePuzzles_codeFallsBackToAnyFurnishedRoom(t *testing.T) {
+	placed := 0
+	for seed := int64(1); seed <= 20; seed++ {
+		g := puzzleTestGame(t)
+		g.LevelSeed = seed
+		g.Rand = levelrand.New(seed)
+		// Only one cell on the deck is furnished; whichever room the terminal and the
+		// first-choice code room land in, the code must still end up on it unless the
+		// terminal shares its room.
+		desk := entities.NewFurniture("Desk", "A cluttered desk.", "▤")
+		deskCell := g.Grid.GetCell(2, 4)
+		gameworld.GetGameData(deskCell).Furniture = desk
+		avoid := mapset.New[*world.Cell]()
+		avoid.Put(deskCell)
+
+		PlacePuzzles(g, &avoid)
+
+		at, puzzle := placedPuzzle(g)
+		if puzzle == nil {
+			continue // no cell could take a blocking terminal on this seed
+		}
+		placed++
+		if at.Name == deskCell.Name {
+			if !strings.Contains(puzzle.Hint, "Code: "+puzzle.Solution) {
+				t.Errorf("seed %d: code has nowhere to go but the hint; got %q", seed, puzzle.Hint)
+			}
+			continue
+		}
+		if entities.ParseCode(desk.Description) != puzzle.Solution {
+			t.Errorf("seed %d: desk description %q should carry code %q", seed, desk.Description, puzzle.Solution)
+		}
+		if strings.Contains(puzzle.Hint, puzzle.Solution) {
+			t.Errorf("seed %d: hint should point at the desk, not print the code: %q", seed, puzzle.Hint)
+		}
+	}
+	if placed == 0 {
+		t.Fatal("no seed placed a puzzle terminal")
+	}
+}
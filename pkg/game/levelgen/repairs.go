@@ -36,7 +36,7 @@ func PlaceRepairObjectives(g *state.Game, avoid *mapset.Set[*world.Cell]) {
 		return
 	}
 
-	exitGate := PickExitGateKind(g.Level)
+	exitGate := PickExitGateKind(g.RNG(), g.Level, g.IsFinalDeckLevel(g.Level))
 	types := repairChainForLevel(g.Level, exitGate)
 	fractions := []float64{0.65, 0.25, 0.45, 0.85}
 	var placed []*entities.RepairObjective
@@ -127,7 +127,7 @@ func collectRepairCandidatesUnfiltered(g *state.Game, avoid *mapset.Set[*world.C
 			return
 		}
 		c := repairCandidate{cell: cell, dist: dist[cell]}
-		if cell.Name != "Corridor" {
+		if !cell.IsCorridor {
 			preferred = append(preferred, c)
 		} else {
 			fallback = append(fallback, c)
@@ -0,0 +1,99 @@
+package levelgen
+
+import (
+	"github.com/zyedidia/generic/mapset"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/levelrand"
+	"darkstation/pkg/game/setup"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// SurvivorMinLevel is the first deck that may hold a survivor to escort.
+const SurvivorMinLevel = 3
+
+// survivorChancePct is the percent chance an eligible deck holds a survivor.
+const survivorChancePct = 50
+
+// maxSurvivorCandidates caps how many cells are run through the blocking-placement checks.
+const maxSurvivorCandidates = 24
+
+var survivorNames = []string{
+	"Technician Okafor",
+	"Ensign Vale",
+	"Dr. Ilse Marr",
+	"Cargo Officer Teague",
+	"Medic Santos",
+	"Engineer Halvorsen",
+}
+
+// PlaceSurvivor may place a stranded survivor the player must escort to the lift.
+// Survivors refuse to walk through hazards, so the survivor is only placed where a
+// hazard-free route to the lift exists once doors are open and blocking hazards cleared.
+// Runs after ambient hazards so those rooms are known.
+func PlaceSurvivor(g *state.Game, avoid *mapset.Set[*world.Cell]) {
+	if g == nil || g.Grid == nil || g.Level < SurvivorMinLevel || g.IsFinalDeckLevel(g.Level) {
+		return
+	}
+	exit := g.Grid.ExitCell()
+	if exit == nil || setup.PlayerEntryCell(g) == nil {
+		return
+	}
+	rng := levelrand.NewDerived(g.LevelSeed, 0x5C7A1B)
+	if rng.Intn(100) >= survivorChancePct {
+		return
+	}
+
+	walkable := survivorWalkableFrom(exit)
+	var candidates []*world.Cell
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if !walkable.Has(cell) || !setup.ValidFloorLootPlacementCell(g, cell, avoid) {
+			return
+		}
+		if data := gameworld.GetGameData(cell); data.PowerRelay != nil || data.Survivor != nil {
+			return
+		}
+		candidates = append(candidates, cell)
+	})
+	setup.SortCellsByPosition(candidates)
+	rng.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	if len(candidates) > maxSurvivorCandidates {
+		candidates = candidates[:maxSurvivorCandidates]
+	}
+
+	for _, cell := range candidates {
+		if !setup.CanPlaceBlockingEntity(g, cell) {
+			continue
+		}
+		name := survivorNames[rng.Intn(len(survivorNames))]
+		gameworld.GetGameData(cell).Survivor = entities.NewSurvivor(name)
+		if avoid != nil {
+			avoid.Put(cell)
+		}
+		return
+	}
+}
+
+// survivorWalkableFrom returns room cells a survivor could walk to from start once the
+// deck is complete: permanent blockers and ambient hazard rooms are impassable.
+func survivorWalkableFrom(start *world.Cell) *mapset.Set[*world.Cell] {
+	reachable := mapset.New[*world.Cell]()
+	reachable.Put(start)
+	queue := []*world.Cell{start}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, n := range cur.GetNeighbors() {
+			if reachable.Has(n) || !n.Room || setup.IsPermanentlyBlockingCell(n) || gameworld.AmbientHazardAt(n) != nil {
+				continue
+			}
+			reachable.Put(n)
+			queue = append(queue, n)
+		}
+	}
+	return &reachable
+}
@@ -0,0 +1,68 @@
+package levelgen
+
+import (
+	"testing"
+
+	"github.com/zyedidia/generic/mapset"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func makeSurvivorTestGame(level int, seed int64) *state.Game {
+	g := state.NewGame()
+	g.Level = level
+	g.LevelSeed = seed
+	grid := world.NewGrid(4, 4)
+	for r := 0; r < 4; r++ {
+		for c := 0; c < 4; c++ {
+			grid.MarkAsRoomWithName(r, c, "R", "room")
+			gameworld.InitGameData(grid.GetCell(r, c))
+		}
+	}
+	grid.BuildAllCellConnections()
+	grid.SetStartCellAt(0, 0)
+	grid.SetExitCellAt(3, 3)
+	g.Grid = grid
+	// Ambient hazard across row 0: survivors must never be stranded behind it.
+	for c := 0; c < 4; c++ {
+		gameworld.GetGameData(grid.GetCell(0, c)).AmbientHazard = entities.NewHazard(entities.HazardPowerBleed)
+	}
+	return g
+}
+
+func TestPlaceSurvivor(t *testing.T) {
+	placed := 0
+	for seed := int64(1); seed <= 40; seed++ {
+		g := makeSurvivorTestGame(SurvivorMinLevel, seed)
+		avoid := mapset.New[*world.Cell]()
+		PlaceSurvivor(g, &avoid)
+		cell, s := g.DeckSurvivor()
+		if s == nil {
+			continue
+		}
+		placed++
+		if gameworld.AmbientHazardAt(cell) != nil {
+			t.Errorf("seed %d: survivor placed in ambient hazard at (%d,%d)", seed, cell.Row, cell.Col)
+		}
+		if cell.ExitCell || !avoid.Has(cell) {
+			t.Errorf("seed %d: survivor cell (%d,%d) is the lift or missing from avoid", seed, cell.Row, cell.Col)
+		}
+		if s.IsFollowing() {
+			t.Errorf("seed %d: survivor should start waiting", seed)
+		}
+	}
+	if placed == 0 {
+		t.Error("expected some seeds to place a survivor")
+	}
+
+	for seed := int64(1); seed <= 40; seed++ {
+		g := makeSurvivorTestGame(SurvivorMinLevel-1, seed)
+		PlaceSurvivor(g, nil)
+		if _, s := g.DeckSurvivor(); s != nil {
+			t.Fatalf("seed %d: survivor placed below SurvivorMinLevel", seed)
+		}
+	}
+}
@@ -148,9 +148,10 @@ func collectRoutingCouplerCandidates(g *state.Game, avoid *mapset.Set[*world.Cel
 	}
 	entry := setup.PlayerEntryCell(g)
 	reachable := setup.InitialReachableCells(g)
+	doorPower := make(map[string]bool)
 	var out []*world.Cell
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
-		if !routingCouplerCandidateCell(g, cell, avoid, usedCouplerCells, requirePowered, entry, reachable) {
+		if !routingCouplerCandidateCell(g, cell, avoid, usedCouplerCells, requirePowered, entry, reachable, doorPower) {
 			return
 		}
 		if lightValidation {
@@ -166,11 +167,14 @@ func collectRoutingCouplerCandidates(g *state.Game, avoid *mapset.Set[*world.Cel
 	return out
 }
 
-func routingCouplerCandidateCell(g *state.Game, cell *world.Cell, avoid *mapset.Set[*world.Cell], usedCouplerCells map[*world.Cell]bool, requirePowered bool, entry *world.Cell, reachable *mapset.Set[*world.Cell]) bool {
+// routingCouplerCandidateCell reports whether cell can host a routing coupler. doorPower
+// memoises CanPowerRoomDoorsFromReachable per room for the scan calling it, since the
+// answer only depends on the room while the grid and reachable set are unchanged.
+func routingCouplerCandidateCell(g *state.Game, cell *world.Cell, avoid *mapset.Set[*world.Cell], usedCouplerCells map[*world.Cell]bool, requirePowered bool, entry *world.Cell, reachable *mapset.Set[*world.Cell], doorPower map[string]bool) bool {
 	if cell == nil || !cell.Room || avoid.Has(cell) {
 		return false
 	}
-	if cell == entry || cell.Name == "Corridor" || generator.IsPlacementExcludedRoom(cell.Name) {
+	if cell == entry || cell.IsCorridor || generator.IsPlacementExcludedRoom(cell.Name) {
 		return false
 	}
 	if usedCouplerCells != nil && usedCouplerCells[cell] {
@@ -182,8 +186,15 @@ func routingCouplerCandidateCell(g *state.Game, cell *world.Cell, avoid *mapset.
 	// Accept rooms reachable at init, or whose doors can be armed from the lift-entry
 	// pocket (same accessibility contract as exit-gating repairs); on small decks the
 	// only spare room may sit behind a door the player powers from the shaft terminal.
-	if !reachable.Has(cell) && !setup.CanPowerRoomDoorsFromReachable(g, reachable, cell.Name) {
-		return false
+	if !reachable.Has(cell) {
+		powerable, seen := doorPower[cell.Name]
+		if !seen {
+			powerable = setup.CanPowerRoomDoorsFromReachable(g, reachable, cell.Name)
+			doorPower[cell.Name] = powerable
+		}
+		if !powerable {
+			return false
+		}
 	}
 	if requirePowered && !setup.RoomConsideredPowered(g, cell.Name) {
 		return false
@@ -252,7 +263,7 @@ func armRoutingCouplerRoomPower(g *state.Game) {
 			continue
 		}
 		cell := g.Grid.GetCell(repair.DeviceRow, repair.DeviceCol)
-		if cell == nil || cell.Name == "" || cell.Name == "Corridor" {
+		if cell == nil || cell.Name == "" || cell.IsCorridor {
 			continue
 		}
 		if g.RoomDoorsPowered[cell.Name] || setup.RoomConsideredPowered(g, cell.Name) {
@@ -2,7 +2,7 @@
 package levelgen
 
 import (
-	"darkstation/pkg/game/levelrand"
+	"math/rand"
 	"strings"
 
 	"github.com/zyedidia/generic/mapset"
@@ -104,7 +104,7 @@ func FindRoom(g *state.Game, start *world.Cell, avoid *mapset.Set[*world.Cell])
 
 	// Pick a random room from the candidates
 	setup.SortCellsByPosition(farRooms)
-	return farRooms[levelrand.Intn(len(farRooms))]
+	return farRooms[g.RNG().Intn(len(farRooms))]
 }
 
 // GetReachableCells returns all cells reachable from start without passing through locked doors
@@ -150,28 +150,23 @@ func GetReachableCellsExcluding(grid *world.Grid, start *world.Cell, lockedDoors
 
 // IsArticulationPoint returns true if blocking this cell would disconnect the reachable set (i.e. the cell is a chokepoint).
 // Hazard controls and other blocking entities should not be placed on articulation points.
+// Callers testing many cells against the same walk should build one setup.CutIndex instead.
 func IsArticulationPoint(grid *world.Grid, start *world.Cell, cell *world.Cell, lockedDoors *mapset.Set[*world.Cell]) bool {
-	fullReach := GetReachableCells(grid, start, lockedDoors)
-	if !fullReach.Has(cell) {
-		return false
-	}
-	reachWithoutCell := GetReachableCellsExcluding(grid, start, lockedDoors, cell)
-	// If blocking this cell loses more than just the cell itself, it's an articulation point
-	return reachWithoutCell.Size() < fullReach.Size()-1
+	return setup.NewCutIndex(start, lockedDoors).IsArticulationPoint(cell)
 }
 
-// FindRoomInReachable finds a random room cell within the reachable set
-func FindRoomInReachable(reachable *mapset.Set[*world.Cell], avoid *mapset.Set[*world.Cell]) *world.Cell {
-	return FindNonArticulationCellInReachable(nil, nil, nil, reachable, avoid)
+// FindRoomInReachable finds a random room cell within the reachable set, drawn from rng
+func FindRoomInReachable(rng *rand.Rand, reachable *mapset.Set[*world.Cell], avoid *mapset.Set[*world.Cell]) *world.Cell {
+	return FindNonArticulationCellInReachable(rng, nil, nil, nil, reachable, avoid)
 }
 
 // FindNonArticulationCellInReachable finds a random cell within the reachable set that is NOT an articulation point,
 // so placing a blocking entity (e.g. hazard control) there won't disconnect rooms. Pass grid, start, lockedDoors
 // as nil to skip articulation-point check (same behavior as old FindRoomInReachable).
-func FindNonArticulationCellInReachable(grid *world.Grid, start *world.Cell, lockedDoors *mapset.Set[*world.Cell], reachable *mapset.Set[*world.Cell], avoid *mapset.Set[*world.Cell]) *world.Cell {
+func FindNonArticulationCellInReachable(rng *rand.Rand, grid *world.Grid, start *world.Cell, lockedDoors *mapset.Set[*world.Cell], reachable *mapset.Set[*world.Cell], avoid *mapset.Set[*world.Cell]) *world.Cell {
 	var candidates []*world.Cell
 	reachable.Each(func(cell *world.Cell) {
-		if cell.Name != "Corridor" && !avoid.Has(cell) {
+		if !cell.IsCorridor && !avoid.Has(cell) {
 			candidates = append(candidates, cell)
 		}
 	})
@@ -190,9 +185,10 @@ func FindNonArticulationCellInReachable(grid *world.Grid, start *world.Cell, loc
 
 	// Filter out articulation points so we don't block paths
 	if grid != nil && start != nil && lockedDoors != nil {
+		cuts := setup.NewCutIndex(start, lockedDoors)
 		var safe []*world.Cell
 		for _, cell := range candidates {
-			if !IsArticulationPoint(grid, start, cell, lockedDoors) {
+			if !cuts.IsArticulationPoint(cell) {
 				safe = append(safe, cell)
 			}
 		}
@@ -202,13 +198,13 @@ func FindNonArticulationCellInReachable(grid *world.Grid, start *world.Cell, loc
 	}
 
 	setup.SortCellsByPosition(candidates)
-	return candidates[levelrand.Intn(len(candidates))]
+	return candidates[rng.Intn(len(candidates))]
 }
 
 // FindNonArticulationCellInRoom finds a cell in the same room as roomCell that is NOT an articulation point,
 // so placing a blocking entity (e.g. puzzle terminal) there won't disconnect rooms. Returns nil if none found;
 // callers can fall back to roomCell.
-func FindNonArticulationCellInRoom(grid *world.Grid, start *world.Cell, roomCell *world.Cell, avoid *mapset.Set[*world.Cell], lockedDoors *mapset.Set[*world.Cell]) *world.Cell {
+func FindNonArticulationCellInRoom(rng *rand.Rand, grid *world.Grid, start *world.Cell, roomCell *world.Cell, avoid *mapset.Set[*world.Cell], lockedDoors *mapset.Set[*world.Cell]) *world.Cell {
 	if grid == nil || start == nil || roomCell == nil || lockedDoors == nil {
 		return nil
 	}
@@ -222,9 +218,10 @@ func FindNonArticulationCellInRoom(grid *world.Grid, start *world.Cell, roomCell
 	if len(candidates) == 0 {
 		return nil
 	}
+	cuts := setup.NewCutIndex(start, lockedDoors)
 	var safe []*world.Cell
 	for _, cell := range candidates {
-		if !IsArticulationPoint(grid, start, cell, lockedDoors) {
+		if !cuts.IsArticulationPoint(cell) {
 			safe = append(safe, cell)
 		}
 	}
@@ -232,7 +229,7 @@ func FindNonArticulationCellInRoom(grid *world.Grid, start *world.Cell, roomCell
 		return nil
 	}
 	setup.SortCellsByPosition(safe)
-	return safe[levelrand.Intn(len(safe))]
+	return safe[rng.Intn(len(safe))]
 }
 
 // ContainsSubstring checks if s contains substr
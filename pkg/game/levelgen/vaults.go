@@ -0,0 +1,103 @@
+package levelgen
+
+import (
+	"fmt"
+
+	"github.com/zyedidia/generic/mapset"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/setup"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// vaultMinLevel is the first deck where one keycard room also needs an access code.
+const vaultMinLevel = 4
+
+// PlaceVaultCode turns one keycard-locked room into a vault: its doors need the keycard
+// AND an access code. The code is written on furniture the player can reach without the
+// vault's keycard, so both requirements can be met before the doors are tried.
+// Must run after furniture and puzzle codes are placed.
+func PlaceVaultCode(g *state.Game) {
+	if g == nil || g.Grid == nil || g.Level < vaultMinLevel {
+		return
+	}
+	doorsByRoom := make(map[string][]*entities.Door)
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if gameworld.HasLockedDoor(cell) {
+			door := gameworld.GetGameData(cell).Door
+			doorsByRoom[door.RoomName] = append(doorsByRoom[door.RoomName], door)
+		}
+	})
+	rooms := SortedRoomMapKeys(doorsByRoom)
+	if len(rooms) == 0 {
+		return
+	}
+	vaultRoom := rooms[g.RNG().Intn(len(rooms))]
+
+	codeCell := findVaultCodeFurniture(g, vaultRoom)
+	if codeCell == nil {
+		return
+	}
+	code := newVaultCode(g)
+	furniture := gameworld.GetGameData(codeCell).Furniture
+	furniture.Description += fmt.Sprintf(" Code: %s", code)
+	for _, door := range doorsByRoom[vaultRoom] {
+		door.RequiresCode = code
+	}
+
+	g.AddHint(fmt.Sprintf("%s is a vault: it needs its keycard and an access code", renderer.StyledCell(vaultRoom)))
+	g.AddHint(fmt.Sprintf("The vault code for %s is on the %s in %s",
+		renderer.StyledCell(vaultRoom), renderer.StyledFurniture(furniture.Name), renderer.StyledCell(codeCell.Name)))
+}
+
+// findVaultCodeFurniture picks furniture outside vaultRoom that carries no code yet and
+// stands next to a cell reachable without entering the vault or any other locked room.
+func findVaultCodeFurniture(g *state.Game, vaultRoom string) *world.Cell {
+	reach := setup.ReachableWithoutEnteringRoom(g, vaultRoom)
+	var candidates []*world.Cell
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if !cell.Room || cell.IsCorridor || cell.Name == vaultRoom || !gameworld.HasFurniture(cell) {
+			return
+		}
+		if entities.ParseCode(gameworld.GetGameData(cell).Furniture.Description) != "" {
+			return
+		}
+		if nextToReachable(reach, cell) {
+			candidates = append(candidates, cell)
+		}
+	})
+	if len(candidates) == 0 {
+		return nil
+	}
+	setup.SortCellsByPosition(candidates)
+	return candidates[g.RNG().Intn(len(candidates))]
+}
+
+func nextToReachable(reach *mapset.Set[*world.Cell], cell *world.Cell) bool {
+	for _, n := range cell.GetNeighbors() {
+		if n != nil && reach.Has(n) {
+			return true
+		}
+	}
+	return false
+}
+
+// newVaultCode returns a four-digit sequence that no puzzle terminal on the deck uses.
+func newVaultCode(g *state.Game) string {
+	used := make(map[string]bool)
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if puzzle := gameworld.GetGameData(cell).Puzzle; puzzle != nil {
+			used[puzzle.Solution] = true
+		}
+	})
+	for {
+		code := fmt.Sprintf("%d-%d-%d-%d",
+			1+g.RNG().Intn(9), 1+g.RNG().Intn(9), 1+g.RNG().Intn(9), 1+g.RNG().Intn(9))
+		if !used[code] {
+			return code
+		}
+	}
+}
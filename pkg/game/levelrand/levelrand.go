@@ -1,45 +1,17 @@
-// Package levelrand provides a deterministic RNG for level generation and setup.
-// All procedural placement must use this package (not math/rand global state).
+// Package levelrand builds the deterministic RNGs used for level generation and setup.
+// Generation draws from an explicit *rand.Rand (state.Game.Rand, built with New), never
+// from math/rand global state, so decks are reproducible from their seed and several
+// can be generated at once.
 package levelrand
 
 import "math/rand"
 
-var rng *rand.Rand
-
-// Seed resets the level-generation RNG. Seed 0 is treated as 1.
-func Seed(seed int64) {
+// New returns a level-generation RNG for seed. Seed 0 is treated as 1.
+func New(seed int64) *rand.Rand {
 	if seed == 0 {
 		seed = 1
 	}
-	rng = rand.New(rand.NewSource(seed))
-}
-
-// RNG returns the active level-generation random source.
-func RNG() *rand.Rand {
-	if rng == nil {
-		Seed(1)
-	}
-	return rng
-}
-
-// Intn returns a uniform int in [0,n).
-func Intn(n int) int {
-	return RNG().Intn(n)
-}
-
-// Float64 returns a uniform float64 in [0,1).
-func Float64() float64 {
-	return RNG().Float64()
-}
-
-// Float32 returns a uniform float32 in [0,1).
-func Float32() float32 {
-	return RNG().Float32()
-}
-
-// Shuffle shuffles items using the level RNG.
-func Shuffle(n int, swap func(i, j int)) {
-	RNG().Shuffle(n, swap)
+	return rand.New(rand.NewSource(seed))
 }
 
 // NewDerived returns an independent RNG stream from the level seed and tag (subsystems).
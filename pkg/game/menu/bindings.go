@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/config"
 	"darkstation/pkg/game/renderer"
 )
 
@@ -59,6 +60,51 @@ func (b *BindingHeaderItem) GetHelpText() string {
 	return ""
 }
 
+// BindingsFileMenuItem exports or imports all bindings via the shared bindings file.
+type BindingsFileMenuItem struct {
+	Import bool
+}
+
+// GetLabel returns the display label for this bindings file row.
+func (b *BindingsFileMenuItem) GetLabel() string {
+	if b.Import {
+		return "Import bindings\tSUBTLE{bindings.json}"
+	}
+	return "Export bindings\tSUBTLE{bindings.json}"
+}
+
+// IsSelectable returns whether this item can be selected.
+func (b *BindingsFileMenuItem) IsSelectable() bool {
+	return true
+}
+
+// GetHelpText returns help text for this bindings file row.
+func (b *BindingsFileMenuItem) GetHelpText() string {
+	if b.Import {
+		return "Load bindings from the settings folder into the active profile and apply them now"
+	}
+	return "Save all bindings to the settings folder to back up or share"
+}
+
+// activate runs the export or import and returns the result for the help line.
+func (b *BindingsFileMenuItem) activate() string {
+	path, err := config.BindingsPath()
+	if err != nil {
+		return fmt.Sprintf("Bindings file unavailable: %v", err)
+	}
+	if b.Import {
+		if err := engineinput.ReadBindingsFile(path); err != nil {
+			return fmt.Sprintf("Import failed: %v", err)
+		}
+		saveActiveBindingProfile()
+		return fmt.Sprintf("Imported bindings from %s", path)
+	}
+	if err := engineinput.WriteBindingsFile(path); err != nil {
+		return fmt.Sprintf("Export failed: %v", err)
+	}
+	return fmt.Sprintf("Exported bindings to %s", path)
+}
+
 // BackMenuItem represents a "Back" menu item for returning to the previous menu.
 type BackMenuItem struct{}
 
@@ -100,6 +146,10 @@ func NewBindingsMenuHandler(fromMainMenu bool) *BindingsMenuHandler {
 				engineinput.ActionMoveSouth,
 				engineinput.ActionMoveWest,
 				engineinput.ActionMoveEast,
+				engineinput.ActionMoveNE,
+				engineinput.ActionMoveNW,
+				engineinput.ActionMoveSE,
+				engineinput.ActionMoveSW,
 			},
 		},
 		{
@@ -107,6 +157,11 @@ func NewBindingsMenuHandler(fromMainMenu bool) *BindingsMenuHandler {
 			Actions: []engineinput.Action{
 				engineinput.ActionInteract,
 				engineinput.ActionHint,
+				engineinput.ActionAutoExplore,
+				engineinput.ActionPickUp,
+				engineinput.ActionDropItem,
+				engineinput.ActionPickLock,
+				engineinput.ActionUseItem,
 			},
 		},
 		{
@@ -114,6 +169,12 @@ func NewBindingsMenuHandler(fromMainMenu bool) *BindingsMenuHandler {
 			Actions: []engineinput.Action{
 				engineinput.ActionZoomIn,
 				engineinput.ActionZoomOut,
+				engineinput.ActionZoomReset,
+				engineinput.ActionExportMap,
+				engineinput.ActionExportDeck,
+				engineinput.ActionPowerDiagnostics,
+				engineinput.ActionCycleObjectives,
+				engineinput.ActionRecenter,
 			},
 		},
 		{
@@ -121,6 +182,7 @@ func NewBindingsMenuHandler(fromMainMenu bool) *BindingsMenuHandler {
 			Actions: []engineinput.Action{
 				engineinput.ActionOpenMenu,
 				engineinput.ActionOpenInventory,
+				engineinput.ActionMessageLog,
 				engineinput.ActionCancel,
 				engineinput.ActionQuit,
 			},
@@ -183,6 +245,15 @@ func (h *BindingsMenuHandler) OnActivate(item MenuItem, index int) (shouldClose
 		return true, ""
 	}
 
+	if fileItem, ok := item.(*BindingsFileMenuItem); ok {
+		return false, fileItem.activate()
+	}
+
+	if profileItem, ok := item.(*BindingProfileMenuItem); ok {
+		_, helpText := profileItem.HandleCycle(1)
+		return false, helpText
+	}
+
 	bindingItem, ok := item.(*BindingMenuItem)
 	if !ok {
 		return false, ""
@@ -200,6 +271,7 @@ func (h *BindingsMenuHandler) OnActivate(item MenuItem, index int) (shouldClose
 	code := renderer.CaptureBindingCode()
 	if code != "" {
 		engineinput.SetSingleBinding(action, code)
+		saveActiveBindingProfile()
 		helpText = fmt.Sprintf("Set binding for %s to %s", actionName, engineinput.FormatBindingCode(code))
 	} else {
 		helpText = ""
@@ -232,6 +304,13 @@ func (h *BindingsMenuHandler) CoreMenuItems() []MenuItem {
 			})
 		}
 	}
+	items = append(items,
+		&BindingHeaderItem{Label: "TITLE{Profile}"},
+		&BindingProfileMenuItem{},
+		&BindingHeaderItem{Label: "TITLE{Backup}"},
+		&BindingsFileMenuItem{},
+		&BindingsFileMenuItem{Import: true},
+	)
 	return items
 }
 
@@ -246,12 +325,7 @@ func (h *BindingsMenuHandler) GetMenuItems() []MenuItem {
 
 // isNonRebindable checks if an action cannot be rebound.
 func isNonRebindable(action engineinput.Action) bool {
-	return action == engineinput.ActionInteract ||
-		action == engineinput.ActionZoomIn ||
-		action == engineinput.ActionZoomOut ||
-		action == engineinput.ActionOpenMenu ||
-		action == engineinput.ActionCancel ||
-		action == engineinput.ActionQuit
+	return engineinput.IsNonRebindable(action)
 }
 
 func bindingLabelsForAction(action engineinput.Action, gamepad bool) string {
@@ -0,0 +1,75 @@
+package menu
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/debuglog"
+)
+
+// BindingProfileMenuItem cycles the named binding profiles. The bindings being left are
+// saved to their profile first; a profile with no saved file starts as a copy of them.
+type BindingProfileMenuItem struct{}
+
+func (b *BindingProfileMenuItem) GetLabel() string {
+	return "Profile\tACTION{" + config.Current().BindingProfile + "}\tSUBTLE{< left/right >}"
+}
+
+func (b *BindingProfileMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (b *BindingProfileMenuItem) GetHelpText() string {
+	return "Switch between saved binding layouts; rebinding updates the active profile"
+}
+
+func (b *BindingProfileMenuItem) CanCycle() bool {
+	return true
+}
+
+func (b *BindingProfileMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	saveActiveBindingProfile()
+	profiles := config.BindingProfiles
+	next := 0
+	for i, name := range profiles {
+		if name == cfg.BindingProfile {
+			next = (i + delta + len(profiles)) % len(profiles)
+		}
+	}
+	if err := cfg.SetBindingProfile(profiles[next]); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	if err := LoadActiveBindingProfile(); err != nil {
+		return true, fmt.Sprintf("Binding profile %s not loaded: %v", cfg.BindingProfile, err)
+	}
+	saveActiveBindingProfile()
+	return true, "Binding profile: " + cfg.BindingProfile
+}
+
+// LoadActiveBindingProfile applies the active profile's saved bindings. A profile that
+// has never been saved leaves the current bindings in place.
+func LoadActiveBindingProfile() error {
+	path, err := config.BindingProfilePath(config.Current().BindingProfile)
+	if err != nil {
+		return err
+	}
+	if err := engineinput.ReadBindingsFile(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// saveActiveBindingProfile writes the current bindings to the active profile's file.
+func saveActiveBindingProfile() {
+	path, err := config.BindingProfilePath(config.Current().BindingProfile)
+	if err == nil {
+		err = engineinput.WriteBindingsFile(path)
+	}
+	if err != nil {
+		debuglog.Warnf("could not save binding profile: %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package menu
+
+import (
+	"fmt"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/deck"
+	"darkstation/pkg/game/state"
+)
+
+// DeckHistoryItem is one read-only row in the deck-cleared history.
+type DeckHistoryItem struct {
+	Label string
+	Help  string
+}
+
+func (d *DeckHistoryItem) GetLabel() string    { return d.Label }
+func (d *DeckHistoryItem) IsSelectable() bool  { return false }
+func (d *DeckHistoryItem) GetHelpText() string { return d.Help }
+
+// DeckHistoryMenuHandler lists cleared decks in descent order.
+type DeckHistoryMenuHandler struct {
+	items []MenuItem
+}
+
+// deckHistoryRowLabel formats a record as a menu row: heading, then clear time, pickups, and rooms.
+func deckHistoryRowLabel(g *state.Game, rec state.DeckClearRecord) string {
+	heading := fmt.Sprintf("Deck %d", rec.Level)
+	if title := deck.ThemeDisplayName(g.ThemeForDeck(rec.DeckID)); title != "" {
+		heading = fmt.Sprintf("Deck %d — %s", rec.Level, title)
+	}
+	rooms := "SUBTLE{rooms unexplored}"
+	if rec.AllRoomsVisited {
+		rooms = "ACTION{all rooms explored}"
+	}
+	return fmt.Sprintf("%s\tSUBTLE{%s} · %s · %s",
+		heading, state.FormatClearClock(rec.ClearedAtSecs), state.FormatItemCount(rec.ItemsCollected), rooms)
+}
+
+// NewDeckHistoryMenuHandler builds read-only rows from g.DeckHistory.
+func NewDeckHistoryMenuHandler(g *state.Game) *DeckHistoryMenuHandler {
+	h := &DeckHistoryMenuHandler{}
+	if g == nil || len(g.DeckHistory) == 0 {
+		h.items = []MenuItem{&DeckHistoryItem{Label: "SUBTLE{No decks cleared yet}"}}
+		return h
+	}
+	for _, rec := range g.DeckHistory {
+		h.items = append(h.items, &DeckHistoryItem{
+			Label: deckHistoryRowLabel(g, rec),
+			Help:  fmt.Sprintf("Cleared %s into the run", state.FormatClearClock(rec.ClearedAtSecs)),
+		})
+	}
+	return h
+}
+
+func (h *DeckHistoryMenuHandler) GetTitle() string {
+	return "Deck History"
+}
+
+func (h *DeckHistoryMenuHandler) GetInstructions(selected MenuItem) string {
+	return engineinput.HintMenuInstructionsGameplay()
+}
+
+func (h *DeckHistoryMenuHandler) OnSelect(item MenuItem, index int) {}
+func (h *DeckHistoryMenuHandler) OnActivate(item MenuItem, index int) (bool, string) {
+	return false, ""
+}
+func (h *DeckHistoryMenuHandler) OnExit()                      {}
+func (h *DeckHistoryMenuHandler) ShouldCloseOnAnyAction() bool { return false }
+
+// RunDeckHistoryMenu opens the deck-cleared history viewer.
+func RunDeckHistoryMenu(g *state.Game) {
+	if g == nil {
+		return
+	}
+	handler := NewDeckHistoryMenuHandler(g)
+	RunMenu(g, handler.items, handler)
+}
@@ -0,0 +1,82 @@
+package menu
+
+import (
+	"fmt"
+	"strings"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/state"
+)
+
+// FloorGuideItem is one read-only row in the floor guide.
+type FloorGuideItem struct {
+	Label string
+	Help  string
+}
+
+func (f *FloorGuideItem) GetLabel() string    { return f.Label }
+func (f *FloorGuideItem) IsSelectable() bool  { return true }
+func (f *FloorGuideItem) GetHelpText() string { return f.Help }
+
+// FloorGuideMenuHandler shows which floor glyph marks which kind of room, grouped by function.
+type FloorGuideMenuHandler struct {
+	items []MenuItem
+}
+
+// floorGuideRow formats a family as an icon row: unvisited glyph, family name, then its rooms.
+func floorGuideRow(fam renderer.RoomFloorFamily) *FloorGuideItem {
+	dep := renderer.InventoryDepiction{Icon: fam.Unvisited, Key: renderer.InventoryDepictionKeyFloor}
+	label := fmt.Sprintf("ITEM{%s}  SUBTLE{%s}", fam.Name, strings.Join(fam.Rooms, ", "))
+	help := fam.Description
+	if fam.Visited != fam.Unvisited {
+		help = fmt.Sprintf("%s. Shows %s once walked", fam.Description, fam.Visited)
+	}
+	return &FloorGuideItem{Label: renderer.FormatInventoryRowLine(dep, label), Help: help}
+}
+
+// NewFloorGuideMenuHandler builds the legend from renderer.RoomFloorFamilies.
+func NewFloorGuideMenuHandler() *FloorGuideMenuHandler {
+	h := &FloorGuideMenuHandler{}
+	h.items = append(h.items, &BindingHeaderItem{Label: "TITLE{Room function}"})
+	for _, fam := range renderer.RoomFloorFamilies {
+		if !fam.Structural {
+			h.items = append(h.items, floorGuideRow(fam))
+		}
+	}
+	h.items = append(h.items, &InfoMenuItem{Label: ""})
+	h.items = append(h.items, &BindingHeaderItem{Label: "TITLE{Structure}"})
+	for _, fam := range renderer.RoomFloorFamilies {
+		if fam.Structural {
+			h.items = append(h.items, floorGuideRow(fam))
+		}
+	}
+	h.items = append(h.items, &InfoMenuItem{Label: ""})
+	h.items = append(h.items, &CloseMenuItem{Label: "Close"})
+	return h
+}
+
+func (h *FloorGuideMenuHandler) GetTitle() string {
+	return "Floor Guide"
+}
+
+func (h *FloorGuideMenuHandler) GetInstructions(selected MenuItem) string {
+	return engineinput.HintMenuInstructionsGameplay()
+}
+
+func (h *FloorGuideMenuHandler) OnSelect(item MenuItem, index int) {}
+func (h *FloorGuideMenuHandler) OnActivate(item MenuItem, index int) (bool, string) {
+	_, isClose := item.(*CloseMenuItem)
+	return isClose, ""
+}
+func (h *FloorGuideMenuHandler) OnExit()                      {}
+func (h *FloorGuideMenuHandler) ShouldCloseOnAnyAction() bool { return false }
+
+// RunFloorGuideMenu opens the room floor glyph legend.
+func RunFloorGuideMenu(g *state.Game) {
+	if g == nil {
+		return
+	}
+	handler := NewFloorGuideMenuHandler()
+	RunMenu(g, handler.items, handler)
+}
@@ -0,0 +1,33 @@
+package menu
+
+import (
+	"strings"
+	"testing"
+
+	"darkstation/pkg/game/renderer"
+)
+
+func TestFloorGuideMenu_listsEveryFamily(t *testing.T) {
+	h := NewFloorGuideMenuHandler()
+	var rows []string
+	for _, item := range h.items {
+		if _, ok := item.(*FloorGuideItem); ok {
+			rows = append(rows, item.GetLabel())
+		}
+	}
+	if len(rows) != len(renderer.RoomFloorFamilies) {
+		t.Fatalf("got %d rows, want one per family (%d)", len(rows), len(renderer.RoomFloorFamilies))
+	}
+	for _, fam := range renderer.RoomFloorFamilies {
+		found := false
+		for _, row := range rows {
+			icon, key, label, ok := renderer.ParseInventoryRowLine(row)
+			if ok && icon == fam.Unvisited && key == string(renderer.InventoryDepictionKeyFloor) && strings.Contains(label, fam.Name) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("family %q has no legend row", fam.Name)
+		}
+	}
+}
@@ -14,6 +14,9 @@ type GameModeMenuItem struct {
 }
 
 func (m *GameModeMenuItem) GetLabel() string {
+	if m.Mode.Endless {
+		return fmt.Sprintf("%s\tSUBTLE{endless decks}", m.Mode.DisplayName)
+	}
 	deckLabel := "deck"
 	if m.Mode.TotalDecks != 1 {
 		deckLabel = "decks"
@@ -33,6 +36,8 @@ func (m *GameModeMenuItem) GetHelpText() string {
 		return "Single-deck layout for quick sessions and experiments"
 	case gamemode.FindTheBatteries:
 		return "Explore one large deck, collect every battery, and power the generator"
+	case gamemode.Endless:
+		return "Score attack: decks keep getting deeper and harder until you give up"
 	default:
 		return fmt.Sprintf("Start a new game in %s mode", m.Mode.DisplayName)
 	}
@@ -9,6 +9,10 @@ type GameplayMenuAction int
 const (
 	GameplayMenuActionClose GameplayMenuAction = iota
 	GameplayMenuActionInventory
+	GameplayMenuActionDeckHistory
+	GameplayMenuActionMessageLog
+	GameplayMenuActionMapPins
+	GameplayMenuActionFloorGuide
 	GameplayMenuActionSettings
 	GameplayMenuActionQuitToTitle
 )
@@ -36,6 +40,14 @@ func (m *GameplayMenuItem) GetHelpText() string {
 		return "Return to the game"
 	case GameplayMenuActionInventory:
 		return "View run-wide inventory"
+	case GameplayMenuActionDeckHistory:
+		return "Review decks cleared this run"
+	case GameplayMenuActionMessageLog:
+		return "Scroll back through this run's messages"
+	case GameplayMenuActionMapPins:
+		return "Pin notes on cells and route back to them"
+	case GameplayMenuActionFloorGuide:
+		return "Which floor pattern marks which kind of room"
 	case GameplayMenuActionSettings:
 		return "Configure bindings and display settings"
 	case GameplayMenuActionQuitToTitle:
@@ -117,6 +129,10 @@ func (h *GameplayMenuHandler) GetMenuItems() []MenuItem {
 	return []MenuItem{
 		&GameplayMenuItem{Label: "Close Menu", Action: GameplayMenuActionClose},
 		&GameplayMenuItem{Label: "Inventory", Action: GameplayMenuActionInventory},
+		&GameplayMenuItem{Label: "Deck History", Action: GameplayMenuActionDeckHistory},
+		&GameplayMenuItem{Label: "Message Log", Action: GameplayMenuActionMessageLog},
+		&GameplayMenuItem{Label: "Map Pins", Action: GameplayMenuActionMapPins},
+		&GameplayMenuItem{Label: "Floor Guide", Action: GameplayMenuActionFloorGuide},
 		&GameplayMenuItem{Label: "Settings", Action: GameplayMenuActionSettings},
 		&GameplayMenuItem{Label: "Quit to Title", Action: GameplayMenuActionQuitToTitle},
 	}
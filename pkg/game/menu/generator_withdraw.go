@@ -0,0 +1,69 @@
+package menu
+
+import (
+	"fmt"
+	"sort"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/debuglog"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/setup"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// withdrawableGenerators returns generators on roomName's armed grid that hold batteries
+// the player can take back, sorted by name. Stalled and tripped units count; permanent
+// and sequenced ones are left out.
+func withdrawableGenerators(g *state.Game, roomName string) []*entities.Generator {
+	var gens []*entities.Generator
+	setup.ArmedGridForRoom(g, roomName).Each(func(c *world.Cell) {
+		gen := gameworld.GetGameData(c).Generator
+		if gen == nil || gen.Permanent || gen.IsSequenced() || gen.BatteriesInserted == 0 {
+			return
+		}
+		gens = append(gens, gen)
+	})
+	sort.Slice(gens, func(i, j int) bool { return gens[i].Name < gens[j].Name })
+	return gens
+}
+
+// WithdrawBatteriesMenuItem takes the batteries back out of one generator on the
+// terminal's grid so they can be carried to another.
+type WithdrawBatteriesMenuItem struct {
+	Parent *MaintenanceMenuHandler
+	Gen    *entities.Generator
+}
+
+func (w *WithdrawBatteriesMenuItem) GetLabel() string {
+	return fmt.Sprintf("Withdraw batteries from %s (ACTION{%d})", w.Gen.Name, w.Gen.BatteriesInserted)
+}
+
+func (w *WithdrawBatteriesMenuItem) IsSelectable() bool { return true }
+
+func (w *WithdrawBatteriesMenuItem) GetHelpText() string {
+	if w.Gen.IsPowered() {
+		return engineinput.HintPressConfirmTo("shut " + w.Gen.Name + " down and take its batteries")
+	}
+	return engineinput.HintPressConfirmTo("take back the batteries in " + w.Gen.Name)
+}
+
+// withdrawBatteries returns gen's batteries to the player. Taking them from a running
+// generator drops its output, so the grid is recomputed (a lift that needed it re-locks).
+func (h *MaintenanceMenuHandler) withdrawBatteries(gen *entities.Generator) string {
+	wasPowered := gen.IsPowered()
+	n := gen.WithdrawBatteries()
+	if n == 0 {
+		return "No batteries to withdraw"
+	}
+	h.g.AddBatteries(n)
+	if wasPowered {
+		setup.NotifyPowerGridChanged(h.g)
+	}
+	debuglog.Info("generator.withdraw", "name", gen.Name, "batteries", n, "was_powered", wasPowered)
+	if wasPowered {
+		return fmt.Sprintf("Withdrew %d battery(ies) — %s is offline", n, gen.Name)
+	}
+	return fmt.Sprintf("Withdrew %d battery(ies) from %s", n, gen.Name)
+}
@@ -0,0 +1,85 @@
+package menu
+
+import (
+	"fmt"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/state"
+)
+
+// DropItemMenuItem is one carried item the player can put down.
+type DropItemMenuItem struct {
+	Item *world.Item
+}
+
+func (d *DropItemMenuItem) GetLabel() string   { return inventoryRowLabel(d.Item.Name) }
+func (d *DropItemMenuItem) IsSelectable() bool { return true }
+func (d *DropItemMenuItem) GetHelpText() string {
+	return "Drop it here; the pick-up key collects it again"
+}
+
+// DropItemMenuHandler lists the droppable carried items; activating one drops it on
+// the player's cell and closes the menu.
+type DropItemMenuHandler struct {
+	g       *state.Game
+	items   []MenuItem
+	dropped *world.Item
+}
+
+// NewDropItemMenuHandler builds the drop list from g's inventory.
+func NewDropItemMenuHandler(g *state.Game) *DropItemMenuHandler {
+	h := &DropItemMenuHandler{g: g}
+	if limit := config.Current().InventoryCap; limit > 0 {
+		h.items = append(h.items, &InfoMenuItem{Label: fmt.Sprintf("SUBTLE{Carrying %d of %d}", g.CarriedItemCount(), limit)})
+	}
+	droppable := g.DroppableItems()
+	if len(droppable) == 0 {
+		h.items = append(h.items, &InfoMenuItem{Label: "SUBTLE{Nothing to drop}"})
+	}
+	for _, item := range droppable {
+		h.items = append(h.items, &DropItemMenuItem{Item: item})
+	}
+	h.items = append(h.items, &InfoMenuItem{Label: ""}, &CloseMenuItem{Label: "Back"})
+	return h
+}
+
+func (h *DropItemMenuHandler) GetTitle() string {
+	return "Drop Item"
+}
+
+func (h *DropItemMenuHandler) GetInstructions(selected MenuItem) string {
+	return engineinput.HintMenuInstructionsGameplay()
+}
+
+func (h *DropItemMenuHandler) OnSelect(item MenuItem, index int) {}
+
+func (h *DropItemMenuHandler) OnActivate(item MenuItem, index int) (bool, string) {
+	if _, isClose := item.(*CloseMenuItem); isClose {
+		return true, ""
+	}
+	drop, ok := item.(*DropItemMenuItem)
+	if !ok {
+		return false, ""
+	}
+	if !h.g.DropItem(drop.Item) {
+		return false, "You can't drop that here"
+	}
+	h.dropped = drop.Item
+	return true, ""
+}
+
+func (h *DropItemMenuHandler) OnExit()                      {}
+func (h *DropItemMenuHandler) ShouldCloseOnAnyAction() bool { return false }
+
+// RunDropItemMenu lets the player choose a carried item to drop on their cell.
+// Returns the dropped item, or nil when they backed out.
+func RunDropItemMenu(g *state.Game) *world.Item {
+	if g == nil {
+		return nil
+	}
+	handler := NewDropItemMenuHandler(g)
+	RunMenu(g, handler.items, handler)
+	return handler.dropped
+}
@@ -103,3 +103,32 @@ func TestNewInventoryMenuHandler_sortedByTypeThenTitle(t *testing.T) {
 		t.Fatalf("third deck row should be Zebra Tool: %q", deckRows[2])
 	}
 }
+
+func TestDropItemMenuHandler_dropsChosenItem(t *testing.T) {
+	g := state.NewGame()
+	g.CurrentCell = world.NewCell(0, 0, "Cargo Bay", "")
+	g.HasMap = true
+	g.OwnedItems.Put(world.NewItem("Map"))
+	kit := world.NewItem("Patch Kit")
+	g.OwnedItems.Put(kit)
+
+	h := NewDropItemMenuHandler(g)
+	var drop *DropItemMenuItem
+	for _, item := range h.items {
+		if d, ok := item.(*DropItemMenuItem); ok {
+			if drop != nil {
+				t.Fatalf("only the patch kit should be droppable, also got %q", d.Item.Name)
+			}
+			drop = d
+		}
+	}
+	if drop == nil || drop.Item != kit {
+		t.Fatal("patch kit should be listed for dropping")
+	}
+	if closeMenu, _ := h.OnActivate(drop, 0); !closeMenu || h.dropped != kit {
+		t.Fatal("activating the row should drop the kit and close the menu")
+	}
+	if !g.CurrentCell.ItemsOnFloor.Has(kit) {
+		t.Error("dropped kit should be on the player's cell")
+	}
+}
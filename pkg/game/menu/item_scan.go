@@ -0,0 +1,148 @@
+package menu
+
+import (
+	"fmt"
+	"sort"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// itemScanBatteryCost is how many batteries one deck item scan drains from the player.
+const itemScanBatteryCost = 1
+
+// roomLoot counts one room's uncollected items for the item scan.
+type roomLoot struct {
+	Room   string
+	Floor  int // lying on the floor
+	Hidden int // inside furniture not yet searched
+}
+
+// Total returns every uncollected item in the room.
+func (r roomLoot) Total() int {
+	return r.Floor + r.Hidden
+}
+
+// uncollectedLootByRoom counts items still on the floor and items hidden in unchecked
+// furniture, grouped by room (all corridors share one entry) and sorted by room name.
+func uncollectedLootByRoom(g *state.Game) []roomLoot {
+	if g == nil || g.Grid == nil {
+		return nil
+	}
+	byRoom := make(map[string]*roomLoot)
+	g.Grid.ForEachCell(func(row, col int, c *world.Cell) {
+		if c == nil || !c.Room {
+			return
+		}
+		floor := c.ItemsOnFloor.Size()
+		hidden := 0
+		if f := gameworld.GetGameData(c).Furniture; f != nil && f.HasItem() && !f.IsChecked() {
+			hidden = 1
+		}
+		if floor+hidden == 0 {
+			return
+		}
+		entry := byRoom[c.Name]
+		if entry == nil {
+			entry = &roomLoot{Room: c.Name}
+			byRoom[c.Name] = entry
+		}
+		entry.Floor += floor
+		entry.Hidden += hidden
+	})
+	out := make([]roomLoot, 0, len(byRoom))
+	for _, entry := range byRoom {
+		out = append(out, *entry)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Room < out[j].Room })
+	return out
+}
+
+// ItemScanMenuItem spends a battery to report uncollected items on the deck by room.
+type ItemScanMenuItem struct {
+	Parent *MaintenanceMenuHandler
+}
+
+func (s *ItemScanMenuItem) GetLabel() string {
+	return fmt.Sprintf("Scan for items (%d battery)", itemScanBatteryCost)
+}
+
+func (s *ItemScanMenuItem) IsSelectable() bool { return true }
+
+func (s *ItemScanMenuItem) GetHelpText() string {
+	if s.Parent == nil || s.Parent.g.Batteries < itemScanBatteryCost {
+		return "Requires a battery to power the scan"
+	}
+	return engineinput.HintPressConfirmTo("list rooms that still hold uncollected items")
+}
+
+// runItemScanResultsMenu shows the scan results; tests replace it to skip the blocking menu.
+var runItemScanResultsMenu = func(g *state.Game, handler *ItemScanResultsMenuHandler) {
+	RunMenu(g, handler.items, handler)
+}
+
+// scanForItems drains the scan's battery cost and opens the per-room results.
+func (h *MaintenanceMenuHandler) scanForItems() string {
+	if h.g.Batteries < itemScanBatteryCost {
+		return "Requires a battery to power the scan"
+	}
+	h.g.UseBatteries(itemScanBatteryCost)
+	loot := uncollectedLootByRoom(h.g)
+	total := 0
+	for _, r := range loot {
+		total += r.Total()
+	}
+	if total == 0 {
+		return "Scan: no uncollected items on this deck"
+	}
+	runItemScanResultsMenu(h.g, NewItemScanResultsMenuHandler(loot, total))
+	return fmt.Sprintf("Scan: %d uncollected item(s) in %d room(s)", total, len(loot))
+}
+
+// ItemScanResultsMenuHandler lists the rooms an item scan found loot in, without cells.
+type ItemScanResultsMenuHandler struct {
+	items []MenuItem
+}
+
+// NewItemScanResultsMenuHandler builds one row per room with its floor and hidden counts.
+func NewItemScanResultsMenuHandler(loot []roomLoot, total int) *ItemScanResultsMenuHandler {
+	h := &ItemScanResultsMenuHandler{}
+	h.items = append(h.items,
+		&InfoMenuItem{Label: fmt.Sprintf("%d uncollected item(s) remain on this deck:", total)},
+		&InfoMenuItem{Label: ""},
+	)
+	for _, r := range loot {
+		room := r.Room
+		if room == world.CorridorName {
+			room = "Corridors"
+		}
+		detail := fmt.Sprintf("%d on the floor", r.Floor)
+		switch {
+		case r.Floor == 0:
+			detail = fmt.Sprintf("%d in furniture", r.Hidden)
+		case r.Hidden > 0:
+			detail += fmt.Sprintf(", %d in furniture", r.Hidden)
+		}
+		h.items = append(h.items, &InfoMenuItem{Label: fmt.Sprintf("%s -\t%s", room, detail)})
+	}
+	h.items = append(h.items, &InfoMenuItem{Label: ""}, &CloseMenuItem{Label: "Back"})
+	return h
+}
+
+func (h *ItemScanResultsMenuHandler) GetTitle() string {
+	return "Item Scan"
+}
+
+func (h *ItemScanResultsMenuHandler) GetInstructions(selected MenuItem) string {
+	return engineinput.HintMenuCloseShort() + "."
+}
+
+func (h *ItemScanResultsMenuHandler) OnSelect(item MenuItem, index int) {}
+func (h *ItemScanResultsMenuHandler) OnActivate(item MenuItem, index int) (bool, string) {
+	_, isClose := item.(*CloseMenuItem)
+	return isClose, ""
+}
+func (h *ItemScanResultsMenuHandler) OnExit()                      {}
+func (h *ItemScanResultsMenuHandler) ShouldCloseOnAnyAction() bool { return false }
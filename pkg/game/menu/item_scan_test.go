@@ -0,0 +1,73 @@
+package menu
+
+import (
+	"strings"
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func TestUncollectedLootByRoom_countsFloorAndUnsearchedFurniture(t *testing.T) {
+	g, _ := makeMenuTestGame(t)
+	g.Grid.GetCell(0, 0).ItemsOnFloor.Put(world.NewItem("Battery"))
+	g.Grid.GetCell(1, 1).ItemsOnFloor.Put(world.NewItem("Keycard"))
+	locker := entities.NewFurniture("Locker", "", "L")
+	locker.ContainedItem = world.NewItem("Fuse")
+	gameworld.GetGameData(g.Grid.GetCell(2, 0)).Furniture = locker
+	searched := entities.NewFurniture("Desk", "", "D")
+	searched.ContainedItem = world.NewItem("Map")
+	searched.Checked = true
+	gameworld.GetGameData(g.Grid.GetCell(0, 1)).Furniture = searched
+
+	loot := uncollectedLootByRoom(g)
+	want := []roomLoot{
+		{Room: "Corridor", Floor: 1},
+		{Room: "RoomA", Floor: 1},
+		{Room: "RoomB", Hidden: 1},
+	}
+	if len(loot) != len(want) {
+		t.Fatalf("loot = %+v, want %+v", loot, want)
+	}
+	for i := range want {
+		if loot[i] != want[i] {
+			t.Errorf("loot[%d] = %+v, want %+v", i, loot[i], want[i])
+		}
+	}
+}
+
+func TestScanForItems_spendsBatteryAndListsRooms(t *testing.T) {
+	g, termCell := makeMenuTestGame(t)
+	h := NewMaintenanceMenuHandler(g, termCell, gameworld.GetGameData(termCell).MaintenanceTerm)
+	item := &ItemScanMenuItem{Parent: h}
+	var shown *ItemScanResultsMenuHandler
+	prev := runItemScanResultsMenu
+	runItemScanResultsMenu = func(_ *state.Game, handler *ItemScanResultsMenuHandler) { shown = handler }
+	t.Cleanup(func() { runItemScanResultsMenu = prev })
+
+	if _, help := h.OnActivate(item, 0); !strings.Contains(help, "Requires a battery") {
+		t.Fatalf("without batteries help = %q", help)
+	}
+
+	g.AddBatteries(1)
+	if _, help := h.OnActivate(item, 0); help != "Scan: no uncollected items on this deck" || g.Batteries != 0 {
+		t.Fatalf("empty deck scan help = %q batteries = %d", help, g.Batteries)
+	}
+
+	g.AddBatteries(1)
+	g.Grid.GetCell(2, 1).ItemsOnFloor.Put(world.NewItem("Fuse"))
+	g.Grid.GetCell(2, 0).ItemsOnFloor.Put(world.NewItem("Keycard"))
+	_, help := h.OnActivate(item, 0)
+	if help != "Scan: 2 uncollected item(s) in 1 room(s)" || g.Batteries != 0 {
+		t.Fatalf("scan help = %q batteries = %d", help, g.Batteries)
+	}
+	if shown == nil {
+		t.Fatal("scan results menu was not opened")
+	}
+	rows := strings.Join(labels(shown.items), "\n")
+	if !strings.Contains(rows, "RoomB -\t2 on the floor") || strings.Contains(rows, "(2,") {
+		t.Fatalf("results rows = %q, want a RoomB count without cells", rows)
+	}
+}
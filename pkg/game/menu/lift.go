@@ -30,6 +30,15 @@ func (d *LiftDeckItem) deckHeading() string {
 	return fmt.Sprintf("Deck %d", d.Level)
 }
 
+// rating returns the pre-descent difficulty preview for this deck, if one was computed.
+func (d *LiftDeckItem) rating() (state.DeckRating, bool) {
+	if d.G == nil || d.Blocked != "" {
+		return state.DeckRating{}, false
+	}
+	r, ok := d.G.DeckRatings[d.DeckID]
+	return r, ok
+}
+
 func (d *LiftDeckItem) GetLabel() string {
 	if d.Blocked != "" {
 		return fmt.Sprintf("%s\tSUBTLE{%s}", d.deckHeading(), d.Blocked)
@@ -37,6 +46,9 @@ func (d *LiftDeckItem) GetLabel() string {
 	if d.G != nil && d.G.CurrentDeckID == d.DeckID {
 		return fmt.Sprintf("%s\tSUBTLE{current}", d.deckHeading())
 	}
+	if r, ok := d.rating(); ok {
+		return fmt.Sprintf("%s\tSUBTLE{difficulty: }ACTION{%s} SUBTLE{(%d/5)}", d.deckHeading(), r.Label(), r.Tier())
+	}
 	return d.deckHeading()
 }
 
@@ -51,10 +63,14 @@ func (d *LiftDeckItem) GetHelpText() string {
 	if d.G != nil && d.G.CurrentDeckID == d.DeckID {
 		return "You are already on this deck"
 	}
+	help := fmt.Sprintf("Travel to deck %d", d.Level)
 	if title := d.deckTitle(); title != "" {
-		return fmt.Sprintf("Travel to deck %d — %s", d.Level, title)
+		help = fmt.Sprintf("Travel to deck %d — %s", d.Level, title)
+	}
+	if r, ok := d.rating(); ok {
+		help += fmt.Sprintf(". %s: %s", r.Label(), r.Summary())
 	}
-	return fmt.Sprintf("Travel to deck %d", d.Level)
+	return help
 }
 
 // LiftMenuHandler handles deck selection at the lift shaft.
@@ -49,3 +49,19 @@ func TestLiftDeckItem_includesThemeTitle(t *testing.T) {
 		t.Fatalf("label %q should include theme separator", label)
 	}
 }
+
+func TestLiftDeckItem_showsDifficultyPreview(t *testing.T) {
+	g := state.NewGame()
+	g.InitRunUnlocks(42)
+	g.CurrentDeckID = 0
+	g.DeckRatings = map[int]state.DeckRating{1: {LockedRooms: 3, BatteriesRequired: 4, BatteriesAvailable: 4}}
+
+	item := &LiftDeckItem{DeckID: 1, Level: 2, G: g}
+	if label := item.GetLabel(); !strings.Contains(label, "Moderate") {
+		t.Fatalf("label %q should include the difficulty tier", label)
+	}
+	help := item.GetHelpText()
+	if !strings.Contains(help, "3 locked room(s)") || !strings.Contains(help, "4 batteries needed / 4 found") {
+		t.Errorf("help %q should list what drives the rating", help)
+	}
+}
@@ -0,0 +1,149 @@
+package menu
+
+import (
+	"fmt"
+	"strings"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+)
+
+// LockpickMenuHandler runs the lock-picking mini-game on one door cell. The lock's pins
+// bind in a hidden order and must be pushed in that order; pushing any other pin drops
+// every pin and costs a slip (counted on the door). Running out of slips snaps the pick.
+type LockpickMenuHandler struct {
+	door      *entities.Door
+	order     []int
+	set       int // pins set so far, in binding order
+	maxSlips  int
+	onPicked  func()
+	onSnapped func()
+}
+
+// NewLockpickMenuHandler builds the mini-game for door. seed fixes the binding order
+// (see entities.LockpickSeed); onPicked runs when the last pin sets and onSnapped when
+// the pick breaks.
+func NewLockpickMenuHandler(door *entities.Door, seed int64, onPicked, onSnapped func()) *LockpickMenuHandler {
+	pins := max(door.PickDifficulty, 1)
+	return &LockpickMenuHandler{
+		door:      door,
+		order:     entities.LockpickOrder(seed, pins),
+		maxSlips:  entities.LockpickSlips(pins),
+		onPicked:  onPicked,
+		onSnapped: onSnapped,
+	}
+}
+
+func (h *LockpickMenuHandler) GetTitle() string {
+	return "Pick Lock — " + h.door.DoorName()
+}
+
+func (h *LockpickMenuHandler) GetInstructions(selected MenuItem) string {
+	return fmt.Sprintf("%s, %s to push a pin, %s to stop",
+		engineinput.HintMenuSelect(), engineinput.HintConfirm(), engineinput.HintMenuClose())
+}
+
+func (h *LockpickMenuHandler) OnSelect(item MenuItem, index int) {}
+
+func (h *LockpickMenuHandler) OnActivate(item MenuItem, index int) (shouldClose bool, helpText string) {
+	pin, ok := item.(*LockpickPinItem)
+	if !ok {
+		return false, ""
+	}
+	return h.push(pin.Index)
+}
+
+func (h *LockpickMenuHandler) OnExit() {}
+
+func (h *LockpickMenuHandler) ShouldCloseOnAnyAction() bool {
+	return false
+}
+
+// HandleCancelShortcut backs away from the lock; pins already set drop, slips stay counted.
+func (h *LockpickMenuHandler) HandleCancelShortcut(g *state.Game) bool {
+	return true
+}
+
+func (h *LockpickMenuHandler) GetMenuItems() []MenuItem {
+	items := []MenuItem{&LockpickStatusItem{Handler: h}}
+	for i := range h.order {
+		items = append(items, &LockpickPinItem{Handler: h, Index: i})
+	}
+	return items
+}
+
+// push pushes pin index: the next pin in binding order sets, any other pin drops them all.
+func (h *LockpickMenuHandler) push(index int) (shouldClose bool, helpText string) {
+	if h.isSet(index) {
+		return false, fmt.Sprintf("Pin %d is already set", index+1)
+	}
+	if h.order[h.set] == index {
+		h.set++
+		if h.set == len(h.order) {
+			if h.onPicked != nil {
+				h.onPicked()
+			}
+			return true, ""
+		}
+		return false, fmt.Sprintf("POWERED{Click.} Pin %d sets", index+1)
+	}
+	h.set = 0
+	h.door.PickSlips++
+	if h.door.PickSlips >= h.maxSlips {
+		if h.onSnapped != nil {
+			h.onSnapped()
+		}
+		return true, ""
+	}
+	return false, fmt.Sprintf("UNPOWERED{Slip!} Every pin drops — %d slips left", h.slipsLeft())
+}
+
+func (h *LockpickMenuHandler) slipsLeft() int {
+	return max(h.maxSlips-h.door.PickSlips, 0)
+}
+
+func (h *LockpickMenuHandler) isSet(index int) bool {
+	for _, pin := range h.order[:h.set] {
+		if pin == index {
+			return true
+		}
+	}
+	return false
+}
+
+// LockpickStatusItem shows how many pins are set and how many slips the pick has left.
+type LockpickStatusItem struct {
+	Handler *LockpickMenuHandler
+}
+
+func (s *LockpickStatusItem) GetLabel() string {
+	h := s.Handler
+	bar := strings.Repeat("█", h.set) + strings.Repeat("░", len(h.order)-h.set)
+	return fmt.Sprintf("Pins set: ACTION{%d}/%d %s  Slips left: ACTION{%d}", h.set, len(h.order), bar, h.slipsLeft())
+}
+
+func (s *LockpickStatusItem) IsSelectable() bool { return false }
+
+func (s *LockpickStatusItem) GetHelpText() string {
+	return "Find the order the pins bind in; a wrong pin drops them all"
+}
+
+// LockpickPinItem is one pin of the lock.
+type LockpickPinItem struct {
+	Handler *LockpickMenuHandler
+	Index   int
+}
+
+func (p *LockpickPinItem) GetLabel() string {
+	if p.Handler.isSet(p.Index) {
+		return fmt.Sprintf("Pin %d: POWERED{set}", p.Index+1)
+	}
+	return fmt.Sprintf("Pin %d: SUBTLE{loose}", p.Index+1)
+}
+
+func (p *LockpickPinItem) IsSelectable() bool { return true }
+
+func (p *LockpickPinItem) GetHelpText() string {
+	return engineinput.HintPressConfirmTo(fmt.Sprintf("push pin %d", p.Index+1))
+}
@@ -0,0 +1,61 @@
+package menu
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/entities"
+)
+
+func newTestLockpick(pins int) (*LockpickMenuHandler, *bool, *bool) {
+	door := entities.NewDoor("Lab")
+	door.Pickable = true
+	door.PickDifficulty = pins
+	picked, snapped := false, false
+	h := NewLockpickMenuHandler(door, 99, func() { picked = true }, func() { snapped = true })
+	return h, &picked, &snapped
+}
+
+func TestLockpickMenuHandler_pinsInOrderOpenTheLock(t *testing.T) {
+	h, picked, snapped := newTestLockpick(4)
+	for i, pin := range h.order {
+		closed, _ := h.push(pin)
+		if last := i == len(h.order)-1; closed != last {
+			t.Fatalf("push %d closed = %v, want %v", i, closed, last)
+		}
+	}
+	if !*picked || *snapped {
+		t.Fatalf("picked = %v, snapped = %v; want picked only", *picked, *snapped)
+	}
+}
+
+func TestLockpickMenuHandler_wrongPinDropsPinsAndSnapsAtLimit(t *testing.T) {
+	h, picked, snapped := newTestLockpick(3)
+	if closed, _ := h.push(h.order[0]); closed || h.set != 1 {
+		t.Fatalf("first correct pin: closed = %v, set = %d", closed, h.set)
+	}
+	wrong := h.order[2]
+	if closed, _ := h.push(wrong); closed {
+		t.Fatal("a slip with slips left should keep the lock open")
+	}
+	if h.set != 0 || h.door.PickSlips != 1 {
+		t.Fatalf("after slip set = %d, slips = %d; want 0 and 1", h.set, h.door.PickSlips)
+	}
+	for h.door.PickSlips < h.maxSlips-1 {
+		h.push(wrong)
+	}
+	if closed, _ := h.push(wrong); !closed {
+		t.Fatal("the last slip should snap the pick and close")
+	}
+	if *picked || !*snapped {
+		t.Fatalf("picked = %v, snapped = %v; want snapped only", *picked, *snapped)
+	}
+}
+
+func TestLockpickMenuHandler_slipsSurviveReopening(t *testing.T) {
+	h, _, _ := newTestLockpick(3)
+	h.push(h.order[1])
+	again := NewLockpickMenuHandler(h.door, 99, nil, nil)
+	if got, want := again.slipsLeft(), h.maxSlips-1; got != want {
+		t.Fatalf("slips left after reopening = %d, want %d", got, want)
+	}
+}
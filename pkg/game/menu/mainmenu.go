@@ -13,15 +13,18 @@ type MainMenuAction int
 
 const (
 	MainMenuActionGenerate MainMenuAction = iota
+	MainMenuActionDaily
 	MainMenuActionSettings
 	MainMenuActionPerfMap
 	MainMenuActionQuit
+	MainMenuActionResume
 )
 
 // MainMenuItem represents a menu item in the main menu.
 type MainMenuItem struct {
 	Label  string
 	Action MainMenuAction
+	Detail string // Extra context for the help line (the autosave summary for Resume)
 }
 
 // GetLabel returns the display label for this menu item.
@@ -37,8 +40,12 @@ func (m *MainMenuItem) IsSelectable() bool {
 // GetHelpText returns help text for this menu item.
 func (m *MainMenuItem) GetHelpText() string {
 	switch m.Action {
+	case MainMenuActionResume:
+		return "Continue the autosaved run: " + m.Detail
 	case MainMenuActionGenerate:
-		return "Choose a game mode and start a new run"
+		return "Choose a game mode, difficulty, deck size, and seed, then start a new run"
+	case MainMenuActionDaily:
+		return "Today's station: the same seed for every player, changing at midnight UTC"
 	case MainMenuActionSettings:
 		return "Configure bindings and display settings"
 	case MainMenuActionQuit:
@@ -52,6 +59,7 @@ func (m *MainMenuItem) GetHelpText() string {
 type MainMenuHandler struct {
 	selectedAction  MainMenuAction
 	perfMapScenario string
+	resumeSummary   string
 	shouldQuit      bool
 }
 
@@ -62,6 +70,12 @@ func NewMainMenuHandler() *MainMenuHandler {
 	}
 }
 
+// SetResume offers a Continue row above New Game, described by summary. An empty
+// summary (no usable autosave) hides the row.
+func (h *MainMenuHandler) SetResume(summary string) {
+	h.resumeSummary = summary
+}
+
 // GetTitle returns the menu title.
 func (h *MainMenuHandler) GetTitle() string {
 	return "The Dark Station"
@@ -142,11 +156,16 @@ func (h *MainMenuHandler) ShouldQuit() bool {
 
 // GetMenuItems returns the menu items for the main menu.
 func (h *MainMenuHandler) GetMenuItems() []MenuItem {
-	return []MenuItem{
-		&MainMenuItem{Label: "Generate", Action: MainMenuActionGenerate},
+	var items []MenuItem
+	if h.resumeSummary != "" {
+		items = append(items, &MainMenuItem{Label: "Continue", Action: MainMenuActionResume, Detail: h.resumeSummary})
+	}
+	return append(items,
+		&MainMenuItem{Label: "New Game", Action: MainMenuActionGenerate},
+		&MainMenuItem{Label: "Daily", Action: MainMenuActionDaily},
 		&MainMenuItem{Label: "Settings", Action: MainMenuActionSettings},
 		&MainMenuItem{Label: "Quit", Action: MainMenuActionQuit},
-	}
+	)
 }
 
 // RunMainMenu runs the main menu and returns the selected action or quits.
@@ -18,3 +18,18 @@ func TestMainMenuHandler_PerfMapShortcut(t *testing.T) {
 		t.Fatalf("perf map scenario = %q, want entities", h.GetPerfMapScenario())
 	}
 }
+
+func TestMainMenuHandler_ResumeRowOnlyWithAutosave(t *testing.T) {
+	h := NewMainMenuHandler()
+	if first := h.GetMenuItems()[0].(*MainMenuItem); first.Action != MainMenuActionGenerate {
+		t.Fatalf("first row without autosave = %q, want New Game", first.Label)
+	}
+	h.SetResume("Deck 3, Normal, saved 16 Oct 14:05")
+	first := h.GetMenuItems()[0].(*MainMenuItem)
+	if first.Action != MainMenuActionResume {
+		t.Fatalf("first row with autosave = %q, want Continue", first.Label)
+	}
+	if help := first.GetHelpText(); help != "Continue the autosaved run: Deck 3, Normal, saved 16 Oct 14:05" {
+		t.Fatalf("resume help = %q", help)
+	}
+}
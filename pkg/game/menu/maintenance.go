@@ -3,10 +3,11 @@ package menu
 
 import (
 	"fmt"
+	"sort"
 
 	engineinput "darkstation/pkg/engine/input"
 	"darkstation/pkg/engine/world"
-	"darkstation/pkg/game/deck"
+	"darkstation/pkg/game/debuglog"
 	"darkstation/pkg/game/entities"
 	"darkstation/pkg/game/renderer"
 	"darkstation/pkg/game/setup"
@@ -14,37 +15,34 @@ import (
 	gameworld "darkstation/pkg/game/world"
 )
 
-// roomPowerSummary returns per-room supply and consumption for display.
-func roomPowerSummary(g *state.Game, roomName string) (supply, consumption int) {
-	if g == nil || g.Grid == nil {
-		return 0, 0
-	}
-	params := deck.DecayParamsForDeck(g.CurrentDeckID)
-
-	// Supply from generators on the same armed routing grid as this room.
-	supply = setup.ArmedGridSupplyForRoom(g, roomName)
-
-	// Consumption from doors (10w per powered room), CCTV and puzzles (in this room)
-	var rawConsumption int
-	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
-		if cell == nil {
-			return
-		}
-		data := gameworld.GetGameData(cell)
-		if data.Door != nil && data.Door.RoomName == roomName && setup.RoomIsOnline(g, roomName) {
-			rawConsumption += 10
-		}
-		if cell.Room && cell.Name == roomName {
-			if data.Terminal != nil && g.RoomCCTVPowered[roomName] {
-				rawConsumption += 10
-			}
-			if data.Puzzle != nil && data.Puzzle.IsSolved() {
-				rawConsumption += 3
-			}
+// gridGeneratorLines lists generators on the armed grid at cell with their rating,
+// so the player can see which units carry the load.
+func gridGeneratorLines(g *state.Game, cell *world.Cell) []string {
+	grid := setup.ArmedGridForCell(g, cell)
+	var gens []*entities.Generator
+	grid.Each(func(c *world.Cell) {
+		if gen := gameworld.GetGameData(c).Generator; gen != nil {
+			gens = append(gens, gen)
 		}
 	})
-	consumption = int(float64(rawConsumption) * params.PowerCostMultiplier)
-	return supply, consumption
+	sort.Slice(gens, func(i, j int) bool { return gens[i].Name < gens[j].Name })
+	lines := make([]string, 0, len(gens))
+	for _, gen := range gens {
+		lines = append(lines, fmt.Sprintf("%s (%s) -\t%s", gen.Name, gen.TierName(), renderer.FormatPowerLoad(gen.RatedOutput(), gen.IsPowered(), false)))
+	}
+	return lines
+}
+
+// liftMotorLine reports free watts on the lift's grid against what its motor needs.
+func liftMotorLine(g *state.Game) string {
+	free, required := setup.ExitLiftPower(g)
+	if required == 0 {
+		return "SUBTLE{Lift motor: }UNPOWERED{no grid power}"
+	}
+	if free < required {
+		return fmt.Sprintf("SUBTLE{Lift motor: }UNPOWERED{%dw free of %dw needed}", max(free, 0), required)
+	}
+	return fmt.Sprintf("SUBTLE{Lift motor: }POWERED{%dw free of %dw needed}", free, required)
 }
 
 // RoomLabelWithPowerDraw returns the room name with current draw appended (e.g. "Station Spine: 6w").
@@ -224,6 +222,10 @@ type RoomPowerToggleMenuItem struct {
 	CountSuffix    string // e.g. " cells" for lights, "" for doors
 }
 
+// soleSupplyLightsHelp explains why the lights in the room holding the only running
+// generator cannot be switched off.
+const soleSupplyLightsHelp = "Lights stay on while this room's generator is the only supply"
+
 // Room power draw in watts when on (per specs).
 const roomPowerWattsWhenOn = 10
 
@@ -242,23 +244,26 @@ func canToggleRoomPower(g *state.Game, controllerRoom, targetRoom string) bool {
 	return roomMaintenanceTerminalPowered(g, targetRoom)
 }
 
-// GetLabel returns the current power state and watts for this room/system.
-func (r *RoomPowerToggleMenuItem) GetLabel() string {
-	var on bool
+// isOn reports whether this room/system is currently switched on.
+func (r *RoomPowerToggleMenuItem) isOn() bool {
 	switch r.PowerType {
 	case "doors":
-		on = r.G.RoomDoorsPowered[r.RoomName]
+		return r.G.RoomDoorsPowered[r.RoomName]
 	case "cctv":
-		on = r.G.RoomCCTVPowered[r.RoomName]
+		return r.G.RoomCCTVPowered[r.RoomName]
 	case "lights":
 		if v, ok := r.G.RoomLightsPowered[r.RoomName]; ok {
-			on = v
-		} else {
-			on = true // default on when not yet set
+			return v
 		}
+		return true // default on when not yet set
 	default:
-		on = false
+		return false
 	}
+}
+
+// GetLabel returns the current power state and watts for this room/system.
+func (r *RoomPowerToggleMenuItem) GetLabel() string {
+	on := r.isOn()
 	canControl := canToggleRoomPower(r.G, r.ControllerRoom, r.RoomName)
 	watts := 0
 	if on && r.PowerType != "lights" {
@@ -294,6 +299,12 @@ func (r *RoomPowerToggleMenuItem) IsSelectable() bool {
 // GetHelpText returns help text; explains dependency when control is unavailable.
 func (r *RoomPowerToggleMenuItem) GetHelpText() string {
 	if canToggleRoomPower(r.G, r.ControllerRoom, r.RoomName) {
+		if r.PowerType == "lights" && r.isOn() && setup.RoomHoldsSoleSupply(r.G, r.RoomName) {
+			return soleSupplyLightsHelp
+		}
+		if impact := PreviewRoomPowerToggleImpact(r.G, r.RoomName, r.PowerType); impact != "" {
+			return impact
+		}
 		return engineinput.HintPressConfirmTo("toggle power")
 	}
 	if r.ControllerRoom != "" && r.ControllerRoom != r.RoomName {
@@ -636,9 +647,13 @@ func (h *MaintenanceMenuHandler) OnActivate(item MenuItem, index int) (shouldClo
 			if _, ok := h.g.RoomLightsPowered[toggle.RoomName]; !ok {
 				current = true
 			}
+			if current && setup.RoomHoldsSoleSupply(h.g, toggle.RoomName) {
+				return false, soleSupplyLightsHelp
+			}
 			h.g.RoomLightsPowered[toggle.RoomName] = !current
 			// Lights use 0w, no consumption change
 		}
+		debuglog.Info("power.toggle", "room", toggle.RoomName, "system", toggle.PowerType, "on", toggle.isOn(), "note", helpText)
 		if helpText == "" {
 			setup.ApplyGridConductivePower(h.g)
 		}
@@ -684,6 +699,15 @@ func (h *MaintenanceMenuHandler) OnActivate(item MenuItem, index int) (shouldClo
 	if _, isPing := item.(*PingTerminalsMenuItem); isPing {
 		return false, h.pingNearbyInline()
 	}
+	if _, isSonar := item.(*SonarPingMenuItem); isSonar {
+		return false, h.sonarPing()
+	}
+	if _, isScan := item.(*ItemScanMenuItem); isScan {
+		return false, h.scanForItems()
+	}
+	if withdraw, isWithdraw := item.(*WithdrawBatteriesMenuItem); isWithdraw {
+		return false, h.withdrawBatteries(withdraw.Gen)
+	}
 	if _, isView := item.(*ViewingRoomMenuItem); isView {
 		if msg, ok := h.cycleRoomMessage(1); ok {
 			return false, msg
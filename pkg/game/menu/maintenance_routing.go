@@ -547,7 +547,7 @@ func (h *MaintenanceMenuHandler) HandleMaintenanceIntent(intent engineinput.Inte
 func (h *MaintenanceMenuHandler) getControlsMenuItems() []MenuItem {
 	flavourLine := deck.TerminalFlavourText(h.g.CurrentDeckID)
 	gridSupply, gridUsed, _ := setup.GridPowerSummary(h.g, h.cell)
-	_, roomConsumption := roomPowerSummary(h.g, h.selectedRoomName)
+	_, roomConsumption := setup.RoomPowerSummary(h.g, h.selectedRoomName)
 
 	items := []MenuItem{
 		&InfoMenuItem{Label: "SUBTLE{" + flavourLine + "}"},
@@ -558,6 +558,8 @@ func (h *MaintenanceMenuHandler) getControlsMenuItems() []MenuItem {
 		&RoomCircuitPresetMenuItem{Parent: h},
 		&DelayedShutdownMenuItem{Parent: h},
 		&PingTerminalsMenuItem{},
+		&SonarPingMenuItem{Parent: h},
+		&ItemScanMenuItem{Parent: h},
 		&ModeToggleMenuItem{Parent: h},
 		&InfoMenuItem{Label: ""},
 		&CloseMenuItem{Label: "Close"},
@@ -607,10 +609,23 @@ func (h *MaintenanceMenuHandler) getDiagnosticsMenuItems() []MenuItem {
 			items = append(items, &DeviceMenuItem{Device: device})
 		}
 	}
-	roomSupply, roomConsumption := roomPowerSummary(h.g, h.selectedRoomName)
+	roomSupply, roomConsumption := setup.RoomPowerSummary(h.g, h.selectedRoomName)
 	items = append(items,
 		&InfoMenuItem{Label: renderer.FormatPowerBarLine("Room power", roomSupply, roomConsumption)},
 		&InfoMenuItem{Label: ""},
+	)
+	if genLines := gridGeneratorLines(h.g, h.cell); len(genLines) > 0 {
+		items = append(items, &InfoMenuItem{Label: "Generators on this grid:"})
+		for _, line := range genLines {
+			items = append(items, &InfoMenuItem{Label: line})
+		}
+	}
+	for _, gen := range withdrawableGenerators(h.g, h.terminalRoomName) {
+		items = append(items, &WithdrawBatteriesMenuItem{Parent: h, Gen: gen})
+	}
+	items = append(items,
+		&InfoMenuItem{Label: liftMotorLine(h.g)},
+		&InfoMenuItem{Label: ""},
 		&RefreshPowerGridMenuItem{Parent: h},
 		&AdvancedPowerMenuItem{Parent: h},
 		&ModeToggleMenuItem{Parent: h},
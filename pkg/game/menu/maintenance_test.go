@@ -655,3 +655,35 @@ func TestOnActivate_viewingRoomCycles(t *testing.T) {
 		t.Fatal("expected help text after cycle")
 	}
 }
+
+func TestWithdrawBatteriesMenuItem_returnsBatteriesAndDropsSupply(t *testing.T) {
+	g, termCell := makeMenuTestGame(t)
+	gen := gameworld.GetGameData(g.Grid.GetCell(0, 0)).Generator
+	h := NewMaintenanceMenuHandler(g, termCell, gameworld.GetGameData(termCell).MaintenanceTerm)
+	h.mode = maintModeDiagnostics
+
+	var withdraw *WithdrawBatteriesMenuItem
+	for _, it := range h.GetMenuItems() {
+		if w, ok := it.(*WithdrawBatteriesMenuItem); ok && w.Gen == gen {
+			withdraw = w
+		}
+	}
+	if withdraw == nil {
+		t.Fatal("diagnostics panel should offer to withdraw G1's battery")
+	}
+	before := g.Batteries
+	if _, msg := h.OnActivate(withdraw, 0); !strings.Contains(msg, "G1 is offline") {
+		t.Errorf("help text = %q, want it to report G1 offline", msg)
+	}
+	if g.Batteries != before+1 || gen.IsPowered() {
+		t.Errorf("batteries=%d powered=%v, want %d and offline", g.Batteries, gen.IsPowered(), before+1)
+	}
+	if g.PowerSupply != 0 {
+		t.Errorf("power supply = %d after withdrawing the only generator, want 0", g.PowerSupply)
+	}
+	for _, it := range h.GetMenuItems() {
+		if _, ok := it.(*WithdrawBatteriesMenuItem); ok {
+			t.Error("empty generator is still offered for withdrawal")
+		}
+	}
+}
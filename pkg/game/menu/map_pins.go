@@ -0,0 +1,149 @@
+package menu
+
+import (
+	"fmt"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/state"
+)
+
+// MapPinMenuAction identifies what a map pins row does.
+type MapPinMenuAction int
+
+const (
+	MapPinMenuActionPlace MapPinMenuAction = iota
+	MapPinMenuActionRemove
+	MapPinMenuActionRoute
+)
+
+// MapPinMenuItem is one selectable row in the map pins menu.
+type MapPinMenuItem struct {
+	Label  string
+	Help   string
+	Action MapPinMenuAction
+	Row    int
+	Col    int
+}
+
+func (m *MapPinMenuItem) GetLabel() string    { return m.Label }
+func (m *MapPinMenuItem) IsSelectable() bool  { return true }
+func (m *MapPinMenuItem) GetHelpText() string { return m.Help }
+
+// MapPinsMenuHandler places, edits, and lists the player's pins on the current deck.
+type MapPinsMenuHandler struct {
+	g       *state.Game
+	target  *world.Cell
+	onRoute func(row, col int)
+}
+
+// NewMapPinsMenuHandler builds the pins menu. target is the cell a new pin goes on (may be nil);
+// onRoute is called when the player picks a pin from the list.
+func NewMapPinsMenuHandler(g *state.Game, target *world.Cell, onRoute func(row, col int)) *MapPinsMenuHandler {
+	return &MapPinsMenuHandler{g: g, target: target, onRoute: onRoute}
+}
+
+// mapPinRowLabel formats a pin as a list row: note, then room and cell position.
+func mapPinRowLabel(g *state.Game, pin state.MapPin) string {
+	where := fmt.Sprintf("%d,%d", pin.Row, pin.Col)
+	if g != nil && g.Grid != nil {
+		if cell := g.Grid.GetCell(pin.Row, pin.Col); cell != nil && cell.Name != "" {
+			where = fmt.Sprintf("%s %s", cell.Name, where)
+		}
+	}
+	return fmt.Sprintf("ITEM{%s}\tSUBTLE{%s}", pin.Note, where)
+}
+
+func (h *MapPinsMenuHandler) GetMenuItems() []MenuItem {
+	var items []MenuItem
+	if h.target != nil {
+		note, pinned := h.g.MapPinAt(h.target.Row, h.target.Col)
+		label := "Pin this cell"
+		if pinned {
+			label = "Edit pin\tSUBTLE{" + note + "}"
+		}
+		items = append(items, &MapPinMenuItem{
+			Label:  label,
+			Help:   "Write a note on the faced cell, or your own cell",
+			Action: MapPinMenuActionPlace,
+			Row:    h.target.Row,
+			Col:    h.target.Col,
+		})
+		if pinned {
+			items = append(items, &MapPinMenuItem{
+				Label:  "Remove pin",
+				Help:   "Delete the note on this cell",
+				Action: MapPinMenuActionRemove,
+				Row:    h.target.Row,
+				Col:    h.target.Col,
+			})
+		}
+		items = append(items, &InfoMenuItem{Label: ""})
+	}
+	items = append(items, &BindingHeaderItem{Label: "TITLE{Pins on this deck}"})
+	pins := h.g.SortedMapPins()
+	if len(pins) == 0 {
+		items = append(items, &InfoMenuItem{Label: "SUBTLE{No pins yet}"})
+	}
+	for _, pin := range pins {
+		items = append(items, &MapPinMenuItem{
+			Label:  mapPinRowLabel(h.g, pin),
+			Help:   "Trace a route to this pin",
+			Action: MapPinMenuActionRoute,
+			Row:    pin.Row,
+			Col:    pin.Col,
+		})
+	}
+	items = append(items, &InfoMenuItem{Label: ""})
+	items = append(items, &CloseMenuItem{Label: "Close"})
+	return items
+}
+
+func (h *MapPinsMenuHandler) GetTitle() string {
+	return "Map Pins"
+}
+
+func (h *MapPinsMenuHandler) GetInstructions(selected MenuItem) string {
+	return engineinput.HintMenuInstructionsGameplay()
+}
+
+func (h *MapPinsMenuHandler) OnSelect(item MenuItem, index int) {}
+
+func (h *MapPinsMenuHandler) OnActivate(item MenuItem, index int) (bool, string) {
+	if _, isClose := item.(*CloseMenuItem); isClose {
+		return true, ""
+	}
+	pinItem, ok := item.(*MapPinMenuItem)
+	if !ok {
+		return false, ""
+	}
+	switch pinItem.Action {
+	case MapPinMenuActionPlace:
+		initial, _ := h.g.MapPinAt(pinItem.Row, pinItem.Col)
+		note, ok := RunTextInputDialog(h.g, TextInputOptions{
+			Title:   "Map pin",
+			Prompt:  fmt.Sprintf("Note (up to %d characters)", state.MapPinNoteMaxLen),
+			Initial: initial,
+		})
+		if !ok {
+			return false, "Pin cancelled"
+		}
+		h.g.SetMapPin(pinItem.Row, pinItem.Col, note)
+		if _, pinned := h.g.MapPinAt(pinItem.Row, pinItem.Col); !pinned {
+			return false, "Pin removed"
+		}
+		return false, "Pin placed"
+	case MapPinMenuActionRemove:
+		h.g.RemoveMapPin(pinItem.Row, pinItem.Col)
+		return false, "Pin removed"
+	case MapPinMenuActionRoute:
+		if h.onRoute != nil {
+			h.onRoute(pinItem.Row, pinItem.Col)
+		}
+		return true, ""
+	}
+	return false, ""
+}
+
+func (h *MapPinsMenuHandler) OnExit()                      {}
+func (h *MapPinsMenuHandler) ShouldCloseOnAnyAction() bool { return false }
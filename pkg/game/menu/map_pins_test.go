@@ -0,0 +1,63 @@
+package menu
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/state"
+)
+
+func TestMapPinsMenu_listsPinsAndRoutes(t *testing.T) {
+	g := state.NewGame()
+	grid := world.NewGrid(1, 3)
+	grid.MarkAsRoomWithName(0, 0, "Cargo Bay", "ROOM_CARGO")
+	grid.MarkAsRoomWithName(0, 2, "Cargo Bay", "ROOM_CARGO")
+	g.Grid = grid
+	g.SetMapPin(0, 2, "battery here")
+
+	var routed *state.CellCoord
+	h := NewMapPinsMenuHandler(g, grid.GetCell(0, 0), func(row, col int) {
+		routed = &state.CellCoord{Row: row, Col: col}
+	})
+	var pinRow *MapPinMenuItem
+	for _, item := range h.GetMenuItems() {
+		if pi, ok := item.(*MapPinMenuItem); ok && pi.Action == MapPinMenuActionRoute {
+			pinRow = pi
+		}
+	}
+	if pinRow == nil {
+		t.Fatal("no route row for the pin")
+	}
+	if want := "ITEM{battery here}\tSUBTLE{Cargo Bay 0,2}"; pinRow.Label != want {
+		t.Errorf("label = %q, want %q", pinRow.Label, want)
+	}
+	if closeMenu, _ := h.OnActivate(pinRow, 0); !closeMenu {
+		t.Error("routing to a pin should close the menu")
+	}
+	if routed == nil || *routed != (state.CellCoord{Row: 0, Col: 2}) {
+		t.Errorf("routed = %v, want 0,2", routed)
+	}
+}
+
+func TestMapPinsMenu_removeRowOnlyWhenPinned(t *testing.T) {
+	g := state.NewGame()
+	g.Grid = world.NewGrid(1, 1)
+	target := g.Grid.GetCell(0, 0)
+	h := NewMapPinsMenuHandler(g, target, nil)
+	countRemove := func() int {
+		n := 0
+		for _, item := range h.GetMenuItems() {
+			if pi, ok := item.(*MapPinMenuItem); ok && pi.Action == MapPinMenuActionRemove {
+				n++
+			}
+		}
+		return n
+	}
+	if n := countRemove(); n != 0 {
+		t.Fatalf("remove rows = %d before pinning, want 0", n)
+	}
+	g.SetMapPin(0, 0, "note")
+	if n := countRemove(); n != 1 {
+		t.Fatalf("remove rows = %d after pinning, want 1", n)
+	}
+}
@@ -0,0 +1,125 @@
+package menu
+
+import (
+	"fmt"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/state"
+)
+
+// messageLogPageRows is how many log entries the message log shows at once.
+const messageLogPageRows = 15
+
+// MessageLogRowItem is one visible row of the message log; its entry follows the scroll position.
+type MessageLogRowItem struct {
+	Parent *MessageLogMenuHandler
+	Row    int
+}
+
+func (r *MessageLogRowItem) GetLabel() string {
+	entry, ok := r.Parent.entryAt(r.Row)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("SUBTLE{Deck %d · %s}  %s", entry.Level, state.FormatClearClock(entry.AtSecs), entry.Text)
+}
+
+func (r *MessageLogRowItem) IsSelectable() bool  { return false }
+func (r *MessageLogRowItem) GetHelpText() string { return "" }
+
+// MessageLogMenuHandler shows the run's message history newest first. Rows are not
+// selectable: up/down scroll by one entry and left/right by a page, so only the Back
+// row takes the highlight.
+type MessageLogMenuHandler struct {
+	log    []state.LoggedMessage
+	offset int // entries scrolled past from the newest
+	items  []MenuItem
+}
+
+// NewMessageLogMenuHandler snapshots g.MessageLog and builds one page of rows.
+func NewMessageLogMenuHandler(g *state.Game) *MessageLogMenuHandler {
+	h := &MessageLogMenuHandler{}
+	if g != nil {
+		h.log = append([]state.LoggedMessage(nil), g.MessageLog...)
+	}
+	if len(h.log) == 0 {
+		h.items = []MenuItem{&InfoMenuItem{Label: "SUBTLE{No messages yet}"}}
+	} else {
+		h.items = append(h.items, &InfoMenuItem{Label: h.positionLabel()})
+		for row := range min(len(h.log), messageLogPageRows) {
+			h.items = append(h.items, &MessageLogRowItem{Parent: h, Row: row})
+		}
+	}
+	h.items = append(h.items, &InfoMenuItem{Label: ""})
+	if len(h.log) > messageLogPageRows {
+		h.items = append(h.items, &InfoMenuItem{Label: "SUBTLE{" + engineinput.HintScrollInstructions() + "}"})
+	}
+	h.items = append(h.items, &CloseMenuItem{Label: "Back"})
+	return h
+}
+
+// entryAt returns the entry shown on a visible row, counting from the newest message.
+func (h *MessageLogMenuHandler) entryAt(row int) (state.LoggedMessage, bool) {
+	i := len(h.log) - 1 - h.offset - row
+	if row < 0 || i < 0 || i >= len(h.log) {
+		return state.LoggedMessage{}, false
+	}
+	return h.log[i], true
+}
+
+// scroll moves the view by delta entries toward older messages, clamped to the log.
+func (h *MessageLogMenuHandler) scroll(delta int) {
+	maxOffset := max(len(h.log)-messageLogPageRows, 0)
+	h.offset = min(max(h.offset+delta, 0), maxOffset)
+	if info, ok := h.items[0].(*InfoMenuItem); ok && len(h.log) > 0 {
+		info.Label = h.positionLabel()
+	}
+}
+
+// positionLabel reports which entries are on screen, newest being 1.
+func (h *MessageLogMenuHandler) positionLabel() string {
+	last := min(h.offset+messageLogPageRows, len(h.log))
+	return fmt.Sprintf("SUBTLE{Messages %d–%d of %d, newest first}", h.offset+1, last, len(h.log))
+}
+
+// HandleMaintenanceIntent scrolls the log; RunMenu offers extra input to any handler.
+func (h *MessageLogMenuHandler) HandleMaintenanceIntent(intent engineinput.Intent) (bool, string) {
+	switch intent.Action {
+	case engineinput.ActionMoveNorth:
+		h.scroll(-1)
+	case engineinput.ActionMoveSouth:
+		h.scroll(1)
+	case engineinput.ActionMoveWest:
+		h.scroll(-messageLogPageRows)
+	case engineinput.ActionMoveEast:
+		h.scroll(messageLogPageRows)
+	default:
+		return false, ""
+	}
+	return true, ""
+}
+
+func (h *MessageLogMenuHandler) GetTitle() string {
+	return "Message Log"
+}
+
+func (h *MessageLogMenuHandler) GetInstructions(selected MenuItem) string {
+	return engineinput.HintScrollInstructions()
+}
+
+func (h *MessageLogMenuHandler) OnSelect(item MenuItem, index int) {}
+func (h *MessageLogMenuHandler) OnActivate(item MenuItem, index int) (bool, string) {
+	_, isClose := item.(*CloseMenuItem)
+	return isClose, ""
+}
+func (h *MessageLogMenuHandler) OnExit()                      {}
+func (h *MessageLogMenuHandler) ShouldCloseOnAnyAction() bool { return false }
+
+// RunMessageLogMenu opens the scrollable history of this run's messages.
+func RunMessageLogMenu(g *state.Game) {
+	if g == nil {
+		return
+	}
+	handler := NewMessageLogMenuHandler(g)
+	RunMenu(g, handler.items, handler)
+}
@@ -0,0 +1,51 @@
+package menu
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/state"
+)
+
+func TestMessageLogMenu_NewestFirstAndScrolls(t *testing.T) {
+	g := state.NewGame()
+	for i := range messageLogPageRows + 5 {
+		g.AddMessage(fmt.Sprintf("event %d", i))
+	}
+	h := NewMessageLogMenuHandler(g)
+	row := h.items[1]
+	if label := row.GetLabel(); !strings.HasSuffix(label, "event 19") {
+		t.Fatalf("top row = %q, want the newest message", label)
+	}
+
+	h.HandleMaintenanceIntent(engineinput.Intent{Action: engineinput.ActionMoveSouth})
+	if label := row.GetLabel(); !strings.HasSuffix(label, "event 18") {
+		t.Errorf("top row after scrolling down = %q, want event 18", label)
+	}
+	h.HandleMaintenanceIntent(engineinput.Intent{Action: engineinput.ActionMoveEast})
+	if label := row.GetLabel(); !strings.HasSuffix(label, "event 14") {
+		t.Errorf("top row after paging = %q, want the scroll clamped at the oldest page", label)
+	}
+	if !strings.Contains(h.items[0].GetLabel(), "6–20 of 20") {
+		t.Errorf("position label = %q, want 6–20 of 20", h.items[0].GetLabel())
+	}
+	h.HandleMaintenanceIntent(engineinput.Intent{Action: engineinput.ActionMoveWest})
+	if label := row.GetLabel(); !strings.HasSuffix(label, "event 19") {
+		t.Errorf("top row after paging back = %q, want the newest message", label)
+	}
+	if consumed, _ := h.HandleMaintenanceIntent(engineinput.Intent{Action: engineinput.ActionInteract}); consumed {
+		t.Error("interact was consumed; Back must stay activatable")
+	}
+}
+
+func TestMessageLogMenu_EmptyLog(t *testing.T) {
+	h := NewMessageLogMenuHandler(state.NewGame())
+	if !strings.Contains(h.items[0].GetLabel(), "No messages yet") {
+		t.Errorf("first row = %q, want the empty-log notice", h.items[0].GetLabel())
+	}
+	if _, ok := h.items[len(h.items)-1].(*CloseMenuItem); !ok {
+		t.Error("last row is not Back")
+	}
+}
@@ -0,0 +1,219 @@
+package menu
+
+import (
+	"fmt"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/gamemode"
+	"darkstation/pkg/game/levelseed"
+	"darkstation/pkg/game/state"
+)
+
+// NewGameOption identifies a row on the new-game options screen.
+type NewGameOption int
+
+const (
+	NewGameOptionDifficulty NewGameOption = iota
+	NewGameOptionDeckSize
+	NewGameOptionSeed
+	NewGameOptionCreative
+	NewGameOptionSurvival
+	NewGameOptionStart
+)
+
+// NewGameOptionItem is one row on the new-game options screen.
+type NewGameOptionItem struct {
+	Option NewGameOption
+	opts   *gamemode.RunOptions
+}
+
+func (m *NewGameOptionItem) GetLabel() string {
+	switch m.Option {
+	case NewGameOptionDifficulty:
+		return "Difficulty\tACTION{" + m.opts.Difficulty.String() + "}\tSUBTLE{< left/right >}"
+	case NewGameOptionDeckSize:
+		return "Deck Size\tACTION{" + m.opts.DeckSize.String() + "}\tSUBTLE{< left/right >}"
+	case NewGameOptionSeed:
+		if m.opts.Seed == 0 {
+			return "Seed\tSUBTLE{random}"
+		}
+		return "Seed\tACTION{" + levelseed.Format(m.opts.Seed) + "}"
+	case NewGameOptionCreative:
+		if m.opts.Creative {
+			return "Creative\tACTION{on}\tSUBTLE{< left/right >}"
+		}
+		return "Creative\tACTION{off}\tSUBTLE{< left/right >}"
+	case NewGameOptionSurvival:
+		if m.opts.Survival {
+			return "Survival\tACTION{on}\tSUBTLE{< left/right >}"
+		}
+		return "Survival\tACTION{off}\tSUBTLE{< left/right >}"
+	default:
+		return "Start"
+	}
+}
+
+func (m *NewGameOptionItem) IsSelectable() bool {
+	return true
+}
+
+func (m *NewGameOptionItem) GetHelpText() string {
+	switch m.Option {
+	case NewGameOptionDifficulty:
+		return "Easy places spare batteries, fewer locked rooms and hazards; Hard places none, locks more and hides more items"
+	case NewGameOptionDeckSize:
+		return "Scale the floor area of every deck between the airlock and the final deck"
+	case NewGameOptionSeed:
+		return "Enter a hex seed to replay a station, or clear it for a random one"
+	case NewGameOptionCreative:
+		return "Explore freely: generators powered, doors open, hazards passable, map revealed. Not recorded"
+	case NewGameOptionSurvival:
+		return "Running generators slowly drain their batteries and go dark unless you refuel them"
+	default:
+		return "Generate the station and begin"
+	}
+}
+
+func (m *NewGameOptionItem) CanCycle() bool {
+	return m.Option == NewGameOptionDifficulty || m.Option == NewGameOptionDeckSize || m.Option == NewGameOptionCreative ||
+		m.Option == NewGameOptionSurvival
+}
+
+func (m *NewGameOptionItem) HandleCycle(delta int) (bool, string) {
+	switch m.Option {
+	case NewGameOptionDifficulty:
+		m.opts.Difficulty = cycleOption(gamemode.Difficulties(), m.opts.Difficulty, delta)
+		return true, "Difficulty: " + m.opts.Difficulty.String()
+	case NewGameOptionDeckSize:
+		m.opts.DeckSize = cycleOption(gamemode.DeckSizes(), m.opts.DeckSize, delta)
+		return true, "Deck size: " + m.opts.DeckSize.String()
+	case NewGameOptionCreative:
+		m.opts.Creative = !m.opts.Creative
+		if m.opts.Creative {
+			return true, "Creative: on"
+		}
+		return true, "Creative: off"
+	case NewGameOptionSurvival:
+		m.opts.Survival = !m.opts.Survival
+		if m.opts.Survival {
+			return true, "Survival: on"
+		}
+		return true, "Survival: off"
+	}
+	return false, ""
+}
+
+// cycleOption returns the value delta steps from current in values, wrapping around.
+func cycleOption[T comparable](values []T, current T, delta int) T {
+	for i, v := range values {
+		if v == current {
+			return values[((i+delta)%len(values)+len(values))%len(values)]
+		}
+	}
+	return values[0]
+}
+
+// NewGameMenuHandler collects difficulty, deck size, seed, creative and survival mode before a run is built.
+type NewGameMenuHandler struct {
+	g         *state.Game
+	mode      gamemode.Mode
+	opts      gamemode.RunOptions
+	items     []MenuItem
+	confirmed bool
+}
+
+// NewNewGameMenuHandler builds the new-game options screen for mode.
+func NewNewGameMenuHandler(g *state.Game, mode gamemode.Mode) *NewGameMenuHandler {
+	h := &NewGameMenuHandler{g: g, mode: mode}
+	for _, opt := range []NewGameOption{NewGameOptionDifficulty, NewGameOptionDeckSize, NewGameOptionSeed, NewGameOptionCreative, NewGameOptionSurvival, NewGameOptionStart} {
+		h.items = append(h.items, &NewGameOptionItem{Option: opt, opts: &h.opts})
+	}
+	h.items = append(h.items, &BackMenuItem{})
+	return h
+}
+
+func (h *NewGameMenuHandler) GetTitle() string {
+	return fmt.Sprintf("New Game: %s", h.mode.DisplayName)
+}
+
+func (h *NewGameMenuHandler) GetInstructions(selected MenuItem) string {
+	if item, ok := selected.(*NewGameOptionItem); ok && item.CanCycle() {
+		return engineinput.HintMenuSelect() + ", left/right to cycle."
+	}
+	return engineinput.HintMenuInstructionsMain()
+}
+
+func (h *NewGameMenuHandler) OnSelect(item MenuItem, index int) {}
+
+func (h *NewGameMenuHandler) OnActivate(item MenuItem, index int) (shouldClose bool, helpText string) {
+	if _, ok := item.(*BackMenuItem); ok {
+		return true, ""
+	}
+	opt, ok := item.(*NewGameOptionItem)
+	if !ok {
+		return false, ""
+	}
+	switch opt.Option {
+	case NewGameOptionSeed:
+		return false, h.editSeed()
+	case NewGameOptionStart:
+		h.confirmed = true
+		return true, ""
+	}
+	_, helpText = opt.HandleCycle(1)
+	return false, helpText
+}
+
+// editSeed prompts for a hex seed; an empty entry returns to a random seed.
+func (h *NewGameMenuHandler) editSeed() string {
+	initial := ""
+	if h.opts.Seed != 0 {
+		initial = levelseed.Format(h.opts.Seed)
+	}
+	text, ok := RunTextInputDialog(h.g, TextInputOptions{
+		Title:   "Station seed",
+		Prompt:  "Enter hex seed (leave empty for random)",
+		Initial: initial,
+		Hex:     true,
+	})
+	if !ok {
+		return "Seed entry cancelled"
+	}
+	if text == "" {
+		h.opts.Seed = 0
+		return "Seed: random"
+	}
+	seed, err := levelseed.Parse(text)
+	if err != nil {
+		return err.Error()
+	}
+	h.opts.Seed = seed
+	return "Seed: " + levelseed.Format(seed)
+}
+
+func (h *NewGameMenuHandler) InitialMenuSelection(items []MenuItem) int {
+	for i, item := range items {
+		if opt, ok := item.(*NewGameOptionItem); ok && opt.Option == NewGameOptionStart {
+			return i
+		}
+	}
+	return 0
+}
+
+func (h *NewGameMenuHandler) OnExit() {}
+
+func (h *NewGameMenuHandler) ShouldCloseOnAnyAction() bool {
+	return false
+}
+
+// RunNewGameMenu opens the new-game options for mode with difficulty preselected (-difficulty).
+// Returns the chosen options and true when the player starts the run.
+func RunNewGameMenu(g *state.Game, mode gamemode.Mode, difficulty gamemode.Difficulty) (gamemode.RunOptions, bool) {
+	handler := NewNewGameMenuHandler(g, mode)
+	handler.opts.Difficulty = difficulty
+	RunMenu(g, handler.items, handler)
+	if !handler.confirmed {
+		return gamemode.RunOptions{}, false
+	}
+	return handler.opts, true
+}
@@ -0,0 +1,58 @@
+package menu
+
+import (
+	"testing"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/gamemode"
+	"darkstation/pkg/game/state"
+)
+
+func TestNewGameMenuHandler_cyclesAndStarts(t *testing.T) {
+	h := NewNewGameMenuHandler(state.NewGame(), gamemode.Default())
+	difficulty := h.items[NewGameOptionDifficulty]
+	size := h.items[NewGameOptionDeckSize]
+
+	if consumed, _ := handleCycleIntent(h.items, int(NewGameOptionDifficulty), engineinput.Intent{Action: engineinput.ActionMoveEast}); !consumed {
+		t.Fatal("difficulty row should cycle on right")
+	}
+	if h.opts.Difficulty != gamemode.DifficultyHard {
+		t.Fatalf("Difficulty = %v, want Hard", h.opts.Difficulty)
+	}
+	h.OnActivate(difficulty, 0)
+	if h.opts.Difficulty != gamemode.DifficultyEasy {
+		t.Fatalf("Difficulty after wrap = %v, want Easy", h.opts.Difficulty)
+	}
+	size.(CycleMenuItem).HandleCycle(-1)
+	if h.opts.DeckSize != gamemode.DeckSizeCompact {
+		t.Fatalf("DeckSize = %v, want Compact", h.opts.DeckSize)
+	}
+
+	h.items[NewGameOptionCreative].(CycleMenuItem).HandleCycle(1)
+	if !h.opts.Creative {
+		t.Fatal("Creative row should toggle creative mode on")
+	}
+	h.items[NewGameOptionSurvival].(CycleMenuItem).HandleCycle(1)
+	if !h.opts.Survival {
+		t.Fatal("Survival row should toggle survival mode on")
+	}
+
+	if got := h.InitialMenuSelection(h.items); got != int(NewGameOptionStart) {
+		t.Fatalf("InitialMenuSelection = %d, want Start row", got)
+	}
+	if closeMenu, _ := h.OnActivate(h.items[NewGameOptionStart], int(NewGameOptionStart)); !closeMenu || !h.confirmed {
+		t.Fatal("Start should close the menu and confirm")
+	}
+}
+
+func TestNewGameOptionItem_seedLabel(t *testing.T) {
+	opts := gamemode.RunOptions{}
+	item := &NewGameOptionItem{Option: NewGameOptionSeed, opts: &opts}
+	if got := item.GetLabel(); got != "Seed\tSUBTLE{random}" {
+		t.Fatalf("label = %q", got)
+	}
+	opts.Seed = 42
+	if got := item.GetLabel(); got != "Seed\tACTION{2A}" {
+		t.Fatalf("label = %q", got)
+	}
+}
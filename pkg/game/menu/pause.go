@@ -0,0 +1,109 @@
+package menu
+
+import engineinput "darkstation/pkg/engine/input"
+
+// PauseMenuAction represents the action type for pause menu items.
+type PauseMenuAction int
+
+const (
+	PauseMenuActionResume PauseMenuAction = iota
+	PauseMenuActionSettings
+	PauseMenuActionRestartDeck
+	PauseMenuActionQuitToTitle
+	PauseMenuActionQuit
+)
+
+// PauseMenuItem represents a menu item in the pause menu.
+type PauseMenuItem struct {
+	Label  string
+	Action PauseMenuAction
+}
+
+// GetLabel returns the display label for this menu item.
+func (m *PauseMenuItem) GetLabel() string {
+	return m.Label
+}
+
+// IsSelectable returns whether this item can be selected.
+func (m *PauseMenuItem) IsSelectable() bool {
+	return true
+}
+
+// GetHelpText returns help text for this menu item.
+func (m *PauseMenuItem) GetHelpText() string {
+	switch m.Action {
+	case PauseMenuActionResume:
+		return "Return to the game"
+	case PauseMenuActionSettings:
+		return "Configure bindings and display settings"
+	case PauseMenuActionRestartDeck:
+		return "Start this deck again from the lift"
+	case PauseMenuActionQuitToTitle:
+		return "Return to the main menu"
+	case PauseMenuActionQuit:
+		return "Exit the game"
+	default:
+		return ""
+	}
+}
+
+// PauseMenuHandler handles the pause menu Escape opens during play. Only an activated row
+// counts: closing the menu any other way resumes, whatever row was highlighted.
+type PauseMenuHandler struct {
+	selectedAction PauseMenuAction
+}
+
+// NewPauseMenuHandler creates a new pause menu handler.
+func NewPauseMenuHandler() *PauseMenuHandler {
+	return &PauseMenuHandler{}
+}
+
+// GetTitle returns the menu title.
+func (h *PauseMenuHandler) GetTitle() string {
+	return "Paused"
+}
+
+// GetInstructions returns the menu instructions.
+func (h *PauseMenuHandler) GetInstructions(selected MenuItem) string {
+	return engineinput.HintMenuInstructionsGameplay()
+}
+
+// OnSelect is called when an item is selected.
+func (h *PauseMenuHandler) OnSelect(item MenuItem, index int) {
+	// Highlighting a row does not choose it.
+}
+
+// OnActivate is called when an item is activated.
+func (h *PauseMenuHandler) OnActivate(item MenuItem, index int) (shouldClose bool, helpText string) {
+	if pauseItem, ok := item.(*PauseMenuItem); ok {
+		h.selectedAction = pauseItem.Action
+		return true, ""
+	}
+	return false, ""
+}
+
+// OnExit is called when the menu is exited.
+func (h *PauseMenuHandler) OnExit() {
+	// Nothing to do on exit
+}
+
+// ShouldCloseOnAnyAction returns true if the menu should close on any action.
+func (h *PauseMenuHandler) ShouldCloseOnAnyAction() bool {
+	return false
+}
+
+// GetSelectedAction returns the activated action, or PauseMenuActionResume.
+func (h *PauseMenuHandler) GetSelectedAction() PauseMenuAction {
+	return h.selectedAction
+}
+
+// GetMenuItems returns the menu items for the pause menu.
+func (h *PauseMenuHandler) GetMenuItems() []MenuItem {
+	return []MenuItem{
+		&PauseMenuItem{Label: "Resume", Action: PauseMenuActionResume},
+		&PauseMenuItem{Label: "Settings", Action: PauseMenuActionSettings},
+		&PauseMenuItem{Label: "Restart Deck", Action: PauseMenuActionRestartDeck},
+		&PauseMenuItem{Label: "Quit to Title", Action: PauseMenuActionQuitToTitle},
+		&PauseMenuItem{Label: "Quit Game", Action: PauseMenuActionQuit},
+	}
+}
@@ -0,0 +1,19 @@
+package menu
+
+import "testing"
+
+func TestPauseMenuHandler_onlyActivatedRowCounts(t *testing.T) {
+	h := NewPauseMenuHandler()
+	items := h.GetMenuItems()
+	quit := items[len(items)-1]
+	h.OnSelect(quit, len(items)-1)
+	if h.GetSelectedAction() != PauseMenuActionResume {
+		t.Fatalf("highlighting %q should not choose it", quit.GetLabel())
+	}
+	if closeMenu, _ := h.OnActivate(items[2], 2); !closeMenu {
+		t.Fatal("activating a row should close the pause menu")
+	}
+	if h.GetSelectedAction() != PauseMenuActionRestartDeck {
+		t.Fatalf("selected action = %v, want PauseMenuActionRestartDeck", h.GetSelectedAction())
+	}
+}
@@ -123,7 +123,30 @@ func PreviewCircuitToggleImpact(g *state.Game, roomName string, targetPreset Cir
 	}
 	doorsOn := targetPreset == CircuitEssential || targetPreset == CircuitFull
 	cctvOn := targetPreset == CircuitFull
+	return previewLoadImpact(g, roomName, doorsOn, cctvOn)
+}
+
+// PreviewRoomPowerToggleImpact describes the watt change if one system ("doors" or "cctv")
+// in roomName were flipped. Lights draw nothing, so they report no preview.
+func PreviewRoomPowerToggleImpact(g *state.Game, roomName, powerType string) string {
+	if g == nil || roomName == "" {
+		return ""
+	}
+	doorsOn := g.RoomDoorsPowered[roomName]
+	cctvOn := g.RoomCCTVPowered[roomName]
+	switch powerType {
+	case "doors":
+		doorsOn = !doorsOn
+	case "cctv":
+		cctvOn = !cctvOn
+	default:
+		return ""
+	}
+	return previewLoadImpact(g, roomName, doorsOn, cctvOn)
+}
 
+// previewLoadImpact formats the station load change for roomName running doors/CCTV as given.
+func previewLoadImpact(g *state.Game, roomName string, doorsOn, cctvOn bool) string {
 	before, afterApply, afterShed := setup.PreviewRoomPresetConsumption(g, roomName, doorsOn, cctvOn)
 	delta := afterApply - before
 	if delta == 0 {
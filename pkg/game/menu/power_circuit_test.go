@@ -215,3 +215,63 @@ func labels(items []MenuItem) []string {
 	}
 	return out
 }
+
+func TestPreviewRoomPowerToggleImpact_perDevice(t *testing.T) {
+	g, _ := makeMenuTestGame(t)
+	gameworld.GetGameData(g.Grid.GetCell(0, 0)).Door = entities.NewDoor("RoomA")
+	gameworld.GetGameData(g.Grid.GetCell(0, 1)).Terminal = entities.NewCCTVTerminal("CCTV-A")
+	g.RoomPowerOnline = map[string]bool{"RoomA": false, "RoomB": false}
+
+	if got := PreviewRoomPowerToggleImpact(g, "RoomA", "doors"); got != "+10w usage" {
+		t.Fatalf("doors on = %q, want +10w usage", got)
+	}
+	if got := PreviewRoomPowerToggleImpact(g, "RoomA", "lights"); got != "" {
+		t.Fatalf("lights draw nothing, got %q", got)
+	}
+
+	g.RoomDoorsPowered["RoomA"] = true
+	g.RoomPowerOnline["RoomA"] = true
+	g.InvalidateLivePowerCache()
+	if got := PreviewRoomPowerToggleImpact(g, "RoomA", "cctv"); got != "+10w usage" {
+		t.Fatalf("cctv on with doors on = %q, want +10w usage", got)
+	}
+	g.RoomCCTVPowered["RoomA"] = true
+	if got := PreviewRoomPowerToggleImpact(g, "RoomA", "cctv"); got != "-10w usage" {
+		t.Fatalf("cctv off = %q, want -10w usage", got)
+	}
+
+	g.RoomCCTVPowered["RoomA"] = false
+	gameworld.GetGameData(g.Grid.GetCell(0, 0)).Generator.Trip()
+	g.UpdatePowerSupply()
+	// The doors already draw 10w, so with no supply the whole 20w is over budget.
+	want := "+10w, 20w over supply - will trigger overload!"
+	if got := PreviewRoomPowerToggleImpact(g, "RoomA", "cctv"); got != want {
+		t.Fatalf("cctv on without supply = %q, want %q", got, want)
+	}
+	item := &RoomPowerToggleMenuItem{G: g, RoomName: "RoomA", PowerType: "cctv"}
+	if got := item.GetHelpText(); got != want {
+		t.Fatalf("toggle help = %q, want the overload preview", got)
+	}
+}
+
+func TestToggleLights_soleSupplyRoomStaysLit(t *testing.T) {
+	g, termCell := makeMenuTestGame(t)
+	term := gameworld.GetGameData(termCell).MaintenanceTerm
+	h := NewMaintenanceMenuHandler(g, termCell, term)
+	lights := &RoomPowerToggleMenuItem{G: g, RoomName: "RoomA", PowerType: "lights"}
+
+	if _, help := h.OnActivate(lights, 0); help != soleSupplyLightsHelp {
+		t.Fatalf("help = %q, want sole-supply refusal", help)
+	}
+	if !g.RoomLightsPowered["RoomA"] {
+		t.Fatal("lights in the only generator's room must stay on")
+	}
+
+	second := entities.NewGenerator("G2", 1)
+	second.InsertBatteriesAndStart(1)
+	gameworld.GetGameData(g.Grid.GetCell(2, 0)).Generator = second
+	h.OnActivate(lights, 0)
+	if g.RoomLightsPowered["RoomA"] {
+		t.Fatal("with a second running generator the lights may be switched off")
+	}
+}
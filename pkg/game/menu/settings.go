@@ -49,7 +49,7 @@ func (h *SettingsMenuHandler) GetInstructions(selected MenuItem) string {
 	if _, ok := selected.(*BindingMenuItem); ok {
 		return fmt.Sprintf("%s, %s, %s.", engineinput.HintMenuSelect(), engineinput.HintMenuEditBinding(), exitHint)
 	}
-	if _, ok := selected.(*WindowModeMenuItem); ok {
+	if cycler, ok := selected.(CycleMenuItem); ok && cycler.CanCycle() {
 		return engineinput.HintMenuSelect() + ", " + engineinput.HintMenuActivate() + ", left/right to cycle, " + exitHint + "."
 	}
 	if _, ok := selected.(*BackMenuItem); ok {
@@ -78,6 +78,9 @@ func (h *SettingsMenuHandler) OnActivate(item MenuItem, index int) (shouldClose
 		h.setTab(tab.tab)
 		return false, ""
 	}
+	if reset, ok := item.(*ResetSettingsMenuItem); ok {
+		return false, reset.Reset()
+	}
 	if cycler, ok := item.(CycleMenuItem); ok && cycler.CanCycle() {
 		_, helpText := cycler.HandleCycle(1)
 		return false, helpText
@@ -187,7 +190,7 @@ func (h *SettingsMenuHandler) GetMenuItems() []MenuItem {
 	case SettingsTabBindings:
 		items = append(items, h.bindings.CoreMenuItems()...)
 	case SettingsTabVideo:
-		items = append(items, &WindowModeMenuItem{})
+		items = append(items, videoSettingsItems()...)
 	}
 	if h.fromMainMenu {
 		items = append(items, &BackMenuItem{})
@@ -235,7 +238,7 @@ func (s *SettingsTabItem) GetHelpText() string {
 	case SettingsTabBindings:
 		return "Keyboard and controller bindings"
 	default:
-		return "Display and window options"
+		return "Display, sound and gameplay options"
 	}
 }
 
@@ -1,9 +1,12 @@
 package menu
 
 import (
+	"slices"
 	"testing"
 
 	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/gamemode"
 )
 
 func TestSettingsMenuHandler_tabsSwitch(t *testing.T) {
@@ -93,3 +96,480 @@ func TestSettingsMenuHandler_inGameHasCloseBack(t *testing.T) {
 		t.Fatalf("last item = %v, want CloseMenuItem Back", last)
 	}
 }
+
+func TestGeneratorBadgesMenuItem_togglesAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &GeneratorBadgesMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Generator badges: off" {
+		t.Fatalf("first cycle = %q, want badges off", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.GeneratorBadges {
+		t.Error("saved config should have generator badges off")
+	}
+	if _, msg := item.HandleCycle(1); msg != "Generator badges: on" || !config.Current().GeneratorBadges {
+		t.Errorf("second cycle = %q, want badges back on", msg)
+	}
+}
+
+func TestRoomProgressMenuItem_togglesAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &RoomProgressMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Room progress: off" {
+		t.Fatalf("first cycle = %q, want room progress off", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.RoomProgress {
+		t.Error("saved config should have room progress off")
+	}
+}
+
+func TestReduceMotionMenuItem_togglesAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &ReduceMotionMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Reduce motion: on" {
+		t.Fatalf("first cycle = %q, want reduce motion on", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !loaded.ReduceMotion {
+		t.Error("saved config should have reduce motion on")
+	}
+}
+
+func TestSoundMenuItem_togglesAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &SoundMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Sound: off" {
+		t.Fatalf("first cycle = %q, want sound off", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !loaded.Muted {
+		t.Error("saved config should have sound muted")
+	}
+}
+
+func TestRoomEntryCalloutMenuItem_togglesAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &RoomEntryCalloutMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Room entry callout: on" {
+		t.Fatalf("first cycle = %q, want room entry callout on (off by default)", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !loaded.RoomEntryCallout {
+		t.Error("saved config should have the room entry callout on")
+	}
+}
+
+func TestFOVRadiusMenuItem_cyclesRadiiAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &FOVRadiusMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Sight radius: 2 cells" {
+		t.Fatalf("first cycle = %q, want 2 cells (unlimited by default)", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.FOVRadius != 2 {
+		t.Errorf("saved sight radius = %d, want 2", loaded.FOVRadius)
+	}
+	item.HandleCycle(-1)
+	if _, msg := item.HandleCycle(-1); msg != "Sight radius: 5 cells" {
+		t.Errorf("cycling back past unlimited = %q, want 5 cells", msg)
+	}
+}
+
+func TestInventoryCapMenuItem_switchesHardcoreCapAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &InventoryCapMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Inventory limit: 6 items" {
+		t.Fatalf("first cycle = %q, want the hardcore cap (unlimited by default)", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.InventoryCap != config.HardcoreInventoryCap {
+		t.Errorf("saved inventory cap = %d, want %d", loaded.InventoryCap, config.HardcoreInventoryCap)
+	}
+	if _, msg := item.HandleCycle(1); msg != "Inventory limit: unlimited" {
+		t.Errorf("second cycle = %q, want unlimited", msg)
+	}
+}
+
+func TestHintsMenuItem_togglesAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &HintsMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Stuck hints: off" {
+		t.Fatalf("first cycle = %q, want hints off", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.HintsEnabled {
+		t.Error("saved config should have hints off")
+	}
+}
+
+func TestTutorialHintsMenuItem_togglesAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &TutorialHintsMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Tutorial hints: off" {
+		t.Fatalf("first cycle = %q, want tutorial hints off", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.TutorialHints {
+		t.Error("saved config should have tutorial hints off")
+	}
+}
+
+func TestRevealRoomMenuItem_togglesAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &RevealRoomMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Reveal room on entry: on" {
+		t.Fatalf("first cycle = %q, want reveal room on", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !loaded.RevealRoomOnEntry {
+		t.Error("saved config should have reveal room on entry on")
+	}
+}
+
+func TestCameraFollowMenuItem_cyclesAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &CameraFollowMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Camera follow: smooth" {
+		t.Fatalf("first cycle = %q, want smooth", msg)
+	}
+	if _, msg := item.HandleCycle(-1); msg != "Camera follow: tight" {
+		t.Fatalf("cycle back = %q, want tight", msg)
+	}
+	if _, msg := item.HandleCycle(-1); msg != "Camera follow: snap" {
+		t.Fatalf("second cycle back = %q, want snap", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.CameraFollow != config.CameraFollowSnap {
+		t.Errorf("saved camera follow = %q, want snap", loaded.CameraFollow)
+	}
+}
+
+func TestColorblindMenuItem_cyclesAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &ColorblindMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Colorblind mode: deuteranopia" {
+		t.Fatalf("first cycle = %q, want deuteranopia", msg)
+	}
+	if _, msg := item.HandleCycle(-1); msg != "Colorblind mode: off" {
+		t.Fatalf("cycle back = %q, want off", msg)
+	}
+	if _, msg := item.HandleCycle(-1); msg != "Colorblind mode: tritanopia" {
+		t.Fatalf("wrap back = %q, want tritanopia", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.ColorblindMode != config.ColorblindTritanopia {
+		t.Errorf("saved colorblind mode = %q, want tritanopia", loaded.ColorblindMode)
+	}
+}
+
+func TestTextSizeMenuItem_cyclesAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &TextSizeMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Text size: 125%" {
+		t.Fatalf("first cycle = %q, want 125%%", msg)
+	}
+	if _, msg := item.HandleCycle(-1); msg != "Text size: 100%" {
+		t.Fatalf("cycle back = %q, want 100%%", msg)
+	}
+	if _, msg := item.HandleCycle(-1); msg != "Text size: 75%" {
+		t.Fatalf("second cycle back = %q, want 75%%", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.UIScale != 0.75 {
+		t.Errorf("saved ui scale = %v, want 0.75", loaded.UIScale)
+	}
+}
+
+func TestCameraPanMenuItem_cyclesPresetsAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &CameraPanMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Camera pan: 1.5s" {
+		t.Fatalf("first cycle = %q, want 1.5s", msg)
+	}
+	if _, msg := item.HandleCycle(1); msg != "Camera pan: instant" {
+		t.Fatalf("wrapped cycle = %q, want instant", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.CameraPanMs != 0 {
+		t.Errorf("saved camera pan = %dms, want 0", loaded.CameraPanMs)
+	}
+
+	config.Current().CameraPanMs = 600 // hand-edited, between presets
+	if _, msg := item.HandleCycle(-1); msg != "Camera pan: 0.5s" {
+		t.Fatalf("cycle back from 600ms = %q, want 0.5s", msg)
+	}
+}
+
+func TestCameraEasingMenuItem_cyclesAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &CameraEasingMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Camera easing: linear" {
+		t.Fatalf("first cycle = %q, want linear", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.CameraEasing != config.CameraEasingLinear {
+		t.Errorf("saved camera easing = %q, want linear", loaded.CameraEasing)
+	}
+}
+
+func TestConfirmRiskyMovesMenuItem_togglesAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &ConfirmRiskyMovesMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Confirm risky moves: on" {
+		t.Fatalf("first cycle = %q, want confirm risky moves on", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !loaded.ConfirmRiskyMoves {
+		t.Error("saved config should have confirm risky moves on")
+	}
+}
+
+func TestPowerSurgesMenuItem_togglesAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &PowerSurgesMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Power surges: off" {
+		t.Fatalf("first cycle = %q, want surges off", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.PowerSurges {
+		t.Error("saved config should have power surges off")
+	}
+}
+
+func TestManualPickupMenuItem_togglesAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &ManualPickupMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Item pick-up: manual" {
+		t.Fatalf("first cycle = %q, want manual pick-up", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !loaded.ManualPickup {
+		t.Error("saved config should have manual pick-up on")
+	}
+}
+
+func TestSoftLockCheckMenuItem_togglesAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &SoftLockCheckMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Soft-lock check: off" {
+		t.Fatalf("first cycle = %q, want soft-lock check off", msg)
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.SoftLockCheck {
+		t.Error("saved config should have soft-lock check off")
+	}
+}
+
+func TestBindingProfileMenuItem_switchesAndRestoresLayouts(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+	orig, err := engineinput.ExportBindings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = engineinput.ImportBindings(orig) })
+
+	hintBound := func(code string) bool {
+		return slices.Contains(engineinput.GetBindingsByAction()[engineinput.ActionHint], code)
+	}
+
+	engineinput.SetSingleBinding(engineinput.ActionHint, "z")
+	item := &BindingProfileMenuItem{}
+	if _, msg := item.HandleCycle(1); msg != "Binding profile: custom1" {
+		t.Fatalf("first cycle = %q, want custom1", msg)
+	}
+	if !hintBound("z") {
+		t.Fatal("a new profile should start from the bindings being left")
+	}
+
+	engineinput.SetSingleBinding(engineinput.ActionHint, "y")
+	saveActiveBindingProfile()
+	if _, msg := item.HandleCycle(-1); msg != "Binding profile: default" {
+		t.Fatalf("cycle back = %q, want default", msg)
+	}
+	if !hintBound("z") || hintBound("y") {
+		t.Error("switching back should restore the default profile's hint binding")
+	}
+	if loaded, err := config.Load(); err != nil || loaded.BindingProfile != config.BindingProfileDefault {
+		t.Errorf("saved profile = %q (%v), want default", loaded.BindingProfile, err)
+	}
+}
+
+func TestCyclePreset_snapsBetweenPresets(t *testing.T) {
+	sizes := []int{12, 16, 24, 32}
+	for _, tc := range []struct{ current, delta, want int }{
+		{16, 1, 24},
+		{32, 1, 12},
+		{12, -1, 32},
+		{20, 1, 24},
+		{20, -1, 16},
+		{40, 1, 12},
+	} {
+		if got := cyclePreset(sizes, tc.current, tc.delta); got != tc.want {
+			t.Errorf("cyclePreset(%d, %+d) = %d, want %d", tc.current, tc.delta, got, tc.want)
+		}
+	}
+}
+
+func TestPreferredGameModeMenuItem_cyclesAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	config.SetCurrent(config.DefaultConfig())
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	item := &PreferredGameModeMenuItem{}
+	item.HandleCycle(1)
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.GameMode != string(gamemode.SingleDeckSandbox) || PreferredGameMode().ID != gamemode.SingleDeckSandbox {
+		t.Fatalf("saved game mode = %q, want %s", loaded.GameMode, gamemode.SingleDeckSandbox)
+	}
+	(&PreferredDifficultyMenuItem{}).HandleCycle(1)
+	if PreferredDifficulty() != gamemode.DifficultyHard {
+		t.Fatalf("difficulty after one step from Normal = %v, want Hard", PreferredDifficulty())
+	}
+}
+
+func TestResetSettingsMenuItem_restoresDefaultsButKeepsRecords(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	cfg := config.DefaultConfig()
+	cfg.EndlessHighScore = 7
+	cfg.BindingProfile = "custom1"
+	config.SetCurrent(cfg)
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	(&VolumeMenuItem{}).HandleCycle(-1)
+	(&MapZoomMenuItem{}).HandleCycle(1)
+	(&PreferredDifficultyMenuItem{}).HandleCycle(-1)
+	if msg := (&ResetSettingsMenuItem{}).Reset(); msg == "" {
+		t.Fatal("reset should report what it did")
+	}
+	loaded, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	want := config.DefaultConfig()
+	if loaded.Volume != want.Volume || loaded.TileSize != want.TileSize || loaded.Difficulty != "" {
+		t.Fatalf("after reset volume=%d tile=%d difficulty=%q, want defaults", loaded.Volume, loaded.TileSize, loaded.Difficulty)
+	}
+	if loaded.EndlessHighScore != 7 || loaded.BindingProfile != "custom1" {
+		t.Fatalf("reset lost records: high score %d, profile %q", loaded.EndlessHighScore, loaded.BindingProfile)
+	}
+}
@@ -0,0 +1,98 @@
+package menu
+
+import (
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/state"
+)
+
+// SoftLockChoice is what the player picked when offered a way out of a soft-locked deck.
+type SoftLockChoice int
+
+const (
+	// SoftLockKeepPlaying leaves the deck as it is.
+	SoftLockKeepPlaying SoftLockChoice = iota
+	// SoftLockReset rebuilds the deck from the same seed and puts the player back at the lift.
+	SoftLockReset
+	// SoftLockReroll rebuilds the deck from a fresh seed.
+	SoftLockReroll
+)
+
+// SoftLockMenuItem is one way out offered by the soft-lock menu.
+type SoftLockMenuItem struct {
+	Choice SoftLockChoice
+}
+
+func (s *SoftLockMenuItem) GetLabel() string {
+	switch s.Choice {
+	case SoftLockReset:
+		return "Reset deck\tSUBTLE{same layout}"
+	case SoftLockReroll:
+		return "Reroll deck\tSUBTLE{new layout}"
+	default:
+		return "Keep playing"
+	}
+}
+
+func (s *SoftLockMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (s *SoftLockMenuItem) GetHelpText() string {
+	switch s.Choice {
+	case SoftLockReset:
+		return "Start this deck over from the lift with the same layout"
+	case SoftLockReroll:
+		return "Start this deck over from the lift with a new layout"
+	default:
+		return "Carry on; this will not be offered again on this deck"
+	}
+}
+
+// SoftLockMenuHandler offers a reset or reroll when the deck can no longer be finished.
+type SoftLockMenuHandler struct {
+	items  []MenuItem
+	choice SoftLockChoice
+}
+
+// NewSoftLockMenuHandler builds the soft-lock menu.
+func NewSoftLockMenuHandler() *SoftLockMenuHandler {
+	return &SoftLockMenuHandler{
+		items: []MenuItem{
+			&SoftLockMenuItem{Choice: SoftLockReset},
+			&SoftLockMenuItem{Choice: SoftLockReroll},
+			&SoftLockMenuItem{Choice: SoftLockKeepPlaying},
+		},
+	}
+}
+
+func (h *SoftLockMenuHandler) GetTitle() string {
+	return "This deck can no longer be finished"
+}
+
+func (h *SoftLockMenuHandler) GetInstructions(selected MenuItem) string {
+	return engineinput.HintMenuInstructionsGameplay()
+}
+
+func (h *SoftLockMenuHandler) OnSelect(item MenuItem, index int) {}
+
+func (h *SoftLockMenuHandler) OnActivate(item MenuItem, index int) (shouldClose bool, helpText string) {
+	choiceItem, ok := item.(*SoftLockMenuItem)
+	if !ok {
+		return false, ""
+	}
+	h.choice = choiceItem.Choice
+	return true, ""
+}
+
+func (h *SoftLockMenuHandler) OnExit() {}
+
+func (h *SoftLockMenuHandler) ShouldCloseOnAnyAction() bool {
+	return false
+}
+
+// RunSoftLockMenu offers a reset or reroll. Closing the menu keeps playing.
+func RunSoftLockMenu(g *state.Game) SoftLockChoice {
+	handler := NewSoftLockMenuHandler()
+	RunMenu(g, handler.items, handler)
+	return handler.choice
+}
@@ -0,0 +1,20 @@
+package menu
+
+import "testing"
+
+func TestSoftLockMenuHandler_OnActivate(t *testing.T) {
+	h := NewSoftLockMenuHandler()
+	if len(h.items) != 3 {
+		t.Fatalf("items = %d, want reset, reroll and keep playing", len(h.items))
+	}
+	if h.choice != SoftLockKeepPlaying {
+		t.Fatalf("choice before activation = %v, want keep playing", h.choice)
+	}
+	closeMenu, _ := h.OnActivate(h.items[1], 1)
+	if !closeMenu {
+		t.Fatal("OnActivate should close the menu")
+	}
+	if h.choice != SoftLockReroll {
+		t.Fatalf("choice = %v, want reroll", h.choice)
+	}
+}
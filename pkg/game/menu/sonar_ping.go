@@ -0,0 +1,59 @@
+package menu
+
+import (
+	"fmt"
+	"math"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/setup"
+)
+
+// sonarPingWatts is the momentary draw of one sonar ping; the deck needs this much spare
+// power (supply over consumption) or the pulse is refused.
+const sonarPingWatts = 5
+
+// sonarPingCalloutMs is how long the bearing callout stays on the terminal.
+const sonarPingCalloutMs = 6000
+
+// SonarPingMenuItem pulses for the nearest item the player has not found within pingRadius
+// and points toward it, without revealing the cell.
+type SonarPingMenuItem struct {
+	Parent *MaintenanceMenuHandler
+}
+
+func (s *SonarPingMenuItem) GetLabel() string {
+	return fmt.Sprintf("Sonar ping for items (%dw)", sonarPingWatts)
+}
+
+func (s *SonarPingMenuItem) IsSelectable() bool { return true }
+
+func (s *SonarPingMenuItem) GetHelpText() string {
+	return engineinput.HintPressConfirmTo("point toward the nearest item you have not found")
+}
+
+// sonarPing locates the nearest unfound item around the terminal and leaves a callout on
+// the terminal with its rough bearing and distance. Refused when the terminal's room has
+// no live power or the deck cannot spare sonarPingWatts.
+func (h *MaintenanceMenuHandler) sonarPing() string {
+	if h.cell == nil {
+		return "Ping: no terminal"
+	}
+	if !setup.RoomHasLivePower(h.g, h.terminalRoomName) {
+		return "Ping: no power in this room"
+	}
+	if free := h.g.GetAvailablePower(); free < sonarPingWatts {
+		return fmt.Sprintf("Ping: needs %dw spare power (%dw available)", sonarPingWatts, max(free, 0))
+	}
+	target, name, ok := setup.FindNearestItem(h.g, h.cell, pingRadius)
+	if !ok {
+		return "Ping: no unfound items within range"
+	}
+	dist := int(math.Round(math.Hypot(float64(target.Row-h.cell.Row), float64(target.Col-h.cell.Col))))
+	where := fmt.Sprintf("~%d cells", dist)
+	if bearing := setup.CompassBearing(h.cell, target); bearing != "" {
+		where += " " + bearing
+	}
+	renderer.AddCallout(h.cell.Row, h.cell.Col, fmt.Sprintf("Ping: ITEM{%s} %s", name, where), renderer.CalloutColorItem, sonarPingCalloutMs)
+	return fmt.Sprintf("Ping: %s %s", name, where)
+}
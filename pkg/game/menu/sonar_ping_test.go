@@ -0,0 +1,42 @@
+package menu
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func TestSonarPing_pointsTowardNearestUnfoundItem(t *testing.T) {
+	g, termCell := makeMenuTestGame(t)
+	h := NewMaintenanceMenuHandler(g, termCell, gameworld.GetGameData(termCell).MaintenanceTerm)
+	item := &SonarPingMenuItem{Parent: h}
+
+	if _, help := h.OnActivate(item, 0); help != "Ping: no unfound items within range" {
+		t.Fatalf("empty deck ping = %q", help)
+	}
+
+	seen := g.Grid.GetCell(0, 0)
+	seen.Discovered = true
+	seen.ItemsOnFloor.Put(world.NewItem("Map"))
+	g.Grid.GetCell(2, 0).ItemsOnFloor.Put(world.NewItem("Battery"))
+	if _, help := h.OnActivate(item, 0); help != "Ping: Battery ~2 cells SW" {
+		t.Fatalf("ping = %q, want the undiscovered battery two rows south", help)
+	}
+	if g.Grid.GetCell(2, 0).Discovered {
+		t.Error("ping should point toward the item, not reveal its cell")
+	}
+}
+
+func TestSonarPing_refusedWithoutPower(t *testing.T) {
+	g, termCell := makeMenuTestGame(t)
+	g.Generators[0].Trip()
+	g.UpdatePowerSupply()
+	g.InvalidateLivePowerCache()
+	g.Grid.GetCell(2, 0).ItemsOnFloor.Put(world.NewItem("Battery"))
+	h := NewMaintenanceMenuHandler(g, termCell, gameworld.GetGameData(termCell).MaintenanceTerm)
+
+	if _, help := h.OnActivate(&SonarPingMenuItem{Parent: h}, 0); help != "Ping: no power in this room" {
+		t.Fatalf("unpowered ping = %q", help)
+	}
+}
@@ -1,7 +1,14 @@
 package menu
 
 import (
+	"fmt"
+	"math"
+	"strconv"
+
 	"darkstation/pkg/engine/input"
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/debuglog"
+	"darkstation/pkg/game/gamemode"
 	"darkstation/pkg/game/renderer"
 )
 
@@ -27,6 +34,9 @@ func (h *VideoMenuHandler) OnActivate(item MenuItem, index int) (shouldClose boo
 	if _, isClose := item.(*CloseMenuItem); isClose {
 		return true, ""
 	}
+	if reset, ok := item.(*ResetSettingsMenuItem); ok {
+		return false, reset.Reset()
+	}
 	if cycler, ok := item.(CycleMenuItem); ok && cycler.CanCycle() {
 		_, helpText := cycler.HandleCycle(1)
 		return false, helpText
@@ -41,9 +51,40 @@ func (h *VideoMenuHandler) ShouldCloseOnAnyAction() bool {
 }
 
 func (h *VideoMenuHandler) GetMenuItems() []MenuItem {
+	return append(videoSettingsItems(), &CloseMenuItem{Label: "Back"})
+}
+
+// videoSettingsItems returns every persisted preference row, shared by the Video menu
+// and the settings menu's Video tab, ending with Reset to Defaults.
+func videoSettingsItems() []MenuItem {
 	return []MenuItem{
 		&WindowModeMenuItem{},
-		&CloseMenuItem{Label: "Back"},
+		&MapZoomMenuItem{},
+		&MapWidthMenuItem{},
+		&TextSizeMenuItem{},
+		&GeneratorBadgesMenuItem{},
+		&RoomProgressMenuItem{},
+		&CameraFollowMenuItem{},
+		&CameraPanMenuItem{},
+		&CameraEasingMenuItem{},
+		&ReduceMotionMenuItem{},
+		&SoundMenuItem{},
+		&VolumeMenuItem{},
+		&ColorblindMenuItem{},
+		&HintsMenuItem{},
+		&TutorialHintsMenuItem{},
+		&FOVRadiusMenuItem{},
+		&RevealRoomMenuItem{},
+		&RoomEntryCalloutMenuItem{},
+		&ConfirmRiskyMovesMenuItem{},
+		&PowerSurgesMenuItem{},
+		&ManualPickupMenuItem{},
+		&InventoryCapMenuItem{},
+		&SoftLockCheckMenuItem{},
+		&DiagonalMovementMenuItem{},
+		&PreferredDifficultyMenuItem{},
+		&PreferredGameModeMenuItem{},
+		&ResetSettingsMenuItem{},
 	}
 }
 
@@ -78,3 +119,941 @@ func (w *WindowModeMenuItem) HandleCycle(delta int) (bool, string) {
 	}
 	return true, "Window mode: windowed"
 }
+
+// MapZoomMenuItem cycles the map tile size, the same zoom the +/- keys adjust.
+type MapZoomMenuItem struct{}
+
+func (z *MapZoomMenuItem) GetLabel() string {
+	return "Map Zoom\tACTION{" + strconv.Itoa(config.Current().TileSize) + " px}\tSUBTLE{< left/right >}"
+}
+
+func (z *MapZoomMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (z *MapZoomMenuItem) GetHelpText() string {
+	return "Size of each map tile; the zoom keys fine-tune between these steps"
+}
+
+func (z *MapZoomMenuItem) CanCycle() bool {
+	return true
+}
+
+func (z *MapZoomMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	if err := cfg.SetTileSize(cyclePreset(config.TileSizes, cfg.TileSize, delta)); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	return true, "Map zoom: " + strconv.Itoa(cfg.TileSize) + " px"
+}
+
+// MapWidthMenuItem cycles the widest the map may be before the window is letterboxed.
+type MapWidthMenuItem struct{}
+
+func (w *MapWidthMenuItem) GetLabel() string {
+	return "Map Width\tACTION{" + mapWidthLabel(config.Current().MaxViewportCols) + "}\tSUBTLE{< left/right >}"
+}
+
+func (w *MapWidthMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (w *MapWidthMenuItem) GetHelpText() string {
+	return "Cap the map's width and letterbox the rest of a wide window"
+}
+
+func (w *MapWidthMenuItem) CanCycle() bool {
+	return true
+}
+
+func (w *MapWidthMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	if err := cfg.SetMaxViewportCols(cyclePreset(config.MaxViewportColsOptions, cfg.MaxViewportCols, delta)); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	return true, "Map width: " + mapWidthLabel(cfg.MaxViewportCols)
+}
+
+// mapWidthLabel formats a Map Width cap for the settings menu.
+func mapWidthLabel(cols int) string {
+	if cols <= 0 {
+		return "fill window"
+	}
+	return fmt.Sprintf("%d tiles", cols)
+}
+
+// cyclePreset steps through ascending presets from current. A value between presets (a
+// zoom-key size) moves to the nearest preset in that direction.
+func cyclePreset(presets []int, current, delta int) int {
+	for i, v := range presets {
+		if v == current {
+			return presets[(i+delta+len(presets))%len(presets)]
+		}
+	}
+	if delta > 0 {
+		for _, v := range presets {
+			if v > current {
+				return v
+			}
+		}
+		return presets[0]
+	}
+	for i := len(presets) - 1; i >= 0; i-- {
+		if presets[i] < current {
+			return presets[i]
+		}
+	}
+	return presets[len(presets)-1]
+}
+
+// TextSizeMenuItem cycles the UI text scale, independent of map zoom.
+type TextSizeMenuItem struct{}
+
+func (t *TextSizeMenuItem) GetLabel() string {
+	return "Text Size\tACTION{" + uiScaleLabel(config.Current().UIScale) + "}\tSUBTLE{< left/right >}"
+}
+
+func (t *TextSizeMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (t *TextSizeMenuItem) GetHelpText() string {
+	return "Scale the status panel, messages and menus without changing the map zoom"
+}
+
+func (t *TextSizeMenuItem) CanCycle() bool {
+	return true
+}
+
+func (t *TextSizeMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	scales := config.UIScales
+	// A hand-edited scale between presets moves to the next preset in that direction.
+	next := 0
+	if delta < 0 {
+		next = len(scales) - 1
+	}
+	for i, scale := range scales {
+		if scale == cfg.UIScale {
+			next = (i + delta + len(scales)) % len(scales)
+			break
+		}
+		if delta > 0 && scale > cfg.UIScale {
+			next = i
+			break
+		}
+		if delta < 0 && scale < cfg.UIScale {
+			next = i
+		}
+	}
+	if err := cfg.SetUIScale(scales[next]); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	return true, "Text size: " + uiScaleLabel(cfg.UIScale)
+}
+
+// uiScaleLabel formats a UI scale as a percentage for the settings menu.
+func uiScaleLabel(scale float64) string {
+	return strconv.Itoa(int(math.Round(scale*100))) + "%"
+}
+
+// GeneratorBadgesMenuItem toggles the batteries-needed badge on unpowered generator tiles.
+type GeneratorBadgesMenuItem struct{}
+
+func (b *GeneratorBadgesMenuItem) GetLabel() string {
+	state := "off"
+	if config.Current().GeneratorBadges {
+		state = "on"
+	}
+	return "Generator Badges\tACTION{" + state + "}\tSUBTLE{< left/right >}"
+}
+
+func (b *GeneratorBadgesMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (b *GeneratorBadgesMenuItem) GetHelpText() string {
+	return "Show how many batteries each unpowered generator still needs on the map"
+}
+
+func (b *GeneratorBadgesMenuItem) CanCycle() bool {
+	return true
+}
+
+func (b *GeneratorBadgesMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	if err := cfg.SetGeneratorBadges(!cfg.GeneratorBadges); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	if cfg.GeneratorBadges {
+		return true, "Generator badges: on"
+	}
+	return true, "Generator badges: off"
+}
+
+// RoomProgressMenuItem toggles the rooms-explored line in the status panel.
+type RoomProgressMenuItem struct{}
+
+func (r *RoomProgressMenuItem) GetLabel() string {
+	state := "off"
+	if config.Current().RoomProgress {
+		state = "on"
+	}
+	return "Room Progress\tACTION{" + state + "}\tSUBTLE{< left/right >}"
+}
+
+func (r *RoomProgressMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (r *RoomProgressMenuItem) GetHelpText() string {
+	return "Show how many of this deck's rooms you have explored in the status panel"
+}
+
+func (r *RoomProgressMenuItem) CanCycle() bool {
+	return true
+}
+
+func (r *RoomProgressMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	if err := cfg.SetRoomProgress(!cfg.RoomProgress); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	if cfg.RoomProgress {
+		return true, "Room progress: on"
+	}
+	return true, "Room progress: off"
+}
+
+// CameraFollowMenuItem cycles how closely the map camera follows the player.
+type CameraFollowMenuItem struct{}
+
+func (c *CameraFollowMenuItem) GetLabel() string {
+	return "Camera Follow\tACTION{" + config.Current().CameraFollow + "}\tSUBTLE{< left/right >}"
+}
+
+func (c *CameraFollowMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (c *CameraFollowMenuItem) GetHelpText() string {
+	return "Snap jumps to each step; tight rides the player; smooth and relaxed trail and ease in"
+}
+
+func (c *CameraFollowMenuItem) CanCycle() bool {
+	return true
+}
+
+func (c *CameraFollowMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	styles := config.CameraFollowStyles
+	next := 0
+	for i, style := range styles {
+		if style == cfg.CameraFollow {
+			next = (i + delta + len(styles)) % len(styles)
+		}
+	}
+	if err := cfg.SetCameraFollow(styles[next]); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	return true, "Camera follow: " + cfg.CameraFollow
+}
+
+// CameraPanMenuItem cycles how long the camera takes to pan to a focused room.
+type CameraPanMenuItem struct{}
+
+func (c *CameraPanMenuItem) GetLabel() string {
+	return "Camera Pan\tACTION{" + cameraPanLabel(config.Current().CameraPanMs) + "}\tSUBTLE{< left/right >}"
+}
+
+func (c *CameraPanMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (c *CameraPanMenuItem) GetHelpText() string {
+	return "How long the camera takes to pan to a room on the maintenance map; instant jumps there"
+}
+
+func (c *CameraPanMenuItem) CanCycle() bool {
+	return true
+}
+
+func (c *CameraPanMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	durations := config.CameraPanDurationsMs
+	// A hand-edited duration between presets moves to the next preset in that direction.
+	next := 0
+	if delta < 0 {
+		next = len(durations) - 1
+	}
+	for i, ms := range durations {
+		if ms == cfg.CameraPanMs {
+			next = (i + delta + len(durations)) % len(durations)
+			break
+		}
+		if delta > 0 && ms > cfg.CameraPanMs {
+			next = i
+			break
+		}
+		if delta < 0 && ms < cfg.CameraPanMs {
+			next = i
+		}
+	}
+	if err := cfg.SetCameraPanMs(durations[next]); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	return true, "Camera pan: " + cameraPanLabel(cfg.CameraPanMs)
+}
+
+// cameraPanLabel formats a pan duration for the settings menu.
+func cameraPanLabel(ms int) string {
+	if ms <= 0 {
+		return "instant"
+	}
+	return strconv.FormatFloat(float64(ms)/1000, 'f', -1, 64) + "s"
+}
+
+// CameraEasingMenuItem cycles the easing curve of room-focus camera pans.
+type CameraEasingMenuItem struct{}
+
+func (c *CameraEasingMenuItem) GetLabel() string {
+	return "Camera Easing\tACTION{" + config.Current().CameraEasing + "}\tSUBTLE{< left/right >}"
+}
+
+func (c *CameraEasingMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (c *CameraEasingMenuItem) GetHelpText() string {
+	return "Linear pans at a steady rate; ease_in_out and quintic start and stop gently"
+}
+
+func (c *CameraEasingMenuItem) CanCycle() bool {
+	return true
+}
+
+func (c *CameraEasingMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	easings := config.CameraEasings
+	next := 0
+	for i, easing := range easings {
+		if easing == cfg.CameraEasing {
+			next = (i + delta + len(easings)) % len(easings)
+		}
+	}
+	if err := cfg.SetCameraEasing(easings[next]); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	return true, "Camera easing: " + cfg.CameraEasing
+}
+
+// ReduceMotionMenuItem toggles holding animated map effects still.
+type ReduceMotionMenuItem struct{}
+
+func (r *ReduceMotionMenuItem) GetLabel() string {
+	state := "off"
+	if config.Current().ReduceMotion {
+		state = "on"
+	}
+	return "Reduce Motion\tACTION{" + state + "}\tSUBTLE{< left/right >}"
+}
+
+func (r *ReduceMotionMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (r *ReduceMotionMenuItem) GetHelpText() string {
+	return "Stop the exit pulse, bump bounce, callout slides and power shimmer"
+}
+
+func (r *ReduceMotionMenuItem) CanCycle() bool {
+	return true
+}
+
+func (r *ReduceMotionMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	if err := cfg.SetReduceMotion(!cfg.ReduceMotion); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	if cfg.ReduceMotion {
+		return true, "Reduce motion: on"
+	}
+	return true, "Reduce motion: off"
+}
+
+// SoundMenuItem toggles sound effects.
+type SoundMenuItem struct{}
+
+func (s *SoundMenuItem) GetLabel() string {
+	state := "on"
+	if config.Current().Muted {
+		state = "off"
+	}
+	return "Sound\tACTION{" + state + "}\tSUBTLE{< left/right >}"
+}
+
+func (s *SoundMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (s *SoundMenuItem) GetHelpText() string {
+	return "Play sound effects for pickups, generators, doors, hazards and the exit"
+}
+
+func (s *SoundMenuItem) CanCycle() bool {
+	return true
+}
+
+func (s *SoundMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	if err := cfg.SetMuted(!cfg.Muted); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	renderer.SetMuted(cfg.Muted)
+	if cfg.Muted {
+		return true, "Sound: off"
+	}
+	return true, "Sound: on"
+}
+
+// VolumeMenuItem cycles the sound volume.
+type VolumeMenuItem struct{}
+
+func (v *VolumeMenuItem) GetLabel() string {
+	return "Volume\tACTION{" + strconv.Itoa(config.Current().Volume) + "%}\tSUBTLE{< left/right >}"
+}
+
+func (v *VolumeMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (v *VolumeMenuItem) GetHelpText() string {
+	return "Loudness of sound effects and the ambient power hum"
+}
+
+func (v *VolumeMenuItem) CanCycle() bool {
+	return true
+}
+
+func (v *VolumeMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	if err := cfg.SetVolume(cyclePreset(config.Volumes, cfg.Volume, delta)); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	return true, "Volume: " + strconv.Itoa(cfg.Volume) + "%"
+}
+
+// ColorblindMenuItem cycles the colorblind palette presets.
+type ColorblindMenuItem struct{}
+
+func (c *ColorblindMenuItem) GetLabel() string {
+	return "Colorblind Mode\tACTION{" + config.Current().ColorblindMode + "}\tSUBTLE{< left/right >}"
+}
+
+func (c *ColorblindMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (c *ColorblindMenuItem) GetHelpText() string {
+	return "Recolor generators, hazards, doors and the lift for your color vision; doors also change shape"
+}
+
+func (c *ColorblindMenuItem) CanCycle() bool {
+	return true
+}
+
+func (c *ColorblindMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	modes := config.ColorblindModes
+	next := 0
+	for i, mode := range modes {
+		if mode == cfg.ColorblindMode {
+			next = (i + delta + len(modes)) % len(modes)
+		}
+	}
+	if err := cfg.SetColorblindMode(modes[next]); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	return true, "Colorblind mode: " + cfg.ColorblindMode
+}
+
+// HintsMenuItem toggles the stuck-player hint nudge.
+type HintsMenuItem struct{}
+
+func (h *HintsMenuItem) GetLabel() string {
+	state := "off"
+	if config.Current().HintsEnabled {
+		state = "on"
+	}
+	return "Stuck Hints\tACTION{" + state + "}\tSUBTLE{< left/right >}"
+}
+
+func (h *HintsMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (h *HintsMenuItem) GetHelpText() string {
+	return "Point toward the next objective after a long stretch without progress (never on Hard)"
+}
+
+func (h *HintsMenuItem) CanCycle() bool {
+	return true
+}
+
+func (h *HintsMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	if err := cfg.SetHintsEnabled(!cfg.HintsEnabled); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	if cfg.HintsEnabled {
+		return true, "Stuck hints: on"
+	}
+	return true, "Stuck hints: off"
+}
+
+// TutorialHintsMenuItem toggles the movement/interact prompts shown for the first few actions.
+type TutorialHintsMenuItem struct{}
+
+func (h *TutorialHintsMenuItem) GetLabel() string {
+	state := "off"
+	if config.Current().TutorialHints {
+		state = "on"
+	}
+	return "Tutorial Hints\tACTION{" + state + "}\tSUBTLE{< left/right >}"
+}
+
+func (h *TutorialHintsMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (h *TutorialHintsMenuItem) GetHelpText() string {
+	return "Move and interact prompts for your first few actions (turns off after your first cleared deck)"
+}
+
+func (h *TutorialHintsMenuItem) CanCycle() bool {
+	return true
+}
+
+func (h *TutorialHintsMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	if err := cfg.SetTutorialHints(!cfg.TutorialHints); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	if cfg.TutorialHints {
+		return true, "Tutorial hints: on"
+	}
+	return true, "Tutorial hints: off"
+}
+
+// RevealRoomMenuItem toggles revealing a whole room the first time the player enters it.
+type RevealRoomMenuItem struct{}
+
+func (r *RevealRoomMenuItem) GetLabel() string {
+	state := "off"
+	if config.Current().RevealRoomOnEntry {
+		state = "on"
+	}
+	return "Reveal Room on Entry\tACTION{" + state + "}\tSUBTLE{< left/right >}"
+}
+
+func (r *RevealRoomMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (r *RevealRoomMenuItem) GetHelpText() string {
+	return "Show the whole room as soon as you step into it (corridors still reveal as you walk)"
+}
+
+func (r *RevealRoomMenuItem) CanCycle() bool {
+	return true
+}
+
+func (r *RevealRoomMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	if err := cfg.SetRevealRoomOnEntry(!cfg.RevealRoomOnEntry); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	if cfg.RevealRoomOnEntry {
+		return true, "Reveal room on entry: on"
+	}
+	return true, "Reveal room on entry: off"
+}
+
+// RoomEntryCalloutMenuItem toggles the room name callout shown on first entry.
+type RoomEntryCalloutMenuItem struct{}
+
+func (r *RoomEntryCalloutMenuItem) GetLabel() string {
+	state := "off"
+	if config.Current().RoomEntryCallout {
+		state = "on"
+	}
+	return "Room Entry Callout\tACTION{" + state + "}\tSUBTLE{< left/right >}"
+}
+
+func (r *RoomEntryCalloutMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (r *RoomEntryCalloutMenuItem) GetHelpText() string {
+	return "Name each room and its kind the first time you walk into it"
+}
+
+func (r *RoomEntryCalloutMenuItem) CanCycle() bool {
+	return true
+}
+
+func (r *RoomEntryCalloutMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	if err := cfg.SetRoomEntryCallout(!cfg.RoomEntryCallout); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	if cfg.RoomEntryCallout {
+		return true, "Room entry callout: on"
+	}
+	return true, "Room entry callout: off"
+}
+
+// ConfirmRiskyMovesMenuItem toggles asking for a second move before stepping onto hazardous floor.
+type ConfirmRiskyMovesMenuItem struct{}
+
+func (c *ConfirmRiskyMovesMenuItem) GetLabel() string {
+	state := "off"
+	if config.Current().ConfirmRiskyMoves {
+		state = "on"
+	}
+	return "Confirm Risky Moves\tACTION{" + state + "}\tSUBTLE{< left/right >}"
+}
+
+func (c *ConfirmRiskyMovesMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (c *ConfirmRiskyMovesMenuItem) GetHelpText() string {
+	return "Stepping onto hazardous floor needs a second press of the same direction"
+}
+
+func (c *ConfirmRiskyMovesMenuItem) CanCycle() bool {
+	return true
+}
+
+func (c *ConfirmRiskyMovesMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	if err := cfg.SetConfirmRiskyMoves(!cfg.ConfirmRiskyMoves); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	if cfg.ConfirmRiskyMoves {
+		return true, "Confirm risky moves: on"
+	}
+	return true, "Confirm risky moves: off"
+}
+
+// PowerSurgesMenuItem toggles random power surges on deep decks.
+type PowerSurgesMenuItem struct{}
+
+func (p *PowerSurgesMenuItem) GetLabel() string {
+	state := "off"
+	if config.Current().PowerSurges {
+		state = "on"
+	}
+	return "Power Surges\tACTION{" + state + "}\tSUBTLE{< left/right >}"
+}
+
+func (p *PowerSurgesMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (p *PowerSurgesMenuItem) GetHelpText() string {
+	return "Random grid surges on deep decks that can shed load and black out rooms"
+}
+
+func (p *PowerSurgesMenuItem) CanCycle() bool {
+	return true
+}
+
+func (p *PowerSurgesMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	if err := cfg.SetPowerSurges(!cfg.PowerSurges); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	if cfg.PowerSurges {
+		return true, "Power surges: on"
+	}
+	return true, "Power surges: off"
+}
+
+// ManualPickupMenuItem toggles between automatic and manual pick-up of floor items.
+type ManualPickupMenuItem struct{}
+
+func (m *ManualPickupMenuItem) GetLabel() string {
+	mode := "auto"
+	if config.Current().ManualPickup {
+		mode = "manual"
+	}
+	return "Item Pick-up\tACTION{" + mode + "}\tSUBTLE{< left/right >}"
+}
+
+func (m *ManualPickupMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (m *ManualPickupMenuItem) GetHelpText() string {
+	return "Manual leaves floor items in place until you press the pick-up key (G)"
+}
+
+func (m *ManualPickupMenuItem) CanCycle() bool {
+	return true
+}
+
+func (m *ManualPickupMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	if err := cfg.SetManualPickup(!cfg.ManualPickup); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	if cfg.ManualPickup {
+		return true, "Item pick-up: manual"
+	}
+	return true, "Item pick-up: auto"
+}
+
+// FOVRadiusMenuItem cycles how far the player can see.
+type FOVRadiusMenuItem struct{}
+
+func (f *FOVRadiusMenuItem) GetLabel() string {
+	return "Sight Radius\tACTION{" + fovRadiusLabel(config.Current().FOVRadius) + "}\tSUBTLE{< left/right >}"
+}
+
+func (f *FOVRadiusMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (f *FOVRadiusMenuItem) GetHelpText() string {
+	return "How many cells you can see; a limited radius drops by one in unpowered rooms"
+}
+
+func (f *FOVRadiusMenuItem) CanCycle() bool {
+	return true
+}
+
+func (f *FOVRadiusMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	radii := config.FOVRadii
+	next := 0
+	for i, r := range radii {
+		if r == cfg.FOVRadius {
+			next = (i + delta + len(radii)) % len(radii)
+		}
+	}
+	if err := cfg.SetFOVRadius(radii[next]); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	return true, "Sight radius: " + fovRadiusLabel(cfg.FOVRadius)
+}
+
+// fovRadiusLabel formats a sight radius for the settings menu.
+func fovRadiusLabel(radius int) string {
+	if radius <= 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d cells", radius)
+}
+
+// InventoryCapMenuItem switches between an unlimited inventory and the hardcore cap.
+type InventoryCapMenuItem struct{}
+
+func (i *InventoryCapMenuItem) GetLabel() string {
+	limit := "unlimited"
+	if n := config.Current().InventoryCap; n > 0 {
+		limit = fmt.Sprintf("%d items", n)
+	}
+	return "Inventory Limit\tACTION{" + limit + "}\tSUBTLE{< left/right >}"
+}
+
+func (i *InventoryCapMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (i *InventoryCapMenuItem) GetHelpText() string {
+	return fmt.Sprintf("Hardcore carries at most %d items besides batteries; drop extras with the drop key (V)", config.HardcoreInventoryCap)
+}
+
+func (i *InventoryCapMenuItem) CanCycle() bool {
+	return true
+}
+
+func (i *InventoryCapMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	next := config.HardcoreInventoryCap
+	if cfg.InventoryCap > 0 {
+		next = 0
+	}
+	if err := cfg.SetInventoryCap(next); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	if next > 0 {
+		return true, fmt.Sprintf("Inventory limit: %d items", next)
+	}
+	return true, "Inventory limit: unlimited"
+}
+
+// SoftLockCheckMenuItem toggles watching for decks that can no longer be finished.
+type SoftLockCheckMenuItem struct{}
+
+func (s *SoftLockCheckMenuItem) GetLabel() string {
+	state := "off"
+	if config.Current().SoftLockCheck {
+		state = "on"
+	}
+	return "Soft-lock Check\tACTION{" + state + "}\tSUBTLE{< left/right >}"
+}
+
+func (s *SoftLockCheckMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (s *SoftLockCheckMenuItem) GetHelpText() string {
+	return "Offer a deck reset or reroll when the deck can no longer be finished"
+}
+
+func (s *SoftLockCheckMenuItem) CanCycle() bool {
+	return true
+}
+
+func (s *SoftLockCheckMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	if err := cfg.SetSoftLockCheck(!cfg.SoftLockCheck); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	if cfg.SoftLockCheck {
+		return true, "Soft-lock check: on"
+	}
+	return true, "Soft-lock check: off"
+}
+
+// DiagonalMovementMenuItem toggles 8-direction movement on the keypad.
+type DiagonalMovementMenuItem struct{}
+
+func (d *DiagonalMovementMenuItem) GetLabel() string {
+	state := "off"
+	if config.Current().DiagonalMovement {
+		state = "on"
+	}
+	return "Diagonal Movement\tACTION{" + state + "}\tSUBTLE{< left/right >}"
+}
+
+func (d *DiagonalMovementMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (d *DiagonalMovementMenuItem) GetHelpText() string {
+	return "Move diagonally with keypad 7/9/1/3; steps cannot squeeze between two walls"
+}
+
+func (d *DiagonalMovementMenuItem) CanCycle() bool {
+	return true
+}
+
+func (d *DiagonalMovementMenuItem) HandleCycle(delta int) (bool, string) {
+	cfg := config.Current()
+	if err := cfg.SetDiagonalMovement(!cfg.DiagonalMovement); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	if cfg.DiagonalMovement {
+		return true, "Diagonal movement: on"
+	}
+	return true, "Diagonal movement: off"
+}
+
+// PreferredDifficultyMenuItem cycles the difficulty the new-game screen starts on.
+type PreferredDifficultyMenuItem struct{}
+
+func (d *PreferredDifficultyMenuItem) GetLabel() string {
+	return "Difficulty\tACTION{" + PreferredDifficulty().String() + "}\tSUBTLE{< left/right >}"
+}
+
+func (d *PreferredDifficultyMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (d *PreferredDifficultyMenuItem) GetHelpText() string {
+	return "Difficulty preselected for new runs; the run in progress keeps its own"
+}
+
+func (d *PreferredDifficultyMenuItem) CanCycle() bool {
+	return true
+}
+
+func (d *PreferredDifficultyMenuItem) HandleCycle(delta int) (bool, string) {
+	next := cycleOption(gamemode.Difficulties(), PreferredDifficulty(), delta)
+	if err := config.Current().SetDifficulty(next.String()); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	return true, "Difficulty: " + next.String()
+}
+
+// PreferredDifficulty returns the saved new-game difficulty, Normal when unset or unknown.
+func PreferredDifficulty() gamemode.Difficulty {
+	d, _ := gamemode.ParseDifficulty(config.Current().Difficulty)
+	return d
+}
+
+// PreferredGameModeMenuItem cycles the game mode the mode screen starts on.
+type PreferredGameModeMenuItem struct{}
+
+func (m *PreferredGameModeMenuItem) GetLabel() string {
+	return "Game Mode\tACTION{" + PreferredGameMode().DisplayName + "}\tSUBTLE{< left/right >}"
+}
+
+func (m *PreferredGameModeMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (m *PreferredGameModeMenuItem) GetHelpText() string {
+	return "Game mode highlighted when starting a new game"
+}
+
+func (m *PreferredGameModeMenuItem) CanCycle() bool {
+	return true
+}
+
+func (m *PreferredGameModeMenuItem) HandleCycle(delta int) (bool, string) {
+	var ids []gamemode.ID
+	for _, mode := range gamemode.All() {
+		ids = append(ids, mode.ID)
+	}
+	next := gamemode.Get(cycleOption(ids, PreferredGameMode().ID, delta))
+	if err := config.Current().SetGameMode(string(next.ID)); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	return true, "Game mode: " + next.DisplayName
+}
+
+// PreferredGameMode returns the saved game mode, the standard mode when unset or unknown.
+func PreferredGameMode() gamemode.Mode {
+	return gamemode.Get(gamemode.ID(config.Current().GameMode))
+}
+
+// ResetSettingsMenuItem restores every setting on this tab to its default when activated.
+type ResetSettingsMenuItem struct{}
+
+func (r *ResetSettingsMenuItem) GetLabel() string {
+	return "Reset to Defaults"
+}
+
+func (r *ResetSettingsMenuItem) IsSelectable() bool {
+	return true
+}
+
+func (r *ResetSettingsMenuItem) GetHelpText() string {
+	return "Restore every setting above to its default; bindings, high scores and pars are kept"
+}
+
+// Reset restores the defaults, applies the ones the renderer does not pick up on its own,
+// and returns the help line to show.
+func (r *ResetSettingsMenuItem) Reset() string {
+	cfg := config.Current()
+	if err := cfg.ResetToDefaults(); err != nil {
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+	renderer.SetMuted(cfg.Muted)
+	return "Settings restored to defaults"
+}
@@ -14,6 +14,15 @@ type DeveloperDebugRenderer interface {
 	SetShowPlayerPosition(on bool)
 	ShowPlayerPositionEnabled() bool
 	ToggleShowPlayerPosition() bool
+	SetShowCellCoords(on bool)
+	ShowCellCoordsEnabled() bool
+	ToggleShowCellCoords() bool
+}
+
+// RenderBenchmarkRenderer is implemented by renderers that can time their map drawing
+// for the console benchmark command.
+type RenderBenchmarkRenderer interface {
+	StartRenderBenchmark(seconds float64) bool
 }
 
 // WindowModeRenderer is implemented by renderers that can switch between
@@ -116,6 +125,29 @@ func ToggleShowPlayerPosition() bool {
 	return false
 }
 
+// SetShowCellCoords enables or disables row,col labels on map tiles via draw.coords cvar.
+func SetShowCellCoords(on bool) {
+	if dr, ok := Current.(DeveloperDebugRenderer); ok {
+		dr.SetShowCellCoords(on)
+	}
+}
+
+// ShowCellCoordsEnabled reports whether draw.coords labels map tiles with row,col.
+func ShowCellCoordsEnabled() bool {
+	if dr, ok := Current.(DeveloperDebugRenderer); ok {
+		return dr.ShowCellCoordsEnabled()
+	}
+	return false // draw.coords default is 0
+}
+
+// ToggleShowCellCoords flips draw.coords and returns the new state.
+func ToggleShowCellCoords() bool {
+	if dr, ok := Current.(DeveloperDebugRenderer); ok {
+		return dr.ToggleShowCellCoords()
+	}
+	return false
+}
+
 // SetFullscreen switches the active renderer between windowed and borderless fullscreen.
 func SetFullscreen(on bool) {
 	if wr, ok := Current.(WindowModeRenderer); ok {
@@ -138,3 +170,13 @@ func ToggleFullscreen() bool {
 	}
 	return false
 }
+
+// StartRenderBenchmark has the renderer time map frames at the overview zoom for seconds
+// and log min/avg/max frame times. Returns false if the renderer cannot benchmark or one
+// is already running.
+func StartRenderBenchmark(seconds float64) bool {
+	if br, ok := Current.(RenderBenchmarkRenderer); ok {
+		return br.StartRenderBenchmark(seconds)
+	}
+	return false
+}
@@ -1,6 +1,6 @@
-// Ambient map feedback: live conduits shimmer faintly, headlamp-only light
-// flickers like a battery lamp, and devices the player just changed pulse for a
-// moment ("the station noticed"). All effects are presentation-only — they read
+// Ambient map feedback: live conduits shimmer faintly, rooms on a strained grid
+// burn dim, headlamp-only light flickers like a battery lamp, and devices the
+// player just changed pulse for a moment ("the station noticed"). All effects are presentation-only — they read
 // existing snapshot state and never touch game state.
 package ebiten
 
@@ -31,6 +31,12 @@ const (
 	headlampFlickerPeriod1  = 1100.0
 	headlampFlickerPeriod2  = 370.0
 	headlampFlickerCellSeed = 0.9
+
+	// Power surge flicker: powered cells stutter while a surge is telegraphed and
+	// brown out hard while it is live.
+	surgeFlickerStepMs  = 90
+	surgeWarnFlickerAmp = 0.25
+	surgeLiveFlickerAmp = 0.5
 )
 
 // devicePulseSnapshot is one active "station noticed" highlight copied for Draw.
@@ -70,7 +76,10 @@ func (e *EbitenRenderer) snapshotDevicePulses(nowMs int64) {
 }
 
 // ambientTileColors applies idle-world modulation to a tile's plate and glyph:
-// device pulse > headlamp flicker > conduit shimmer. Returns the colors to draw.
+// device pulse > power-up sweep > surge flicker > headlamp flicker > conduit shimmer.
+// Strained-grid dimming scales powered cells underneath surge flicker and shimmer.
+// With reduce motion the idle shimmer and headlamp flicker hold at a steady level.
+// Returns the colors to draw.
 func (e *EbitenRenderer) ambientTileColors(g *state.Game, cell *world.Cell, snap *renderSnapshot,
 	opts *CellRenderOptions, customBg color.Color) (bg, fg color.Color) {
 	bg, fg = customBg, opts.Color
@@ -78,21 +87,36 @@ func (e *EbitenRenderer) ambientTileColors(g *state.Game, cell *world.Cell, snap
 		return bg, fg
 	}
 	nowMs := time.Now().UnixMilli()
+	live := cellKnowledgeTier(g, cell) == knowledgeLive
+
+	poweringUp := false
+	if live && len(snap.powerUps) > 0 {
+		bg, fg, poweringUp = powerUpTileColors(snap, cell, bg, fg, nowMs)
+	}
 
 	if startMs, ok := snapDevicePulseAt(snap, cell.Row, cell.Col); ok {
 		return devicePulseColors(bg, fg, opts, nowMs-startMs)
 	}
 
-	if cellKnowledgeTier(g, cell) != knowledgeLive {
+	if !live || poweringUp {
 		return bg, fg
 	}
 	if snapCellHasLivePower(snap, cell) {
+		if level := snapRoomLightLevel(snap, cell); level < 1 {
+			bg, fg = scaleColor(bg, level), scaleColor(fg, level)
+		}
+		if factor, ok := surgeFlickerFactor(snap.powerSurge, cell, nowMs); ok {
+			return scaleColor(bg, factor), scaleColor(fg, factor)
+		}
+		if snap.reduceMotion {
+			return bg, fg
+		}
 		// Powered conduit: a faint wave travels along the run.
 		phase := 2*math.Pi*float64(nowMs)/conduitShimmerPeriodMs - 0.6*float64(cell.Row+cell.Col)
 		factor := 1 + conduitShimmerAmp*math.Sin(phase)
 		return scaleColor(bg, factor), fg
 	}
-	if gameworld.GetGameData(cell).LightsOn {
+	if gameworld.GetGameData(cell).LightsOn && !snap.reduceMotion {
 		// Lit only by the player's own lamp: battery flicker on plate and glyph.
 		cellPhase := headlampFlickerCellSeed * float64((cell.Row*31+cell.Col*17)%64)
 		factor := 1 +
@@ -103,6 +127,39 @@ func (e *EbitenRenderer) ambientTileColors(g *state.Game, cell *world.Cell, snap
 	return bg, fg
 }
 
+// snapRoomLightLevel returns the brightness of a powered room cell: below 1 while the
+// room's lights run dim on a strained grid, 1 for corridors and rooms at full power.
+func snapRoomLightLevel(snap *renderSnapshot, cell *world.Cell) float64 {
+	if cell.IsCorridor || cell.Name == "" {
+		return 1
+	}
+	if level, ok := snap.mapPower.roomLightLevel[cell.Name]; ok {
+		return level
+	}
+	return 1
+}
+
+// surgeFlickerFactor returns the brightness factor for a powered cell during a power
+// surge. Cells drop out in a hashed step pattern so the grid stutters rather than pulses.
+func surgeFlickerFactor(surge state.PowerSurge, cell *world.Cell, nowMs int64) (float64, bool) {
+	if !surge.Warned || nowMs >= surge.EndMs {
+		return 1, false
+	}
+	amp := surgeWarnFlickerAmp
+	if surge.Watts > 0 {
+		amp = surgeLiveFlickerAmp
+	}
+	step := nowMs / surgeFlickerStepMs
+	hash := (step*7919 + int64(cell.Row*31+cell.Col*17)) % 5
+	if hash < 0 {
+		hash = -hash
+	}
+	if hash >= 2 {
+		return 1, true
+	}
+	return 1 - amp, true
+}
+
 func snapDevicePulseAt(snap *renderSnapshot, row, col int) (int64, bool) {
 	for _, p := range snap.devicePulses {
 		if p.row == row && p.col == col {
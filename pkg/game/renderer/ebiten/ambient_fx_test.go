@@ -4,6 +4,9 @@ import (
 	"image/color"
 	"testing"
 	"time"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/state"
 )
 
 func TestScaleColor_BrightensAndClamps(t *testing.T) {
@@ -78,3 +81,57 @@ func TestSnapshotDevicePulses_PrunesExpired(t *testing.T) {
 		t.Errorf("expired pulse should be removed from the registry, have %d entries", len(e.devicePulses))
 	}
 }
+
+func TestSurgeFlickerFactor(t *testing.T) {
+	cell := &world.Cell{Row: 2, Col: 3}
+	if _, ok := surgeFlickerFactor(state.PowerSurge{WarnAtMs: 100}, cell, 200); ok {
+		t.Error("no flicker before the surge is telegraphed")
+	}
+	warned := state.PowerSurge{Warned: true, StartMs: 1000, EndMs: 2000}
+	if _, ok := surgeFlickerFactor(warned, cell, 2000); ok {
+		t.Error("no flicker once the surge has ended")
+	}
+	live := warned
+	live.Watts = 20
+	minWarn, minLive := 1.0, 1.0
+	for now := int64(500); now < 2000; now += surgeFlickerStepMs {
+		if f, ok := surgeFlickerFactor(warned, cell, now); ok && f < minWarn {
+			minWarn = f
+		}
+		if f, ok := surgeFlickerFactor(live, cell, now); ok && f < minLive {
+			minLive = f
+		}
+	}
+	if minWarn != 1-surgeWarnFlickerAmp || minLive != 1-surgeLiveFlickerAmp {
+		t.Errorf("deepest dips warn=%v live=%v, want %v and %v", minWarn, minLive, 1-surgeWarnFlickerAmp, 1-surgeLiveFlickerAmp)
+	}
+}
+
+func TestSnapRoomLightLevel(t *testing.T) {
+	snap := &renderSnapshot{}
+	snap.mapPower.roomLightLevel = map[string]float64{"Lab": 0.5}
+	if got := snapRoomLightLevel(snap, &world.Cell{Name: "Lab"}); got != 0.5 {
+		t.Errorf("dimmed room level = %v, want 0.5", got)
+	}
+	if got := snapRoomLightLevel(snap, &world.Cell{Name: "Galley"}); got != 1 {
+		t.Errorf("room at full power level = %v, want 1", got)
+	}
+	if got := snapRoomLightLevel(snap, &world.Cell{Name: "Lab", IsCorridor: true}); got != 1 {
+		t.Errorf("corridor level = %v, want 1", got)
+	}
+}
+
+func TestExitPulse_ReduceMotionHoldsSteady(t *testing.T) {
+	e := &EbitenRenderer{}
+	if got := e.getPulsingExitColor(true); got != color.Color(colorExitUnlocked) {
+		t.Errorf("reduce motion exit color = %v, want steady %v", got, colorExitUnlocked)
+	}
+	if got := exitPendingPulse(0.15, 0.35, true); got != 0.35 {
+		t.Errorf("reduce motion pending pulse = %v, want 0.35", got)
+	}
+	first := e.getPulsingExitBackgroundColor(true)
+	time.Sleep(5 * time.Millisecond)
+	if second := e.getPulsingExitBackgroundColor(true); second != first {
+		t.Errorf("reduce motion exit background changed over time: %v then %v", first, second)
+	}
+}
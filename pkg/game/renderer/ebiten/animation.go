@@ -8,16 +8,11 @@ import (
 )
 
 // getPulsingExitColor returns a pulsing color for the unlocked exit icon
-// Uses a sine wave to create a smooth pulsing effect
-func (e *EbitenRenderer) getPulsingExitColor() color.Color {
+// Uses a sine wave to create a smooth pulsing effect; with reduceMotion it holds at full brightness
+func (e *EbitenRenderer) getPulsingExitColor(reduceMotion bool) color.Color {
 	// Pulse period: 2 seconds (2000ms)
 	const pulsePeriod = 2000.0
-	now := time.Now().UnixMilli()
-
-	// Calculate pulse value (0.0 to 1.0) using sine wave
-	// This creates a smooth oscillation
-	pulsePhase := float64(now%int64(pulsePeriod)) / pulsePeriod
-	pulseValue := (math.Sin(pulsePhase*2*math.Pi) + 1.0) / 2.0 // 0.0 to 1.0
+	pulseValue := exitPulseValue(pulsePeriod, reduceMotion)
 
 	// Pulse between 50% and 100% brightness
 	minBrightness := 0.5
@@ -34,16 +29,41 @@ func (e *EbitenRenderer) getPulsingExitColor() color.Color {
 	return color.RGBA{r8, g8, b8, a8}
 }
 
+// exitPendingPulsePeriodMs is slower than the ready lift's pulse so a partly ready
+// lift never reads as open at a glance.
+const exitPendingPulsePeriodMs = 3000
+
+// getPulsingExitPendingColor returns a slow amber pulse for a lift that has power but is
+// still held by objectives or a motor power shortfall
+func (e *EbitenRenderer) getPulsingExitPendingColor(reduceMotion bool) color.Color {
+	return scaleColor(colorExitPending, exitPendingPulse(0.6, 1.0, reduceMotion))
+}
+
+// getPulsingExitPendingBackgroundColor returns the dim amber background behind a partly ready lift
+func (e *EbitenRenderer) getPulsingExitPendingBackgroundColor(reduceMotion bool) color.Color {
+	return scaleColor(color.RGBA{255, 170, 0, 255}, exitPendingPulse(0.15, 0.35, reduceMotion))
+}
+
+// exitPendingPulse returns a brightness between lo and hi on the partly ready lift's pulse.
+func exitPendingPulse(lo, hi float64, reduceMotion bool) float64 {
+	return lo + (hi-lo)*exitPulseValue(exitPendingPulsePeriodMs, reduceMotion)
+}
+
+// exitPulseValue returns the exit pulse's sine phase as 0..1, or a steady 1 with reduceMotion.
+func exitPulseValue(periodMs float64, reduceMotion bool) float64 {
+	if reduceMotion {
+		return 1
+	}
+	phase := float64(time.Now().UnixMilli()%int64(periodMs)) / periodMs
+	return (math.Sin(phase*2*math.Pi) + 1.0) / 2.0
+}
+
 // getPulsingExitBackgroundColor returns a pulsing background color for the unlocked exit
-// Uses a distinct color (cyan/blue) that pulses
-func (e *EbitenRenderer) getPulsingExitBackgroundColor() color.Color {
+// Uses a distinct color (cyan/blue) that pulses; with reduceMotion it holds at its brightest
+func (e *EbitenRenderer) getPulsingExitBackgroundColor(reduceMotion bool) color.Color {
 	// Pulse period: 2 seconds (2000ms)
 	const pulsePeriod = 2000.0
-	now := time.Now().UnixMilli()
-
-	// Calculate pulse value (0.0 to 1.0) using sine wave
-	pulsePhase := float64(now%int64(pulsePeriod)) / pulsePeriod
-	pulseValue := (math.Sin(pulsePhase*2*math.Pi) + 1.0) / 2.0 // 0.0 to 1.0
+	pulseValue := exitPulseValue(pulsePeriod, reduceMotion)
 
 	// Pulse between 30% and 70% brightness for background (distinct from icon)
 	minBrightness := 0.3
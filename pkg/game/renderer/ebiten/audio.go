@@ -0,0 +1,199 @@
+// Sound effects for key gameplay events (pickups, batteries, generators, doors,
+// hazards, the exit, overload) and the looping hum heard while the deck has power.
+// The audio device is opened on the first unmuted sound, so a player who mutes
+// before then never touches it.
+package ebiten
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"github.com/hajimehoshi/ebiten/v2/audio"
+	"github.com/hajimehoshi/ebiten/v2/audio/wav"
+
+	"darkstation/pkg/game/debuglog"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/resources"
+)
+
+// audioSampleRate is the output rate; the embedded clips are resampled to it on decode.
+const audioSampleRate = 44100
+
+// soundVolume keeps effects well under full scale so they sit behind play.
+const soundVolume = 0.6
+
+// humVolume keeps the ambient loop a background presence under the effects.
+const humVolume = 0.25
+
+// soundClips maps each effect to its embedded WAV.
+var soundClips = map[renderer.Sound][]byte{
+	renderer.SoundPickup:        resources.SoundPickup,
+	renderer.SoundBatteryInsert: resources.SoundBattery,
+	renderer.SoundGeneratorOn:   resources.SoundGenerator,
+	renderer.SoundDoorUnlock:    resources.SoundDoor,
+	renderer.SoundHazardCleared: resources.SoundHazardCleared,
+	renderer.SoundExit:          resources.SoundExit,
+	renderer.SoundPowerWarning:  resources.SoundPowerWarning,
+}
+
+// soundBank owns the audio context and the decoded clips. Sounds are requested from
+// the game goroutine, so every field is guarded by mu.
+type soundBank struct {
+	mu      sync.Mutex
+	muted   bool
+	volume  float64 // Volume setting as a fraction; scales soundVolume and humVolume
+	ctx     *audio.Context
+	decoded map[renderer.Sound][]byte // PCM per sound; nil marks a clip that failed to decode
+
+	humWanted bool          // gameplay asked for the hum (deck has power)
+	hum       *audio.Player // the running hum loop, nil while stopped
+	humPCM    []byte        // decoded hum loop, kept for restarts
+}
+
+// setMuted silences or restores the bank. Sounds requested while muted are dropped,
+// and the hum stops until the bank is unmuted.
+func (b *soundBank) setMuted(muted bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.muted = muted
+	b.syncHum()
+}
+
+// setVolume applies the Volume setting (percent) to new sounds and the running hum.
+func (b *soundBank) setVolume(percent int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	volume := float64(min(max(percent, 0), 100)) / 100
+	if volume == b.volume {
+		return
+	}
+	b.volume = volume
+	if b.hum != nil {
+		b.hum.SetVolume(humVolume * b.volume)
+	}
+}
+
+// setAmbientHum records whether the deck wants the hum and starts or stops the loop.
+func (b *soundBank) setAmbientHum(on bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.humWanted = on
+	b.syncHum()
+}
+
+// close stops the hum for good when the window shuts. Later requests are ignored.
+func (b *soundBank) close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.humWanted = false
+	b.muted = true
+	b.syncHum()
+}
+
+// syncHum starts the hum loop when it is wanted and the bank is unmuted, and closes
+// its player otherwise. Callers hold b.mu.
+func (b *soundBank) syncHum() {
+	want := b.humWanted && !b.muted
+	if !want {
+		if b.hum != nil {
+			if err := b.hum.Close(); err != nil {
+				debuglog.Warn("sound.hum_close_failed", "err", err)
+			}
+			b.hum = nil
+		}
+		return
+	}
+	if b.hum != nil {
+		return
+	}
+	if b.humPCM == nil {
+		pcm, err := decodeSound(resources.AmbientHum)
+		if err != nil || len(pcm) == 0 {
+			debuglog.Warn("sound.decode_failed", "sound", "hum", "err", err)
+			b.humWanted = false
+			return
+		}
+		b.humPCM = pcm
+	}
+	p, err := b.context().NewPlayer(audio.NewInfiniteLoop(bytes.NewReader(b.humPCM), int64(len(b.humPCM))))
+	if err != nil {
+		debuglog.Warn("sound.hum_failed", "err", err)
+		return
+	}
+	p.SetVolume(humVolume * b.volume)
+	p.Play()
+	b.hum = p
+}
+
+// context returns the shared audio context, opening the device on first use.
+// Callers hold b.mu.
+func (b *soundBank) context() *audio.Context {
+	if b.ctx == nil {
+		if b.ctx = audio.CurrentContext(); b.ctx == nil {
+			b.ctx = audio.NewContext(audioSampleRate)
+		}
+	}
+	return b.ctx
+}
+
+// play starts s unless the bank is muted or the clip cannot be decoded.
+func (b *soundBank) play(s renderer.Sound) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.muted {
+		return
+	}
+	pcm := b.clip(s)
+	if pcm == nil {
+		return
+	}
+	p := b.context().NewPlayerFromBytes(pcm)
+	p.SetVolume(soundVolume * b.volume)
+	p.Play()
+}
+
+// clip returns the decoded PCM for s, decoding the embedded WAV on first use.
+// Callers hold b.mu.
+func (b *soundBank) clip(s renderer.Sound) []byte {
+	if pcm, ok := b.decoded[s]; ok {
+		return pcm
+	}
+	data, ok := soundClips[s]
+	if !ok {
+		return nil
+	}
+	if b.decoded == nil {
+		b.decoded = make(map[renderer.Sound][]byte)
+	}
+	pcm, err := decodeSound(data)
+	if err != nil {
+		debuglog.Warn("sound.decode_failed", "sound", int(s), "err", err)
+	}
+	b.decoded[s] = pcm
+	return pcm
+}
+
+// decodeSound turns an embedded WAV into 16-bit stereo PCM at audioSampleRate.
+func decodeSound(data []byte) ([]byte, error) {
+	stream, err := wav.DecodeWithSampleRate(audioSampleRate, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(stream)
+}
+
+// PlaySound implements renderer.SoundPlayer.
+func (e *EbitenRenderer) PlaySound(s renderer.Sound) {
+	e.sounds.play(s)
+}
+
+// SetAmbientHum implements renderer.SoundPlayer.
+func (e *EbitenRenderer) SetAmbientHum(on bool) {
+	e.sounds.setAmbientHum(on)
+}
+
+// SetMuted implements renderer.SoundPlayer.
+func (e *EbitenRenderer) SetMuted(muted bool) {
+	e.sounds.setMuted(muted)
+}
@@ -0,0 +1,59 @@
+package ebiten
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/resources"
+)
+
+func TestSoundClips_allDecode(t *testing.T) {
+	for s := renderer.SoundPickup; s <= renderer.SoundPowerWarning; s++ {
+		data, ok := soundClips[s]
+		if !ok {
+			t.Fatalf("sound %d has no clip", s)
+		}
+		pcm, err := decodeSound(data)
+		if err != nil {
+			t.Fatalf("sound %d: %v", s, err)
+		}
+		if len(pcm) == 0 || len(pcm)%4 != 0 {
+			t.Fatalf("sound %d decoded to %d bytes, want whole 16-bit stereo frames", s, len(pcm))
+		}
+	}
+}
+
+func TestSoundBank_mutedDropsSounds(t *testing.T) {
+	var b soundBank
+	b.setMuted(true)
+	b.play(renderer.SoundPickup)
+	if b.ctx != nil || b.decoded != nil {
+		t.Fatal("a muted bank should not open the audio device or decode clips")
+	}
+}
+
+func TestAmbientHum_decodesToWholeFrames(t *testing.T) {
+	pcm, err := decodeSound(resources.AmbientHum)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pcm) == 0 || len(pcm)%4 != 0 {
+		t.Fatalf("hum decoded to %d bytes, want whole 16-bit stereo frames", len(pcm))
+	}
+}
+
+func TestSoundBank_humWaitsForUnmuteAndStopsOnClose(t *testing.T) {
+	var b soundBank
+	b.setMuted(true)
+	b.setAmbientHum(true)
+	if b.hum != nil || b.ctx != nil {
+		t.Fatal("a muted bank should not start the hum or open the audio device")
+	}
+	if !b.humWanted {
+		t.Fatal("the hum request should be remembered for when sound is unmuted")
+	}
+	b.close()
+	if b.humWanted || !b.muted {
+		t.Fatal("close should drop the hum request and silence the bank")
+	}
+}
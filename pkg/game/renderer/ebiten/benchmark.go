@@ -0,0 +1,106 @@
+package ebiten
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"darkstation/pkg/game/state"
+)
+
+// renderBenchmark times the map draw (tiles into mapBuffer, then the blit) for the
+// console benchmark command. The map draw cache is cleared every frame so each sample
+// redraws the whole viewport.
+type renderBenchmark struct {
+	startNano, endNano int64
+	savedTileSize      int
+
+	frames                int
+	minNs, maxNs, totalNs int64
+}
+
+func (b *renderBenchmark) record(d time.Duration) {
+	ns := d.Nanoseconds()
+	if b.frames == 0 || ns < b.minNs {
+		b.minNs = ns
+	}
+	b.maxNs = max(b.maxNs, ns)
+	b.totalNs += ns
+	b.frames++
+}
+
+// summary formats the frame timing for the log and console.
+func (b *renderBenchmark) summary(tileSize, viewportRows, viewportCols int) string {
+	if b.frames == 0 {
+		return fmt.Sprintf("no map frames drawn at %dpx (is a menu open?)", tileSize)
+	}
+	ms := func(ns int64) float64 { return float64(ns) / 1e6 }
+	seconds := float64(b.endNano-b.startNano) / 1e9
+	return fmt.Sprintf("%d frames in %.1fs (%.1f fps) at %dpx, %dx%d tiles: map draw min/avg/max %.2f/%.2f/%.2f ms",
+		b.frames, seconds, float64(b.frames)/seconds, tileSize, viewportCols, viewportRows,
+		ms(b.minNs), ms(b.totalNs/int64(b.frames)), ms(b.maxNs))
+}
+
+// StartRenderBenchmark queues a render benchmark of the given length
+// (renderer.RenderBenchmarkRenderer). It starts on the next Update so the zoom change
+// stays on the Ebiten thread. Returns false if one is already queued or running.
+func (e *EbitenRenderer) StartRenderBenchmark(seconds float64) bool {
+	e.benchMutex.Lock()
+	defer e.benchMutex.Unlock()
+	if seconds <= 0 || e.benchPendingSeconds > 0 || e.bench != nil {
+		return false
+	}
+	e.benchPendingSeconds = seconds
+	return true
+}
+
+// advanceRenderBenchmark starts a queued benchmark at the overview zoom and, once its
+// time is up, logs the results and restores the player's zoom (Update thread).
+func (e *EbitenRenderer) advanceRenderBenchmark() {
+	now := time.Now().UnixNano()
+	e.benchMutex.Lock()
+	pending := e.benchPendingSeconds
+	e.benchPendingSeconds = 0
+	b := e.bench
+	if pending > 0 {
+		e.bench = &renderBenchmark{
+			startNano:     now,
+			endNano:       now + int64(pending*float64(time.Second)),
+			savedTileSize: e.tileSize,
+		}
+	} else if b != nil && now >= b.endNano {
+		e.bench = nil
+	}
+	e.benchMutex.Unlock()
+
+	switch {
+	case pending > 0:
+		// Zoom is not saved to preferences; the player's size comes back afterwards.
+		e.tileSize = minTileSize
+		e.recalculateViewport()
+		log.Printf("[Benchmark] rendering for %.1fs at %dpx", pending, e.tileSize)
+	case b != nil && now >= b.endNano:
+		result := b.summary(e.tileSize, e.viewportRows, e.viewportCols)
+		e.tileSize = b.savedTileSize
+		e.recalculateViewport()
+		log.Printf("[Benchmark] %s", result)
+		e.addConsoleOutput("Benchmark: " + result)
+	}
+}
+
+// drawMapTimed draws the map, timing it while a render benchmark runs.
+func (e *EbitenRenderer) drawMapTimed(screen *ebiten.Image, g *state.Game, screenWidth, screenHeight int, snap *renderSnapshot) {
+	e.benchMutex.Lock()
+	b := e.bench
+	e.benchMutex.Unlock()
+	if b == nil {
+		e.drawMap(screen, g, screenWidth, screenHeight, snap)
+		return
+	}
+	e.invalidateMapDrawCache()
+	start := time.Now()
+	e.drawMap(screen, g, screenWidth, screenHeight, snap)
+	b.record(time.Since(start))
+}
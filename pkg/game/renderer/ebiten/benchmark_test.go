@@ -0,0 +1,36 @@
+package ebiten
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStartRenderBenchmark_queuesOneAtATime(t *testing.T) {
+	e := &EbitenRenderer{}
+	if e.StartRenderBenchmark(0) {
+		t.Fatal("a zero-length benchmark should be refused")
+	}
+	if !e.StartRenderBenchmark(5) {
+		t.Fatal("first benchmark should queue")
+	}
+	if e.StartRenderBenchmark(5) {
+		t.Fatal("second benchmark should be refused while one is queued")
+	}
+}
+
+func TestRenderBenchmarkSummary(t *testing.T) {
+	b := &renderBenchmark{startNano: 0, endNano: int64(2 * time.Second)}
+	if got := b.summary(16, 40, 60); !strings.Contains(got, "no map frames") {
+		t.Fatalf("empty summary = %q", got)
+	}
+	b.record(4 * time.Millisecond)
+	b.record(2 * time.Millisecond)
+	b.record(6 * time.Millisecond)
+	got := b.summary(16, 40, 60)
+	for _, want := range []string{"3 frames", "1.5 fps", "60x40 tiles", "2.00/4.00/6.00 ms"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("summary %q missing %q", got, want)
+		}
+	}
+}
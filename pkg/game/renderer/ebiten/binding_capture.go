@@ -181,6 +181,8 @@ func ebitenKeyToBindingCode(key ebiten.Key) string {
 		return "/"
 	case ebiten.KeyF5:
 		return "f5"
+	case ebiten.KeyF7:
+		return "f7"
 	case ebiten.KeyF8:
 		return "f8"
 	case ebiten.KeyF9:
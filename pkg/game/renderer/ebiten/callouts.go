@@ -9,6 +9,7 @@ import (
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 
+	"darkstation/pkg/game/config"
 	"darkstation/pkg/game/renderer"
 )
 
@@ -77,9 +78,14 @@ func (e *EbitenRenderer) ClearCalloutsIfMoved(row, col int) bool {
 	return false
 }
 
-// ShowRoomEntryIfNew shows a room entry callout if the player entered a new room
-// Skips corridors and returns true if a callout was shown
-func (e *EbitenRenderer) ShowRoomEntryIfNew(row, col int, roomName string) bool {
+// roomEntryCalloutMs is how long the room entry callout stays up.
+const roomEntryCalloutMs = 2000
+
+// ShowRoomEntryIfNew shows a room entry callout when the player walks into a room on
+// this deck for the first time and the room entry callout setting is on. It fires on
+// the change of room, not per cell, and skips corridors and the room the player
+// arrives in. Returns true if a callout was shown
+func (e *EbitenRenderer) ShowRoomEntryIfNew(level, row, col int, roomName string, corridor bool) bool {
 	// Skip if room name hasn't changed
 	if e.lastRoomName == roomName {
 		return false
@@ -89,18 +95,27 @@ func (e *EbitenRenderer) ShowRoomEntryIfNew(row, col int, roomName string) bool
 	oldRoom := e.lastRoomName
 	e.lastRoomName = roomName
 
+	// A new deck starts a fresh set of entered rooms; the arrival room counts as entered
+	arrived := oldRoom == "" || e.enteredRooms == nil || e.enteredRoomsLevel != level
+	if arrived {
+		e.enteredRooms = make(map[string]bool)
+		e.enteredRoomsLevel = level
+	}
+
 	// Skip corridors
-	lowerName := strings.ToLower(roomName)
-	if strings.Contains(lowerName, "corridor") || strings.Contains(lowerName, "hallway") {
+	if corridor {
 		return false
 	}
 
-	// Skip if this is the first room (game just started)
-	if oldRoom == "" {
+	if e.enteredRooms[roomName] {
+		return false
+	}
+	e.enteredRooms[roomName] = true
+	if arrived || !config.Current().RoomEntryCallout {
 		return false
 	}
 
-	// Room entry callout removed - no longer showing room titles on entry
+	e.AddCallout(row, col, renderer.RoomEntryCalloutText(roomName), renderer.CalloutColorRoom, roomEntryCalloutMs)
 	return true
 }
 
@@ -163,6 +178,9 @@ func (e *EbitenRenderer) drawCallouts(screen *ebiten.Image, snap *renderSnapshot
 				continue // Skip expired callouts
 			}
 		}
+		if snap.reduceMotion {
+			slideOffsetY = 0 // Fade only, no slide
+		}
 
 		// Calculate pixel position (center of the cell)
 		cellX := mapX + float64(vCol*e.tileSize)
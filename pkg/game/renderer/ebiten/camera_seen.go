@@ -0,0 +1,20 @@
+package ebiten
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// drawCameraSeenMarker draws a small square in the tile's top-right corner on cells the
+// player has only seen on a CCTV feed: hollow blue while the view still matches the cell,
+// filled orange once something there has changed since.
+func (e *EbitenRenderer) drawCameraSeenMarker(buf *ebiten.Image, x, y int, stale bool) {
+	size := max(float32(e.tileSize)/6, 3)
+	mx := float32(x+e.tileSize) - size - 1
+	my := float32(y) + 1
+	if stale {
+		vector.DrawFilledRect(buf, mx, my, size, size, colorCameraStale, false)
+		return
+	}
+	vector.StrokeRect(buf, mx, my, size, size, 1, colorCameraSeen, false)
+}
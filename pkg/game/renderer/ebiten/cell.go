@@ -3,6 +3,7 @@ package ebiten
 
 import (
 	"image/color"
+	"strconv"
 	"strings"
 
 	"darkstation/pkg/engine/world"
@@ -49,6 +50,19 @@ func cellKnowledgeTier(g *state.Game, cell *world.Cell) cellKnowledge {
 	return knowledgeLayout
 }
 
+// generatorBadgeLabel returns the batteries-needed count to draw on an unpowered
+// generator tile, or "" when badges are off or the tile does not show a generator.
+func generatorBadgeLabel(cell *world.Cell, snap *renderSnapshot, opts *CellRenderOptions) string {
+	if !snap.generatorBadges || opts.Icon != IconGeneratorUnpowered || !gameworld.HasGenerator(cell) {
+		return ""
+	}
+	gen := gameworld.GetGameData(cell).Generator
+	if gen.IsPowered() || gen.Permanent || gen.BatteriesNeeded() == 0 {
+		return ""
+	}
+	return strconv.Itoa(gen.BatteriesNeeded())
+}
+
 // getCellRenderOptions returns rendering options for a cell.
 // When forUnderfoot is true, the cell is treated as if the player were not on it (used to draw floor under the player).
 func (e *EbitenRenderer) getCellRenderOptions(g *state.Game, cell *world.Cell, snap *renderSnapshot, forUnderfoot bool) CellRenderOptions {
@@ -89,6 +103,19 @@ func (e *EbitenRenderer) getCellRenderOptions(g *state.Game, cell *world.Cell, s
 func (e *EbitenRenderer) liveCellRenderOptions(g *state.Game, cell *world.Cell, snap *renderSnapshot) CellRenderOptions {
 	data := gameworld.GetGameData(cell)
 
+	// Patrol bot (drawn over anything it rolls across; dimmed while an EMP has it down)
+	if gameworld.HasPatrol(cell) {
+		if data.Patrol.IsStunned() {
+			return CellRenderOptions{Icon: IconPatrolStunned, Color: colorPatrolStunned, BackgroundColor: colorPatrolStunnedBg, HasBackground: true}
+		}
+		return CellRenderOptions{Icon: IconPatrol, Color: colorPatrol, BackgroundColor: colorPatrolBg, HasBackground: true}
+	}
+
+	// Survivor (plated while waiting to be recruited; plain once following)
+	if gameworld.HasSurvivor(cell) {
+		return CellRenderOptions{Icon: IconSurvivor, Color: colorSurvivor, HasBackground: !data.Survivor.IsFollowing()}
+	}
+
 	// Hazard
 	if gameworld.HasHazard(cell) {
 		if data.Hazard.IsBlocking() {
@@ -125,6 +152,9 @@ func (e *EbitenRenderer) liveCellRenderOptions(g *state.Game, cell *world.Cell,
 				return CellRenderOptions{Icon: IconDoorUnlocked, Color: colorDoorLocked, HasBackground: true}
 			}
 			// Unpowered: use hazard color (matches UNPOWERED{} markup)
+			if colorblindGlyphs {
+				return CellRenderOptions{Icon: IconDoorUnpowered, Color: colorHazard, HasBackground: true}
+			}
 			return CellRenderOptions{Icon: IconDoorUnlocked, Color: colorHazard, HasBackground: true}
 		}
 		if data.Door.Locked {
@@ -187,10 +217,12 @@ func (e *EbitenRenderer) liveCellRenderOptions(g *state.Game, cell *world.Cell,
 		switch setup.ExitLiftState(g) {
 		case state.ExitLiftLockedUnpowered:
 			return CellRenderOptions{Icon: IconExitLocked, Color: colorExitLocked, HasBackground: true}
-		case state.ExitLiftLockedIncomplete:
-			return CellRenderOptions{Icon: IconExitLocked, Color: colorExitPending, HasBackground: true}
+		case state.ExitLiftLockedIncomplete, state.ExitLiftLockedLowPower:
+			// Partly ready: powered but held by objectives or motor watts; a slow amber pulse
+			// keeps it apart from the dead red lift and the green ready one.
+			return CellRenderOptions{Icon: IconExitLocked, Color: e.getPulsingExitPendingColor(snap != nil && snap.reduceMotion), HasBackground: true}
 		default:
-			pulseColor := e.getPulsingExitColor()
+			pulseColor := e.getPulsingExitColor(snap != nil && snap.reduceMotion)
 			return CellRenderOptions{Icon: IconExitUnlocked, Color: pulseColor, HasBackground: true}
 		}
 	}
@@ -223,6 +255,19 @@ func (e *EbitenRenderer) liveCellRenderOptions(g *state.Game, cell *world.Cell,
 		return opts
 	}
 
+	// Hint-traced route to the next objective
+	if snap != nil && snapshotHasCell(snap.objectiveRoute, cell) {
+		return CellRenderOptions{Icon: getFloorIcon(cell.Name, true), Color: colorRoute, HasBackground: true, BackgroundColor: colorRouteBg}
+	}
+
+	// Passable ambient room hazards (flooding, reactor bleed) tint the whole room's floor.
+	if hazard := gameworld.AmbientHazardAt(cell); hazard != nil {
+		if hazard.Type == entities.HazardPowerBleed {
+			return CellRenderOptions{Icon: hazard.GetIcon(), Color: colorAmbientBleed, HasBackground: true, BackgroundColor: colorAmbientBleedBg}
+		}
+		return CellRenderOptions{Icon: hazard.GetIcon(), Color: colorAmbientFlood, HasBackground: true, BackgroundColor: colorAmbientFloodBg}
+	}
+
 	// Visited rooms (when gameplay.visited cvar is enabled)
 	if features.IsVisited(cell) {
 		return CellRenderOptions{Icon: getFloorIcon(cell.Name, true), Color: colorFloorVisited, HasBackground: true, BackgroundColor: colorFloorVisitedBg}
@@ -1,6 +1,7 @@
 package ebiten
 
 import (
+	"image/color"
 	"testing"
 
 	"darkstation/pkg/engine/world"
@@ -46,8 +47,8 @@ func TestGetCellRenderOptions_exitLiftStates(t *testing.T) {
 	gameworld.GetGameData(grid.GetCell(0, 0)).Hazard = entities.NewHazard(entities.HazardVacuum)
 
 	opts = e.getCellRenderOptions(g, exit, snap, false)
-	if opts.Icon != IconExitLocked || opts.Color != colorExitPending {
-		t.Fatalf("grid powered with hazard: icon=%q color=%v, want locked yellow", opts.Icon, opts.Color)
+	if opts.Icon != IconExitLocked || !isExitPendingShade(opts.Color) {
+		t.Fatalf("grid powered with hazard: icon=%q color=%v, want locked pulsing yellow", opts.Icon, opts.Color)
 	}
 
 	gameworld.GetGameData(grid.GetCell(0, 0)).Hazard.Fix()
@@ -87,8 +88,8 @@ func TestGetTileCustomBg_exitLiftPulsingBgOnlyWhenReady(t *testing.T) {
 	opts := e.getCellRenderOptions(g, exit, snap, false)
 
 	gameworld.GetGameData(grid.GetCell(0, 0)).Hazard = entities.NewHazard(entities.HazardVacuum)
-	if bg := e.getTileCustomBg(g, exit, snap, &opts, nil); bg != nil && bg == e.getPulsingExitBackgroundColor() {
-		t.Fatal("incomplete lift should not use pulsing exit background")
+	if bg := e.getTileCustomBg(g, exit, snap, &opts, nil); bg == nil || !isExitPendingBackground(bg) {
+		t.Fatalf("incomplete lift bg = %v, want the amber partly ready background", bg)
 	}
 
 	gameworld.GetGameData(grid.GetCell(0, 0)).Hazard.Fix()
@@ -97,6 +98,18 @@ func TestGetTileCustomBg_exitLiftPulsingBgOnlyWhenReady(t *testing.T) {
 	}
 }
 
+// isExitPendingShade reports a scaled colorExitPending: equal red and green, no blue.
+func isExitPendingShade(c color.Color) bool {
+	r, g, b, _ := c.RGBA()
+	return r > 0 && r == g && b == 0
+}
+
+// isExitPendingBackground reports the amber partly ready lift background: red over green, no blue.
+func isExitPendingBackground(c color.Color) bool {
+	r, g, b, _ := c.RGBA()
+	return r > g && g > 0 && b == 0
+}
+
 func TestGetTileCustomBg_focusedGeneratorIsDarkGreen(t *testing.T) {
 	e := &EbitenRenderer{}
 	g := state.NewGame()
@@ -128,6 +141,29 @@ func TestGetTileCustomBg_focusedGeneratorIsDarkGreen(t *testing.T) {
 	}
 }
 
+func TestGetTileCustomBg_cycleNextGeneratorIsFocused(t *testing.T) {
+	e := &EbitenRenderer{}
+	g := state.NewGame()
+	grid := world.NewGrid(1, 2)
+	grid.MarkAsRoomWithName(0, 0, "Engineering", "")
+	grid.MarkAsRoomWithName(0, 1, "Engineering", "")
+	grid.BuildAllCellConnections()
+	g.Grid = grid
+	g.CurrentCell = grid.GetCell(0, 0)
+	genCell := grid.GetCell(0, 1)
+	genCell.Discovered = true
+	gameworld.InitGameData(g.CurrentCell)
+	genData := gameworld.InitGameData(genCell)
+	genData.LightsOn = true
+	genData.Generator = entities.NewGenerator("G", 1)
+
+	snap := &renderSnapshot{focusedCellRow: -1, focusedCellCol: -1, hasCycleNext: true, cycleNextRow: 0, cycleNextCol: 1}
+	opts := e.getCellRenderOptions(g, genCell, snap, false)
+	if bg := e.getTileCustomBg(g, genCell, snap, &opts, nil); bg != colorGeneratorFocusBg {
+		t.Fatalf("next-in-cycle generator bg = %v, want %v", bg, colorGeneratorFocusBg)
+	}
+}
+
 func TestGetCellRenderOptions_generatorHasDarkGreenBackground(t *testing.T) {
 	e := &EbitenRenderer{}
 	g := state.NewGame()
@@ -0,0 +1,49 @@
+package ebiten
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func TestGeneratorBadgeLabel(t *testing.T) {
+	tests := []struct {
+		name      string
+		badges    bool
+		inserted  int
+		lit       bool
+		wantLabel string
+	}{
+		{"needs all batteries", true, 0, true, "3"},
+		{"partly fed", true, 2, true, "1"},
+		{"powered has no badge", true, 3, true, ""},
+		{"badges disabled", false, 0, true, ""},
+		{"undiscovered has no badge", true, 0, false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &EbitenRenderer{}
+			g := state.NewGame()
+			grid := world.NewGrid(1, 2)
+			grid.MarkAsRoomWithName(0, 0, "Engine Room", "")
+			grid.BuildAllCellConnections()
+			cell := grid.GetCell(0, 0)
+			cell.Discovered = tt.lit
+			gameworld.InitGameData(cell)
+			gameworld.GetGameData(cell).LightsOn = tt.lit
+			gen := entities.NewGenerator("G1", 3)
+			gen.InsertBatteries(tt.inserted)
+			gameworld.GetGameData(cell).Generator = gen
+			g.Grid = grid
+
+			snap := &renderSnapshot{playerRow: -1, playerCol: -1, generatorBadges: tt.badges}
+			opts := e.getCellRenderOptions(g, cell, snap, false)
+			if got := generatorBadgeLabel(cell, snap, &opts); got != tt.wantLabel {
+				t.Errorf("generatorBadgeLabel = %q, want %q", got, tt.wantLabel)
+			}
+		})
+	}
+}
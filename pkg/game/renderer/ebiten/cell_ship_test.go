@@ -33,7 +33,7 @@ func TestOverlayRoomFloorRenderOptions_shipDistinct(t *testing.T) {
 
 func TestShipWallRenderOptions_adjacentToShip(t *testing.T) {
 	wall := &world.Cell{Room: false, Discovered: true}
-	ship := &world.Cell{Name: generator.ShipRoomName, Room: true, East: wall, West: &world.Cell{Room: true, Name: "Corridor"}}
+	ship := &world.Cell{Name: generator.ShipRoomName, Room: true, East: wall, West: &world.Cell{Room: true, Name: "Corridor", IsCorridor: true}}
 	wall.West = ship
 
 	opts, ok := shipWallRenderOptions(wall)
@@ -0,0 +1,81 @@
+package ebiten
+
+import "darkstation/pkg/game/config"
+
+// colorblindPalettes maps each colorblind preset to the color cvars it overrides. Only the
+// state colors that lean on red vs green (or blue vs yellow) change; everything else keeps
+// its default so the station still looks like itself.
+var colorblindPalettes = map[string]map[string]string{
+	// Okabe-Ito style: blue for "good", orange for "bad", yellow for "needs a key".
+	config.ColorblindDeuteranopia: {
+		"colors.generator_off":        "230,120,40,255",
+		"colors.generator_on":         "86,180,233,255",
+		"colors.hazard":               "230,120,40,255",
+		"colors.door_locked":          "240,228,66,255",
+		"colors.door_unlocked":        "86,180,233,255",
+		"colors.exit_locked":          "230,120,40,255",
+		"colors.exit_pending":         "240,228,66,255",
+		"colors.exit_unlocked":        "86,180,233,255",
+		"colors.callout_generator":    "230,120,40,255",
+		"colors.callout_generator_on": "86,180,233,255",
+		"colors.callout_hazard":       "230,120,40,255",
+		"colors.callout_door":         "240,228,66,255",
+	},
+	// Reds read dark without L cones, so "bad" moves up to a bright amber.
+	config.ColorblindProtanopia: {
+		"colors.generator_off":        "255,150,20,255",
+		"colors.generator_on":         "60,170,255,255",
+		"colors.hazard":               "255,150,20,255",
+		"colors.door_locked":          "255,255,140,255",
+		"colors.door_unlocked":        "60,170,255,255",
+		"colors.exit_locked":          "255,150,20,255",
+		"colors.exit_pending":         "255,255,140,255",
+		"colors.exit_unlocked":        "60,170,255,255",
+		"colors.callout_generator":    "255,150,20,255",
+		"colors.callout_generator_on": "60,170,255,255",
+		"colors.callout_hazard":       "255,150,20,255",
+		"colors.callout_door":         "255,255,140,255",
+	},
+	// Blue and yellow collide, so keep to teal vs magenta and move yellows to pink/white.
+	config.ColorblindTritanopia: {
+		"colors.generator_off":        "255,70,120,255",
+		"colors.generator_on":         "0,210,210,255",
+		"colors.hazard":               "255,70,120,255",
+		"colors.door_locked":          "255,150,200,255",
+		"colors.door_unlocked":        "0,210,210,255",
+		"colors.exit_locked":          "255,70,120,255",
+		"colors.exit_pending":         "240,240,240,255",
+		"colors.exit_unlocked":        "0,210,210,255",
+		"colors.callout_generator":    "255,70,120,255",
+		"colors.callout_generator_on": "0,210,210,255",
+		"colors.callout_hazard":       "255,70,120,255",
+		"colors.callout_door":         "255,150,200,255",
+	},
+}
+
+// colorblindGlyphs is true while a colorblind preset is active; cell options then give
+// unpowered doors their own glyph instead of relying on color alone.
+var colorblindGlyphs bool
+
+// applyColorblindPalette resets the color cvars to their defaults, layers the preset for
+// mode on top (unknown modes and "off" keep the defaults), and reloads the color vars.
+// Console color overrides are dropped, as with a fresh start.
+func applyColorblindPalette(mode string) {
+	cvarMutex.Lock()
+	initColorCvarsLocked()
+	for key, value := range colorblindPalettes[mode] {
+		cvarMap[key] = value
+	}
+	cvarMutex.Unlock()
+	loadColorsFromCvars()
+	_, colorblindGlyphs = colorblindPalettes[mode]
+}
+
+// syncColorblindPalette applies the Colorblind Mode setting when it changed since the last tick.
+func (e *EbitenRenderer) syncColorblindPalette() {
+	if mode := config.Current().ColorblindMode; mode != e.colorblindMode {
+		e.colorblindMode = mode
+		applyColorblindPalette(mode)
+		e.invalidateMapDrawCache()
+	}
+}
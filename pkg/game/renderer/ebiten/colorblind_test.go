@@ -0,0 +1,66 @@
+package ebiten
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func TestApplyColorblindPalette_remapsAndRestores(t *testing.T) {
+	initCvars()
+	t.Cleanup(func() { applyColorblindPalette(config.ColorblindOff) })
+	defaultOn, defaultOff := colorGeneratorOn, colorGeneratorOff
+
+	for _, mode := range config.ColorblindModes[1:] {
+		applyColorblindPalette(mode)
+		if colorGeneratorOn == defaultOn || colorHazard == defaultOff {
+			t.Errorf("%s: generator/hazard colors not remapped", mode)
+		}
+		if colorGeneratorOn == colorGeneratorOff || colorDoorLocked == colorDoorUnlocked {
+			t.Errorf("%s: on/off or locked/unlocked share a color", mode)
+		}
+		if !colorblindGlyphs {
+			t.Errorf("%s: door glyphs not enabled", mode)
+		}
+	}
+
+	applyColorblindPalette(config.ColorblindOff)
+	if colorGeneratorOn != defaultOn || colorGeneratorOff != defaultOff {
+		t.Fatalf("off did not restore defaults: on=%v off=%v", colorGeneratorOn, colorGeneratorOff)
+	}
+	if colorblindGlyphs {
+		t.Fatal("off should restore the default door glyphs")
+	}
+}
+
+func TestGetCellRenderOptions_unpoweredDoorGlyphInColorblindMode(t *testing.T) {
+	initCvars()
+	t.Cleanup(func() { applyColorblindPalette(config.ColorblindOff) })
+	e := &EbitenRenderer{}
+	g := state.NewGame()
+	grid := world.NewGrid(1, 2)
+	grid.MarkAsRoomWithName(0, 0, "RoomA", "")
+	grid.MarkAsRoomWithName(0, 1, "Corridor", "")
+	grid.BuildAllCellConnections()
+	g.Grid = grid
+	g.CurrentCell = grid.GetCell(0, 0)
+
+	doorCell := grid.GetCell(0, 1)
+	doorCell.Discovered = true
+	gameworld.InitGameData(doorCell)
+	gameworld.GetGameData(doorCell).LightsOn = true
+	gameworld.GetGameData(doorCell).Door = &entities.Door{RoomName: "RoomB", Locked: false}
+	snap := &renderSnapshot{playerRow: -1, playerCol: -1}
+
+	if opts := e.getCellRenderOptions(g, doorCell, snap, false); opts.Icon != IconDoorUnlocked {
+		t.Fatalf("default unpowered door icon = %q, want %q", opts.Icon, IconDoorUnlocked)
+	}
+	applyColorblindPalette(config.ColorblindDeuteranopia)
+	if opts := e.getCellRenderOptions(g, doorCell, snap, false); opts.Icon != IconDoorUnpowered {
+		t.Fatalf("colorblind unpowered door icon = %q, want %q", opts.Icon, IconDoorUnpowered)
+	}
+}
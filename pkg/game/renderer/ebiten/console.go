@@ -34,9 +34,11 @@ var cvarMutex sync.RWMutex
 func initCvars() {
 	cvarMutex.Lock()
 	cvarMap["debug.maint_pan"] = "0"  // 1 = log maint camera pan tween TRIGGER/COMPLETE + throttled Update samples to stderr
+	cvarMap["debug.panic_draw"] = "0" // 1 = panic in the next Draw (one-shot) to check the fault screen
 	cvarMap["gameplay.visited"] = "0" // 1 = track visited cells (walked-on floor style, room labels, linkage cues)
 	cvarMap["draw.fps"] = "1"         // 1 = show FPS counter in top-right corner
 	cvarMap["draw.player_pos"] = "0"  // 1 = show player X/Y below FPS counter (top-right)
+	cvarMap["draw.coords"] = "0"      // 1 = label each discovered map tile with its row,col
 	cvarMap["draw.env_plaques"] = "0" // 1 = corridor environmental signage (Story 5.1; positioning WIP)
 	cvarMap["version"] = renderer.BuildLabel
 	if renderer.Commit != "unknown" && len(renderer.Commit) > 0 {
@@ -521,6 +523,62 @@ func (e *EbitenRenderer) executeCommandUnlocked(cmd string) {
 			e.addConsoleOutputUnlocked("Input queue full; try again.")
 		}
 
+	case "benchmark":
+		code := ""
+		if len(parts) >= 2 {
+			code = parts[1]
+		}
+		select {
+		case e.inputChan <- engineinput.Intent{Action: engineinput.ActionRenderBenchmark, Code: code}:
+			e.addConsoleOutputUnlocked("Render benchmark requested; results go to the log")
+		default:
+			e.addConsoleOutputUnlocked("Input queue full; try again.")
+		}
+
+	case "screenshot":
+		code := ""
+		if len(parts) >= 2 && strings.EqualFold(parts[1], "deck") {
+			code = "deck"
+		}
+		select {
+		case e.inputChan <- engineinput.Intent{Action: engineinput.ActionScreenshot, Code: code}:
+			e.addConsoleOutputUnlocked("Screenshot requested")
+		default:
+			e.addConsoleOutputUnlocked("Input queue full; try again.")
+		}
+
+	case "exportmap", "export_map":
+		code := ""
+		if len(parts) >= 2 && strings.EqualFold(parts[1], "full") {
+			code = "full"
+		}
+		select {
+		case e.inputChan <- engineinput.Intent{Action: engineinput.ActionExportMap, Code: code}:
+			e.addConsoleOutputUnlocked("Deck map export requested")
+		default:
+			e.addConsoleOutputUnlocked("Input queue full; try again.")
+		}
+
+	case "exportdeck", "export_deck":
+		select {
+		case e.inputChan <- engineinput.Intent{Action: engineinput.ActionExportDeck}:
+			e.addConsoleOutputUnlocked("Deck layout export requested")
+		default:
+			e.addConsoleOutputUnlocked("Input queue full; try again.")
+		}
+
+	case "loaddeck", "load_deck":
+		if len(parts) < 2 {
+			e.addConsoleOutputUnlocked("Usage: loaddeck <file.json>")
+			return
+		}
+		select {
+		case e.inputChan <- engineinput.Intent{Action: engineinput.ActionLoadDeck, Code: strings.Join(parts[1:], " ")}:
+			e.addConsoleOutputUnlocked("Deck load requested")
+		default:
+			e.addConsoleOutputUnlocked("Input queue full; try again.")
+		}
+
 	case "list":
 		// List all cvars in alphabetical order
 		cvarMutex.RLock()
@@ -550,6 +608,11 @@ func (e *EbitenRenderer) executeCommandUnlocked(cmd string) {
 		e.addConsoleOutputUnlocked("  set <cvar> <value>  - Set a configuration variable")
 		e.addConsoleOutputUnlocked("  maint_pan_test      - Load static maint room-picker camera test map")
 		e.addConsoleOutputUnlocked("  perfmap <scenario>  - Load performance test map (use: perfmap list)")
+		e.addConsoleOutputUnlocked("  benchmark [seconds] - Time map drawing on the largest deck, fully revealed")
+		e.addConsoleOutputUnlocked("  exportmap [full]    - Export the deck map to a text file (full ignores fog)")
+		e.addConsoleOutputUnlocked("  exportdeck          - Export the whole deck as JSON plus an ASCII map")
+		e.addConsoleOutputUnlocked("  loaddeck <file>     - Load and play a deck exported with exportdeck")
+		e.addConsoleOutputUnlocked("  screenshot [deck]   - Save an HTML screenshot (deck: every known cell)")
 		e.addConsoleOutputUnlocked("  list                - List all cvars")
 		e.addConsoleOutputUnlocked("  color_update        - Reload colors from cvars")
 		e.addConsoleOutputUnlocked("  clear               - Clear console output")
@@ -604,6 +667,16 @@ func (e *EbitenRenderer) handleBindCommandUnlocked(key, actionName string) {
 		action = engineinput.ActionZoomIn
 	case "zoomout":
 		action = engineinput.ActionZoomOut
+	case "zoomreset":
+		action = engineinput.ActionZoomReset
+	case "exportmap":
+		action = engineinput.ActionExportMap
+	case "exportdeck":
+		action = engineinput.ActionExportDeck
+	case "picklock":
+		action = engineinput.ActionPickLock
+	case "useitem":
+		action = engineinput.ActionUseItem
 	default:
 		e.addConsoleOutputUnlocked(fmt.Sprintf("Unknown action: %s", actionName))
 		return
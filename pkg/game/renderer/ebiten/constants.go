@@ -1,12 +1,17 @@
 // Package ebiten provides an Ebiten-based 2D graphical renderer for The Dark Station.
 package ebiten
 
-import "image/color"
+import (
+	"image/color"
+
+	"darkstation/pkg/game/renderer"
+)
 
 // Color palette for the game - brighter colors for visibility
 var (
 	colorBackground       = color.RGBA{26, 26, 46, 255}    // Dark blue-gray
 	colorMapBackground    = color.RGBA{15, 15, 26, 255}    // Darker for map area
+	colorLetterbox        = color.RGBA{6, 6, 12, 255}      // Bars beside a width- or height-capped map
 	colorPlayer           = color.RGBA{0, 255, 0, 255}     // Bright green
 	colorWall             = color.RGBA{180, 180, 200, 255} // Light gray-blue for wall text
 	colorWallBg           = color.RGBA{60, 60, 80, 255}    // Darker background for walls
@@ -16,6 +21,11 @@ var (
 	colorFloorVisited     = color.RGBA{160, 160, 180, 255} // Lighter gray for visited
 	colorFloorBg          = color.RGBA{38, 38, 58, 255}    // Dark blue-gray background for floor cells
 	colorFloorVisitedBg   = color.RGBA{44, 44, 64, 255}    // Slightly lighter floor background for visited
+	colorRoute            = color.RGBA{130, 220, 255, 255} // Light cyan floor glyph along a hint-traced route
+	colorRouteBg          = color.RGBA{24, 60, 78, 255}    // Dark teal plate for hint-traced route cells
+	colorMapPin           = color.RGBA{255, 200, 60, 255}  // Amber corner marker on player-pinned cells
+	colorCameraSeen       = color.RGBA{100, 150, 255, 255} // CCTV blue corner marker on cells only seen on a camera feed
+	colorCameraStale      = color.RGBA{255, 120, 60, 255}  // Orange — the camera view is out of date
 	colorDoorLocked       = color.RGBA{255, 255, 0, 255}   // Bright yellow
 	colorDoorUnlocked     = color.RGBA{0, 220, 0, 255}     // Bright green
 	colorDoorBg           = color.RGBA{30, 30, 46, 255}    // Door tile plate — darker than walls so doorways read as openings
@@ -37,7 +47,7 @@ var (
 	colorFurniture        = color.RGBA{255, 150, 255, 255} // Bright pink
 	colorFurnitureCheck   = color.RGBA{180, 105, 242, 255} // Violet-purple (checked; natural hue shift from pink)
 	colorExitLocked       = color.RGBA{255, 100, 100, 255} // Bright red — lift locked (generators down)
-	colorExitPending      = color.RGBA{255, 255, 0, 255}   // Bright yellow — lift locked (hazards remain)
+	colorExitPending      = color.RGBA{255, 255, 0, 255}   // Bright yellow — lift partly ready (pulses; objectives or motor watts remain)
 	colorExitUnlocked     = color.RGBA{100, 255, 100, 255} // Bright green — lift ready
 	colorSubtle           = color.RGBA{120, 130, 180, 255} // Soft blue-purple-gray
 	colorUnpoweredSubtle  = color.RGBA{90, 95, 120, 255}   // Muted gray for unpowered due to dependency (room terminal off)
@@ -49,12 +59,23 @@ var (
 	colorPanelBackground  = color.RGBA{30, 30, 50, 220}    // Semi-transparent dark
 	colorFocusBackground  = color.RGBA{60, 80, 100, 200}   // Cvar-backed fallback when focus plate has no opts.Color context
 	colorGeneratorFocusBg = color.RGBA{20, 72, 36, 220}    // Dark green focus plate for generator cells
+	colorGeneratorBadgeBg = color.RGBA{35, 8, 12, 230}     // Dark red plate behind the batteries-needed badge
 	// Fallback when a tile needs a “blocked” plate but no CellRenderOptions are available (should be rare).
 	colorBlockedBackground = color.RGBA{100, 100, 130, 220}
 	colorHazardBackground  = color.RGBA{80, 30, 30, 220}   // Dark red for impassable hazards (e.g. sparks)
 	colorToxicSlime        = color.RGBA{210, 255, 72, 255} // Sickly yellow-green radioactive slime
 	colorToxicSlimeBg      = color.RGBA{58, 92, 18, 245}   // Murky green-yellow floor stain
 	colorToxicSlimePop     = color.RGBA{170, 230, 48, 255} // Bright pop flash while draining
+	colorAmbientFlood      = color.RGBA{80, 150, 230, 255} // Murky blue ripples on flooded floor
+	colorAmbientFloodBg    = color.RGBA{12, 30, 56, 255}   // Dark water plate
+	colorAmbientBleed      = color.RGBA{170, 96, 240, 255} // Violet induction shimmer on reactor-bleed floor
+	colorAmbientBleedBg    = color.RGBA{36, 16, 56, 255}   // Dark violet plate
+	colorSurvivor          = color.RGBA{96, 230, 170, 255} // Mint green — stranded crew to escort
+	colorPatrol            = color.RGBA{255, 110, 40, 255} // Hazard orange — roaming maintenance bot
+	colorPatrolBg          = color.RGBA{70, 24, 8, 240}    // Dark rust plate under the bot
+	colorPatrolCone        = color.RGBA{40, 17, 6, 40}     // Faint orange wash (premultiplied) over the bot's vision cone
+	colorPatrolStunned     = color.RGBA{96, 180, 255, 255} // Static blue — bot knocked out by an EMP
+	colorPatrolStunnedBg   = color.RGBA{10, 26, 60, 240}   // Dark blue plate under the stunned bot
 
 	// Knowledge-tier palette (information economy): dark cells render as memory or floor plan.
 	colorRemembered   = color.RGBA{112, 118, 150, 255} // Glyphs seen lit before, now dark (identity, no state)
@@ -98,6 +119,7 @@ const (
 	// and minimal fonts; geometric ▣/□ often appear as missing-glyph boxes there.
 	IconDoorLocked     = "+" // Locked door
 	IconDoorUnlocked   = "/" // Unlocked door
+	IconDoorUnpowered  = "x" // Unpowered door (colorblind mode; otherwise "/" in hazard color)
 	IconTerminalUnused = "▫" // Unused CCTV terminal
 	IconTerminalUsed   = "▪" // Used CCTV terminal
 	IconMaintenance    = "▤" // Maintenance terminal
@@ -109,41 +131,22 @@ const (
 	IconRepairPump     = "P" // Waste pump repair
 	IconRepairConduit  = "=" // Burned conduit splice repair (grid fault)
 	IconToxicSlime     = "~" // Repair-gated toxic slime
+	IconSurvivor       = "&" // Stranded survivor (waiting or following)
+	IconPatrol         = "B" // Malfunctioning maintenance bot on patrol
+	IconPatrolStunned  = "b" // Maintenance bot knocked out by an EMP Charge
 )
 
-// Floor icons for different room types (visited/unvisited pairs)
-var roomFloorIcons = map[string][2]string{
-	"Bridge":          {"◎", "◉"}, // Command areas
-	"Command Center":  {"◎", "◉"},
-	"Communications":  {"◎", "◉"},
-	"Security":        {"◎", "◉"},
-	"Engineering":     {"▫", "▪"}, // Technical areas
-	"Reactor Core":    {"▫", "▪"},
-	"Server Room":     {"▫", "▪"},
-	"Maintenance Bay": {"▫", "▪"},
-	"Life Support":    {"▫", "▪"},
-	"Cargo Bay":       {"*", ":"}, // Storage areas (ASCII: matches mono fallback coverage)
-	"Storage":         {"*", ":"},
-	"Hangar":          {"*", ":"},
-	"Armory":          {"*", ":"},
-	"Med Bay":         {"◇", "◆"}, // Science/medical areas
-	"Lab":             {"◇", "◆"},
-	"Hydroponics":     {"◇", "◆"},
-	"Observatory":     {"◇", "◆"},
-	"Crew Quarters":   {"○", "●"}, // Living areas (using larger circles for visibility)
-	"Mess Hall":       {"○", "●"},
-	"Airlock":         {"╳", "╳"}, // Special areas
-	"Corridor":        {"░", "░"}, // Corridors
-	"Lift Shaft":      {"▦", "▦"}, // Core lift shaft hub (mesh grating)
-	"Ship": {"⬢", "⬡"}, // Deck 1 player vessel hull
-}
+// Floor icons for different room types (visited/unvisited pairs), built from
+// renderer.RoomFloorFamilies so the in-game floor guide stays in sync.
+var roomFloorIcons = renderer.RoomFloorIcons()
 
 // Tile size constraints
 const (
-	minTileSize  = 12
-	maxTileSize  = 144 // Increased by 3x for higher zoom levels
-	tileSizeStep = 4
-	baseFontSize = 16.0 // Base font size at default tile size
+	minTileSize     = 12
+	maxTileSize     = 144 // Increased by 3x for higher zoom levels
+	tileSizeStep    = 4
+	defaultTileSize = 24
+	baseFontSize    = 16.0 // Base font size at default tile size
 )
 
 const (
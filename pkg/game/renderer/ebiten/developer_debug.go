@@ -85,6 +85,24 @@ func (e *EbitenRenderer) ToggleShowPlayerPosition() bool {
 	return toggleCvarBool("draw.player_pos")
 }
 
+// SetShowCellCoords sets the draw.coords cvar.
+func (e *EbitenRenderer) SetShowCellCoords(on bool) {
+	setCvarBool("draw.coords", on)
+	e.invalidateMapDrawCache()
+}
+
+// ShowCellCoordsEnabled reports whether draw.coords labels map tiles with row,col.
+func (e *EbitenRenderer) ShowCellCoordsEnabled() bool {
+	return cvarEnabled("draw.coords")
+}
+
+// ToggleShowCellCoords flips draw.coords and returns the new state.
+func (e *EbitenRenderer) ToggleShowCellCoords() bool {
+	on := toggleCvarBool("draw.coords")
+	e.invalidateMapDrawCache()
+	return on
+}
+
 func (e *EbitenRenderer) drawMapAreaBorderOutline(screen *ebiten.Image, x, y, w, h int) {
 	if w <= 0 || h <= 0 {
 		return
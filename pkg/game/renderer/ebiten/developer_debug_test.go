@@ -55,6 +55,24 @@ func TestToggleShowPlayerPosition(t *testing.T) {
 	}
 }
 
+func TestToggleShowCellCoords(t *testing.T) {
+	initCvars()
+	e := &EbitenRenderer{}
+	if e.ShowCellCoordsEnabled() {
+		t.Fatal("cell coordinates should start off (draw.coords=0)")
+	}
+	if !e.ToggleShowCellCoords() {
+		t.Fatal("first toggle should enable cell coordinates")
+	}
+	if got := cellCoordsLabel(12, 7); got != "12,7" {
+		t.Fatalf("cellCoordsLabel = %q, want row,col", got)
+	}
+	e.SetShowCellCoords(false)
+	if e.ShowCellCoordsEnabled() {
+		t.Fatal("SetShowCellCoords(false) should disable cell coordinates")
+	}
+}
+
 func TestToggleDrawMapAreaBorder(t *testing.T) {
 	e := &EbitenRenderer{}
 	if e.DrawMapAreaBorderEnabled() {
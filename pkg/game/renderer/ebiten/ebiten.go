@@ -15,6 +15,7 @@ import (
 
 	engineinput "darkstation/pkg/engine/input"
 	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/debuglog"
 	"darkstation/pkg/game/renderer"
 	"darkstation/pkg/resources"
 )
@@ -31,13 +32,14 @@ import (
 // - snapshot.go: frame rendering and snapshot management
 // - font.go: font management
 // - animation.go: animation utilities
+// - audio.go: sound effects
 
 // New creates a new Ebiten renderer
 func New() *EbitenRenderer {
 	return &EbitenRenderer{
 		windowWidth:         1024,
 		windowHeight:        768,
-		tileSize:            24,
+		tileSize:            defaultTileSize,
 		viewportRows:        21,
 		viewportCols:        35,
 		inputChan:           make(chan engineinput.Intent, 10),
@@ -89,46 +91,54 @@ func (e *EbitenRenderer) Init() {
 	ebiten.SetTPS(60)
 
 	// Load saved preferences
-	cfg := config.Current()
-	if cfg.TileSize >= minTileSize && cfg.TileSize <= maxTileSize {
-		e.tileSize = cfg.TileSize
-	}
-
-	// Load the monospace font for map tiles (embedded Cascadia Code NF)
-	monoSrc, err := text.NewGoTextFaceSource(bytes.NewReader(resources.CascadiaCodeNFRegular))
-	if err != nil {
-		// Fall back to embedded Go Mono if Cascadia Code NF fails to load
-		log.Printf("[Font] Monospace: Cascadia Code NF failed to load, using Go Mono (embedded)")
-		monoSrc, err = text.NewGoTextFaceSource(bytes.NewReader(gomono.TTF))
-		if err != nil {
-			panic(fmt.Sprintf("failed to load mono font: %v", err))
-		}
-	} else {
-		log.Printf("[Font] Monospace: Cascadia Code NF (embedded)")
-	}
-	e.monoFontSource = monoSrc
-
-	// Load the sans-serif font for UI text
-	sansSrc, err := text.NewGoTextFaceSource(bytes.NewReader(goregular.TTF))
-	if err != nil {
-		panic(fmt.Sprintf("failed to load sans font: %v", err))
-	}
-	log.Printf("[Font] Sans-serif: Go Regular (embedded)")
-	e.sansFontSource = sansSrc
-
-	// Load the sans-serif bold font for menu titles
-	boldSrc, err := text.NewGoTextFaceSource(bytes.NewReader(gobold.TTF))
-	if err != nil {
-		panic(fmt.Sprintf("failed to load sans bold font: %v", err))
-	}
-	log.Printf("[Font] Sans-serif bold: Go Bold (embedded)")
-	e.sansBoldFontSource = boldSrc
+	e.tileSize = restoredTileSize(config.Current().TileSize)
+	e.tileSizeSetting = config.Current().TileSize
+	e.uiScale = config.Current().UIScale
+	e.sounds.setMuted(config.Current().Muted)
+	e.sounds.setVolume(config.Current().Volume)
+
+	// Monospace for map tiles, sans-serif for UI text, sans bold for menu titles. Each
+	// role falls back to another embedded font; if none loads the role stays nil and the
+	// draw paths that need it are skipped or recovered onto the fault screen.
+	goMono := embeddedFont{"Go Mono", gomono.TTF}
+	goRegular := embeddedFont{"Go Regular", goregular.TTF}
+	e.monoFontSource = loadFontSource("Monospace", embeddedFont{"Cascadia Code NF", resources.CascadiaCodeNFRegular}, goMono, goRegular)
+	e.sansFontSource = loadFontSource("Sans-serif", goRegular, goMono)
+	e.sansBoldFontSource = loadFontSource("Sans-serif bold", embeddedFont{"Go Bold", gobold.TTF}, goRegular)
 
 	// Calculate initial viewport based on window and tile size
 	e.recalculateViewport()
 
 	// Initialize console cvars
 	initCvars()
+	e.syncColorblindPalette()
+}
+
+// embeddedFont is a font file compiled into the binary.
+type embeddedFont struct {
+	name string
+	data []byte
+}
+
+// loadFontSource parses the first candidate that loads, logging the choice and any
+// fallbacks. It returns nil (and logs an error) when none of them load.
+func loadFontSource(role string, candidates ...embeddedFont) *text.GoTextFaceSource {
+	for i, c := range candidates {
+		src, err := text.NewGoTextFaceSource(bytes.NewReader(c.data))
+		if err != nil {
+			log.Printf("[Font] %s: %s failed to load: %v", role, c.name, err)
+			debuglog.Warn("font.failed", "role", role, "font", c.name, "err", err)
+			continue
+		}
+		if i > 0 {
+			log.Printf("[Font] %s: %s (embedded, fallback)", role, c.name)
+		} else {
+			log.Printf("[Font] %s: %s (embedded)", role, c.name)
+		}
+		return src
+	}
+	debuglog.Error("font.unavailable", "role", role)
+	return nil
 }
 
 // Clear clears the display (no-op for Ebiten, clearing happens in Draw)
@@ -195,6 +205,8 @@ func (e *EbitenRenderer) ToggleFullscreen() bool {
 // Run starts the Ebiten game loop
 func (e *EbitenRenderer) Run() error {
 	e.running = true
+	// Release the hum loop once the window closes so no player outlives the game.
+	defer e.sounds.close()
 	return ebiten.RunGame(e)
 }
 
@@ -206,8 +218,8 @@ func (e *EbitenRenderer) IsRunning() bool {
 // RunWithGameLoop starts the Ebiten game loop in a goroutine and returns
 // This allows the main game loop to continue running
 func (e *EbitenRenderer) RunWithGameLoop(gameLoop func()) error {
-	// Start the game loop in a goroutine
-	go gameLoop()
+	// Start the game loop in a goroutine; if it panics the window stays open on the fault screen
+	e.startGameLoop(gameLoop)
 
 	// Run Ebiten (this blocks until the window is closed)
 	return e.Run()
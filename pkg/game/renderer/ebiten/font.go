@@ -3,6 +3,8 @@ package ebiten
 
 import (
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
+
+	"darkstation/pkg/game/config"
 )
 
 // getTileFontSize returns the font size for map tiles, scaled to the current tile size
@@ -11,15 +13,28 @@ func (e *EbitenRenderer) getTileFontSize() float64 {
 	return baseFontSize * float64(e.tileSize) / 24.0
 }
 
-// getUIFontSize returns the font size for UI text (50% of tile size)
+// getUIFontSize returns the font size for UI text: 50% of the tile font (at least 10),
+// times the Text Size setting so UI text can grow or shrink without zooming the map
 func (e *EbitenRenderer) getUIFontSize() float64 {
 	size := e.getTileFontSize() * 0.5
 	if size < 10 {
 		size = 10
 	}
+	if e.uiScale > 0 {
+		size *= e.uiScale
+	}
 	return size
 }
 
+// syncUIScale picks up a changed Text Size setting. The UI faces share one cached size,
+// so the whole font cache is dropped rather than letting one face go stale.
+func (e *EbitenRenderer) syncUIScale() {
+	if scale := config.Current().UIScale; scale != e.uiScale {
+		e.uiScale = scale
+		e.invalidateFontCache()
+	}
+}
+
 // getMonoFontFace returns a cached monospace font face for map tiles
 func (e *EbitenRenderer) getMonoFontFace() *text.GoTextFace {
 	size := e.getTileFontSize()
@@ -0,0 +1,31 @@
+package ebiten
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/config"
+)
+
+func TestGetUIFontSize_scalesIndependentlyOfTiles(t *testing.T) {
+	cfg := config.DefaultConfig()
+	config.SetCurrent(cfg)
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	e := &EbitenRenderer{tileSize: defaultTileSize}
+	e.syncUIScale()
+	base := e.getUIFontSize()
+	tile := e.getTileFontSize()
+
+	cfg.UIScale = 1.5
+	e.cachedSansFace = e.getSansFontFace()
+	e.syncUIScale()
+	if e.cachedSansFace != nil {
+		t.Fatal("changing the text size should drop cached UI faces")
+	}
+	if got := e.getUIFontSize(); got != base*1.5 {
+		t.Errorf("UI font size = %v, want %v", got, base*1.5)
+	}
+	if e.getTileFontSize() != tile {
+		t.Error("text size must not change the map tile font")
+	}
+}
@@ -0,0 +1,125 @@
+package ebiten
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"github.com/leonelquinteros/gotext"
+
+	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/game/state"
+)
+
+// Creative runs are labelled wherever run results are shown so they are not mistaken
+// for normal play.
+const (
+	creativeModeLabel       = "[Creative]"
+	creativeModeNotRecorded = "Creative mode: this run is not recorded"
+)
+
+// gameOverStatLines lists the run totals shown under the cause on the game-over overlay.
+func gameOverStatLines(g *state.Game) []string {
+	stats := g.RunStatsSnapshot
+	lines := []string{
+		fmt.Sprintf(gotext.Get("GAME_OVER_DECK"), g.Level),
+		fmt.Sprintf(gotext.Get("STAT_DECKS_CLEARED"), stats.DecksCompleted),
+		fmt.Sprintf(gotext.Get("STAT_MOVEMENTS"), stats.Movements),
+		fmt.Sprintf(gotext.Get("STAT_INTERACTIONS"), stats.Interactions),
+		state.FormatRunDuration(stats.ElapsedSeconds),
+	}
+	if g.Creative() {
+		lines = append(lines, creativeModeNotRecorded)
+	}
+	return lines
+}
+
+// drawGameOverOverlay draws the failure panel over the frozen map: title, cause,
+// run totals, and the restart / return-to-title instructions.
+func (e *EbitenRenderer) drawGameOverOverlay(screen *ebiten.Image, g *state.Game, w, h int) {
+	if g == nil {
+		return
+	}
+	vector.DrawFilledRect(screen, 0, 0, float32(w), float32(h), color.RGBA{26, 8, 10, 170}, false)
+
+	titleSize := e.getUIFontSize() * 1.5
+	bodySize := e.getUIFontSize()
+	titleFace := e.getSansFontFace()
+	bodyFace := e.getSansFontFace()
+
+	title := gotext.Get("GAME_OVER_TITLE")
+	cause := g.GameOverCause
+	statLines := gameOverStatLines(g)
+	prompt := engineinput.HintGameOverInstructions()
+
+	mainColor := color.RGBA{255, 120, 110, 255}
+	subColor := color.RGBA{220, 200, 200, 255}
+	statColor := color.RGBA{190, 180, 190, 255}
+	promptColor := color.RGBA{160, 150, 160, 255}
+	borderColor := color.RGBA{170, 70, 70, 200}
+	panelBg := color.RGBA{40, 20, 26, 225}
+
+	_, titleH := text.Measure(title, titleFace, 0)
+	_, causeH := text.Measure(cause, titleFace, 0)
+	_, promptH := text.Measure(prompt, bodyFace, 0)
+
+	titleGap := titleSize * 0.35
+	statGap := bodySize * 0.45
+	sectionGap := bodySize * 0.55
+	promptGap := bodySize * 0.65
+
+	statHeights := make([]float64, len(statLines))
+	contentWidth := 0.0
+	for i, line := range statLines {
+		var width float64
+		width, statHeights[i] = text.Measure(line, bodyFace, 0)
+		contentWidth = max(contentWidth, width)
+	}
+	for _, line := range []string{title, cause} {
+		width, _ := text.Measure(line, titleFace, 0)
+		contentWidth = max(contentWidth, width)
+	}
+	promptW, _ := text.Measure(prompt, bodyFace, 0)
+	contentWidth = max(contentWidth, promptW)
+
+	contentHeight := titleH + sectionGap
+	if cause != "" {
+		contentHeight += causeH + titleGap
+	}
+	for i, sh := range statHeights {
+		contentHeight += sh
+		if i < len(statHeights)-1 {
+			contentHeight += statGap
+		}
+	}
+	contentHeight += promptGap + promptH
+
+	const panelPadX = 48.0
+	const panelPadY = 36.0
+	panelW := float32(contentWidth + panelPadX*2)
+	panelH := float32(contentHeight + panelPadY*2)
+	panelX := float32(float64(w)/2 - float64(panelW)/2)
+	panelY := float32(float64(h)/2 - float64(panelH)/2)
+	drawRoundedRectWithShadow(screen, panelX, panelY, panelW, panelH, 14, 2, panelBg, borderColor, 1)
+
+	cx := float64(w) / 2
+	topY := float64(panelY) + panelPadY
+	drawCenteredTextTop(screen, title, titleFace, cx, topY, mainColor)
+	topY += titleH + titleGap
+	if cause != "" {
+		drawCenteredTextTop(screen, cause, titleFace, cx, topY, subColor)
+		topY += causeH
+	}
+	topY += sectionGap
+	for i, line := range statLines {
+		drawCenteredTextTop(screen, line, bodyFace, cx, topY, statColor)
+		topY += statHeights[i]
+		if i < len(statLines)-1 {
+			topY += statGap
+		}
+	}
+	topY += promptGap
+	drawCenteredTextTop(screen, prompt, bodyFace, cx, topY, promptColor)
+}
@@ -2,9 +2,7 @@
 package ebiten
 
 import (
-	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
@@ -12,17 +10,23 @@ import (
 
 	engineinput "darkstation/pkg/engine/input"
 	"darkstation/pkg/game/config"
+	"darkstation/pkg/game/debuglog"
 	"darkstation/pkg/game/state"
 )
 
-// Update handles input and game logic (Ebiten interface)
-func (e *EbitenRenderer) Update() error {
+// update handles input and game logic for one tick (called by Update under fault recovery).
+func (e *EbitenRenderer) update() error {
 	// Single clock for menu overlays this tick (drawGenericMenuOverlay must not call time.Now).
 	now := time.Now()
 	e.menuAnimClockMilli = now.UnixMilli()
 	e.menuAnimTimeNano = now.UnixNano()
 	e.maintPanDrawCount = 0
 	e.advanceTimedGameState(now.UnixMilli())
+	e.syncUIScale()
+	e.syncTileSize()
+	e.syncViewportCaps()
+	e.syncColorblindPalette()
+	e.sounds.setVolume(config.Current().Volume)
 
 	// Log window opening on first update (confirms window is actually running)
 	if !e.windowOpenedLogged {
@@ -66,9 +70,6 @@ func (e *EbitenRenderer) Update() error {
 		e.updateFloatingTiles(w, h)
 	}
 
-	// Handle font size changes (Ctrl+= to increase, Ctrl+- to decrease)
-	e.handleZoom()
-
 	// Track hold state before dispatching interact so the long-use loop sees the key down on the same frame.
 	e.trackInteractHold()
 
@@ -85,7 +86,9 @@ func (e *EbitenRenderer) Update() error {
 	}
 
 	intent := e.pollGameplayIntent()
-	e.enqueueGameplayIntent(intent)
+	if !e.handleZoom(intent) {
+		e.enqueueGameplayIntent(intent)
+	}
 
 	// Camera pan for maintenance menu: once per Update tick, not per Draw (Draw can run
 	// multiple times per frame; time-based interpolation there caused visible jitter).
@@ -97,6 +100,8 @@ func (e *EbitenRenderer) Update() error {
 
 	e.advanceHazardTour()
 
+	e.advanceRenderBenchmark()
+
 	return nil
 }
 
@@ -173,26 +178,26 @@ func (e *EbitenRenderer) advanceHazardTour() {
 	e.hazardTourAdvancer(g, e.menuAnimClockMilli)
 }
 
-// handleZoom handles =/- for font/tile size adjustment
-func (e *EbitenRenderer) handleZoom() {
-	// = or + to increase font size
-	if inpututil.IsKeyJustPressed(ebiten.KeyEqual) || inpututil.IsKeyJustPressed(ebiten.KeyNumpadAdd) {
+// handleZoom applies zoom intents to the font/tile size. Zoom is handled here rather than
+// queued so it works the same in gameplay and menus. Returns false for any other intent.
+func (e *EbitenRenderer) handleZoom(intent engineinput.Intent) bool {
+	switch intent.Action {
+	case engineinput.ActionZoomIn:
 		e.increaseTileSize()
-	}
-	// - to decrease font size
-	if inpututil.IsKeyJustPressed(ebiten.KeyMinus) || inpututil.IsKeyJustPressed(ebiten.KeyNumpadSubtract) {
+	case engineinput.ActionZoomOut:
 		e.decreaseTileSize()
-	}
-	// 0 to reset font size
-	if inpututil.IsKeyJustPressed(ebiten.Key0) || inpututil.IsKeyJustPressed(ebiten.KeyNumpad0) {
+	case engineinput.ActionZoomReset:
 		e.resetTileSize()
+	default:
+		return false
 	}
+	return true
 }
 
 // increaseTileSize increases the tile/font size
 func (e *EbitenRenderer) increaseTileSize() {
 	if e.tileSize < maxTileSize {
-		e.tileSize += tileSizeStep
+		e.tileSize = min(e.tileSize+tileSizeStep, maxTileSize)
 		e.recalculateViewport()
 		e.saveZoomPreference()
 	}
@@ -201,7 +206,7 @@ func (e *EbitenRenderer) increaseTileSize() {
 // decreaseTileSize decreases the tile/font size
 func (e *EbitenRenderer) decreaseTileSize() {
 	if e.tileSize > minTileSize {
-		e.tileSize -= tileSizeStep
+		e.tileSize = max(e.tileSize-tileSizeStep, minTileSize)
 		e.recalculateViewport()
 		e.saveZoomPreference()
 	}
@@ -209,7 +214,7 @@ func (e *EbitenRenderer) decreaseTileSize() {
 
 // resetTileSize resets tile size to default
 func (e *EbitenRenderer) resetTileSize() {
-	e.tileSize = 24
+	e.tileSize = defaultTileSize
 	e.recalculateViewport()
 	e.saveZoomPreference()
 }
@@ -217,12 +222,39 @@ func (e *EbitenRenderer) resetTileSize() {
 // saveZoomPreference saves the current tile size to preferences
 func (e *EbitenRenderer) saveZoomPreference() {
 	cfg := config.Current()
+	e.tileSizeSetting = e.tileSize
 	if err := cfg.SetTileSize(e.tileSize); err != nil {
 		// Silently ignore save errors - not critical
-		fmt.Fprintf(os.Stderr, "Warning: could not save preferences: %v\n", err)
+		debuglog.Warnf("could not save preferences: %v", err)
+	}
+}
+
+// syncTileSize picks up a Map Zoom setting changed outside the zoom keys (the settings
+// menu, Reset to Defaults) and rebuilds the viewport for it.
+func (e *EbitenRenderer) syncTileSize() {
+	if saved := config.Current().TileSize; saved != e.tileSizeSetting {
+		e.tileSizeSetting = saved
+		if size := restoredTileSize(saved); size != e.tileSize {
+			e.tileSize = size
+			e.recalculateViewport()
+		}
 	}
 }
 
+// restoredTileSize validates a saved tile size. Out-of-range values (a corrupt or
+// hand-edited config) fall back to the default; others snap to the zoom step so
+// zooming in and out returns to the same sizes.
+func restoredTileSize(saved int) int {
+	if saved < minTileSize || saved > maxTileSize {
+		if saved != 0 {
+			log.Printf("[Zoom] ignoring saved tile size %d (want %d-%d), using %d", saved, minTileSize, maxTileSize, defaultTileSize)
+		}
+		return defaultTileSize
+	}
+	steps := (saved - minTileSize + tileSizeStep/2) / tileSizeStep
+	return min(minTileSize+steps*tileSizeStep, maxTileSize)
+}
+
 // recalculateViewport recalculates viewport dimensions based on current window and tile size
 func (e *EbitenRenderer) recalculateViewport() {
 	// Invalidate font cache since sizes may have changed
@@ -254,14 +286,16 @@ func viewportTilesForAxis(screenPx, tileSize int) int {
 	return n
 }
 
-// syncViewportForMap sets e.viewportCols/Rows from the full window size and tile size.
-// Odd dimensions keep the player on a center tile; Layout() calls this via recalculateViewport.
+// syncViewportForMap sets e.viewportCols/Rows from the map area (the window, or its
+// letterboxed part; see mapArea) and tile size. Odd dimensions keep the player on a center
+// tile; Layout() calls this via recalculateViewport.
 func (e *EbitenRenderer) syncViewportForMap(screenWidth, screenHeight int) {
 	if e.tileSize <= 0 {
 		return
 	}
-	e.viewportCols = viewportTilesForAxis(screenWidth, e.tileSize)
-	e.viewportRows = viewportTilesForAxis(screenHeight, e.tileSize)
+	area := e.mapArea(screenWidth, screenHeight)
+	e.viewportCols = viewportTilesForAxis(area.Dx(), e.tileSize)
+	e.viewportRows = viewportTilesForAxis(area.Dy(), e.tileSize)
 }
 
 // mapTileGridOrigin returns the top-left pixel where the tile grid is blitted so the player
@@ -339,7 +373,10 @@ func (e *EbitenRenderer) pollGameplayIntent() engineinput.Intent {
 	if intent := e.checkInput(); intent.Action != engineinput.ActionNone {
 		return intent
 	}
-	return e.checkGamepadInput()
+	if intent := e.checkGamepadInput(); intent.Action != engineinput.ActionNone {
+		return intent
+	}
+	return e.checkMouseInput()
 }
 
 // checkGamepadInput checks for controller/gamepad input and returns the corresponding Intent.
@@ -450,6 +487,14 @@ func (e *EbitenRenderer) checkInput() engineinput.Intent {
 		return engineinput.Intent{Action: engineinput.ActionInteract}
 	}
 
+	// Keypad diagonals take keypad 1 and 3 from the maintenance shortcuts while on
+	diagonal := config.Current().DiagonalMovement
+	if diagonal {
+		if intent := e.checkDiagonalInput(); intent.Action != engineinput.ActionNone {
+			return intent
+		}
+	}
+
 	// Maintenance menu shortcuts (consumed only while maintenance menu is open)
 	if inpututil.IsKeyJustPressed(ebiten.KeyTab) {
 		return engineinput.MapToIntent(engineinput.NewDebouncedInput(engineinput.RawInput{
@@ -457,7 +502,7 @@ func (e *EbitenRenderer) checkInput() engineinput.Intent {
 			Code:   "tab",
 		}))
 	}
-	if inpututil.IsKeyJustPressed(ebiten.Key1) || inpututil.IsKeyJustPressed(ebiten.KeyDigit1) || inpututil.IsKeyJustPressed(ebiten.KeyNumpad1) {
+	if inpututil.IsKeyJustPressed(ebiten.Key1) || inpututil.IsKeyJustPressed(ebiten.KeyDigit1) || (!diagonal && inpututil.IsKeyJustPressed(ebiten.KeyNumpad1)) {
 		return engineinput.MapToIntent(engineinput.NewDebouncedInput(engineinput.RawInput{
 			Device: engineinput.DeviceKeyboard,
 			Code:   "1",
@@ -469,7 +514,7 @@ func (e *EbitenRenderer) checkInput() engineinput.Intent {
 			Code:   "2",
 		}))
 	}
-	if inpututil.IsKeyJustPressed(ebiten.Key3) || inpututil.IsKeyJustPressed(ebiten.KeyDigit3) || inpututil.IsKeyJustPressed(ebiten.KeyNumpad3) {
+	if inpututil.IsKeyJustPressed(ebiten.Key3) || inpututil.IsKeyJustPressed(ebiten.KeyDigit3) || (!diagonal && inpututil.IsKeyJustPressed(ebiten.KeyNumpad3)) {
 		return engineinput.MapToIntent(engineinput.NewDebouncedInput(engineinput.RawInput{
 			Device: engineinput.DeviceKeyboard,
 			Code:   "3",
@@ -584,6 +629,69 @@ func (e *EbitenRenderer) checkInput() engineinput.Intent {
 		}))
 	}
 
+	if inpututil.IsKeyJustPressed(ebiten.KeyX) {
+		return engineinput.MapToIntent(engineinput.NewDebouncedInput(engineinput.RawInput{
+			Device: engineinput.DeviceKeyboard,
+			Code:   "x",
+		}))
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyP) {
+		return engineinput.MapToIntent(engineinput.NewDebouncedInput(engineinput.RawInput{
+			Device: engineinput.DeviceKeyboard,
+			Code:   "p",
+		}))
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyC) {
+		return engineinput.MapToIntent(engineinput.NewDebouncedInput(engineinput.RawInput{
+			Device: engineinput.DeviceKeyboard,
+			Code:   "c",
+		}))
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyG) {
+		return engineinput.MapToIntent(engineinput.NewDebouncedInput(engineinput.RawInput{
+			Device: engineinput.DeviceKeyboard,
+			Code:   "g",
+		}))
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyR) {
+		return engineinput.MapToIntent(engineinput.NewDebouncedInput(engineinput.RawInput{
+			Device: engineinput.DeviceKeyboard,
+			Code:   "r",
+		}))
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyM) {
+		return engineinput.MapToIntent(engineinput.NewDebouncedInput(engineinput.RawInput{
+			Device: engineinput.DeviceKeyboard,
+			Code:   "m",
+		}))
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyV) {
+		return engineinput.MapToIntent(engineinput.NewDebouncedInput(engineinput.RawInput{
+			Device: engineinput.DeviceKeyboard,
+			Code:   "v",
+		}))
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyB) {
+		return engineinput.MapToIntent(engineinput.NewDebouncedInput(engineinput.RawInput{
+			Device: engineinput.DeviceKeyboard,
+			Code:   "b",
+		}))
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyU) {
+		return engineinput.MapToIntent(engineinput.NewDebouncedInput(engineinput.RawInput{
+			Device: engineinput.DeviceKeyboard,
+			Code:   "u",
+		}))
+	}
+
 	// Open menu (F10)
 	if inpututil.IsKeyJustPressed(ebiten.KeyF10) {
 		return engineinput.MapToIntent(engineinput.NewDebouncedInput(engineinput.RawInput{
@@ -592,6 +700,14 @@ func (e *EbitenRenderer) checkInput() engineinput.Intent {
 		}))
 	}
 
+	// Export deck map (F7)
+	if inpututil.IsKeyJustPressed(ebiten.KeyF7) {
+		return engineinput.MapToIntent(engineinput.NewDebouncedInput(engineinput.RawInput{
+			Device: engineinput.DeviceKeyboard,
+			Code:   "f7",
+		}))
+	}
+
 	// Debug map dump (F8)
 	if inpututil.IsKeyJustPressed(ebiten.KeyF8) {
 		return engineinput.Intent{Action: engineinput.ActionDebugMapDump}
@@ -610,7 +726,7 @@ func (e *EbitenRenderer) checkInput() engineinput.Intent {
 		}))
 	}
 
-	// Quit (Escape; confirmation required during gameplay)
+	// Quit (Escape; opens the pause menu during gameplay)
 	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
 		return engineinput.MapToIntent(engineinput.NewDebouncedInput(engineinput.RawInput{
 			Device: engineinput.DeviceKeyboard,
@@ -618,6 +734,52 @@ func (e *EbitenRenderer) checkInput() engineinput.Intent {
 		}))
 	}
 
+	return checkZoomInput()
+}
+
+// checkDiagonalInput polls the keypad diagonals (7/9/1/3) with key repeat.
+func (e *EbitenRenderer) checkDiagonalInput() engineinput.Intent {
+	for _, spec := range []struct {
+		key  ebiten.Key
+		code string
+	}{
+		{ebiten.KeyNumpad7, "numpad7"},
+		{ebiten.KeyNumpad9, "numpad9"},
+		{ebiten.KeyNumpad1, "numpad1"},
+		{ebiten.KeyNumpad3, "numpad3"},
+	} {
+		key := spec.key
+		if e.shouldRepeatKey(func() bool { return ebiten.IsKeyPressed(key) }, "key_"+spec.code) {
+			return engineinput.MapToIntent(engineinput.NewDebouncedInput(engineinput.RawInput{
+				Device: engineinput.DeviceKeyboard,
+				Code:   spec.code,
+			}))
+		}
+	}
+	return engineinput.Intent{Action: engineinput.ActionNone}
+}
+
+// checkZoomInput maps any other just-pressed key through the bindings and returns it only
+// when it is bound to a zoom action (or, with diagonal movement on, a diagonal move), so
+// those follow whatever keys the player chose.
+func checkZoomInput() engineinput.Intent {
+	for key := ebiten.Key(0); key <= ebiten.KeyMax; key++ {
+		if !inpututil.IsKeyJustPressed(key) {
+			continue
+		}
+		intent := engineinput.MapToIntent(engineinput.NewDebouncedInput(engineinput.RawInput{
+			Device: engineinput.DeviceKeyboard,
+			Code:   ebitenKeyToBindingCode(key),
+		}))
+		switch intent.Action {
+		case engineinput.ActionZoomIn, engineinput.ActionZoomOut, engineinput.ActionZoomReset:
+			return intent
+		case engineinput.ActionMoveNE, engineinput.ActionMoveNW, engineinput.ActionMoveSE, engineinput.ActionMoveSW:
+			if config.Current().DiagonalMovement {
+				return intent
+			}
+		}
+	}
 	return engineinput.Intent{Action: engineinput.ActionNone}
 }
 
@@ -45,6 +45,7 @@ func hasKeyboardActivityNew() bool {
 		inpututil.IsKeyJustPressed(ebiten.KeyEscape) ||
 		inpututil.IsKeyJustPressed(ebiten.KeyQ) ||
 		inpututil.IsKeyJustPressed(ebiten.KeyF5) ||
+		inpututil.IsKeyJustPressed(ebiten.KeyF7) ||
 		inpututil.IsKeyJustPressed(ebiten.KeyF8) ||
 		inpututil.IsKeyJustPressed(ebiten.KeyF9) ||
 		inpututil.IsKeyJustPressed(ebiten.KeyF10) {
@@ -32,6 +32,8 @@ func inventoryDepictionColors(key string) (fg color.Color, bg color.Color, hasBg
 		return colorBattery, colorWallBg, true
 	case renderer.InventoryDepictionKeyMap:
 		return colorItem, colorWallBg, true
+	case renderer.InventoryDepictionKeyFloor:
+		return colorFloorVisited, colorFloorBg, true
 	default:
 		return colorItem, colorWallBg, true
 	}
@@ -73,10 +75,10 @@ func (e *EbitenRenderer) drawInventoryMenuRow(screen *ebiten.Image, x, y int, li
 	return inventoryMenuRowHeight(int(fontSize))
 }
 
-// inventorySectionHeaderExtraGap adds space after inventory section headers so the
-// first depiction row does not overlap the title line.
+// inventorySectionHeaderExtraGap adds space after inventory (and floor guide) section
+// headers so the first depiction row does not overlap the title line.
 func inventorySectionHeaderExtraGap(menuTitle string, labels []string, index int, lineHeight int) int {
-	if menuTitle != "Inventory" || index < 0 || index >= len(labels) {
+	if (menuTitle != "Inventory" && menuTitle != "Floor Guide") || index < 0 || index >= len(labels) {
 		return 0
 	}
 	if !strings.HasPrefix(labels[index], "TITLE{") {
@@ -0,0 +1,69 @@
+package ebiten
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"darkstation/pkg/game/config"
+)
+
+// letterboxMapArea returns the window rectangle the map fills. Without caps that is the
+// whole window; a maxCols or maxRows cap smaller than the window shrinks that axis to a
+// whole, odd number of tiles (so the player's tile stays in the middle), centered with
+// bars either side.
+func letterboxMapArea(screenWidth, screenHeight, tileSize, maxCols, maxRows int) image.Rectangle {
+	w := letterboxSpan(screenWidth, tileSize, maxCols)
+	h := letterboxSpan(screenHeight, tileSize, maxRows)
+	x, y := (screenWidth-w)/2, (screenHeight-h)/2
+	return image.Rect(x, y, x+w, y+h)
+}
+
+// letterboxSpan returns how many pixels of screenPx the map covers under a maxTiles cap
+// (0 = no cap). Even caps round down to the odd count below.
+func letterboxSpan(screenPx, tileSize, maxTiles int) int {
+	if maxTiles <= 0 || tileSize <= 0 {
+		return screenPx
+	}
+	if maxTiles%2 == 0 {
+		maxTiles--
+	}
+	return min(screenPx, max(maxTiles, 1)*tileSize)
+}
+
+// mapArea returns the letterboxed map rectangle for the current tile size and the
+// Max Viewport settings.
+func (e *EbitenRenderer) mapArea(screenWidth, screenHeight int) image.Rectangle {
+	cfg := config.Current()
+	return letterboxMapArea(screenWidth, screenHeight, e.tileSize, cfg.MaxViewportCols, cfg.MaxViewportRows)
+}
+
+// syncViewportCaps picks up changed Max Viewport settings and resizes the viewport for them.
+func (e *EbitenRenderer) syncViewportCaps() {
+	cfg := config.Current()
+	if cfg.MaxViewportCols != e.maxViewportCols || cfg.MaxViewportRows != e.maxViewportRows {
+		e.maxViewportCols, e.maxViewportRows = cfg.MaxViewportCols, cfg.MaxViewportRows
+		e.recalculateViewport()
+	}
+}
+
+// drawLetterbox fills the window outside the map area, hiding the viewport's margin tiles.
+// The header and status panels are drawn afterwards and stay anchored to the window.
+func (e *EbitenRenderer) drawLetterbox(screen *ebiten.Image, screenWidth, screenHeight int, area image.Rectangle) {
+	sw, sh := float32(screenWidth), float32(screenHeight)
+	x0, y0 := float32(area.Min.X), float32(area.Min.Y)
+	x1, y1 := float32(area.Max.X), float32(area.Max.Y)
+	if y0 > 0 {
+		vector.DrawFilledRect(screen, 0, 0, sw, y0, colorLetterbox, false)
+	}
+	if y1 < sh {
+		vector.DrawFilledRect(screen, 0, y1, sw, sh-y1, colorLetterbox, false)
+	}
+	if x0 > 0 {
+		vector.DrawFilledRect(screen, 0, y0, x0, y1-y0, colorLetterbox, false)
+	}
+	if x1 < sw {
+		vector.DrawFilledRect(screen, x1, y0, sw-x1, y1-y0, colorLetterbox, false)
+	}
+}
@@ -0,0 +1,38 @@
+package ebiten
+
+import (
+	"image"
+	"testing"
+)
+
+func TestLetterboxMapArea(t *testing.T) {
+	tests := []struct {
+		name             string
+		maxCols, maxRows int
+		want             image.Rectangle
+	}{
+		{"no caps fill the window", 0, 0, image.Rect(0, 0, 3440, 1440)},
+		{"odd column cap is centered", 41, 0, image.Rect(1228, 0, 2212, 1440)},
+		{"even cap rounds down to odd", 42, 0, image.Rect(1228, 0, 2212, 1440)},
+		{"cap wider than the window fills it", 201, 0, image.Rect(0, 0, 3440, 1440)},
+		{"row cap letterboxes top and bottom", 0, 21, image.Rect(0, 468, 3440, 972)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := letterboxMapArea(3440, 1440, 24, tt.maxCols, tt.maxRows); got != tt.want {
+				t.Errorf("letterboxMapArea = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLetterboxMapArea_viewportKeepsOddCenteredColumns(t *testing.T) {
+	area := letterboxMapArea(3440, 1440, 24, 41, 0)
+	cols := viewportTilesForAxis(area.Dx(), 24)
+	if cols%2 == 0 || cols < 41 {
+		t.Fatalf("viewport cols = %d for a 41-tile area, want an odd count covering it", cols)
+	}
+	if center := (area.Min.X + area.Max.X) / 2; center != 3440/2 {
+		t.Fatalf("area center = %d, want the window center %d", center, 3440/2)
+	}
+}
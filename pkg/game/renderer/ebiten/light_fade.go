@@ -0,0 +1,74 @@
+// Light fades: when a cell's light goes out (or comes back), its plate and glyph
+// blend from the old look to the new one instead of snapping between knowledge
+// tiers. Dark cells the player has seen lit keep their dim "remembered" look
+// (GameCellData.Lighted), so the mental map survives a blackout; this only smooths
+// the change. Presentation-only, like ambient_fx.go.
+package ebiten
+
+import (
+	"image/color"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/state"
+)
+
+const (
+	// lightFadeMs is how long a cell takes to blend into its new lighting.
+	lightFadeMs = 700
+	// lightFadeStaleMs: a tile not drawn for this long (scrolled out of view) starts
+	// fresh instead of fading from an old look.
+	lightFadeStaleMs = 250
+)
+
+// tileLook is the last drawn appearance of a tile plus any fade in progress.
+type tileLook struct {
+	tier    cellKnowledge
+	bg, fg  color.Color // colors actually drawn (mid-fade when fading)
+	drawnMs int64
+
+	fromBg, fromFg color.Color
+	fadeStartMs    int64
+	fading         bool
+}
+
+// lightFadeColors returns the colors to draw for cell this frame. When the cell's
+// knowledge tier changed since it was last drawn, bg/fg fade in from what was on
+// screen over lightFadeMs.
+func (e *EbitenRenderer) lightFadeColors(g *state.Game, cell *world.Cell, bg, fg color.Color, nowMs int64) (color.Color, color.Color) {
+	if g == nil || cell == nil || !cell.Room {
+		return bg, fg
+	}
+	if e.tileLooksFor != g.Grid || e.tileLooks == nil {
+		e.tileLooks = make(map[uint64]tileLook)
+		e.tileLooksFor = g.Grid
+	}
+	key := cellCoordKey(cell.Row, cell.Col)
+	tier := cellKnowledgeTier(g, cell)
+	look := tileLook{tier: tier, drawnMs: nowMs}
+
+	if prev, ok := e.tileLooks[key]; ok && nowMs-prev.drawnMs <= lightFadeStaleMs {
+		switch {
+		case prev.tier != tier && prev.tier != knowledgeUnknown && tier != knowledgeUnknown:
+			look.fading = true
+			look.fromBg, look.fromFg = prev.bg, prev.fg
+			look.fadeStartMs = nowMs
+		case prev.fading && nowMs-prev.fadeStartMs < lightFadeMs:
+			look.fading = true
+			look.fromBg, look.fromFg = prev.fromBg, prev.fromFg
+			look.fadeStartMs = prev.fadeStartMs
+		}
+	}
+
+	if look.fading {
+		t := smootherstep(float64(nowMs-look.fadeStartMs) / lightFadeMs)
+		if look.fromBg != nil && bg != nil {
+			bg = blendColors(look.fromBg, bg, t)
+		}
+		if look.fromFg != nil && fg != nil {
+			fg = blendColors(look.fromFg, fg, t)
+		}
+	}
+	look.bg, look.fg = bg, fg
+	e.tileLooks[key] = look
+	return bg, fg
+}
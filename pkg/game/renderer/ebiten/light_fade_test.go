@@ -0,0 +1,51 @@
+package ebiten
+
+import (
+	"image/color"
+	"testing"
+
+	gameworld "darkstation/pkg/game/world"
+)
+
+func TestLightFadeColors_blendsWhenLightGoesOut(t *testing.T) {
+	e, g, cell, _ := knowledgeFixture(t)
+	cell.Discovered = true
+	data := gameworld.GetGameData(cell)
+	data.LightsOn, data.Lighted = true, true
+
+	liveBg, liveFg := color.RGBA{200, 200, 200, 255}, color.RGBA{255, 255, 255, 255}
+	darkBg, darkFg := color.RGBA{20, 20, 20, 255}, color.RGBA{60, 60, 60, 255}
+
+	e.lightFadeColors(g, cell, liveBg, liveFg, 1000)
+	data.LightsOn = false
+
+	bg, fg := e.lightFadeColors(g, cell, darkBg, darkFg, 1000)
+	if bg != color.Color(liveBg) || fg != color.Color(liveFg) {
+		t.Fatalf("fade start = %v/%v, want the lit look", bg, fg)
+	}
+	// The map redraws every few tens of ms; step through the fade the same way.
+	for ms := int64(100); ms < lightFadeMs; ms += 100 {
+		bg, _ = e.lightFadeColors(g, cell, darkBg, darkFg, 1000+ms)
+		if r, _, _, _ := bg.RGBA(); r>>8 <= 20 || r>>8 >= 200 {
+			t.Fatalf("bg red at %dms = %d, want between dark and lit", ms, r>>8)
+		}
+	}
+	bg, fg = e.lightFadeColors(g, cell, darkBg, darkFg, 1000+lightFadeMs)
+	if bg != color.Color(darkBg) || fg != color.Color(darkFg) {
+		t.Errorf("fade end = %v/%v, want the remembered look", bg, fg)
+	}
+}
+
+func TestLightFadeColors_staleTileSnaps(t *testing.T) {
+	e, g, cell, _ := knowledgeFixture(t)
+	cell.Discovered = true
+	data := gameworld.GetGameData(cell)
+	data.LightsOn, data.Lighted = true, true
+	e.lightFadeColors(g, cell, colorFloorBg, colorFloor, 1000)
+
+	data.LightsOn = false
+	bg, _ := e.lightFadeColors(g, cell, colorRememberedBg, colorRemembered, 1000+lightFadeStaleMs+1)
+	if bg != colorRememberedBg {
+		t.Errorf("tile scrolled back into view should not fade: bg = %v", bg)
+	}
+}
@@ -0,0 +1,26 @@
+package ebiten
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"darkstation/pkg/game/state"
+)
+
+// mapPinKeyMap copies the player's pinned cells into a cell-key set for Draw.
+func mapPinKeyMap(pins map[state.CellCoord]string) map[uint64]bool {
+	if len(pins) == 0 {
+		return nil
+	}
+	m := make(map[uint64]bool, len(pins))
+	for at := range pins {
+		m[cellCoordKey(at.Row, at.Col)] = true
+	}
+	return m
+}
+
+// drawMapPinMarker draws a small amber dot in the tile's top-left corner.
+func (e *EbitenRenderer) drawMapPinMarker(buf *ebiten.Image, x, y int) {
+	r := max(float32(e.tileSize)/10, 2)
+	vector.DrawFilledCircle(buf, float32(x)+r+1, float32(y)+r+1, r, colorMapPin, false)
+}
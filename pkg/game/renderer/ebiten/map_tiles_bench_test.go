@@ -0,0 +1,63 @@
+package ebiten
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/deck"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/generator"
+	"darkstation/pkg/game/levelrand"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// BenchmarkMapTilesFullViewport times the per-tile work of a map buffer redraw
+// (render options and colors, not GPU draws) for a 1920x1080 viewport at the
+// smallest zoom, over an explored, partly lit late deck.
+func BenchmarkMapTilesFullViewport(b *testing.B) {
+	grid := generator.DefaultGenerator.Generate(9, deck.ThemeReactorControl, levelrand.New(7))
+	g := state.NewGame()
+	g.Grid = grid
+	n := 0
+	grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if !cell.Room {
+			return
+		}
+		n++
+		cell.Discovered = true
+		data := gameworld.GetGameData(cell)
+		data.Lighted = true
+		data.LightsOn = n%3 != 0
+		switch n % 97 {
+		case 11:
+			data.Generator = entities.NewGenerator("G", 2)
+		case 43:
+			data.Furniture = &entities.Furniture{Name: "Locker"}
+		}
+		if cell.IsCorridor && n%41 == 0 {
+			data.Door = entities.NewDoor(cell.Name)
+		}
+	})
+	g.CurrentCell = grid.StartCell()
+
+	e := &EbitenRenderer{tileSize: minTileSize}
+	e.viewportCols = viewportTilesForAxis(1920, e.tileSize)
+	e.viewportRows = viewportTilesForAxis(1080, e.tileSize)
+	snap := &renderSnapshot{playerRow: -1, playerCol: -1}
+	pg := &snap.powerGrid
+	startRow := (grid.Rows() - e.viewportRows) / 2
+	startCol := (grid.Cols() - e.viewportCols) / 2
+	nowMs := int64(1_000_000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for vRow := 0; vRow < e.viewportRows; vRow++ {
+			for vCol := 0; vCol < e.viewportCols; vCol++ {
+				cell := grid.GetCell(startRow+vRow, startCol+vCol)
+				opts := e.getCellRenderOptions(g, cell, snap, false)
+				e.tileColors(g, cell, snap, &opts, pg, nowMs)
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package ebiten
+
+import (
+	"image"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+
+	engineinput "darkstation/pkg/engine/input"
+)
+
+// checkMouseInput turns a left click on the map into an interact-at intent for the clicked
+// cell (gameplay walks there when nothing in reach can be used). Clicks are ignored while a
+// menu overlay is open or before the map is drawn.
+func (e *EbitenRenderer) checkMouseInput() engineinput.Intent {
+	if !inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		return engineinput.Intent{Action: engineinput.ActionNone}
+	}
+	e.genericMenuMutex.RLock()
+	menuActive := e.genericMenuActive
+	e.genericMenuMutex.RUnlock()
+	if menuActive {
+		return engineinput.Intent{Action: engineinput.ActionNone}
+	}
+	x, y := ebiten.CursorPosition()
+	row, col, ok := e.mapClick.cellAt(float64(x), float64(y))
+	if !ok {
+		return engineinput.Intent{Action: engineinput.ActionNone}
+	}
+	return engineinput.MapToIntent(engineinput.NewDebouncedInput(engineinput.RawInput{
+		Device: engineinput.DeviceMouse,
+		Code:   "mouse_left",
+		Row:    row,
+		Col:    col,
+	}))
+}
+
+// cellAt returns the map cell under screen position x, y, or ok=false when the point is
+// outside the drawn viewport.
+func (l mapClickLayout) cellAt(x, y float64) (row, col int, ok bool) {
+	if !l.valid || l.tileSize <= 0 {
+		return 0, 0, false
+	}
+	if !l.area.Empty() && !image.Pt(int(math.Floor(x)), int(math.Floor(y))).In(l.area) {
+		return 0, 0, false
+	}
+	vCol := int(math.Floor((x - l.originX) / float64(l.tileSize)))
+	vRow := int(math.Floor((y - l.originY) / float64(l.tileSize)))
+	if vRow < 0 || vCol < 0 || vRow >= l.rows || vCol >= l.cols {
+		return 0, 0, false
+	}
+	return l.startRow + vRow, l.startCol + vCol, true
+}
@@ -0,0 +1,53 @@
+package ebiten
+
+import (
+	"image"
+	"testing"
+)
+
+func TestMapClickLayoutCellAt(t *testing.T) {
+	l := mapClickLayout{
+		valid:    true,
+		originX:  -8.5,
+		originY:  20,
+		startRow: 3,
+		startCol: 5,
+		rows:     4,
+		cols:     6,
+		tileSize: 16,
+	}
+	tests := []struct {
+		name     string
+		x, y     float64
+		row, col int
+		ok       bool
+	}{
+		{"top-left tile", -8.5, 20, 3, 5, true},
+		{"inside second column", 8, 21, 3, 6, true},
+		{"last tile", -8.5 + 6*16 - 1, 20 + 4*16 - 1, 6, 10, true},
+		{"left of map", -9, 30, 0, 0, false},
+		{"above map", 0, 19, 0, 0, false},
+		{"below viewport", 0, 20 + 4*16, 0, 0, false},
+		{"right of viewport", -8.5 + 6*16, 30, 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row, col, ok := l.cellAt(tt.x, tt.y)
+			if ok != tt.ok || row != tt.row || col != tt.col {
+				t.Errorf("cellAt(%v, %v) = (%d, %d, %v), want (%d, %d, %v)", tt.x, tt.y, row, col, ok, tt.row, tt.col, tt.ok)
+			}
+		})
+	}
+
+	l.area = image.Rect(0, 0, 40, 200)
+	if _, _, ok := l.cellAt(50, 30); ok {
+		t.Error("a click on the letterbox bar should not hit a cell")
+	}
+	if row, col, ok := l.cellAt(8, 21); !ok || row != 3 || col != 6 {
+		t.Errorf("click inside the letterboxed area = (%d, %d, %v), want (3, 6, true)", row, col, ok)
+	}
+
+	if _, _, ok := (mapClickLayout{}).cellAt(0, 0); ok {
+		t.Error("cellAt on an undrawn map should not hit a cell")
+	}
+}
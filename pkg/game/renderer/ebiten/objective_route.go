@@ -0,0 +1,17 @@
+package ebiten
+
+import "darkstation/pkg/game/state"
+
+// objectiveRouteKeyMap copies a traced route into a cell-key set for Draw.
+func objectiveRouteKeyMap(route *state.ObjectiveRoute) map[uint64]bool {
+	if route == nil || len(route.Cells) == 0 {
+		return nil
+	}
+	m := make(map[uint64]bool, len(route.Cells))
+	for _, c := range route.Cells {
+		if c != nil {
+			m[cellCoordKey(c.Row, c.Col)] = true
+		}
+	}
+	return m
+}
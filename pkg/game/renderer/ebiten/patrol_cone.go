@@ -0,0 +1,32 @@
+package ebiten
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// patrolConeKeyMap copies the cells the deck's patrol bot can see into a cell-key set for
+// Draw. The cone is only shown once the player holds the Map.
+func patrolConeKeyMap(g *state.Game) map[uint64]bool {
+	if !g.HasMap {
+		return nil
+	}
+	_, patrol := g.DeckPatrol()
+	cells := gameworld.PatrolSightCells(g.Grid, patrol)
+	if len(cells) == 0 {
+		return nil
+	}
+	m := make(map[uint64]bool, len(cells))
+	for _, c := range cells {
+		m[cellCoordKey(c.Row, c.Col)] = true
+	}
+	return m
+}
+
+// drawPatrolConeTint washes a tile in the patrol's vision cone with faint orange.
+func (e *EbitenRenderer) drawPatrolConeTint(buf *ebiten.Image, x, y int) {
+	vector.FillRect(buf, float32(x), float32(y), float32(e.tileSize), float32(e.tileSize), colorPatrolCone, false)
+}
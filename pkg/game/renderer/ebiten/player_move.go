@@ -3,6 +3,8 @@ package ebiten
 import (
 	"math"
 	"sync"
+
+	"darkstation/pkg/game/config"
 )
 
 // playerMoveDurationMs lives in constants.go (shared with movement key repeat).
@@ -115,6 +117,62 @@ func (t *playerMoveTransition) visualPosition(level, snapRow, snapCol int, snapS
 	return t.row, t.col
 }
 
+// cameraFollowTimeConstantMs is how far the looser follow styles trail the player marker:
+// the gap closes by about 63% every time constant.
+var cameraFollowTimeConstantMs = map[string]float64{
+	config.CameraFollowSmooth:  120,
+	config.CameraFollowRelaxed: 260,
+}
+
+// cameraFollow is the play-mode camera center for the configured follow style.
+// Snap centers on the player's cell, tight rides the sliding marker, and smooth/relaxed
+// ease toward the marker exponentially, so chained steps never restart from rest.
+type cameraFollow struct {
+	mu sync.Mutex
+
+	initialized bool
+	row, col    float64
+	lastMs      int64
+	level       int
+}
+
+// center returns the camera center for this frame. visualRow/Col is the sliding player
+// marker and cellRow/Col the cell the player is on.
+func (c *cameraFollow) center(style string, level, cellRow, cellCol int, visualRow, visualCol float64, nowMs int64) (float64, float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	targetRow, targetCol := visualRow, visualCol
+	if style == config.CameraFollowSnap {
+		targetRow, targetCol = float64(cellRow), float64(cellCol)
+	}
+	tau, eases := cameraFollowTimeConstantMs[style]
+	// New decks and teleports cut straight to the player rather than sweeping across the map.
+	far := math.Abs(targetRow-c.row) > 3 || math.Abs(targetCol-c.col) > 3
+	if !eases || !c.initialized || level != c.level || far {
+		c.row, c.col = targetRow, targetCol
+	} else if dt := float64(nowMs - c.lastMs); dt > 0 {
+		k := 1 - math.Exp(-dt/tau)
+		c.row += (targetRow - c.row) * k
+		c.col += (targetCol - c.col) * k
+		const settleEps = 1e-3
+		if math.Abs(targetRow-c.row) < settleEps && math.Abs(targetCol-c.col) < settleEps {
+			c.row, c.col = targetRow, targetCol
+		}
+	}
+	c.initialized = true
+	c.level = level
+	c.lastMs = nowMs
+	return c.row, c.col
+}
+
+// last returns the most recent camera center, if one has been computed.
+func (c *cameraFollow) last() (row, col float64, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.row, c.col, c.initialized
+}
+
 func mapCameraStartAt(centerRow, centerCol float64, viewportRows, viewportCols int) (startRow, startCol int) {
 	topLeftRow := centerRow - float64(viewportRows)/2
 	topLeftCol := centerCol - float64(viewportCols)/2
@@ -1,6 +1,10 @@
 package ebiten
 
-import "testing"
+import (
+	"testing"
+
+	"darkstation/pkg/game/config"
+)
 
 func TestPlayerMoveTransition_stepsTowardTarget(t *testing.T) {
 	var tAnim playerMoveTransition
@@ -54,3 +58,35 @@ func TestPlayerMoveTransition_snapsOnLevelChange(t *testing.T) {
 		t.Fatalf("level change = (%v,%v), want (1,0)", row, col)
 	}
 }
+
+func TestCameraFollow_styles(t *testing.T) {
+	var snapCam cameraFollow
+	snapCam.center(config.CameraFollowSnap, 1, 0, 0, 0, 0, 0)
+	row, _ := snapCam.center(config.CameraFollowSnap, 1, 1, 0, 0.4, 0, 50)
+	if row != 1 {
+		t.Fatalf("snap row = %v, want the player's cell 1", row)
+	}
+
+	var tight cameraFollow
+	row, _ = tight.center(config.CameraFollowTight, 1, 1, 0, 0.4, 0, 50)
+	if row != 0.4 {
+		t.Fatalf("tight row = %v, want the marker's 0.4", row)
+	}
+
+	var smooth cameraFollow
+	smooth.center(config.CameraFollowSmooth, 1, 0, 0, 0, 0, 0)
+	row, _ = smooth.center(config.CameraFollowSmooth, 1, 1, 0, 1, 0, 50)
+	if row <= 0 || row >= 1 {
+		t.Fatalf("smooth row = %v, want trailing between 0 and 1", row)
+	}
+	for ms := int64(100); ms <= 3000; ms += 50 {
+		row, _ = smooth.center(config.CameraFollowSmooth, 1, 1, 0, 1, 0, ms)
+	}
+	if row != 1 {
+		t.Fatalf("smooth row = %v after settling, want 1", row)
+	}
+	row, _ = smooth.center(config.CameraFollowSmooth, 2, 20, 0, 20, 0, 3050)
+	if row != 20 {
+		t.Fatalf("level change row = %v, want an immediate cut to 20", row)
+	}
+}
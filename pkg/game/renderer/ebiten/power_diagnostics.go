@@ -0,0 +1,213 @@
+package ebiten
+
+import (
+	"fmt"
+	"image/color"
+	"sort"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/setup"
+	"darkstation/pkg/game/state"
+)
+
+var (
+	colorPowerDiagSurplus = color.RGBA{60, 200, 90, 70}   // Room grid has spare watts
+	colorPowerDiagLimit   = color.RGBA{230, 170, 40, 80}  // Room grid exactly at capacity
+	colorPowerDiagDeficit = color.RGBA{230, 60, 50, 85}   // Room grid draws more than it gets
+	colorPowerDiagNoGrid  = color.RGBA{110, 110, 130, 60} // No generator on the room's grid
+	colorPowerDiagLink    = color.RGBA{255, 220, 120, 170}
+)
+
+// powerDiagLink is a generator-to-room line in grid coordinates.
+type powerDiagLink struct {
+	genRow, genCol   int
+	roomRow, roomCol int
+}
+
+// powerDiagnosticsSnapshot holds the power diagnostics view computed on the game thread.
+type powerDiagnosticsSnapshot struct {
+	active      bool
+	available   int
+	supply      int
+	consumption int
+	rooms       map[string]setup.RoomPowerBalance
+	links       []powerDiagLink
+}
+
+// roomAnchorCell returns the room cell closest to the room's centroid, so links land
+// inside the room even for L-shaped or split rooms.
+func roomAnchorCell(cells []*world.Cell) *world.Cell {
+	if len(cells) == 0 {
+		return nil
+	}
+	var sumRow, sumCol int
+	for _, c := range cells {
+		sumRow += c.Row
+		sumCol += c.Col
+	}
+	n := len(cells)
+	best := cells[0]
+	bestDist := -1
+	for _, c := range cells {
+		dr := c.Row*n - sumRow
+		dc := c.Col*n - sumCol
+		if d := dr*dr + dc*dc; bestDist < 0 || d < bestDist {
+			best, bestDist = c, d
+		}
+	}
+	return best
+}
+
+// capturePowerDiagnosticsSnapshot records room balances and generator links on the game thread (RenderFrame).
+func capturePowerDiagnosticsSnapshot(g *state.Game, snap *renderSnapshot) {
+	if snap == nil {
+		return
+	}
+	pd := &snap.powerDiag
+	*pd = powerDiagnosticsSnapshot{}
+	if g == nil || g.Grid == nil || !g.PowerDiagnosticsActive {
+		return
+	}
+	pd.active = true
+	pd.available = g.GetAvailablePower()
+	pd.supply = g.PowerSupply
+	pd.consumption = g.PowerConsumption
+
+	balances := setup.RoomPowerBalances(g)
+	pd.rooms = make(map[string]setup.RoomPowerBalance, len(balances))
+	for _, b := range balances {
+		pd.rooms[b.Room] = b
+	}
+
+	visibleCells := make(map[string][]*world.Cell)
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell != nil && cell.Room && !cell.IsCorridor && cell.Name != "" && (g.HasMap || cell.Discovered) {
+			visibleCells[cell.Name] = append(visibleCells[cell.Name], cell)
+		}
+	})
+	for _, link := range setup.GeneratorRoomLinks(g) {
+		gen := g.Grid.GetCell(link.GenRow, link.GenCol)
+		anchor := roomAnchorCell(visibleCells[link.Room])
+		if gen == nil || anchor == nil || !(g.HasMap || gen.Discovered) {
+			continue
+		}
+		pd.links = append(pd.links, powerDiagLink{genRow: gen.Row, genCol: gen.Col, roomRow: anchor.Row, roomCol: anchor.Col})
+	}
+}
+
+// powerDiagRoomTint picks the balance tint for a room: surplus, at capacity, deficit, or no grid.
+func powerDiagRoomTint(b setup.RoomPowerBalance) color.Color {
+	switch {
+	case b.Supply == 0:
+		return colorPowerDiagNoGrid
+	case b.Net() < 0:
+		return colorPowerDiagDeficit
+	case b.Net() == 0 && b.Consumption > 0:
+		return colorPowerDiagLimit
+	default:
+		return colorPowerDiagSurplus
+	}
+}
+
+// drawPowerDiagnosticsMap tints visible room cells by net balance and draws generator-to-room links.
+func (e *EbitenRenderer) drawPowerDiagnosticsMap(screen *ebiten.Image, g *state.Game, pd *powerDiagnosticsSnapshot, mapX, mapY float64, startRow, startCol int) {
+	if pd == nil || !pd.active || g == nil || g.Grid == nil {
+		return
+	}
+	ts := float32(e.tileSize)
+	for vRow := 0; vRow < e.viewportRows; vRow++ {
+		for vCol := 0; vCol < e.viewportCols; vCol++ {
+			cell := g.Grid.GetCell(startRow+vRow, startCol+vCol)
+			if cell == nil || !cell.Room || cell.IsCorridor || cell.Name == "" || !(g.HasMap || cell.Discovered) {
+				continue
+			}
+			b, ok := pd.rooms[cell.Name]
+			if !ok {
+				continue
+			}
+			x := float32(mapX) + float32(vCol)*ts
+			y := float32(mapY) + float32(vRow)*ts
+			vector.DrawFilledRect(screen, x, y, ts, ts, powerDiagRoomTint(b), false)
+		}
+	}
+	lineWidth := max(float32(e.tileSize)/12, 1.5)
+	for _, l := range pd.links {
+		gx, gy := mapCellCenterScreen(mapX, mapY, l.genRow, l.genCol, startRow, startCol, e.tileSize)
+		rx, ry := mapCellCenterScreen(mapX, mapY, l.roomRow, l.roomCol, startRow, startCol, e.tileSize)
+		vector.StrokeLine(screen, gx, gy, rx, ry, lineWidth, colorPowerDiagLink, false)
+		vector.DrawFilledCircle(screen, rx, ry, lineWidth*1.5, colorPowerDiagLink, false)
+	}
+}
+
+// powerDiagnosticsPanelLines lists the deck totals and the rooms short on power, worst first.
+func powerDiagnosticsPanelLines(pd *powerDiagnosticsSnapshot) []string {
+	lines := []string{
+		"POWER DIAGNOSTICS",
+		fmt.Sprintf("Available: %dw", pd.available),
+		fmt.Sprintf("Supply %dw · Draw %dw", pd.supply, pd.consumption),
+	}
+	var short []setup.RoomPowerBalance
+	for _, b := range pd.rooms {
+		if b.Supply > 0 && b.Net() < 0 {
+			short = append(short, b)
+		}
+	}
+	sort.Slice(short, func(i, j int) bool {
+		if short[i].Net() != short[j].Net() {
+			return short[i].Net() < short[j].Net()
+		}
+		return short[i].Room < short[j].Room
+	})
+	for _, b := range short {
+		lines = append(lines, fmt.Sprintf("%s: %dw short", b.Room, -b.Net()))
+	}
+	return lines
+}
+
+// drawPowerDiagnosticsPanel draws the totals panel in the bottom-right corner.
+func (e *EbitenRenderer) drawPowerDiagnosticsPanel(screen *ebiten.Image, pd *powerDiagnosticsSnapshot, w, h int) {
+	if pd == nil || !pd.active {
+		return
+	}
+	face := e.getSansFontFace()
+	lines := powerDiagnosticsPanelLines(pd)
+	lineGap := e.getUIFontSize() * 0.3
+	var contentW, contentH float64
+	heights := make([]float64, len(lines))
+	for i, line := range lines {
+		lw, lh := text.Measure(line, face, 0)
+		contentW = max(contentW, lw)
+		heights[i] = lh
+		contentH += lh
+		if i < len(lines)-1 {
+			contentH += lineGap
+		}
+	}
+	const pad = 12.0
+	const margin = 16.0
+	panelW := contentW + pad*2
+	panelH := contentH + pad*2
+	panelX := float64(w) - panelW - margin
+	panelY := float64(h) - panelH - margin
+	drawRoundedRectWithShadow(screen, float32(panelX), float32(panelY), float32(panelW), float32(panelH), 8, 1,
+		color.RGBA{20, 24, 34, 220}, colorPowerDiagLink, 1)
+
+	y := panelY + pad
+	for i, line := range lines {
+		c := colorText
+		switch {
+		case i == 0:
+			c = colorPowerDiagLink
+		case i == 1 && pd.available < 0:
+			c = colorHazard
+		case i >= 3:
+			c = colorHazard
+		}
+		e.drawUILeftTextTop(screen, line, int(panelX+pad), int(y), c, face)
+		y += heights[i] + lineGap
+	}
+}
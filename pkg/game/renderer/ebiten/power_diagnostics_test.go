@@ -0,0 +1,49 @@
+package ebiten
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/setup"
+	"darkstation/pkg/game/state"
+)
+
+func TestPowerDiagRoomTint_byBalance(t *testing.T) {
+	cases := []struct {
+		b    setup.RoomPowerBalance
+		want any
+	}{
+		{setup.RoomPowerBalance{Supply: 0, Consumption: 10}, colorPowerDiagNoGrid},
+		{setup.RoomPowerBalance{Supply: 20, Consumption: 30}, colorPowerDiagDeficit},
+		{setup.RoomPowerBalance{Supply: 20, Consumption: 20}, colorPowerDiagLimit},
+		{setup.RoomPowerBalance{Supply: 20, Consumption: 10}, colorPowerDiagSurplus},
+	}
+	for _, tc := range cases {
+		if got := powerDiagRoomTint(tc.b); got != tc.want {
+			t.Errorf("tint(%+v) = %v, want %v", tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestPowerDiagnosticsPanelLines_listsShortRoomsWorstFirst(t *testing.T) {
+	pd := &powerDiagnosticsSnapshot{
+		active:    true,
+		available: -5,
+		rooms: map[string]setup.RoomPowerBalance{
+			"Lab":     {Room: "Lab", Supply: 20, Consumption: 25},
+			"Bridge":  {Room: "Bridge", Supply: 20, Consumption: 40},
+			"Storage": {Room: "Storage", Supply: 0, Consumption: 10},
+		},
+	}
+	lines := powerDiagnosticsPanelLines(pd)
+	if len(lines) != 5 || lines[3] != "Bridge: 20w short" || lines[4] != "Lab: 5w short" {
+		t.Fatalf("lines = %q", lines)
+	}
+}
+
+func TestCapturePowerDiagnosticsSnapshot_inactiveByDefault(t *testing.T) {
+	var snap renderSnapshot
+	capturePowerDiagnosticsSnapshot(state.NewGame(), &snap)
+	if snap.powerDiag.active {
+		t.Fatal("diagnostics should be off until toggled")
+	}
+}
@@ -2,6 +2,7 @@ package ebiten
 
 import (
 	"image/color"
+	"maps"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/vector"
@@ -120,6 +121,7 @@ func captureMapPowerSnapshot(g *state.Game, snap *renderSnapshot) {
 	mp.roomDoorsPowered = copyStringBoolMap(g.RoomDoorsPowered)
 	mp.roomCCTVPowered = copyStringBoolMap(g.RoomCCTVPowered)
 	mp.manualEgressReleased = copyStringBoolMap(g.ManualEgressReleased)
+	mp.roomLightLevel = maps.Clone(g.RoomLightLevel)
 	mp.maintenanceMenuRoom = g.MaintenanceMenuRoom
 	if len(g.MaintenanceSelectableRooms) > 0 {
 		mp.maintenanceSelectableRooms = append([]string(nil), g.MaintenanceSelectableRooms...)
@@ -221,7 +223,7 @@ func cellBlocksPowerGridRoomTint(cell *world.Cell) bool {
 }
 
 func isPowerGridFloorCell(cell *world.Cell, opts *CellRenderOptions) bool {
-	if cell == nil || !cell.Room || cell.Name == "" || cell.Name == "Corridor" {
+	if cell == nil || !cell.Room || cell.Name == "" || cell.IsCorridor {
 		return false
 	}
 	if opts == nil || opts.Icon == IconWall || opts.Icon == IconVoid {
@@ -253,7 +255,7 @@ func powerGridWallBg(g *state.Game, pg *powerGridSnapshot, cell *world.Cell) col
 	anyUnpowered := false
 	anyPowered := false
 	for _, n := range cell.GetNeighbors() {
-		if n == nil || !n.Room || n.Name == "" || n.Name == "Corridor" {
+		if n == nil || !n.Room || n.Name == "" || n.IsCorridor {
 			continue
 		}
 		hasNamedAdjacent = true
@@ -317,7 +319,7 @@ func powerGridCellBg(g *state.Game, pg *powerGridSnapshot, cell *world.Cell) col
 	if !onLive {
 		conduit = colorPowerGridCellOff
 	}
-	if cell.Name == "Corridor" {
+	if cell.IsCorridor {
 		return conduit
 	}
 	if gameworld.HasDoor(cell) || gameworld.HasPowerRelay(cell) {
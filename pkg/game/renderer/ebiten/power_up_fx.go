@@ -0,0 +1,123 @@
+// Generator power-up feedback: the generator glyph ramps from offline red to
+// online green, and a band of light sweeps outward through the rooms the
+// generator just brought online. Presentation-only, like ambient_fx.go.
+package ebiten
+
+import (
+	"image/color"
+	"math"
+	"time"
+
+	"darkstation/pkg/engine/world"
+)
+
+const (
+	// powerUpGlyphMs is how long the generator glyph takes to ramp red -> green.
+	powerUpGlyphMs = 500
+	// powerUpSweepMs is how long the light sweep runs through newly powered rooms.
+	powerUpSweepMs = 900
+	// powerUpSweepCellsPerMs is how fast the sweep front travels (Manhattan cells).
+	powerUpSweepCellsPerMs = 0.03
+	// powerUpSweepBand is the half-width of the bright band around the front, in cells.
+	powerUpSweepBand = 2.0
+	powerUpSweepAmp  = 0.45
+)
+
+// powerUpEffect is one registered generator power-up.
+type powerUpEffect struct {
+	startMs int64
+	rooms   map[string]bool
+}
+
+// powerUpSnapshot is one active power-up copied for Draw.
+type powerUpSnapshot struct {
+	row     int
+	col     int
+	startMs int64
+	rooms   map[string]bool
+}
+
+// AddPowerUp implements renderer.PowerUpRenderer: starts the power-up effect for the
+// generator at (row, col), sweeping light through rooms.
+func (e *EbitenRenderer) AddPowerUp(row, col int, rooms []string) {
+	roomSet := make(map[string]bool, len(rooms))
+	for _, name := range rooms {
+		roomSet[name] = true
+	}
+	e.powerUpMutex.Lock()
+	defer e.powerUpMutex.Unlock()
+	if e.powerUps == nil {
+		e.powerUps = make(map[uint64]powerUpEffect)
+	}
+	e.powerUps[cellCoordKey(row, col)] = powerUpEffect{startMs: time.Now().UnixMilli(), rooms: roomSet}
+}
+
+// snapshotPowerUps prunes finished power-ups and copies the rest for Draw.
+func (e *EbitenRenderer) snapshotPowerUps(nowMs int64) {
+	e.powerUpMutex.Lock()
+	defer e.powerUpMutex.Unlock()
+	e.snapshot.powerUps = e.snapshot.powerUps[:0]
+	for key, fx := range e.powerUps {
+		if nowMs-fx.startMs > max(powerUpGlyphMs, powerUpSweepMs) {
+			delete(e.powerUps, key)
+			continue
+		}
+		e.snapshot.powerUps = append(e.snapshot.powerUps, powerUpSnapshot{
+			row:     int(int32(key >> 32)),
+			col:     int(int32(uint32(key))),
+			startMs: fx.startMs,
+			rooms:   fx.rooms,
+		})
+	}
+}
+
+// powerUpTileColors applies any active power-up to a tile: the generator glyph ramps
+// toward green and cells in newly powered rooms brighten as the sweep passes. Reports
+// whether a power-up touched the tile.
+func powerUpTileColors(snap *renderSnapshot, cell *world.Cell, bg, fg color.Color, nowMs int64) (color.Color, color.Color, bool) {
+	applied := false
+	for _, p := range snap.powerUps {
+		elapsed := nowMs - p.startMs
+		if p.row == cell.Row && p.col == cell.Col {
+			if elapsed <= powerUpGlyphMs {
+				fg, applied = powerUpGlyphColor(elapsed), true
+			}
+			continue
+		}
+		if !p.rooms[cell.Name] {
+			continue
+		}
+		dist := math.Abs(float64(cell.Row-p.row)) + math.Abs(float64(cell.Col-p.col))
+		if s := powerUpSweepStrength(dist, elapsed); s > 0 {
+			bg, fg, applied = scaleColor(bg, 1+s), scaleColor(fg, 1+s), true
+		}
+	}
+	return bg, fg, applied
+}
+
+// powerUpGlyphColor is the generator glyph color elapsedMs into the ramp.
+func powerUpGlyphColor(elapsedMs int64) color.Color {
+	t := float64(elapsedMs) / powerUpGlyphMs
+	if t < 0 {
+		t = 0
+	}
+	if t > 1 {
+		t = 1
+	}
+	return blendColors(colorGeneratorOff, colorGeneratorOn, t)
+}
+
+// powerUpSweepStrength is the extra brightness for a cell dist cells from the generator,
+// elapsedMs into the sweep: a band around the travelling front that fades as it goes.
+func powerUpSweepStrength(dist float64, elapsedMs int64) float64 {
+	if elapsedMs < 0 || elapsedMs > powerUpSweepMs {
+		return 0
+	}
+	front := float64(elapsedMs) * powerUpSweepCellsPerMs
+	band := 1 - math.Abs(dist-front)/powerUpSweepBand
+	if band <= 0 {
+		return 0
+	}
+	envelope := 1 - float64(elapsedMs)/powerUpSweepMs
+	return powerUpSweepAmp * band * envelope
+}
@@ -0,0 +1,88 @@
+package ebiten
+
+import (
+	"image/color"
+	"testing"
+	"time"
+
+	"darkstation/pkg/engine/world"
+)
+
+func TestPowerUpGlyphColor_RampsOffToOn(t *testing.T) {
+	tests := []struct {
+		name      string
+		elapsedMs int64
+		want      color.Color
+	}{
+		{"start is offline red", 0, colorGeneratorOff},
+		{"end is online green", powerUpGlyphMs, colorGeneratorOn},
+		{"after end stays green", powerUpGlyphMs * 2, colorGeneratorOn},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := powerUpGlyphColor(tt.elapsedMs); got != tt.want {
+				t.Errorf("powerUpGlyphColor(%d) = %v, want %v", tt.elapsedMs, got, tt.want)
+			}
+		})
+	}
+	mid := powerUpGlyphColor(powerUpGlyphMs / 2).(color.RGBA)
+	if mid.R >= colorGeneratorOff.R || mid.G <= colorGeneratorOff.G {
+		t.Errorf("midpoint %v should sit between off and on", mid)
+	}
+}
+
+func TestPowerUpSweepStrength_FollowsFront(t *testing.T) {
+	const elapsed = 300
+	front := elapsed * powerUpSweepCellsPerMs
+	if s := powerUpSweepStrength(front, elapsed); s <= 0 {
+		t.Errorf("cell on the front should brighten, got %v", s)
+	}
+	if s := powerUpSweepStrength(front+powerUpSweepBand+1, elapsed); s != 0 {
+		t.Errorf("cell ahead of the band should be untouched, got %v", s)
+	}
+	if s := powerUpSweepStrength(0, powerUpSweepMs+1); s != 0 {
+		t.Errorf("finished sweep should be untouched, got %v", s)
+	}
+}
+
+func TestPowerUpTileColors_OnlyTouchesNewlyPoweredRooms(t *testing.T) {
+	now := time.Now().UnixMilli()
+	snap := &renderSnapshot{powerUps: []powerUpSnapshot{{
+		row: 0, col: 0, startMs: now - 100, rooms: map[string]bool{"Lab": true},
+	}}}
+	bg := color.RGBA{40, 40, 40, 255}
+	fg := color.RGBA{120, 120, 120, 255}
+	front := int(100 * powerUpSweepCellsPerMs)
+
+	lab := &world.Cell{Row: 0, Col: front, Name: "Lab", Room: true}
+	if gotBg, _, ok := powerUpTileColors(snap, lab, bg, fg, now); !ok || gotBg.(color.RGBA).R <= bg.R {
+		t.Errorf("newly powered room cell should brighten, got %v (applied %v)", gotBg, ok)
+	}
+	hall := &world.Cell{Row: 0, Col: front, Name: "Hall", Room: true}
+	if _, _, ok := powerUpTileColors(snap, hall, bg, fg, now); ok {
+		t.Error("room that was already powered must not sweep")
+	}
+	gen := &world.Cell{Row: 0, Col: 0, Name: "Hall", Room: true}
+	if _, gotFg, ok := powerUpTileColors(snap, gen, bg, fg, now); !ok || gotFg == color.Color(fg) {
+		t.Error("generator glyph should ramp during the power-up")
+	}
+}
+
+func TestSnapshotPowerUps_PrunesFinished(t *testing.T) {
+	e := &EbitenRenderer{}
+	now := time.Now().UnixMilli()
+	e.AddPowerUp(2, 5, []string{"Lab"})
+	e.powerUps[cellCoordKey(7, 7)] = powerUpEffect{startMs: now - powerUpSweepMs - 1000}
+
+	e.snapshotPowerUps(now)
+
+	if len(e.snapshot.powerUps) != 1 {
+		t.Fatalf("want 1 active power-up in snapshot, got %d", len(e.snapshot.powerUps))
+	}
+	if p := e.snapshot.powerUps[0]; p.row != 2 || p.col != 5 || !p.rooms["Lab"] {
+		t.Errorf("snapshot power-up = %+v, want (2,5) sweeping Lab", p)
+	}
+	if len(e.powerUps) != 1 {
+		t.Errorf("finished power-up should be removed from the registry, have %d entries", len(e.powerUps))
+	}
+}
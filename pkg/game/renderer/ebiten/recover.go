@@ -0,0 +1,164 @@
+// Package ebiten provides an Ebiten-based 2D graphical renderer for The Dark Station.
+package ebiten
+
+import (
+	"fmt"
+	"image/color"
+	"log"
+	"runtime/debug"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"darkstation/pkg/game/debuglog"
+)
+
+const (
+	faultWhereUpdate   = "update"
+	faultWhereDraw     = "draw"
+	faultWhereGameLoop = "game loop"
+
+	// faultBannerMs is how long a recovered fault stays on screen once frames draw again.
+	faultBannerMs = 6000
+	// faultMessageMax trims long panic values so the fault screen stays readable.
+	faultMessageMax = 240
+)
+
+// rendererFault is the most recent panic recovered from Update, Draw or the game loop.
+type rendererFault struct {
+	where   string
+	message string
+	atMs    int64
+	fatal   bool // the game loop stopped; only the fault screen is left
+}
+
+// Update handles input and game logic (Ebiten interface). A panic skips the rest of the
+// tick instead of closing the window.
+func (e *EbitenRenderer) Update() (err error) {
+	if e.currentFault().fatal {
+		// Nothing reads intents any more; closing the window still works.
+		return nil
+	}
+	defer e.recoverFault(faultWhereUpdate)
+	return e.update()
+}
+
+// Draw renders the game to the screen (Ebiten interface). A panic while drawing shows
+// the fault screen for that frame; the next frame tries the normal path again.
+func (e *EbitenRenderer) Draw(screen *ebiten.Image) {
+	if f := e.currentFault(); f.fatal {
+		e.drawFaultScreen(screen, f)
+		return
+	}
+	if !e.drawGuarded(screen) {
+		e.drawFaultScreen(screen, e.currentFault())
+		return
+	}
+	e.drawFaultBanner(screen)
+}
+
+// drawGuarded runs drawFrame and reports whether it finished without panicking.
+func (e *EbitenRenderer) drawGuarded(screen *ebiten.Image) (ok bool) {
+	defer e.recoverFault(faultWhereDraw)
+	if cvarEnabled("debug.panic_draw") {
+		setCvarBool("debug.panic_draw", false)
+		panic("debug.panic_draw: simulated draw failure")
+	}
+	e.drawFrame(screen)
+	return true
+}
+
+// startGameLoop runs gameLoop on its own goroutine. If it panics, the fault is logged
+// and the window stays open on the fault screen rather than the process dying.
+func (e *EbitenRenderer) startGameLoop(gameLoop func()) {
+	go func() {
+		defer e.recoverFault(faultWhereGameLoop)
+		gameLoop()
+	}()
+}
+
+// recoverFault is deferred directly by each guarded stage. It records a panic as the
+// current fault and logs it (with stack) unless it repeats the fault already logged.
+func (e *EbitenRenderer) recoverFault(where string) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	if e.noteFault(where, fmt.Sprint(r), where == faultWhereGameLoop) {
+		log.Printf("[Recover] %s: %v\n%s", where, r, debug.Stack())
+		debuglog.Recovered(where, r)
+	}
+}
+
+// ReportFault shows a panic the game loop recovered from itself (renderer.FaultReporter).
+// The caller has already logged it.
+func (e *EbitenRenderer) ReportFault(where, message string) {
+	e.noteFault(where, message, false)
+}
+
+// noteFault records a fault and reports whether it is new: a different fault, or the
+// same one after it had been quiet for faultBannerMs. A draw that fails every frame
+// is logged once, not sixty times a second.
+func (e *EbitenRenderer) noteFault(where, message string, fatal bool) bool {
+	if r := []rune(message); len(r) > faultMessageMax {
+		message = string(r[:faultMessageMax]) + "..."
+	}
+	nowMs := time.Now().UnixMilli()
+	e.faultMutex.Lock()
+	defer e.faultMutex.Unlock()
+	f := &e.fault
+	repeat := f.where == where && f.message == message && nowMs-f.atMs < faultBannerMs
+	f.where, f.message, f.atMs = where, message, nowMs
+	f.fatal = f.fatal || fatal
+	return !repeat
+}
+
+func (e *EbitenRenderer) currentFault() rendererFault {
+	e.faultMutex.Lock()
+	defer e.faultMutex.Unlock()
+	return e.fault
+}
+
+// faultActivity describes what the game was doing when a fault happened.
+func faultActivity(where string) string {
+	switch where {
+	case faultWhereDraw:
+		return "drawing the screen"
+	case faultWhereUpdate:
+		return "handling input"
+	default:
+		return "running the game"
+	}
+}
+
+// drawFaultScreen replaces the frame with an explanation of the fault. It uses Ebiten's
+// built-in debug font so it still works when the game fonts failed to load.
+func (e *EbitenRenderer) drawFaultScreen(screen *ebiten.Image, f rendererFault) {
+	screen.Fill(colorBackground)
+	msg := "Something went wrong while " + faultActivity(f.where) + ".\n"
+	if f.fatal {
+		msg += "The game cannot continue. Close the window to quit.\n"
+	} else {
+		msg += "The game is still running and will resume if the problem clears.\n"
+	}
+	msg += "\nError: " + f.message + "\n\n"
+	if debuglog.Enabled() {
+		msg += "Details were written to the debug log."
+	} else {
+		msg += "Start the game with -log debug.log to capture details for a bug report."
+	}
+	ebitenutil.DebugPrintAt(screen, msg, 24, 24)
+}
+
+// drawFaultBanner notes a recently recovered fault along the bottom edge of a normal frame.
+func (e *EbitenRenderer) drawFaultBanner(screen *ebiten.Image) {
+	f := e.currentFault()
+	if f.atMs == 0 || time.Now().UnixMilli()-f.atMs >= faultBannerMs {
+		return
+	}
+	h := screen.Bounds().Dy()
+	vector.DrawFilledRect(screen, 0, float32(h-24), float32(screen.Bounds().Dx()), 24, color.RGBA{80, 20, 20, 220}, false)
+	ebitenutil.DebugPrintAt(screen, "Recovered from an error while "+faultActivity(f.where)+": "+f.message, 8, h-20)
+}
@@ -0,0 +1,73 @@
+package ebiten
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"golang.org/x/image/font/gofont/gomono"
+)
+
+func TestDraw_recoversFromDrawPanic(t *testing.T) {
+	e := New()
+	screen := ebiten.NewImage(320, 240)
+	setCvarBool("debug.panic_draw", true)
+	t.Cleanup(func() { setCvarBool("debug.panic_draw", false) })
+
+	e.Draw(screen) // must not panic
+
+	f := e.currentFault()
+	if f.where != faultWhereDraw || !strings.Contains(f.message, "simulated draw failure") {
+		t.Fatalf("fault = %+v, want the simulated draw panic", f)
+	}
+	if f.fatal {
+		t.Error("a draw panic should not stop the game")
+	}
+	if cvarEnabled("debug.panic_draw") {
+		t.Error("debug.panic_draw should be one-shot")
+	}
+	if !e.drawGuarded(screen) {
+		t.Error("the next frame should draw normally")
+	}
+}
+
+func TestStartGameLoop_panicKeepsWindowOnFaultScreen(t *testing.T) {
+	e := New()
+	e.startGameLoop(func() { panic("loop exploded") })
+
+	deadline := time.Now().Add(2 * time.Second)
+	for !e.currentFault().fatal {
+		if time.Now().After(deadline) {
+			t.Fatal("game loop panic was not recorded")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if err := e.Update(); err != nil {
+		t.Errorf("Update after a stopped game loop = %v, want nil", err)
+	}
+	e.Draw(ebiten.NewImage(320, 240)) // fault screen, no panic
+}
+
+func TestNoteFault_logsRepeatsOnce(t *testing.T) {
+	e := New()
+	if !e.noteFault(faultWhereDraw, "nil map", false) {
+		t.Fatal("first fault should be logged")
+	}
+	if e.noteFault(faultWhereDraw, "nil map", false) {
+		t.Error("the same fault on the next frame should not be logged again")
+	}
+	if !e.noteFault(faultWhereUpdate, "nil map", false) {
+		t.Error("a fault in another stage should be logged")
+	}
+}
+
+func TestLoadFontSource_fallsBackToNextFont(t *testing.T) {
+	broken := embeddedFont{"Broken", []byte("not a font")}
+	if loadFontSource("Monospace", broken, embeddedFont{"Go Mono", gomono.TTF}) == nil {
+		t.Fatal("expected the Go Mono fallback to load")
+	}
+	if loadFontSource("Monospace", broken) != nil {
+		t.Error("expected nil when no candidate loads")
+	}
+}
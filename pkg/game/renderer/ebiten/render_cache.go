@@ -1,6 +1,7 @@
 package ebiten
 
 import (
+	"maps"
 	"time"
 
 	"darkstation/pkg/game/setup"
@@ -50,6 +51,7 @@ func (e *EbitenRenderer) refreshMapPowerSnapshot(g *state.Game, snap *renderSnap
 	mp.roomDoorsPowered = copyStringBoolMap(g.RoomDoorsPowered)
 	mp.roomCCTVPowered = copyStringBoolMap(g.RoomCCTVPowered)
 	mp.manualEgressReleased = copyStringBoolMap(g.ManualEgressReleased)
+	mp.roomLightLevel = maps.Clone(g.RoomLightLevel)
 	mp.maintenanceMenuRoom = g.MaintenanceMenuRoom
 	if len(g.MaintenanceSelectableRooms) > 0 {
 		mp.maintenanceSelectableRooms = append([]string(nil), g.MaintenanceSelectableRooms...)
@@ -98,8 +100,12 @@ func (e *EbitenRenderer) buildObjectivesCacheKey(g *state.Game) objectivesCacheK
 	}
 	return objectivesCacheKey{
 		level:               g.Level,
+		levelSeed:           g.LevelSeed,
+		deckName:            g.DeckName,
 		interactionsCount:   g.InteractionsCount,
 		unpoweredGenerators: g.UnpoweredGeneratorCount(),
+		batteriesNeeded:     g.TotalBatteriesNeeded(),
+		batteries:           g.Batteries,
 		repairSignature:     g.RepairProgressSignature(),
 	}
 }
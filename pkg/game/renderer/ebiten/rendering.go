@@ -3,6 +3,7 @@ package ebiten
 
 import (
 	"fmt"
+	"image"
 	"image/color"
 	"math"
 	"sort"
@@ -15,14 +16,15 @@ import (
 	"github.com/leonelquinteros/gotext"
 
 	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/config"
 	"darkstation/pkg/game/renderer"
 	"darkstation/pkg/game/setup"
 	"darkstation/pkg/game/state"
 	gameworld "darkstation/pkg/game/world"
 )
 
-// Draw renders the game to the screen (Ebiten interface)
-func (e *EbitenRenderer) Draw(screen *ebiten.Image) {
+// drawFrame renders the game to the screen (called by Draw under fault recovery).
+func (e *EbitenRenderer) drawFrame(screen *ebiten.Image) {
 	// Fill background first
 	screen.Fill(colorBackground)
 	screenWidth, screenHeight := screen.Bounds().Dx(), screen.Bounds().Dy()
@@ -105,21 +107,18 @@ func (e *EbitenRenderer) Draw(screen *ebiten.Image) {
 		return
 	}
 
-	// Expand the viewport when the framebuffer is larger than the logical window (HiDPI).
-	if screenWidth > 0 && screenHeight > 0 {
-		if neededCols := viewportTilesForAxis(screenWidth, e.tileSize); neededCols > e.viewportCols {
-			e.viewportCols = neededCols
-		}
-		if neededRows := viewportTilesForAxis(screenHeight, e.tileSize); neededRows > e.viewportRows {
-			e.viewportRows = neededRows
-		}
-	}
+	// Map draw area is the window, letterboxed to the Max Viewport caps; the tile grid is
+	// anchored on the player at screen center either way.
+	mapArea := e.mapArea(screenWidth, screenHeight)
+	e.expandViewportForArea(mapArea)
 
-	// Map draw area is the full window; tile grid is anchored on the player at screen center.
-	mapAreaWidth := screenWidth
-	mapAreaHeight := screenHeight
-
-	e.drawGameplayMapLayer(screen, g, &snap, screenWidth, screenHeight, mapAreaWidth, mapAreaHeight, genericMenuActive)
+	e.drawGameplayMapLayer(screen, g, &snap, screenWidth, screenHeight, mapArea, genericMenuActive)
+	if !genericMenuActive {
+		e.drawPowerDiagnosticsPanel(screen, &snap.powerDiag, screenWidth, screenHeight)
+	}
+	if g.GameOver {
+		e.drawGameOverOverlay(screen, g, screenWidth, screenHeight)
+	}
 
 	// Text input dialog (centered modal; e.g. load level seed)
 	e.drawTextInputDialog(screen)
@@ -137,19 +136,35 @@ func (e *EbitenRenderer) Draw(screen *ebiten.Image) {
 	e.drawDebugTopRight(screen, screenWidth, screenHeight, g)
 }
 
-func (e *EbitenRenderer) drawGameplayMapLayer(screen *ebiten.Image, g *state.Game, snap *renderSnapshot, screenWidth, screenHeight, mapAreaWidth, mapAreaHeight int, genericMenuActive bool) {
+// expandViewportForArea grows the viewport when the framebuffer map area is larger than
+// the logical window's (HiDPI).
+func (e *EbitenRenderer) expandViewportForArea(mapArea image.Rectangle) {
+	if mapArea.Empty() {
+		return
+	}
+	if neededCols := viewportTilesForAxis(mapArea.Dx(), e.tileSize); neededCols > e.viewportCols {
+		e.viewportCols = neededCols
+	}
+	if neededRows := viewportTilesForAxis(mapArea.Dy(), e.tileSize); neededRows > e.viewportRows {
+		e.viewportRows = neededRows
+	}
+}
+
+func (e *EbitenRenderer) drawGameplayMapLayer(screen *ebiten.Image, g *state.Game, snap *renderSnapshot, screenWidth, screenHeight int, mapArea image.Rectangle, genericMenuActive bool) {
 	uiFontSize := e.getUIFontSize()
 	statusBarHeight := int(uiFontSize)*2 + 20
 	const objectivesWindowMargin = 12
 
 	e.drawHeaderFromSnapshot(screen, snap, screenWidth, 0)
-	e.drawMap(screen, g, screenWidth, screenHeight, snap)
+	e.drawMapTimed(screen, g, screenWidth, screenHeight, snap)
+	e.mapClick.area = mapArea
+	e.drawLetterbox(screen, screenWidth, screenHeight, mapArea)
 	if e.DrawMapAreaBorderEnabled() {
-		e.drawMapAreaBorderOutline(screen, 0, 0, mapAreaWidth, mapAreaHeight)
+		e.drawMapAreaBorderOutline(screen, mapArea.Min.X, mapArea.Min.Y, mapArea.Dx(), mapArea.Dy())
 	}
 	statusX := objectivesWindowMargin + 10
 	statusY := objectivesWindowMargin + 5
-	e.drawStatusBarFromSnapshot(screen, snap, statusX, statusY, mapAreaWidth, statusBarHeight)
+	e.drawStatusBarFromSnapshot(screen, snap, statusX, statusY, screenWidth, statusBarHeight)
 	if genericMenuActive {
 		e.drawGenericMenuOverlay(screen)
 	}
@@ -164,15 +179,9 @@ func (e *EbitenRenderer) drawGameCompleteScreen(screen *ebiten.Image, g *state.G
 	showMap := g.CompletionPhase == state.CompletionPhaseSummary || fadeActive
 
 	if showMap {
-		if screenWidth > 0 && screenHeight > 0 {
-			if neededCols := viewportTilesForAxis(screenWidth, e.tileSize); neededCols > e.viewportCols {
-				e.viewportCols = neededCols
-			}
-			if neededRows := viewportTilesForAxis(screenHeight, e.tileSize); neededRows > e.viewportRows {
-				e.viewportRows = neededRows
-			}
-		}
-		e.drawGameplayMapLayer(screen, g, snap, screenWidth, screenHeight, screenWidth, screenHeight, genericMenuActive)
+		mapArea := e.mapArea(screenWidth, screenHeight)
+		e.expandViewportForArea(mapArea)
+		e.drawGameplayMapLayer(screen, g, snap, screenWidth, screenHeight, mapArea, genericMenuActive)
 		if fadeActive {
 			scrim := color.RGBA{15, 15, 26, uint8(220 * fade)}
 			vector.DrawFilledRect(screen, 0, 0, float32(screenWidth), float32(screenHeight), scrim, false)
@@ -246,7 +255,7 @@ func (e *EbitenRenderer) playerPositionDebugText(g *state.Game) string {
 }
 
 // syncPlayModeCamera keeps maintenance pan origin aligned with the on-screen play camera.
-// During normal play drawMap uses playerMove.visualPosition directly; these fields are only
+// During normal play drawMap follows the player via e.camera; these fields are only
 // read when the maintenance menu opens. Without syncing they stay at zero and the first
 // maint pan eases from map origin.
 func (e *EbitenRenderer) syncPlayModeCamera(g *state.Game) {
@@ -258,6 +267,9 @@ func (e *EbitenRenderer) syncPlayModeCamera(g *state.Game) {
 		nowMs = time.Now().UnixMilli()
 	}
 	row, col := e.playerMove.visualPosition(g.Level, g.CurrentCell.Row, g.CurrentCell.Col, e.snapSeq, nowMs)
+	if camRow, camCol, ok := e.camera.last(); ok {
+		row, col = camRow, camCol
+	}
 	e.cameraCenterRow = row
 	e.cameraCenterCol = col
 	e.cameraTargetRow = row
@@ -266,10 +278,12 @@ func (e *EbitenRenderer) syncPlayModeCamera(g *state.Game) {
 }
 
 // advanceMaintenanceCamera sets the map camera center. With the maintenance room list open,
-// the camera eases toward the selected room center (~1s smootherstep). Normal play uses
-// playerMoveTransition in drawMap (visual row/col + matching camera pan, ~140ms ease-out).
+// the camera eases toward the selected room center over the Camera Pan setting (1s quintic
+// by default, see cameraEase). Normal play uses playerMoveTransition in drawMap (visual
+// row/col + a camera that follows it per the Camera Follow setting, see cameraFollow).
 func (e *EbitenRenderer) advanceMaintenanceCamera() {
-	const maintCameraPanMs = 1000
+	cfg := config.Current()
+	maintCameraPanMs := cfg.CameraPanMs
 
 	e.gameMutex.RLock()
 	g := e.game
@@ -310,7 +324,10 @@ func (e *EbitenRenderer) advanceMaintenanceCamera() {
 			elapsedNano = 0
 		}
 		elapsedMs := float64(elapsedNano) / 1e6
-		progress := elapsedMs / float64(maintCameraPanMs)
+		progress := 1.0
+		if maintCameraPanMs > 0 {
+			progress = elapsedMs / float64(maintCameraPanMs)
+		}
 		if progress >= 1.0 {
 			e.cameraCenterRow = e.cameraTargetRow
 			e.cameraCenterCol = e.cameraTargetCol
@@ -323,7 +340,7 @@ func (e *EbitenRenderer) advanceMaintenanceCamera() {
 				e.maintPanCameraTweenActive = false
 			}
 		} else {
-			t := smootherstep(progress)
+			t := cameraEase(cfg.CameraEasing, progress)
 			e.cameraCenterRow = e.cameraFromRow + (e.cameraTargetRow-e.cameraFromRow)*t
 			e.cameraCenterCol = e.cameraFromCol + (e.cameraTargetCol-e.cameraFromCol)*t
 		}
@@ -339,6 +356,20 @@ func (e *EbitenRenderer) advanceMaintenanceCamera() {
 	// and would desync the camera from the snapshot during slow frames (visible jitter).
 }
 
+// cameraEase maps pan progress in [0,1] through the configured room-focus easing curve;
+// unknown names fall back to quintic.
+func cameraEase(easing string, t float64) float64 {
+	t = min(max(t, 0), 1)
+	switch easing {
+	case config.CameraEasingLinear:
+		return t
+	case config.CameraEasingEaseInOut:
+		return easeInOut(t)
+	default:
+		return smootherstep(t)
+	}
+}
+
 // smootherstep maps [0,1] → [0,1] with zero first and second derivatives at the endpoints (Wikipedia / Perlin).
 func smootherstep(t float64) float64 {
 	if t <= 0 {
@@ -379,13 +410,14 @@ func (e *EbitenRenderer) playVisualCamera(snap *renderSnapshot) (camRow, camCol,
 		nowMs = time.Now().UnixMilli()
 	}
 	visualRow, visualCol = e.playerMove.visualPosition(snap.level, snap.playerRow, snap.playerCol, snap.seq, nowMs)
-	camRow, camCol = visualRow, visualCol
+	camRow, camCol = e.camera.center(snap.cameraFollow, snap.level, snap.playerRow, snap.playerCol, visualRow, visualCol, nowMs)
 	startRow, startCol = mapCameraStartAt(camRow, camCol, e.viewportRows, e.viewportCols)
 	return camRow, camCol, visualRow, visualCol, startRow, startCol
 }
 
 // drawMap renders the game map
 func (e *EbitenRenderer) drawMap(screen *ebiten.Image, g *state.Game, screenWidth, screenHeight int, snap *renderSnapshot) {
+	e.mapClick.valid = false
 	if g.CurrentCell == nil || g.Grid == nil {
 		return
 	}
@@ -478,6 +510,7 @@ func (e *EbitenRenderer) drawMap(screen *ebiten.Image, g *state.Game, screenWidt
 	pg := &snap.powerGrid
 	e.drawFOVRays(screen, g, snap, mapScrX, mapScrY, startRow, startCol)
 	e.drawPowerGridOverlay(screen, g, pg, mapScrX, mapScrY, startRow, startCol)
+	e.drawPowerDiagnosticsMap(screen, g, &snap.powerDiag, mapScrX, mapScrY, startRow, startCol)
 
 	// Draw overlays using the same screen origin so labels/callouts match the quantized blit.
 	mapXF := mapScrX
@@ -491,6 +524,17 @@ func (e *EbitenRenderer) drawMap(screen *ebiten.Image, g *state.Game, screenWidt
 	e.drawGeneratorShutdownCountdown(screen, snap, mapXF, mapYF, startRow, startCol)
 	e.drawPlayerWithDebounce(screen, g, snap, mapXF, mapYF, visualRow, visualCol, startRow, startCol)
 	e.drawExitAnimation(screen, snap, mapXF, mapYF, startRow, startCol)
+
+	e.mapClick = mapClickLayout{
+		valid:    true,
+		originX:  mapScrX,
+		originY:  mapScrY,
+		startRow: startRow,
+		startCol: startCol,
+		rows:     e.viewportRows,
+		cols:     e.viewportCols,
+		tileSize: e.tileSize,
+	}
 }
 
 // drawTileToBuffer draws a single tile to the map buffer at integer coordinates.
@@ -519,15 +563,63 @@ func (e *EbitenRenderer) drawTileToBuffer(buf *ebiten.Image, startRow, startCol,
 		return
 	}
 
-	customBg := e.getTileCustomBg(g, cell, snap, &cellRenderOptions, pg)
-	bg, fg := e.ambientTileColors(g, cell, snap, &cellRenderOptions, customBg)
+	bg, fg := e.tileColors(g, cell, snap, &cellRenderOptions, pg, time.Now().UnixMilli())
 	e.drawTileWithBg(buf, cellRenderOptions.Icon, x, y, fg, cellRenderOptions.HasBackground, bg)
+	if cell != nil && snapshotHasCell(snap.patrolCone, cell) {
+		e.drawPatrolConeTint(buf, x, y)
+	}
+	if label := generatorBadgeLabel(cell, snap, &cellRenderOptions); label != "" {
+		e.drawGeneratorBadge(buf, label, x, y)
+	}
+	if cell != nil && cell.Discovered && snapshotHasCell(snap.mapPins, cell) {
+		e.drawMapPinMarker(buf, x, y)
+	}
+	if cell != nil && cell.Discovered && gameworld.GetGameData(cell).SeenViaCamera {
+		e.drawCameraSeenMarker(buf, x, y, gameworld.CameraViewStale(cell))
+	}
+	if cell != nil && cell.Discovered && e.ShowCellCoordsEnabled() {
+		e.drawCellCoords(buf, cell.Row, cell.Col, x, y)
+	}
+}
+
+// drawCellCoords labels a tile with its row,col in small text along the top edge
+// (draw.coords), so bug reports can name exact cells.
+func (e *EbitenRenderer) drawCellCoords(buf *ebiten.Image, row, col, x, y int) {
+	face := &text.GoTextFace{Source: e.sansFontSource, Size: max(float64(e.tileSize)/4, 6)}
+	e.drawColoredTextWithFace(buf, cellCoordsLabel(row, col), x+1, y, colorSubtle, face)
+}
+
+func cellCoordsLabel(row, col int) string {
+	return fmt.Sprintf("%d,%d", row, col)
+}
+
+// tileColors resolves a map tile's final background and foreground colors: the
+// custom plate, ambient effects and the lit/remembered fade, in draw order.
+func (e *EbitenRenderer) tileColors(g *state.Game, cell *world.Cell, snap *renderSnapshot, opts *CellRenderOptions, pg *powerGridSnapshot, nowMs int64) (bg, fg color.Color) {
+	customBg := e.getTileCustomBg(g, cell, snap, opts, pg)
+	bg, fg = e.ambientTileColors(g, cell, snap, opts, customBg)
+	return e.lightFadeColors(g, cell, bg, fg, nowMs)
+}
+
+// drawGeneratorBadge draws a small batteries-needed count in the tile's bottom-right corner.
+func (e *EbitenRenderer) drawGeneratorBadge(buf *ebiten.Image, label string, x, y int) {
+	face := e.getSansBoldFontFace()
+	textW, textH := text.Measure(label, face, 0)
+	padding := 2.0
+	boxW := float32(textW + padding*2)
+	boxH := float32(textH)
+	boxX := float32(x+e.tileSize) - boxW - 1
+	boxY := float32(y+e.tileSize) - boxH - 1
+	vector.DrawFilledRect(buf, boxX, boxY, boxW, boxH, colorGeneratorBadgeBg, false)
+	// drawColoredTextWithFace adds face.Size to y; cancel it so the text top sits on the box.
+	e.drawColoredTextWithFace(buf, label, int(boxX+float32(padding)), int(boxY)-int(face.Size), colorGeneratorOff, face)
 }
 
 // getTileCustomBg returns the background color for a cell (focus, hazard, floor, exit, etc.).
 func (e *EbitenRenderer) getTileCustomBg(g *state.Game, cell *world.Cell, snap *renderSnapshot, opts *CellRenderOptions, pg *powerGridSnapshot) color.Color {
 	var customBg color.Color
-	isFocused := cell != nil && cell.Row == snap.focusedCellRow && cell.Col == snap.focusedCellCol
+	isFocused := cell != nil && ((cell.Row == snap.focusedCellRow && cell.Col == snap.focusedCellCol) ||
+		(snap.hasCycleNext && cell.Row == snap.cycleNextRow && cell.Col == snap.cycleNextCol))
 	isInteractable := false
 	if cell != nil {
 		for _, ic := range snap.interactableCells {
@@ -612,8 +704,13 @@ func (e *EbitenRenderer) getTileCustomBg(g *state.Game, cell *world.Cell, snap *
 			} else {
 				customBg = colorFocusBackground
 			}
-		} else if cell != nil && cell.ExitCell && liveDetail && setup.ExitLiftReady(g) {
-			customBg = e.getPulsingExitBackgroundColor()
+		} else if cell != nil && cell.ExitCell && liveDetail {
+			switch setup.ExitLiftState(g) {
+			case state.ExitLiftReady:
+				customBg = e.getPulsingExitBackgroundColor(snap != nil && snap.reduceMotion)
+			case state.ExitLiftLockedIncomplete, state.ExitLiftLockedLowPower:
+				customBg = e.getPulsingExitPendingBackgroundColor(snap != nil && snap.reduceMotion)
+			}
 		}
 	}
 	return customBg
@@ -737,6 +834,8 @@ func glyphDescription(ch string) string {
 		return "locked door"
 	case IconDoorUnlocked:
 		return "unlocked door"
+	case IconDoorUnpowered:
+		return "unpowered door"
 	case IconTerminalUnused:
 		return "unused terminal / technical floor"
 	case IconTerminalUsed:
@@ -758,7 +857,15 @@ func glyphDescription(ch string) string {
 	case IconRepairConduit:
 		return "burned conduit splice repair"
 	case IconToxicSlime:
-		return "toxic slime blocker"
+		return "toxic slime blocker / flooded floor"
+	case "≈":
+		return "reactor bleed floor"
+	case IconSurvivor:
+		return "survivor"
+	case IconPatrol:
+		return "maintenance bot"
+	case IconPatrolStunned:
+		return "stunned maintenance bot"
 	case "*":
 		return "visited storage floor"
 	case ":":
@@ -973,7 +1080,7 @@ func (e *EbitenRenderer) drawPlayerWithDebounce(screen *ebiten.Image, g *state.G
 	// Calculate debounce offset
 	offsetX := 0
 	offsetY := 0
-	if direction != "" {
+	if direction != "" && !snap.reduceMotion {
 		now := time.Now().UnixMilli()
 		elapsed := now - startTime
 		const debounceDuration = 150 // milliseconds
@@ -992,6 +1099,14 @@ func (e *EbitenRenderer) drawPlayerWithDebounce(screen *ebiten.Image, g *state.G
 				offsetX = int(bounceAmount)
 			case "west":
 				offsetX = int(-bounceAmount)
+			case "northeast":
+				offsetX, offsetY = int(bounceAmount/math.Sqrt2), int(-bounceAmount/math.Sqrt2)
+			case "northwest":
+				offsetX, offsetY = int(-bounceAmount/math.Sqrt2), int(-bounceAmount/math.Sqrt2)
+			case "southeast":
+				offsetX, offsetY = int(bounceAmount/math.Sqrt2), int(bounceAmount/math.Sqrt2)
+			case "southwest":
+				offsetX, offsetY = int(-bounceAmount/math.Sqrt2), int(bounceAmount/math.Sqrt2)
 			}
 		} else {
 			// Animation complete, clear it
@@ -1016,14 +1131,12 @@ func (e *EbitenRenderer) drawExitAnimation(screen *ebiten.Image, snap *renderSna
 
 	now := time.Now().UnixMilli()
 	elapsed := now - snap.exitAnimStartTime
-	const exitAnimDuration = 2000 // 2 seconds for transition
-
-	if elapsed >= exitAnimDuration {
+	if elapsed >= state.ExitAnimDurationMs {
 		return // Animation complete
 	}
 
 	// Calculate fade progress (0.0 to 1.0)
-	progress := float64(elapsed) / exitAnimDuration
+	progress := float64(elapsed) / state.ExitAnimDurationMs
 
 	// Get screen dimensions - use actual screen bounds to ensure full coverage
 	w, h := screen.Bounds().Dx(), screen.Bounds().Dy()
@@ -1223,6 +1336,13 @@ func (e *EbitenRenderer) drawCompletionSummary(screen *ebiten.Image, g *state.Ga
 		fmt.Sprintf(gotext.Get("STAT_INTERACTIONS"), stats.Interactions),
 		state.FormatRunDuration(stats.ElapsedSeconds),
 	}
+	// Descent summary: one line per cleared deck, below the run totals.
+	for _, rec := range g.DeckHistory {
+		statLines = append(statLines, state.FormatDeckClearLine(rec))
+	}
+	if g.Creative() {
+		statLines = append(statLines, creativeModeNotRecorded)
+	}
 
 	mainColor := completionColorAlpha(color.RGBA{220, 170, 255, 255}, contentAlpha)
 	subColor := completionColorAlpha(color.RGBA{200, 200, 220, 255}, contentAlpha)
@@ -1496,7 +1616,12 @@ func (e *EbitenRenderer) drawEnvironmentalPlaques(screen *ebiten.Image, snap *re
 		cellX := mapX + float64(vCol*e.tileSize)
 		cellY := mapY + float64(vRow*e.tileSize)
 
-		txt := dynamicGet(ep.MsgID)
+		var txt string
+		if ep.Arg != "" {
+			txt = dynamicGet(ep.MsgID, ep.Arg)
+		} else {
+			txt = dynamicGet(ep.MsgID)
+		}
 		rs := []rune(txt)
 		if len(rs) > maxRunes {
 			txt = string(rs[:maxRunes-1]) + "…"
@@ -1584,10 +1709,66 @@ func deckHeaderText(snap *renderSnapshot) string {
 	if snap.perfMapScenario != "" {
 		return "perfmap " + snap.perfMapScenario
 	}
+	if snap.deckName != "" {
+		return snap.deckName
+	}
+	header := fmt.Sprintf(gotext.Get("DECK_NUMBER"), snap.level)
 	if snap.deckTitle != "" {
-		return fmt.Sprintf(gotext.Get("DECK_HEADER"), snap.level, snap.deckTitle)
+		header = fmt.Sprintf(gotext.Get("DECK_HEADER"), snap.level, snap.deckTitle)
+	}
+	if snap.endless {
+		header += fmt.Sprintf("  Score %d", snap.endlessScore)
 	}
-	return fmt.Sprintf(gotext.Get("DECK_NUMBER"), snap.level)
+	if snap.creative {
+		header += "  " + creativeModeLabel
+	}
+	return header
+}
+
+// statusBarRoomsText is the rooms-explored line, or "" when the setting is off or the deck has no named rooms.
+func statusBarRoomsText(snap *renderSnapshot) string {
+	if snap == nil || !snap.roomProgress || snap.roomsTotal == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Rooms explored: ACTION{%d}/%d", snap.roomsExplored, snap.roomsTotal)
+}
+
+// statusBarGeneratorsMarkup lists generators per room as powered/total, so a room holding
+// several generators reads as one entry; fully powered rooms are highlighted.
+func statusBarGeneratorsMarkup(snap *renderSnapshot) string {
+	if snap == nil || len(snap.generatorRooms) == 0 {
+		return ""
+	}
+	parts := make([]string, 0, len(snap.generatorRooms))
+	for _, r := range snap.generatorRooms {
+		count := fmt.Sprintf("%d/%d", r.powered, r.total)
+		if r.powered == r.total {
+			count = fmt.Sprintf("POWERED{%s}", count)
+		}
+		parts = append(parts, fmt.Sprintf("ROOM{%s} %s", r.room, count))
+	}
+	return gotext.Get("GENERATORS") + ": " + strings.Join(parts, ", ")
+}
+
+// statusBarDeckStatusText is the deck radiation and pressure readout, or "" while the deck
+// has no radiation on the meter, no radiation leaks and no vacuum breaches.
+func statusBarDeckStatusText(snap *renderSnapshot) string {
+	if snap == nil || (snap.deckRadiation == 0 && snap.radiationLeaks == 0 && snap.pressureBreaches == 0) {
+		return ""
+	}
+	percent := snap.deckRadiation * 100 / state.DeckRadiationMax
+	radiation := fmt.Sprintf("ACTION{%d%%}", percent)
+	if snap.deckRadiation >= state.DeckRadiationMax/2 {
+		radiation = fmt.Sprintf("HAZARD{%d%%}", percent)
+	}
+	pressure := "POWERED{nominal}"
+	switch {
+	case snap.pressureBreaches == 1:
+		pressure = "HAZARD{1 breach}"
+	case snap.pressureBreaches > 1:
+		pressure = fmt.Sprintf("HAZARD{%d breaches}", snap.pressureBreaches)
+	}
+	return "Radiation " + radiation + ", Pressure " + pressure
 }
 
 func statusBarHasInventory(snap *renderSnapshot) bool {
@@ -1640,7 +1821,11 @@ func (e *EbitenRenderer) drawStatusBarFromSnapshot(screen *ebiten.Image, snap *r
 	// Check if there's anything to show
 	hasObjectives := len(snap.objectives) > 0
 	hasInventory := statusBarHasInventory(snap)
-	hasGenerators := len(snap.generators) > 0
+	hasGenerators := len(snap.generatorRooms) > 0
+	deckStatusText := statusBarDeckStatusText(snap)
+	hasDeckStatus := deckStatusText != ""
+	roomsText := statusBarRoomsText(snap)
+	hasRooms := roomsText != ""
 
 	// Always show at least the deck number
 	hasDeckNumber := true
@@ -1670,6 +1855,12 @@ func (e *EbitenRenderer) drawStatusBarFromSnapshot(screen *ebiten.Image, snap *r
 	if hasGenerators {
 		linesNeeded++
 	}
+	if hasDeckStatus {
+		linesNeeded++
+	}
+	if hasRooms {
+		linesNeeded++
+	}
 
 	// Gaps between sections are added to currentY when drawing but must be included in height
 	const sectionGap = 2
@@ -1677,7 +1868,7 @@ func (e *EbitenRenderer) drawStatusBarFromSnapshot(screen *ebiten.Image, snap *r
 	if hasDeckNumber && hasObjectives {
 		gaps += sectionGap
 	}
-	if hasObjectives && (hasInventory || hasGenerators) {
+	if hasObjectives && (hasInventory || hasGenerators || hasDeckStatus || hasRooms) {
 		gaps += sectionGap
 	}
 
@@ -1718,20 +1909,30 @@ func (e *EbitenRenderer) drawStatusBarFromSnapshot(screen *ebiten.Image, snap *r
 		}
 	}
 	if hasGenerators {
-		genLabel := gotext.Get("GENERATORS")
-		genText := genLabel + " "
-		genParts := []string{}
-		for i, gen := range snap.generators {
-			if gen.powered {
-				genParts = append(genParts, fmt.Sprintf("#%d POWERED{ONLINE}", i+1))
-			} else {
-				genParts = append(genParts, fmt.Sprintf("#%d %d/%d", i+1, gen.batteriesInserted, gen.batteriesRequired))
-			}
+		textWidth := 0.0
+		for _, seg := range e.parseMarkup(statusBarGeneratorsMarkup(snap)) {
+			textWidth += e.getTextWidth(seg.text)
 		}
-		genText += strings.Join(genParts, ", ")
-		w := e.getTextWidth(genText)
-		if w > maxTextWidth {
-			maxTextWidth = w
+		if textWidth > maxTextWidth {
+			maxTextWidth = textWidth
+		}
+	}
+	if hasDeckStatus {
+		textWidth := 0.0
+		for _, seg := range e.parseMarkup(deckStatusText) {
+			textWidth += e.getTextWidth(seg.text)
+		}
+		if textWidth > maxTextWidth {
+			maxTextWidth = textWidth
+		}
+	}
+	if hasRooms {
+		textWidth := 0.0
+		for _, seg := range e.parseMarkup(roomsText) {
+			textWidth += e.getTextWidth(seg.text)
+		}
+		if textWidth > maxTextWidth {
+			maxTextWidth = textWidth
 		}
 	}
 
@@ -1795,7 +1996,7 @@ func (e *EbitenRenderer) drawStatusBarFromSnapshot(screen *ebiten.Image, snap *r
 			currentY += lineHeight
 		}
 		// Add a small gap between objectives and inventory
-		if hasInventory || hasGenerators {
+		if hasInventory || hasGenerators || hasDeckStatus || hasRooms {
 			currentY += 2
 		}
 	}
@@ -1810,18 +2011,19 @@ func (e *EbitenRenderer) drawStatusBarFromSnapshot(screen *ebiten.Image, snap *r
 
 	// Generator status (if applicable)
 	if hasGenerators {
-		genLabel := gotext.Get("GENERATORS")
-		genText := genLabel + ": "
-		genParts := []string{}
-		for i, gen := range snap.generators {
-			if gen.powered {
-				genParts = append(genParts, fmt.Sprintf("#%d POWERED{ONLINE}", i+1))
-			} else {
-				genParts = append(genParts, fmt.Sprintf("#%d %d/%d", i+1, gen.batteriesInserted, gen.batteriesRequired))
-			}
-		}
-		genText += strings.Join(genParts, ", ")
-		e.drawColoredTextSegments(screen, e.parseMarkup(genText), x, currentY)
+		e.drawColoredTextSegments(screen, e.parseMarkup(statusBarGeneratorsMarkup(snap)), x, currentY)
+		currentY += lineHeight
+	}
+
+	// Deck-wide radiation and pressure (only while a leak, breach or residue remains)
+	if hasDeckStatus {
+		e.drawColoredTextSegments(screen, e.parseMarkup(deckStatusText), x, currentY)
+		currentY += lineHeight
+	}
+
+	// Rooms explored on this deck (toggleable in settings)
+	if hasRooms {
+		e.drawColoredTextSegments(screen, e.parseMarkup(roomsText), x, currentY)
 	}
 }
 
@@ -0,0 +1,42 @@
+package ebiten
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/config"
+)
+
+func TestShowRoomEntryIfNew_oncePerRoomAndOptIn(t *testing.T) {
+	cfg := config.DefaultConfig()
+	config.SetCurrent(cfg)
+	t.Cleanup(func() { config.SetCurrent(nil) })
+
+	e := &EbitenRenderer{}
+	if e.ShowRoomEntryIfNew(1, 0, 0, "Bridge", false) {
+		t.Fatal("the arrival room should not get a callout")
+	}
+	if e.ShowRoomEntryIfNew(1, 0, 1, "Med Bay", false) {
+		t.Fatal("callout shown with the setting off (it is opt-in)")
+	}
+
+	cfg.RoomEntryCallout = true
+	e.ShowRoomEntryIfNew(1, 1, 1, "Corridor", true)
+	if !e.ShowRoomEntryIfNew(1, 2, 1, "Cargo Bay", false) {
+		t.Fatal("first entry into Cargo Bay should show a callout")
+	}
+	if e.ShowRoomEntryIfNew(1, 2, 2, "Cargo Bay", false) {
+		t.Error("callout repeated for another cell of the same room")
+	}
+	e.ShowRoomEntryIfNew(1, 1, 1, "Corridor", true)
+	if e.ShowRoomEntryIfNew(1, 2, 1, "Cargo Bay", false) || e.ShowRoomEntryIfNew(1, 0, 1, "Med Bay", false) {
+		t.Error("callout repeated for a room already entered on this deck")
+	}
+	if len(e.callouts) != 1 {
+		t.Errorf("callouts = %d, want 1", len(e.callouts))
+	}
+
+	e.ShowRoomEntryIfNew(2, 0, 0, "Lift Shaft", false)
+	if !e.ShowRoomEntryIfNew(2, 0, 1, "Cargo Bay", false) {
+		t.Error("a new deck should announce its rooms afresh")
+	}
+}
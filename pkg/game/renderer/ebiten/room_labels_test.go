@@ -0,0 +1,41 @@
+package ebiten
+
+import (
+	"sort"
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// Rooms whose names mention "corridor" are still rooms; only flagged corridor cells go unlabelled.
+func TestComputeRoomLabels_skipsOnlyFlaggedCorridors(t *testing.T) {
+	g := state.NewGame()
+	grid := world.NewGrid(3, 7)
+	for col := 0; col < 7; col++ {
+		switch {
+		case col < 3:
+			grid.MarkAsRoomWithName(1, col, "Corridor Control", "desc")
+		case col == 3:
+			grid.MarkAsCorridor(1, col, "ROOM_CORRIDOR")
+		default:
+			grid.MarkAsRoomWithName(1, col, "Lab", "desc")
+		}
+	}
+	grid.BuildAllCellConnections()
+	grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		gameworld.InitGameData(cell)
+		cell.Discovered = cell.Room
+	})
+	g.Grid = grid
+
+	var names []string
+	for _, label := range (&EbitenRenderer{}).computeRoomLabels(g) {
+		names = append(names, label.RoomName)
+	}
+	sort.Strings(names)
+	if len(names) != 2 || names[0] != "Corridor Control" || names[1] != "Lab" {
+		t.Errorf("labels = %v, want [Corridor Control Lab]", names)
+	}
+}
@@ -4,13 +4,13 @@ package ebiten
 import (
 	"fmt"
 	"sort"
-	"strings"
 	"time"
 
 	"github.com/leonelquinteros/gotext"
 
 	engineinput 	"darkstation/pkg/engine/input"
 	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/config"
 	"darkstation/pkg/game/deck"
 	"darkstation/pkg/game/entities"
 	"darkstation/pkg/game/features"
@@ -26,6 +26,11 @@ func (e *EbitenRenderer) calculateObjectives(g *state.Game) []string {
 		return nil
 	}
 
+	// A hand-authored deck file can spell out its own objectives.
+	if len(g.DeckObjectives) > 0 {
+		return append([]string(nil), g.DeckObjectives...)
+	}
+
 	var objectives []string
 
 	// Count unpowered generators (show remaining, not total)
@@ -38,6 +43,9 @@ func (e *EbitenRenderer) calculateObjectives(g *state.Game) []string {
 			objectives = append(objectives, fmt.Sprintf(formatStr, unpoweredGenerators))
 		}
 	}
+	if needed := g.TotalBatteriesNeeded(); needed > 0 {
+		objectives = append(objectives, fmt.Sprintf("Batteries needed: %d (have %d)", needed, g.Batteries))
+	}
 
 	// Count hazards (matching showLevelObjectives logic - count remaining active hazards)
 	numHazards := 0
@@ -64,11 +72,37 @@ func (e *EbitenRenderer) calculateObjectives(g *state.Game) []string {
 		}
 	}
 
+	_, survivor := g.DeckSurvivor()
+	if survivor != nil {
+		switch {
+		case !survivor.IsFollowing():
+			objectives = append(objectives, "Find the survivor: "+survivor.Name)
+		case g.SurvivorEscorted():
+			objectives = append(objectives, "Survivor following")
+		default:
+			objectives = append(objectives, "Survivor left behind: "+survivor.Name)
+		}
+	}
+
+	// The lift gates on free watts, not on every generator running.
+	liftState := setup.ExitLiftState(g)
+	if liftState == state.ExitLiftLockedLowPower {
+		free, required := setup.ExitLiftPower(g)
+		objectives = append(objectives, fmt.Sprintf("Lift motor: %dw free of %dw needed", max(free, 0), required))
+	}
+	liftPowered := liftState != state.ExitLiftLockedLowPower &&
+		(liftState != state.ExitLiftLockedUnpowered || unpoweredGenerators == 0)
+
 	// If all objectives are complete, show exit message
-	if unpoweredGenerators == 0 && numHazards == 0 && repairsRemaining == 0 {
+	if liftPowered && numHazards == 0 && repairsRemaining == 0 && (survivor == nil || survivor.IsFollowing()) {
 		objectives = append(objectives, "FIND_LIFT") // Will be translated in drawColoredTextSegments
 	}
 
+	// Par for this exact layout, so seeded and daily runs have a target to beat.
+	if par, ok := config.Current().GetPar(g.Level, g.LevelSeed, g.ParVariant()); ok && !g.Creative() {
+		objectives = append(objectives, fmt.Sprintf("SUBTLE{Par: %d actions}", par))
+	}
+
 	return objectives
 }
 
@@ -104,12 +138,23 @@ func (e *EbitenRenderer) RenderFrame(g *state.Game) {
 	e.snapshot.valid = true
 	e.snapshot.level = g.Level
 	e.snapshot.perfMapScenario = g.PerfMapScenario
+	e.snapshot.endless = g.Mode().Endless
+	e.snapshot.creative = g.Creative()
+	e.snapshot.endlessScore = g.EndlessScore
 	e.snapshot.deckTitle = deck.ThemeDisplayName(g.ThemeForCurrentDeck())
+	e.snapshot.deckName = g.DeckName
 	e.snapshot.playerRow = g.CurrentCell.Row
 	e.snapshot.playerCol = g.CurrentCell.Col
 	e.snapshot.playerFacing = g.PlayerFacing
 	e.snapshot.cellName = g.CurrentCell.Name
 	e.snapshot.hasMap = g.HasMap
+	e.snapshot.generatorBadges = config.Current().GeneratorBadges
+	e.snapshot.reduceMotion = config.Current().ReduceMotion
+	e.snapshot.roomProgress = config.Current().RoomProgress
+	e.snapshot.cameraFollow = config.Current().CameraFollow
+	if e.snapshot.roomProgress {
+		e.snapshot.roomsExplored, e.snapshot.roomsTotal = setup.RoomExplorationProgress(g.Grid)
+	}
 	e.snapshot.batteries = g.Batteries
 	e.snapshot.gridRows = g.Grid.Rows()
 	e.snapshot.gridCols = g.Grid.Cols()
@@ -140,16 +185,22 @@ func (e *EbitenRenderer) RenderFrame(g *state.Game) {
 	})
 	sort.Strings(e.snapshot.runKeycards)
 
-	// Copy generator states
-	e.snapshot.generators = make([]generatorState, len(g.Generators))
-	for i, gen := range g.Generators {
-		e.snapshot.generators[i] = generatorState{
-			powered:           gen.IsPowered(),
-			batteriesInserted: gen.BatteriesInserted,
-			batteriesRequired: gen.BatteriesRequired,
+	// Copy generator counts, grouped by the room they stand in
+	rooms := setup.GeneratorsByRoom(g)
+	e.snapshot.generatorRooms = make([]generatorRoomState, len(rooms))
+	for i, r := range rooms {
+		e.snapshot.generatorRooms[i] = generatorRoomState{
+			room:    r.Room,
+			powered: r.Powered,
+			total:   r.Total,
 		}
 	}
 
+	// Deck-wide environmental status
+	e.snapshot.deckRadiation = g.DeckRadiation.Level
+	e.snapshot.radiationLeaks = g.ActiveHazardCount(entities.HazardRadiation)
+	e.snapshot.pressureBreaches = g.ActiveHazardCount(entities.HazardVacuum)
+
 	// Calculate objectives
 	e.snapshot.objectives = e.refreshObjectives(g)
 
@@ -181,6 +232,9 @@ func (e *EbitenRenderer) RenderFrame(g *state.Game) {
 	}
 	e.calloutsMutex.RUnlock()
 
+	// Next-in-cycle interactable keeps the focus background after its callout expires.
+	e.snapshot.cycleNextRow, e.snapshot.cycleNextCol, e.snapshot.hasCycleNext = g.InteractCycleNext()
+
 	// Find interactable cells adjacent to player (for focus background)
 	e.snapshot.interactableCells = make([]struct {
 		row int
@@ -241,6 +295,7 @@ func (e *EbitenRenderer) RenderFrame(g *state.Game) {
 	}
 
 	e.snapshotDevicePulses(nowUnixMilli)
+	e.snapshotPowerUps(nowUnixMilli)
 
 	e.snapshot.slimePops = e.snapshot.slimePops[:0]
 	for _, pop := range g.SlimePops {
@@ -288,6 +343,11 @@ func (e *EbitenRenderer) RenderFrame(g *state.Game) {
 		e.snapshot.generatorShutdownCol = -1
 	}
 
+	e.snapshot.objectiveRoute = objectiveRouteKeyMap(g.ObjectiveRoute)
+	e.snapshot.mapPins = mapPinKeyMap(g.MapPins)
+	e.snapshot.patrolCone = patrolConeKeyMap(g)
+	e.snapshot.powerSurge = g.PowerSurge
+
 	if g.HazardClear != nil {
 		s := *g.HazardClear
 		e.snapshot.hazardClear = &s
@@ -307,6 +367,7 @@ func (e *EbitenRenderer) RenderFrame(g *state.Game) {
 	}
 
 	capturePowerGridSnapshot(g, &e.snapshot)
+	capturePowerDiagnosticsSnapshot(g, &e.snapshot)
 	e.refreshMapPowerSnapshot(g, &e.snapshot)
 }
 
@@ -329,7 +390,7 @@ func (e *EbitenRenderer) computeRoomLabels(g *state.Game) []roomLabel {
 				continue
 			}
 			// Never label corridors
-			if strings.Contains(strings.ToLower(cell.Name), "corridor") {
+			if cell.IsCorridor {
 				continue
 			}
 			if cell.Visited && features.VisitedSystemEnabled() {
@@ -363,7 +424,7 @@ func (e *EbitenRenderer) computeRoomLabels(g *state.Game) []roomLabel {
 
 			roomName := cell.Name
 			// Skip corridors and unvisited rooms
-			if strings.Contains(strings.ToLower(roomName), "corridor") || !roomVisited[roomName] {
+			if cell.IsCorridor || !roomVisited[roomName] {
 				continue
 			}
 
@@ -394,7 +455,7 @@ func (e *EbitenRenderer) computeRoomLabels(g *state.Game) []roomLabel {
 				hasGap = true // Edge of map
 			} else {
 				aboveCell := g.Grid.GetCell(labelRow, leftmostCol)
-				if aboveCell == nil || !aboveCell.Room || strings.Contains(strings.ToLower(aboveCell.Name), "corridor") {
+				if aboveCell == nil || !aboveCell.Room || aboveCell.IsCorridor {
 					hasGap = true
 				}
 			}
@@ -457,7 +518,7 @@ func (e *EbitenRenderer) computeEnvPlaques(g *state.Game) []envPlaque {
 		if data.EnvPlaqueMsgID == "" {
 			return
 		}
-		out = append(out, envPlaque{Row: row, Col: col, MsgID: data.EnvPlaqueMsgID})
+		out = append(out, envPlaque{Row: row, Col: col, MsgID: data.EnvPlaqueMsgID, Arg: data.EnvPlaqueArg})
 	})
 	return out
 }
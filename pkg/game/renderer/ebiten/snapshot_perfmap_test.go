@@ -49,3 +49,23 @@ func TestRenderFrameSnapshot_perfMapHeader(t *testing.T) {
 		t.Fatalf("header = %q, want perfmap mixed", got)
 	}
 }
+
+func TestRenderFrameSnapshot_deckFileOverrides(t *testing.T) {
+	e := &EbitenRenderer{}
+	g := state.NewGame()
+	g.Grid = world.NewGrid(3, 3)
+	g.CurrentCell = g.Grid.GetCell(1, 1)
+	g.DeckName = "Tutorial: Power"
+	g.DeckObjectives = []string{"Start the generator", "Ride the lift"}
+
+	e.RenderFrame(g)
+
+	e.snapshotMutex.Lock()
+	defer e.snapshotMutex.Unlock()
+	if got := deckHeaderText(&e.snapshot); got != "Tutorial: Power" {
+		t.Fatalf("header = %q, want the deck file's name", got)
+	}
+	if got := e.snapshot.objectives; len(got) != 2 || got[0] != "Start the generator" {
+		t.Fatalf("objectives = %v, want the deck file's list", got)
+	}
+}
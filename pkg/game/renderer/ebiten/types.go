@@ -2,6 +2,7 @@
 package ebiten
 
 import (
+	"image"
 	"image/color"
 	"sync"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
 
 	engineinput "darkstation/pkg/engine/input"
+	"darkstation/pkg/engine/world"
 	gamemenu "darkstation/pkg/game/menu"
 	"darkstation/pkg/game/state"
 )
@@ -52,6 +54,7 @@ type envPlaque struct {
 	Row   int
 	Col   int
 	MsgID string
+	Arg   string // Fills the msgid text's %s when set
 }
 
 // renderSnapshot holds a consistent snapshot of game state for rendering
@@ -61,16 +64,29 @@ type renderSnapshot struct {
 	seq               uint64
 	level             int
 	deckTitle         string // Theme display name (e.g. "Airlock")
+	deckName          string // Name from a hand-authored deck file; replaces the whole deck header
 	perfMapScenario   string // Non-empty on console perfmap layouts
+	endlessScore      int    // Cumulative score; shown in the header when endless is set
+	endless           bool
+	creative          bool
 	playerRow         int
 	playerCol         int
 	playerFacing      state.PlayerFacing
 	cellName          string
 	hasMap            bool
+	cameraFollow      string
+	generatorBadges   bool // Draw batteries still needed on unpowered generator tiles
+	reduceMotion      bool // Hold the exit pulse, bump bounce, callout slides and shimmer still
+	roomProgress      bool // Show the rooms-explored line in the status panel
+	roomsExplored     int  // Named rooms with at least one visited cell
+	roomsTotal        int  // Named rooms on the deck (corridors excluded)
 	batteries         int
 	ownedItems        []string
 	runKeycards       []string
-	generators        []generatorState
+	generatorRooms    []generatorRoomState
+	deckRadiation     int // Deck radiation meter, 0..state.DeckRadiationMax
+	radiationLeaks    int // Uncleared radiation leaks on the deck
+	pressureBreaches  int // Uncleared vacuum breaches on the deck
 	gridRows          int
 	gridCols          int
 	callouts          []Callout
@@ -81,6 +97,9 @@ type renderSnapshot struct {
 	exitAnimStartTime int64    // Timestamp when exit animation started
 	focusedCellRow    int      // Row of cell with active callout (for focus background)
 	focusedCellCol    int      // Col of cell with active callout (for focus background)
+	hasCycleNext      bool     // A next-in-cycle interactable is tracked (focus background)
+	cycleNextRow      int      // Row of the next-in-cycle interactable
+	cycleNextCol      int      // Col of the next-in-cycle interactable
 	interactableCells []struct {
 		row int
 		col int
@@ -98,8 +117,14 @@ type renderSnapshot struct {
 	hazardClear             *state.HazardClearSession
 	hazardTour              *state.HazardTourSession
 	powerGrid               powerGridSnapshot
+	powerDiag               powerDiagnosticsSnapshot
 	mapPower                mapPowerSnapshot
 	devicePulses            []devicePulseSnapshot
+	powerUps                []powerUpSnapshot
+	objectiveRoute          map[uint64]bool // Hint-traced path cells to the next objective
+	mapPins                 map[uint64]bool // Cells carrying a player map pin
+	patrolCone              map[uint64]bool // Cells the patrol bot can see (with the Map)
+	powerSurge              state.PowerSurge
 }
 
 type repairDrainSnapshot struct {
@@ -120,6 +145,7 @@ type mapPowerSnapshot struct {
 	roomDoorsPowered           map[string]bool
 	roomCCTVPowered            map[string]bool
 	manualEgressReleased       map[string]bool
+	roomLightLevel             map[string]float64 // Dimmed rooms on a strained grid (absent = full)
 	maintenanceMenuRoom        string
 	maintenanceSelectableRooms []string
 }
@@ -138,11 +164,11 @@ type powerGridSnapshot struct {
 	fedRooms            map[string]bool
 }
 
-// generatorState holds generator info for rendering
-type generatorState struct {
-	powered           bool
-	batteriesInserted int
-	batteriesRequired int
+// generatorRoomState holds one room's generator counts for the status bar
+type generatorRoomState struct {
+	room    string
+	powered int
+	total   int
 }
 
 // CellRenderOptions describes how a cell should be drawn on the map.
@@ -180,6 +206,11 @@ type EbitenRenderer struct {
 	// Cached font faces (recreated when tile size changes)
 	cachedTileFontSize      float64
 	cachedUIFontSize        float64
+	uiScale                 float64 // Text Size setting last applied to UI fonts (see syncUIScale)
+	tileSizeSetting         int     // Map Zoom setting last applied to tileSize (see syncTileSize)
+	maxViewportCols         int     // Max Viewport caps last applied to the viewport (see syncViewportCaps)
+	maxViewportRows         int     // Row cap last applied, as maxViewportCols
+	colorblindMode          string  // Colorblind Mode setting last applied to the palette (see syncColorblindPalette)
 	cachedMonoUIFontSize    float64
 	cachedMonoFace          *text.GoTextFace
 	cachedSansFace          *text.GoTextFace
@@ -208,10 +239,23 @@ type EbitenRenderer struct {
 	devicePulses     map[uint64]int64
 	devicePulseMutex sync.Mutex
 
+	// Generator power-ups: cellCoordKey -> effect (guarded by powerUpMutex)
+	powerUps     map[uint64]powerUpEffect
+	powerUpMutex sync.Mutex
+
+	// Sound effects (guards itself; see audio.go)
+	sounds soundBank
+
+	// Light fades: cellCoordKey -> last drawn look (draw thread only; reset per grid)
+	tileLooks    map[uint64]tileLook
+	tileLooksFor *world.Grid
+
 	// Track last player position to clear callouts on move
 	lastPlayerRow      int
 	lastPlayerCol      int
 	lastRoomName       string
+	enteredRooms       map[string]bool // Rooms entered on enteredRoomsLevel (room entry callout)
+	enteredRoomsLevel  int
 	lastPosInitialized bool
 
 	// Input channel for communication between Ebiten and game loop
@@ -295,7 +339,7 @@ type EbitenRenderer struct {
 	maintPanDrawCount int
 
 	// maintPanCameraTweenActive tracks an in-flight maintenance-camera ease so debug.maint_pan can
-	// emit a single COMPLETE line when smootherstep finishes (or clears when exiting maintenance UI).
+	// emit a single COMPLETE line when the pan ease finishes (or clears when exiting maintenance UI).
 	maintPanCameraTweenActive bool
 
 	// Analog stick state tracking (for edge detection)
@@ -313,12 +357,13 @@ type EbitenRenderer struct {
 	keyRepeatStateMutex sync.RWMutex
 
 	// Debounce animation state (for failed movement attempts)
-	debounceDirection string // "north", "south", "east", "west"
+	debounceDirection string // "north", "south", "east", "west", or a diagonal such as "northeast"
 	debounceStartTime int64  // Timestamp when debounce started (milliseconds)
 	debounceMutex     sync.RWMutex
 
 	playerFacingRot playerFacingRotation
 	playerMove      playerMoveTransition
+	camera          cameraFollow
 
 	// Camera transition state (smooth pan when focusing on room in select room dialog)
 	cameraCenterRow           float64
@@ -336,6 +381,7 @@ type EbitenRenderer struct {
 	mapBufferWidth  int
 	mapBufferHeight int
 	mapDrawCache    mapDrawCache
+	mapClick        mapClickLayout // where drawMap last placed the map, for click-to-walk
 
 	// snapSeq increments each RenderFrame; map draw cache uses it to skip redundant buffer fills
 	// when Ebiten calls Draw() more than once per game tick.
@@ -357,6 +403,10 @@ type EbitenRenderer struct {
 	floatingTilesScreenH   int
 	floatingTilesMutex     sync.RWMutex
 
+	// Last recovered panic from Update, Draw or the game loop (see recover.go)
+	fault      rendererFault
+	faultMutex sync.Mutex
+
 	// Developer message (bottom-left overlay; e.g. map dump confirmation)
 	developerMessage      string
 	developerMessageAt    int64
@@ -372,6 +422,12 @@ type EbitenRenderer struct {
 	fovRayDebugEnabled bool
 	devDebugMutex      sync.RWMutex
 
+	// Console render benchmark (see benchmark.go). benchPendingSeconds is set from the game
+	// loop; bench is only touched on the Ebiten thread.
+	benchPendingSeconds float64
+	bench               *renderBenchmark
+	benchMutex          sync.Mutex
+
 	// Console state
 	consoleActive        bool
 	consoleText          string   // Current input text
@@ -418,6 +474,17 @@ type mapDrawCache struct {
 	animBucket int64
 }
 
+// mapClickLayout records where drawMap last placed the map on screen so mouse clicks
+// can be turned back into map cells.
+type mapClickLayout struct {
+	valid              bool
+	originX, originY   float64
+	startRow, startCol int
+	rows, cols         int
+	tileSize           int
+	area               image.Rectangle // letterboxed map area; clicks on the bars miss
+}
+
 type glyphMetrics struct {
 	w float64
 	h float64
@@ -440,8 +507,12 @@ type roomLabelsCacheKey struct {
 
 type objectivesCacheKey struct {
 	level, interactionsCount int
+	levelSeed                int64
 	unpoweredGenerators      int
+	batteriesNeeded          int // Refreshes the batteries line as batteries are picked up or inserted
+	batteries                int
 	repairSignature          string
+	deckName                 string
 }
 
 type envPlaquesCacheKey struct {
@@ -1,9 +1,11 @@
 package ebiten
 
 import (
+	"math"
 	"testing"
 
 	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/config"
 	"darkstation/pkg/game/state"
 )
 
@@ -110,3 +112,45 @@ func TestMapCameraScreenOrigin_playerCentered(t *testing.T) {
 		t.Fatalf("player screen = %.0f,%.0f want 512,384", playerScreenX, playerScreenY)
 	}
 }
+
+func TestRestoredTileSize(t *testing.T) {
+	tests := []struct {
+		saved, want int
+	}{
+		{0, defaultTileSize},
+		{-40, defaultTileSize},
+		{maxTileSize + 1, defaultTileSize},
+		{minTileSize, minTileSize},
+		{maxTileSize, maxTileSize},
+		{48, 48},
+		{49, 48},
+		{50, 52},
+	}
+	for _, tc := range tests {
+		if got := restoredTileSize(tc.saved); got != tc.want {
+			t.Errorf("restoredTileSize(%d) = %d, want %d", tc.saved, got, tc.want)
+		}
+	}
+}
+
+func TestCameraEase_curves(t *testing.T) {
+	for _, easing := range config.CameraEasings {
+		if cameraEase(easing, 0) != 0 || cameraEase(easing, 1) != 1 || cameraEase(easing, 2) != 1 {
+			t.Errorf("%s should map 0→0 and clamp at 1", easing)
+		}
+		if got := cameraEase(easing, 0.5); math.Abs(got-0.5) > 1e-9 {
+			t.Errorf("%s(0.5) = %v, want 0.5 (symmetric curve)", easing, got)
+		}
+	}
+	if got := cameraEase(config.CameraEasingLinear, 0.25); got != 0.25 {
+		t.Errorf("linear(0.25) = %v, want 0.25", got)
+	}
+	inOut := cameraEase(config.CameraEasingEaseInOut, 0.25)
+	quintic := cameraEase(config.CameraEasingQuintic, 0.25)
+	if !(quintic < 0.25 && inOut < 0.25) {
+		t.Errorf("eased curves should start slower than linear: ease_in_out=%v quintic=%v", inOut, quintic)
+	}
+	if got := cameraEase("bogus", 0.25); got != quintic {
+		t.Errorf("unknown easing = %v, want quintic fallback %v", got, quintic)
+	}
+}
@@ -230,9 +230,9 @@ type CalloutRenderer interface {
 	// SetDebounceAnimation triggers a debounce animation in the given direction
 	SetDebounceAnimation(direction string)
 
-	// ShowRoomEntryIfNew shows a room entry callout if the player entered a new room
-	// Returns true if a callout was shown
-	ShowRoomEntryIfNew(row, col int, roomName string) bool
+	// ShowRoomEntryIfNew shows a room entry callout if the player entered a room on
+	// deck level they had not entered before. Returns true if a callout was shown
+	ShowRoomEntryIfNew(level, row, col int, roomName string, corridor bool) bool
 }
 
 // Callout colors for different message types (matching cell colors)
@@ -269,6 +269,33 @@ func AddDevicePulse(row, col int) {
 	}
 }
 
+// PowerUpRenderer is an optional interface for renderers that can animate a
+// generator coming online: the glyph ramps to powered and light sweeps through
+// the rooms it just brought online.
+type PowerUpRenderer interface {
+	AddPowerUp(row, col int, rooms []string)
+}
+
+// AddPowerUp starts the power-up animation for the generator at (row, col).
+func AddPowerUp(row, col int, rooms []string) {
+	if pr, ok := Current.(PowerUpRenderer); ok {
+		pr.AddPowerUp(row, col, rooms)
+	}
+}
+
+// FaultReporter is an optional interface for renderers that can tell the player a
+// panic was recovered (e.g. one game tick failed and the run returned to the title).
+type FaultReporter interface {
+	ReportFault(where, message string)
+}
+
+// ReportFault shows a recovered error on the active renderer, if it supports that.
+func ReportFault(where, message string) {
+	if fr, ok := Current.(FaultReporter); ok {
+		fr.ReportFault(where, message)
+	}
+}
+
 // AddCallout adds a callout if the current renderer supports it
 func AddCallout(row, col int, message string, c color.Color, durationMs int) {
 	if cr, ok := Current.(CalloutRenderer); ok {
@@ -299,9 +326,9 @@ func ClearCalloutsIfMoved(row, col int) bool {
 }
 
 // ShowRoomEntryIfNew shows a room entry callout if the player entered a new room
-func ShowRoomEntryIfNew(row, col int, roomName string) bool {
+func ShowRoomEntryIfNew(level, row, col int, roomName string, corridor bool) bool {
 	if cr, ok := Current.(CalloutRenderer); ok {
-		return cr.ShowRoomEntryIfNew(row, col, roomName)
+		return cr.ShowRoomEntryIfNew(level, row, col, roomName, corridor)
 	}
 	return false
 }
@@ -20,6 +20,7 @@ const (
 	InventoryDepictionKeyBattery InventoryDepictionKey = "battery"
 	InventoryDepictionKeyMap    InventoryDepictionKey = "map"
 	InventoryDepictionKeyItem   InventoryDepictionKey = "item"
+	InventoryDepictionKeyFloor  InventoryDepictionKey = "floor"
 )
 
 // InventoryDepiction describes how an inventory entry appears on the grid.
@@ -0,0 +1,114 @@
+package renderer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RoomFloorFamily groups room types that share a floor glyph, so a room's function can
+// be read from its floor texture before entering.
+type RoomFloorFamily struct {
+	Name        string
+	Description string
+	Visited     string // Floor glyph once the player has walked the room
+	Unvisited   string // Floor glyph before the player has walked the room
+	Rooms       []string
+	Structural  bool // Connective spaces rather than a room function
+}
+
+// RoomFloorFamilies is the source of the per-room floor glyphs and of the in-game
+// floor guide. Rooms match by substring of the cell's room name.
+var RoomFloorFamilies = []RoomFloorFamily{
+	{
+		Name:        "Command",
+		Description: "Station control and security posts",
+		Visited:     "◎", Unvisited: "◉",
+		Rooms: []string{"Bridge", "Command Center", "Communications", "Security"},
+	},
+	{
+		Name:        "Technical",
+		Description: "Power, systems, and machinery; likely repairs and terminals",
+		Visited:     "▫", Unvisited: "▪",
+		Rooms: []string{"Engineering", "Reactor Core", "Server Room", "Maintenance Bay", "Life Support"},
+	},
+	{
+		Name:        "Storage",
+		Description: "Holds and lockers; good odds of loose supplies",
+		Visited:     "*", Unvisited: ":", // ASCII: matches mono fallback coverage
+		Rooms: []string{"Cargo Bay", "Storage", "Hangar", "Armory"},
+	},
+	{
+		Name:        "Science",
+		Description: "Medical and research spaces",
+		Visited:     "◇", Unvisited: "◆",
+		Rooms: []string{"Med Bay", "Lab", "Hydroponics", "Observatory"},
+	},
+	{
+		Name:        "Living",
+		Description: "Crew accommodation and galley",
+		Visited:     "○", Unvisited: "●", // Larger circles for visibility
+		Rooms: []string{"Crew Quarters", "Mess Hall"},
+	},
+	{
+		Name:        "Airlock",
+		Description: "Pressure doors to the outside",
+		Visited:     "╳", Unvisited: "╳",
+		Rooms:      []string{"Airlock"},
+		Structural: true,
+	},
+	{
+		Name:        "Corridor",
+		Description: "Passageways between rooms",
+		Visited:     "░", Unvisited: "░",
+		Rooms:      []string{"Corridor"},
+		Structural: true,
+	},
+	{
+		Name:        "Lift shaft",
+		Description: "Core lift hub (mesh grating) linking the decks",
+		Visited:     "▦", Unvisited: "▦",
+		Rooms:      []string{"Lift Shaft"},
+		Structural: true,
+	},
+	{
+		Name:        "Ship",
+		Description: "Your vessel, docked on deck 1",
+		Visited:     "⬢", Unvisited: "⬡",
+		Rooms:      []string{"Ship"},
+		Structural: true,
+	},
+}
+
+// RoomFloorIcons flattens RoomFloorFamilies into base room name -> {visited, unvisited}.
+func RoomFloorIcons() map[string][2]string {
+	icons := make(map[string][2]string)
+	for _, fam := range RoomFloorFamilies {
+		for _, room := range fam.Rooms {
+			icons[room] = [2]string{fam.Visited, fam.Unvisited}
+		}
+	}
+	return icons
+}
+
+// RoomFloorFamilyFor returns the family whose room names appear in roomName, matching
+// rooms the same way the floor glyphs do.
+func RoomFloorFamilyFor(roomName string) (RoomFloorFamily, bool) {
+	for _, fam := range RoomFloorFamilies {
+		for _, room := range fam.Rooms {
+			if strings.Contains(roomName, room) {
+				return fam, true
+			}
+		}
+	}
+	return RoomFloorFamily{}, false
+}
+
+// RoomEntryCalloutText is the callout shown when the player first walks into a room:
+// the room name, then its family and what that kind of room holds when it has one.
+func RoomEntryCalloutText(roomName string) string {
+	text := fmt.Sprintf("Entering ROOM{%s}", roomName)
+	if fam, ok := RoomFloorFamilyFor(roomName); ok {
+		text += fmt.Sprintf("\nSUBTLE{%s: %s}", fam.Name, fam.Description)
+	}
+	return text
+}
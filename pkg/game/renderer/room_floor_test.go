@@ -0,0 +1,32 @@
+package renderer
+
+import "testing"
+
+func TestRoomFloorIcons_coversEveryFamilyRoom(t *testing.T) {
+	icons := RoomFloorIcons()
+	total := 0
+	for _, fam := range RoomFloorFamilies {
+		for _, room := range fam.Rooms {
+			total++
+			got, ok := icons[room]
+			if !ok {
+				t.Fatalf("room %q missing from icon map", room)
+			}
+			if got != [2]string{fam.Visited, fam.Unvisited} {
+				t.Errorf("room %q icons = %v, want family %q glyphs", room, got, fam.Name)
+			}
+		}
+	}
+	if total != len(icons) {
+		t.Errorf("room listed in more than one family: %d rows, %d unique rooms", total, len(icons))
+	}
+}
+
+func TestRoomEntryCalloutText_namesFamily(t *testing.T) {
+	if got, want := RoomEntryCalloutText("Forward Engineering"), "Entering ROOM{Forward Engineering}\nSUBTLE{Technical: Power, systems, and machinery; likely repairs and terminals}"; got != want {
+		t.Errorf("callout = %q, want %q", got, want)
+	}
+	if got, want := RoomEntryCalloutText("Chapel"), "Entering ROOM{Chapel}"; got != want {
+		t.Errorf("callout for a room outside every family = %q, want %q", got, want)
+	}
+}
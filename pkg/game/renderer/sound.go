@@ -0,0 +1,45 @@
+package renderer
+
+// Sound identifies a short sound effect played on a key gameplay event.
+type Sound int
+
+const (
+	SoundPickup        Sound = iota // an item was picked up
+	SoundBatteryInsert              // batteries went into a generator that still needs more
+	SoundGeneratorOn                // a generator came online
+	SoundDoorUnlock                 // a locked door was opened
+	SoundHazardCleared              // a hazard was cleared
+	SoundExit                       // the lift carried the player to another deck
+	SoundPowerWarning               // a power grid first went into overload
+)
+
+// SoundPlayer is an optional interface for renderers that can play sound effects.
+// Without one (headless tests, simulations) every sound call is a no-op.
+type SoundPlayer interface {
+	PlaySound(s Sound)
+	SetMuted(muted bool)
+	// SetAmbientHum starts or stops the looping power hum. Repeating the current
+	// state is a no-op; stopping releases the loop's player.
+	SetAmbientHum(on bool)
+}
+
+// PlaySound plays s if the current renderer supports sound.
+func PlaySound(s Sound) {
+	if sp, ok := Current.(SoundPlayer); ok {
+		sp.PlaySound(s)
+	}
+}
+
+// SetMuted silences (or restores) sound effects on the current renderer.
+func SetMuted(muted bool) {
+	if sp, ok := Current.(SoundPlayer); ok {
+		sp.SetMuted(muted)
+	}
+}
+
+// SetAmbientHum starts or stops the looping power hum if the current renderer supports sound.
+func SetAmbientHum(on bool) {
+	if sp, ok := Current.(SoundPlayer); ok {
+		sp.SetAmbientHum(on)
+	}
+}
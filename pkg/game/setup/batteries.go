@@ -3,7 +3,6 @@ package setup
 
 import (
 	"darkstation/pkg/game/gamemode"
-	"darkstation/pkg/game/levelrand"
 
 	"github.com/zyedidia/generic/mapset"
 
@@ -34,7 +33,7 @@ func placeBatteriesForGenerators(g *state.Game, avoid *mapset.Set[*world.Cell],
 		return
 	}
 
-	totalBatteries := demand + prefs.ExtraBatteryRoll(levelrand.Intn)
+	totalBatteries := demand + prefs.ExtraBatteryRoll(g.RNG().Intn)
 
 	for i := 0; i < totalBatteries; i++ {
 		battery := world.NewItem("Battery")
@@ -43,6 +42,7 @@ func placeBatteriesForGenerators(g *state.Game, avoid *mapset.Set[*world.Cell],
 }
 
 // UnpoweredGeneratorBatteryDemand sums remaining battery slots on unpowered grid generators.
+// Sequenced generators are left out: they take their own tagged batteries.
 func UnpoweredGeneratorBatteryDemand(g *state.Game) int {
 	return unpoweredGeneratorBatteryDemand(g)
 }
@@ -57,7 +57,7 @@ func unpoweredGeneratorBatteryDemand(g *state.Game) int {
 			return
 		}
 		gen := gameworld.GetGameData(cell).Generator
-		if gen == nil || gen.IsPowered() {
+		if gen == nil || gen.IsPowered() || gen.IsSequenced() {
 			return
 		}
 		total += gen.BatteriesNeeded()
@@ -7,6 +7,7 @@ import (
 
 	"darkstation/pkg/engine/world"
 	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/generator"
 	"darkstation/pkg/game/state"
 	gameworld "darkstation/pkg/game/world"
 )
@@ -93,7 +94,7 @@ func TestPlaceBatteries_Level5PlacesBatteries(t *testing.T) {
 	avoid.Put(g.Grid.ExitCell())
 
 	InitRoomPower(g)
-	placeSpawnGenerator(g, &avoid)
+	placeSpawnGenerator(g, &avoid, generator.DifficultyParamsFor(g.Mode().Difficulty))
 	PlaceAdditionalGenerators(g, &avoid)
 
 	placeBatteries(g, &avoid)
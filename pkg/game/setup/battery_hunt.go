@@ -6,9 +6,7 @@ import (
 	"github.com/zyedidia/generic/mapset"
 
 	"darkstation/pkg/engine/world"
-	"darkstation/pkg/game/entities"
 	"darkstation/pkg/game/generator"
-	"darkstation/pkg/game/levelrand"
 	"darkstation/pkg/game/renderer"
 	"darkstation/pkg/game/state"
 	gameworld "darkstation/pkg/game/world"
@@ -31,7 +29,7 @@ func SetupBatteryHuntLevel(g *state.Game) *SetupConfig {
 	lockedDoorCells := mapset.New[*world.Cell]()
 	InitRoomPower(g)
 
-	required := g.Mode().LevelGen.BatteryHuntRequiredRoll(levelrand.Intn)
+	required := g.Mode().LevelGen.BatteryHuntRequiredRoll(g.RNG().Intn)
 	placeBatteryHuntGenerator(g, &avoid, required)
 	scatterBatteryHuntLoot(g, &avoid, required)
 
@@ -66,7 +64,7 @@ func placeBatteryHuntGenerator(g *state.Game, avoid *mapset.Set[*world.Cell], ba
 		return
 	}
 
-	gen := entities.NewGenerator("Generator #1", batteriesRequired)
+	gen := newRatedGenerator("Generator #1", batteriesRequired)
 	gameworld.GetGameData(cell).Generator = gen
 	g.AddGenerator(gen)
 	avoid.Put(cell)
@@ -12,11 +12,11 @@ import (
 )
 
 func TestSetupBatteryHuntLevel_PlacesGeneratorAndBatteries(t *testing.T) {
-	levelrand.Seed(42)
 	g := state.NewGame()
+	g.Rand = levelrand.New(42)
 	g.SetMode(gamemode.FindTheBatteries)
 	g.Level = 1
-	g.Grid = generator.BSP.GenerateWithOptions(1, g.ThemeForDeck(0), generator.GenerateOptionsFromMode(g.Mode()))
+	g.Grid = generator.BSP.GenerateWithOptions(1, g.ThemeForDeck(0), generator.GenerateOptionsFromMode(g.Mode()), g.RNG())
 
 	SetupBatteryHuntLevel(g)
 
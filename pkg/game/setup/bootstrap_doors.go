@@ -29,7 +29,7 @@ func initPocketBootstrapDoors(g *state.Game) []*world.Cell {
 			return
 		}
 		for _, n := range cell.GetNeighbors() {
-			if n == nil || !n.Room || n.Name == "" || n.Name == "Corridor" {
+			if n == nil || !n.Room || n.Name == "" || n.IsCorridor {
 				continue
 			}
 			if initRooms[n.Name] {
@@ -0,0 +1,65 @@
+package setup
+
+import (
+	"github.com/zyedidia/generic/mapset"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// brownoutWattsPerLitCell is how many partial watts buy one cell of brownout light radius,
+// so one of three batteries in a standard generator (33w) lights three steps around it.
+const brownoutWattsPerLitCell = 10
+
+// BrownoutLightRadius returns how many steps a partly fueled generator's emergency lights
+// reach (0 when it gives no partial output).
+func BrownoutLightRadius(gen *entities.Generator) int {
+	return gen.PartialOutput() / brownoutWattsPerLitCell
+}
+
+// BrownoutLitCells returns the cells lit by partly fueled generators: a walk of up to
+// BrownoutLightRadius steps through the generator's own room (or corridor), stopping at
+// doors and grid-blocking fixtures like local generator feed does. Brownout power never
+// arms door or CCTV circuits; it only keeps a pool of light around the generator.
+func BrownoutLitCells(g *state.Game) *mapset.Set[*world.Cell] {
+	lit := mapset.New[*world.Cell]()
+	if g == nil || g.Grid == nil || !anyBrownoutGenerator(g) {
+		return &lit
+	}
+	for _, seed := range generatorCellsOnGrid(g) {
+		radius := BrownoutLightRadius(gameworld.GetGameData(seed).Generator)
+		if radius <= 0 {
+			continue
+		}
+		lit.Put(seed)
+		frontier := []*world.Cell{seed}
+		for step := 0; step < radius && len(frontier) > 0; step++ {
+			var next []*world.Cell
+			for _, cur := range frontier {
+				for _, n := range cur.GetNeighbors() {
+					if n == nil || lit.Has(n) || !CanTraverseCellForLocalGeneratorFeed(g, n) {
+						continue
+					}
+					if seed.Name != "" && seed.Name != world.CorridorName && n.Name != seed.Name {
+						continue
+					}
+					lit.Put(n)
+					next = append(next, n)
+				}
+			}
+			frontier = next
+		}
+	}
+	return &lit
+}
+
+func anyBrownoutGenerator(g *state.Game) bool {
+	for _, gen := range g.Generators {
+		if BrownoutLightRadius(gen) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+// Package setup provides level setup functionality for The Dark Station.
+package setup
+
+import (
+	"github.com/zyedidia/generic/mapset"
+
+	"darkstation/pkg/engine/world"
+)
+
+// CutIndex answers "how many cells stay reachable from start if this one cell is
+// blocked?" for every cell at once. It is built with a single depth-first search
+// (articulation points with subtree sizes) instead of one BFS per queried cell.
+// An index stays valid while the grid's walkable cells and the blocked set are unchanged.
+type CutIndex struct {
+	reachable int
+	order     map[*world.Cell]int
+	lost      map[*world.Cell]int
+}
+
+// NewCutIndex builds the index for cells reachable from start through walkable cells,
+// treating blocked cells as walls (the same walk as getReachableCells).
+func NewCutIndex(start *world.Cell, blocked *mapset.Set[*world.Cell]) *CutIndex {
+	idx := &CutIndex{
+		order: make(map[*world.Cell]int),
+		lost:  make(map[*world.Cell]int),
+	}
+	passable := func(c *world.Cell) bool {
+		return c != nil && c.Room && (blocked == nil || !blocked.Has(c))
+	}
+	if !passable(start) {
+		return idx
+	}
+
+	type frame struct {
+		cell, parent *world.Cell
+		next         int
+	}
+	low := make(map[*world.Cell]int)
+	size := make(map[*world.Cell]int)
+	visit := func(c *world.Cell) {
+		n := len(idx.order) + 1
+		idx.order[c] = n
+		low[c] = n
+		size[c] = 1
+	}
+	visit(start)
+	stack := []frame{{cell: start}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if top.next < 4 {
+			cur := top.cell
+			n := [4]*world.Cell{cur.North, cur.East, cur.South, cur.West}[top.next]
+			top.next++
+			if !passable(n) || n == top.parent {
+				continue
+			}
+			if d, seen := idx.order[n]; seen {
+				low[cur] = min(low[cur], d)
+				continue
+			}
+			visit(n)
+			stack = append(stack, frame{cell: n, parent: cur})
+			continue
+		}
+
+		// Subtree finished: fold it into the parent. When the subtree cannot climb above
+		// the parent, blocking the parent strands every cell in it.
+		cur, parent := top.cell, top.parent
+		stack = stack[:len(stack)-1]
+		if parent == nil {
+			continue
+		}
+		size[parent] += size[cur]
+		low[parent] = min(low[parent], low[cur])
+		if low[cur] >= idx.order[parent] {
+			idx.lost[parent] += size[cur]
+		}
+	}
+	idx.reachable = len(idx.order)
+	return idx
+}
+
+// Reachable returns how many cells are reachable from start with nothing extra blocked.
+func (idx *CutIndex) Reachable() int {
+	return idx.reachable
+}
+
+// ReachableWithout returns how many cells stay reachable from start when cell is
+// also blocked. Blocking start itself leaves nothing reachable.
+func (idx *CutIndex) ReachableWithout(cell *world.Cell) int {
+	if _, ok := idx.order[cell]; !ok {
+		return idx.reachable
+	}
+	return idx.reachable - 1 - idx.lost[cell]
+}
+
+// IsArticulationPoint reports whether blocking cell would cut off any other reachable cell.
+func (idx *CutIndex) IsArticulationPoint(cell *world.Cell) bool {
+	if _, ok := idx.order[cell]; !ok {
+		return false
+	}
+	return idx.ReachableWithout(cell) < idx.reachable-1
+}
+
+// chokepointChecker caches the cut index and room count behind isChokepoint so a
+// scan over many candidate cells costs one search instead of one per cell. The
+// search runs on the first check, so scans that reject every cell earlier skip it.
+type chokepointChecker struct {
+	grid       *world.Grid
+	start      *world.Cell
+	cuts       *CutIndex
+	totalRooms int
+}
+
+// newChokepointChecker prepares chokepoint checks for walks from start.
+func newChokepointChecker(grid *world.Grid, start *world.Cell) *chokepointChecker {
+	return &chokepointChecker{grid: grid, start: start}
+}
+
+// isChokepoint reports whether blocking cell would cut off more than 10% of the map.
+func (cc *chokepointChecker) isChokepoint(cell *world.Cell) bool {
+	if cell == nil || !cell.Room {
+		return false
+	}
+	if cc.cuts == nil {
+		cc.cuts = NewCutIndex(cc.start, nil)
+		cc.grid.ForEachCell(func(row, col int, c *world.Cell) {
+			if c != nil && c.Room {
+				cc.totalRooms++
+			}
+		})
+	}
+	threshold := cc.totalRooms / 10
+	return cc.cuts.ReachableWithout(cell) < cc.totalRooms-threshold
+}
@@ -0,0 +1,56 @@
+package setup
+
+import (
+	"testing"
+
+	"github.com/zyedidia/generic/mapset"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/deck"
+	"darkstation/pkg/game/generator"
+	"darkstation/pkg/game/levelrand"
+)
+
+// TestCutIndex_MatchesPerCellSearch checks the single-pass index against a fresh
+// reachability search per blocked cell on generated decks, with and without extra
+// blocked cells.
+func TestCutIndex_MatchesPerCellSearch(t *testing.T) {
+	for _, seed := range []int64{3, 0x18B512C7318DA329} {
+		grid := generator.DefaultGenerator.Generate(6, deck.ThemeThermalReg, levelrand.New(seed))
+		var start *world.Cell
+		var cells []*world.Cell
+		grid.ForEachCell(func(row, col int, c *world.Cell) {
+			if c == nil || !c.Room {
+				return
+			}
+			if start == nil {
+				start = c
+			}
+			cells = append(cells, c)
+		})
+		blocked := mapset.New[*world.Cell]()
+		for i := 7; i < len(cells); i += 53 {
+			if cells[i] != start {
+				blocked.Put(cells[i])
+			}
+		}
+
+		for _, walls := range []*mapset.Set[*world.Cell]{nil, &blocked} {
+			cuts := NewCutIndex(start, walls)
+			if full := getReachableCells(grid, start, walls).Size(); cuts.Reachable() != full {
+				t.Fatalf("seed %d: Reachable() = %d, want %d", seed, cuts.Reachable(), full)
+			}
+			for _, c := range cells {
+				without := mapset.New[*world.Cell]()
+				if walls != nil {
+					walls.Each(func(b *world.Cell) { without.Put(b) })
+				}
+				without.Put(c)
+				want := getReachableCells(grid, start, &without).Size()
+				if got := cuts.ReachableWithout(c); got != want {
+					t.Fatalf("seed %d: ReachableWithout(%d,%d) = %d, want %d", seed, c.Row, c.Col, got, want)
+				}
+			}
+		}
+	}
+}
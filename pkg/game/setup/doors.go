@@ -2,13 +2,13 @@
 package setup
 
 import (
-	"darkstation/pkg/game/levelrand"
 	"fmt"
 
 	"github.com/zyedidia/generic/mapset"
 
 	"darkstation/pkg/engine/world"
 	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/generator"
 	"darkstation/pkg/game/renderer"
 	"darkstation/pkg/game/state"
 	gameworld "darkstation/pkg/game/world"
@@ -27,14 +27,14 @@ func findRoomEntryPoints(grid *world.Grid) map[string]*RoomEntryPoints {
 
 	grid.ForEachCell(func(row, col int, cell *world.Cell) {
 		// Only look at corridor cells
-		if !cell.Room || cell.Name != "Corridor" {
+		if !cell.Room || !cell.IsCorridor {
 			return
 		}
 
 		// Check adjacent cells for rooms (not corridors)
 		neighbors := []*world.Cell{cell.North, cell.East, cell.South, cell.West}
 		for _, neighbor := range neighbors {
-			if neighbor != nil && neighbor.Room && neighbor.Name != "Corridor" && neighbor.Name != "" {
+			if neighbor != nil && neighbor.Room && !neighbor.IsCorridor && neighbor.Name != "" {
 				roomName := neighbor.Name
 				cellKey := fmt.Sprintf("%d,%d-%s", cell.Row, cell.Col, roomName)
 
@@ -84,8 +84,8 @@ func getNumLockedRooms(level int) int {
 }
 
 // placeLockedRooms places doors to lock rooms based on level requirements
-func placeLockedRooms(g *state.Game, avoid *mapset.Set[*world.Cell], lockedDoorCells *mapset.Set[*world.Cell]) {
-	numLockedRooms := getNumLockedRooms(g.Level)
+func placeLockedRooms(g *state.Game, avoid *mapset.Set[*world.Cell], lockedDoorCells *mapset.Set[*world.Cell], params generator.DifficultyParams) {
+	numLockedRooms := params.LockedRooms(getNumLockedRooms(params.GenerationLevel(g.Level)))
 	if numLockedRooms == 0 {
 		return
 	}
@@ -97,7 +97,7 @@ func placeLockedRooms(g *state.Game, avoid *mapset.Set[*world.Cell], lockedDoorC
 	candidates := buildRoomCandidates(roomEntries)
 
 	// Shuffle candidates for variety
-	levelrand.Shuffle(len(candidates), func(i, j int) {
+	g.RNG().Shuffle(len(candidates), func(i, j int) {
 		candidates[i], candidates[j] = candidates[j], candidates[i]
 	})
 
@@ -105,19 +105,25 @@ func placeLockedRooms(g *state.Game, avoid *mapset.Set[*world.Cell], lockedDoorC
 	roomsWithDoors := mapset.New[string]()
 	lockedRoomsPlaced := 0
 
+	// Reachability only changes when doors are placed, so compute it once per
+	// placement decision instead of once per candidate.
+	currentlyReachable := InitialReachableWithLockedDoors(g, lockedDoorCells)
+
 	// Place doors to fully block selected rooms
 	for _, candidate := range candidates {
 		if lockedRoomsPlaced >= numLockedRooms {
 			break
 		}
 
-		if !canPlaceDoorsForRoom(g, candidate, avoid, lockedDoorCells) {
+		reachableWithDoors := reachableBehindRoomDoors(g, candidate, avoid, lockedDoorCells, currentlyReachable)
+		if reachableWithDoors == nil {
 			continue
 		}
 
 		// Place the doors and keycard
-		placeRoomDoors(g, candidate, avoid, lockedDoorCells, &roomsWithDoors)
+		placeRoomDoors(g, candidate, avoid, lockedDoorCells, &roomsWithDoors, reachableWithDoors)
 		lockedRoomsPlaced++
+		currentlyReachable = InitialReachableWithLockedDoors(g, lockedDoorCells)
 	}
 }
 
@@ -143,18 +149,16 @@ func buildRoomCandidates(roomEntries map[string]*RoomEntryPoints) []roomCandidat
 	return candidates
 }
 
-// canPlaceDoorsForRoom checks if doors can be placed for a room
-func canPlaceDoorsForRoom(g *state.Game, candidate roomCandidate, avoid *mapset.Set[*world.Cell], lockedDoorCells *mapset.Set[*world.Cell]) bool {
+// reachableBehindRoomDoors checks if doors can be placed for a room. It returns the
+// cells still reachable once all the room's entries are locked, or nil when the room
+// can't be locked (an entry is taken or unreachable, or locking it cuts nothing off).
+func reachableBehindRoomDoors(g *state.Game, candidate roomCandidate, avoid *mapset.Set[*world.Cell], lockedDoorCells *mapset.Set[*world.Cell], currentlyReachable *mapset.Set[*world.Cell]) *mapset.Set[*world.Cell] {
 	entryCells := candidate.entries.EntryCells
 
-	// Skip if already has doors
-	// This check is done by the caller with roomsWithDoors
-
 	// Check if all entry cells are available and reachable
-	currentlyReachable := InitialReachableWithLockedDoors(g, lockedDoorCells)
 	for _, cell := range entryCells {
 		if avoid.Has(cell) || lockedDoorCells.Has(cell) || !currentlyReachable.Has(cell) {
-			return false
+			return nil
 		}
 	}
 
@@ -168,25 +172,18 @@ func canPlaceDoorsForRoom(g *state.Game, candidate roomCandidate, avoid *mapset.
 
 	// Must actually block something
 	if reachableWithDoors.Size() >= currentlyReachable.Size() {
-		return false
+		return nil
 	}
 
-	return true
+	return reachableWithDoors
 }
 
-// placeRoomDoors places doors and keycard for a room
-func placeRoomDoors(g *state.Game, candidate roomCandidate, avoid *mapset.Set[*world.Cell], lockedDoorCells *mapset.Set[*world.Cell], roomsWithDoors *mapset.Set[string]) {
+// placeRoomDoors places doors and keycard for a room. reachableWithDoors is the
+// area left reachable once the room is locked, as returned by reachableBehindRoomDoors.
+func placeRoomDoors(g *state.Game, candidate roomCandidate, avoid *mapset.Set[*world.Cell], lockedDoorCells *mapset.Set[*world.Cell], roomsWithDoors *mapset.Set[string], reachableWithDoors *mapset.Set[*world.Cell]) {
 	roomName := candidate.name
 	entryCells := candidate.entries.EntryCells
 
-	// Test reachability with doors
-	testLocked := mapset.New[*world.Cell]()
-	lockedDoorCells.Each(func(c *world.Cell) { testLocked.Put(c) })
-	for _, cell := range entryCells {
-		testLocked.Put(cell)
-	}
-	reachableWithDoors := InitialReachableWithLockedDoors(g, &testLocked)
-
 	// Place the keycard in the area reachable BEFORE these doors
 	keycardRoom := findRoomInReachable(g, reachableWithDoors, avoid)
 	if keycardRoom == nil {
@@ -205,6 +202,7 @@ func placeRoomDoors(g *state.Game, candidate roomCandidate, avoid *mapset.Set[*w
 	// Place doors on ALL entry cells (they share the same keycard)
 	for _, cell := range entryCells {
 		cellDoor := entities.NewDoor(roomName)
+		cellDoor.Tier = entities.KeycardTierForLevel(g.Level)
 		gameworld.GetGameData(cell).Door = cellDoor
 		avoid.Put(cell)
 		lockedDoorCells.Put(cell)
@@ -14,9 +14,10 @@ import (
 
 func TestSetupLevel_PlacesKeycards_level4Seed(t *testing.T) {
 	const seed int64 = 0x18B512C7318DA329
-	levelrand.Seed(seed)
-	grid := generator.DefaultGenerator.Generate(4, deck.ThemeThermalReg)
+	rng := levelrand.New(seed)
+	grid := generator.DefaultGenerator.Generate(4, deck.ThemeThermalReg, rng)
 	g := state.NewGame()
+	g.Rand = rng
 	g.Level = 4
 	g.Grid = grid
 	grid.ForEachCell(func(row, col int, cell *world.Cell) {
@@ -24,7 +25,7 @@ func TestSetupLevel_PlacesKeycards_level4Seed(t *testing.T) {
 			gameworld.InitGameData(cell)
 		}
 	})
-	SetupLevel(g)
+	SetupLevel(g, generator.DifficultyParamsFor(g.Mode().Difficulty))
 
 	n := 0
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
@@ -13,7 +13,7 @@ import (
 const envMaxPlaquesPerDeck = 14
 
 func isCorridorCell(c *world.Cell) bool {
-	return c != nil && c.Room && c.Name == "Corridor" && c.Description == "ROOM_CORRIDOR"
+	return c != nil && c.Room && c.IsCorridor && c.Description == "ROOM_CORRIDOR"
 }
 
 // IsCorridorJunctionLayer returns true for corridor cells used as junction plaque / linkage anchors (Stories 5.1–5.3).
@@ -15,7 +15,7 @@ import (
 // ExitGatingRepairRoomAccessible reports whether roomName contains an init-reachable cell
 // or its doors can be toggled from a maintenance terminal in the lift-entry pocket.
 func ExitGatingRepairRoomAccessible(g *state.Game, roomName string) bool {
-	if g == nil || g.Grid == nil || roomName == "" || roomName == "Corridor" ||
+	if g == nil || g.Grid == nil || roomName == "" || roomName == world.CorridorName ||
 		generator.IsPlacementExcludedRoom(roomName) {
 		return false
 	}
@@ -109,10 +109,11 @@ func findExitGatingRepairRelocationCell(g *state.Game, avoid *world.Cell, repair
 		return nil
 	}
 	entry := PlayerEntryCell(g)
+	roomAccessible := make(map[string]bool)
 	var best *world.Cell
 	bestDist := int(^uint(0) >> 1)
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
-		if cell == nil || cell == avoid || !validExitGatingRepairRelocationCell(g, cell, repair) {
+		if cell == nil || cell == avoid || !validExitGatingRepairRelocationCell(g, cell, repair, roomAccessible) {
 			return
 		}
 		dist := manhattanDistance(entry, cell)
@@ -124,11 +125,18 @@ func findExitGatingRepairRelocationCell(g *state.Game, avoid *world.Cell, repair
 	return best
 }
 
-func validExitGatingRepairRelocationCell(g *state.Game, cell *world.Cell, repair *entities.RepairObjective) bool {
+// validExitGatingRepairRelocationCell reports whether repair could move to cell.
+// roomAccessible memoises ExitGatingRepairRoomAccessible per room for one scan.
+func validExitGatingRepairRelocationCell(g *state.Game, cell *world.Cell, repair *entities.RepairObjective, roomAccessible map[string]bool) bool {
 	if cell == nil || !cell.Room || cell == PlayerEntryCell(g) || cell.ExitCell {
 		return false
 	}
-	if !ExitGatingRepairRoomAccessible(g, cell.Name) {
+	accessible, seen := roomAccessible[cell.Name]
+	if !seen {
+		accessible = ExitGatingRepairRoomAccessible(g, cell.Name)
+		roomAccessible[cell.Name] = accessible
+	}
+	if !accessible {
 		return false
 	}
 	if !entityHasInitReachAdjacentStand(g, cell, nil) {
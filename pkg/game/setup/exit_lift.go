@@ -2,9 +2,33 @@ package setup
 
 import (
 	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/deck"
 	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
 )
 
+// LiftMotorWattsBase is the free grid power the lift motor needs before deck cost decay.
+const LiftMotorWattsBase = 30
+
+// LiftMotorWatts returns the free watts the lift motor needs on this deck.
+func LiftMotorWatts(g *state.Game) int {
+	if g == nil {
+		return LiftMotorWattsBase
+	}
+	return int(float64(LiftMotorWattsBase) * deck.DecayParamsForDeck(g.CurrentDeckID).PowerCostMultiplier)
+}
+
+// ExitLiftPower returns free watts on the exit lift's grid and the watts the motor needs.
+// A lift opened by manual egress release has no grid to draw on and is not metered.
+func ExitLiftPower(g *state.Game) (free, required int) {
+	exit := ExitCell(g)
+	if exit == nil || !CellHasLivePower(g, exit) {
+		return 0, 0
+	}
+	_, _, free = GridPowerSummary(g, exit)
+	return free, LiftMotorWatts(g)
+}
+
 // ExitCellHasLivePower reports whether the exit lift cell has propagated grid power,
 // or the exit room has manual egress release (same rules as powered doors).
 func ExitCellHasLivePower(g *state.Game) bool {
@@ -18,29 +42,71 @@ func ExitCellHasLivePower(g *state.Game) bool {
 	if CellHasLivePower(g, exit) {
 		return true
 	}
-	if exit.Name != "" && exit.Name != "Corridor" {
+	if exit.Name != "" && !exit.IsCorridor {
 		return RoomManualEgressReleased(g, exit.Name)
 	}
 	return false
 }
 
-// ExitLiftState returns the current lift readiness for this deck.
-func ExitLiftState(g *state.Game) state.ExitLiftState {
+// ExitLiftGates is every condition the exit lift checks, read in one pass so the
+// movement gate, the map icon and the "what's missing" callout never disagree.
+type ExitLiftGates struct {
+	Powered    bool   // lift cell has grid power or manual egress release
+	Hazards    int    // blocking hazards left on the deck
+	Repairs    int    // exit-gating repairs not yet complete
+	Survivor   string // survivor still to be escorted to the lift ("" when none)
+	FreeWatts  int    // free watts on the lift's grid
+	MotorWatts int    // free watts the lift motor needs (0 when not metered)
+}
+
+// ReadExitLiftGates gathers the lift's gates for this deck.
+func ReadExitLiftGates(g *state.Game) ExitLiftGates {
+	var gates ExitLiftGates
 	if g == nil {
-		return state.ExitLiftLockedUnpowered
+		return gates
 	}
-	if !ExitCellHasLivePower(g) {
-		return state.ExitLiftLockedUnpowered
+	gates.Powered = ExitCellHasLivePower(g)
+	if g.Grid != nil {
+		g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+			if gameworld.HasBlockingHazard(cell) {
+				gates.Hazards++
+			}
+		})
 	}
-	if !g.AllHazardsCleared() {
-		return state.ExitLiftLockedIncomplete
+	gates.Repairs = g.IncompleteRepairCount()
+	if !g.SurvivorEscorted() {
+		_, survivor := g.DeckSurvivor()
+		gates.Survivor = survivor.Name
+	}
+	if gates.Powered {
+		gates.FreeWatts, gates.MotorWatts = ExitLiftPower(g)
 	}
-	if !g.AllRepairsComplete() {
+	return gates
+}
+
+// MotorShort reports whether the lift's grid lacks the free watts its motor needs.
+func (x ExitLiftGates) MotorShort() bool {
+	return x.FreeWatts < x.MotorWatts
+}
+
+// State folds the gates into the lift readiness shown on the map.
+func (x ExitLiftGates) State() state.ExitLiftState {
+	switch {
+	case !x.Powered:
+		return state.ExitLiftLockedUnpowered
+	case x.Hazards > 0 || x.Repairs > 0 || x.Survivor != "":
 		return state.ExitLiftLockedIncomplete
+	case x.MotorShort():
+		return state.ExitLiftLockedLowPower
 	}
 	return state.ExitLiftReady
 }
 
+// ExitLiftState returns the current lift readiness for this deck.
+func ExitLiftState(g *state.Game) state.ExitLiftState {
+	return ReadExitLiftGates(g).State()
+}
+
 // ExitLiftReady reports whether the player may enter and use the exit lift.
 func ExitLiftReady(g *state.Game) bool {
 	return ExitLiftState(g) == state.ExitLiftReady
@@ -43,6 +43,45 @@ func TestExitLiftState_gridPower(t *testing.T) {
 	}
 }
 
+func TestReadExitLiftGates_listsEveryOutstandingGate(t *testing.T) {
+	g := state.NewGame()
+	grid := world.NewGrid(2, 2)
+	grid.MarkAsRoomWithName(0, 0, "Start", "")
+	grid.MarkAsRoomWithName(0, 1, "Lift", "")
+	grid.MarkAsRoomWithName(1, 0, "Start", "")
+	grid.BuildAllCellConnections()
+	grid.SetExitCellAt(0, 1)
+	gameworld.InitGameData(grid.GetCell(0, 0))
+	gameworld.InitGameData(grid.GetCell(0, 1))
+	gameworld.InitGameData(grid.GetCell(1, 0))
+	g.Grid = grid
+	g.RoomDoorsPowered["Start"] = true
+	g.RoomDoorsPowered["Lift"] = true
+	gameworld.GetGameData(grid.GetCell(1, 0)).Hazard = entities.NewHazard(entities.HazardVacuum)
+
+	gates := ReadExitLiftGates(g)
+	if gates.Powered || gates.Hazards != 1 {
+		t.Fatalf("unpowered lift with a hazard: gates = %+v, want unpowered with 1 hazard", gates)
+	}
+	if gates.State() != state.ExitLiftLockedUnpowered {
+		t.Fatalf("State = %v, want LockedUnpowered", gates.State())
+	}
+
+	gen := entities.NewGenerator("G", 1)
+	gen.InsertBatteriesAndStart(1)
+	gameworld.GetGameData(grid.GetCell(0, 0)).Generator = gen
+	g.AddGenerator(gen)
+	PropagateRoomPowerOnlineFromGenerators(g)
+
+	gates = ReadExitLiftGates(g)
+	if !gates.Powered || gates.State() != state.ExitLiftLockedIncomplete {
+		t.Fatalf("powered lift with a hazard: gates = %+v state = %v, want partly ready", gates, gates.State())
+	}
+	if gates.State() != ExitLiftState(g) {
+		t.Fatalf("gates state %v disagrees with ExitLiftState %v", gates.State(), ExitLiftState(g))
+	}
+}
+
 func TestExitCellHasLivePower_manualEgress(t *testing.T) {
 	g := state.NewGame()
 	grid := world.NewGrid(1, 1)
@@ -93,3 +132,38 @@ func TestExitLiftState_lockedByIncompleteRepairs(t *testing.T) {
 		t.Fatalf("complete repair: ExitLiftState = %v, want Ready", got)
 	}
 }
+
+func TestExitLiftState_lockedByLowPower(t *testing.T) {
+	g := state.NewGame()
+	grid := world.NewGrid(1, 2)
+	grid.MarkAsRoomWithName(0, 0, "Start", "")
+	grid.MarkAsRoomWithName(0, 1, "Lift", "")
+	grid.BuildAllCellConnections()
+	grid.SetStartCellAt(0, 0)
+	grid.SetExitCellAt(0, 1)
+	g.Grid = grid
+	gen := entities.NewGenerator("G", 1)
+	gen.OutputWatts = 25
+	gen.InsertBatteriesAndStart(1)
+	gameworld.GetGameData(grid.GetCell(0, 0)).Generator = gen
+	g.AddGenerator(gen)
+	g.RoomDoorsPowered["Start"] = true
+	g.RoomDoorsPowered["Lift"] = true
+	PropagateRoomPowerOnlineFromGenerators(g)
+
+	free, required := ExitLiftPower(g)
+	if required != LiftMotorWattsBase {
+		t.Errorf("deck 1 lift motor = %dw, want %dw", required, LiftMotorWattsBase)
+	}
+	if free >= required {
+		t.Fatalf("free = %dw, want below the %dw motor draw", free, required)
+	}
+	if got := ExitLiftState(g); got != state.ExitLiftLockedLowPower {
+		t.Fatalf("low free power: ExitLiftState = %v, want LockedLowPower", got)
+	}
+
+	gen.OutputWatts = entities.GeneratorOutputHeavy
+	if got := ExitLiftState(g); got != state.ExitLiftReady {
+		t.Fatalf("heavy generator: ExitLiftState = %v, want Ready", got)
+	}
+}
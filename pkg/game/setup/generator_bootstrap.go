@@ -13,7 +13,7 @@ import (
 
 // BootstrapGeneratorRoom turns the room circuit ON (doors + CCTV) for a powered generator on cell.
 func BootstrapGeneratorRoom(g *state.Game, cell *world.Cell) {
-	if g == nil || cell == nil || !cell.Room || cell.Name == "" || cell.Name == "Corridor" {
+	if g == nil || cell == nil || !cell.Room || cell.Name == "" || cell.IsCorridor {
 		return
 	}
 	gen := gameworld.GetGameData(cell).Generator
@@ -32,7 +32,7 @@ const shaftFarSuffix = " Far"
 // shaftFragmentPairName returns the paired base/Far room name when a generator room was split
 // by the lift shaft carve (e.g. "Lab" ↔ "Lab Far").
 func shaftFragmentPairName(roomName string) string {
-	if roomName == "" || roomName == "Corridor" {
+	if roomName == "" || roomName == world.CorridorName {
 		return ""
 	}
 	if strings.HasSuffix(roomName, shaftFarSuffix) {
@@ -42,7 +42,7 @@ func shaftFragmentPairName(roomName string) string {
 }
 
 func armGeneratorRoomCircuit(g *state.Game, roomName string) {
-	if g == nil || roomName == "" || roomName == "Corridor" {
+	if g == nil || roomName == "" || roomName == world.CorridorName {
 		return
 	}
 	if g.RoomDoorsPowered == nil {
@@ -86,7 +86,7 @@ func EnsureMinimumMaintenanceCoverage(g *state.Game) {
 	}
 	if entry := PlayerEntryCell(g); entry != nil && entry.Name != "" {
 		for _, roomName := range GetAdjacentRoomNames(g.Grid, entry.Name) {
-			if roomName == entry.Name || roomName == "Corridor" {
+			if roomName == entry.Name || roomName == world.CorridorName {
 				continue
 			}
 			if placeMaintenanceTerminalInRoom(g, roomName, true) {
@@ -145,7 +145,7 @@ func EnsureGeneratorRoomMaintenanceTerminal(g *state.Game) {
 			return
 		}
 		roomName := cell.Name
-		if roomName == "" || roomName == "Corridor" {
+		if roomName == "" || roomName == world.CorridorName {
 			return
 		}
 		if roomHasMaintenanceTerminal(g, roomName) {
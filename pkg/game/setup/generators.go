@@ -4,8 +4,8 @@ package setup
 import (
 	"darkstation/pkg/game/deck"
 	"darkstation/pkg/game/generator"
-	"darkstation/pkg/game/levelrand"
 	"fmt"
+	"math/rand"
 	"sort"
 
 	"github.com/zyedidia/generic/mapset"
@@ -18,18 +18,18 @@ import (
 )
 
 // placeGenerators places generators in the level (spawn generator only; additional gens after bootstrap).
-func placeGenerators(g *state.Game, avoid *mapset.Set[*world.Cell]) {
-	placeSpawnGenerator(g, avoid)
+func placeGenerators(g *state.Game, avoid *mapset.Set[*world.Cell], params generator.DifficultyParams) {
+	placeSpawnGenerator(g, avoid, params)
 }
 
 // PlaceSpawnGeneratorForTest exposes bootstrap generator placement for cross-package tests.
 func PlaceSpawnGeneratorForTest(g *state.Game, avoid *mapset.Set[*world.Cell]) {
-	placeSpawnGenerator(g, avoid)
+	placeSpawnGenerator(g, avoid, generator.DifficultyParamsFor(g.Mode().Difficulty))
 }
 
 // placeSpawnGenerator places the bootstrap generator in the lift shaft south-west corner,
 // leaving the cell to its east for the bootstrap maintenance terminal.
-func placeSpawnGenerator(g *state.Game, avoid *mapset.Set[*world.Cell]) {
+func placeSpawnGenerator(g *state.Game, avoid *mapset.Set[*world.Cell], params generator.DifficultyParams) {
 	spawnRoomCell := liftShaftGeneratorCell(g, avoid)
 	if spawnRoomCell == nil {
 		spawnRoomCell = liftShaftBootstrapCell(g, avoid, nil)
@@ -42,13 +42,8 @@ func placeSpawnGenerator(g *state.Game, avoid *mapset.Set[*world.Cell]) {
 	}
 	spawnRoomName := spawnRoomCell.Name
 
-	// Level 1-2: 1 battery, Level 3+: 1-3 batteries
-	batteriesRequired := 1
-	if g.Level >= 3 {
-		batteriesRequired = 1 + levelrand.Intn(3) // 1-3 batteries
-	}
-
-	gen := entities.NewGenerator("Generator #1", batteriesRequired)
+	batteriesRequired := spawnBatteriesRequired(g, params)
+	gen := newRatedGenerator("Generator #1", batteriesRequired)
 	// Auto-power the spawn room generator
 	gen.InsertBatteriesAndStart(batteriesRequired)
 	gameworld.GetGameData(spawnRoomCell).Generator = gen
@@ -62,6 +57,15 @@ func placeSpawnGenerator(g *state.Game, avoid *mapset.Set[*world.Cell]) {
 	g.AddHint("A generator is in " + renderer.StyledCell(spawnRoomName))
 }
 
+// spawnBatteriesRequired rolls the spawn generator's battery rating: one on decks 1-2,
+// then within the difficulty's range (1-3 on Normal).
+func spawnBatteriesRequired(g *state.Game, params generator.DifficultyParams) int {
+	if g.Level < 3 {
+		return 1
+	}
+	return params.BatteriesRequiredRoll(g.RNG().Intn)
+}
+
 func legacySpawnGeneratorCell(g *state.Game, avoid *mapset.Set[*world.Cell]) *world.Cell {
 	spawnCell := g.Grid.StartCell()
 	if spawnCell == nil || generator.IsEmptyOverlayRoom(spawnCell.Name) {
@@ -90,6 +94,7 @@ func legacySpawnGeneratorCell(g *state.Game, avoid *mapset.Set[*world.Cell]) *wo
 // findValidGeneratorCell finds a valid cell for generator placement
 func findValidGeneratorCell(g *state.Game, roomName string, startCell *world.Cell, avoid *mapset.Set[*world.Cell]) *world.Cell {
 	var preferred, fallback []*world.Cell
+	chokepoints := newChokepointChecker(g.Grid, startCell)
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
 		if cell == nil || !cell.Room || cell.Name != roomName {
 			return
@@ -97,7 +102,7 @@ func findValidGeneratorCell(g *state.Game, roomName string, startCell *world.Cel
 		if !isValidForGenerator(cell, avoid) || !CanPlaceBlockingEntity(g, cell) {
 			return
 		}
-		if !isChokepoint(g.Grid, cell, startCell) {
+		if !chokepoints.isChokepoint(cell) {
 			preferred = append(preferred, cell)
 		} else {
 			fallback = append(fallback, cell)
@@ -110,7 +115,7 @@ func findValidGeneratorCell(g *state.Game, roomName string, startCell *world.Cel
 	if len(pool) == 0 {
 		return nil
 	}
-	levelrand.Shuffle(len(pool), func(i, j int) { pool[i], pool[j] = pool[j], pool[i] })
+	g.RNG().Shuffle(len(pool), func(i, j int) { pool[i], pool[j] = pool[j], pool[i] })
 	return pool[0]
 }
 
@@ -182,8 +187,15 @@ func findGeneratorCellInRoom(g *state.Game, roomName string, startCell *world.Ce
 	return candidates[0]
 }
 
+// newRatedGenerator creates a level generator whose output rating follows its battery cost.
+func newRatedGenerator(name string, batteriesRequired int) *entities.Generator {
+	gen := entities.NewGenerator(name, batteriesRequired)
+	gen.OutputWatts = entities.GeneratorOutputForBatteries(batteriesRequired)
+	return gen
+}
+
 // calculateBatteriesForGenerator calculates battery requirements for a generator
-func calculateBatteriesForGenerator(level int) int {
+func calculateBatteriesForGenerator(rng *rand.Rand, level int) int {
 	minBatteries := 1 + (level-3)/3
 	maxBatteries := 2 + (level-3)/2
 	if minBatteries > 5 {
@@ -195,22 +207,24 @@ func calculateBatteriesForGenerator(level int) int {
 	if maxBatteries < minBatteries {
 		maxBatteries = minBatteries
 	}
-	return minBatteries + levelrand.Intn(maxBatteries-minBatteries+1)
+	return minBatteries + rng.Intn(maxBatteries-minBatteries+1)
 }
 
 func placeAdditionalGenerators(g *state.Game, avoid *mapset.Set[*world.Cell]) {
-	numAdditionalGenerators := numAdditionalGeneratorsForLevel(g.Level)
+	numAdditionalGenerators := numAdditionalGeneratorsForLevel(g.Level, g.IsFinalDeckLevel(g.Level))
 	start := PlayerEntryCell(g)
 	for i := 0; i < numAdditionalGenerators; i++ {
-		batteriesRequired := calculateBatteriesForGenerator(g.Level)
-		gen := entities.NewGenerator(fmt.Sprintf("Generator #%d", i+2), batteriesRequired)
-		if placeAdditionalGenerator(g, start, avoid, gen) {
-			continue
+		batteriesRequired := calculateBatteriesForGenerator(g.RNG(), g.Level)
+		name := fmt.Sprintf("Generator #%d", i+2)
+		gen := newRatedGenerator(name, batteriesRequired)
+		if i == numAdditionalGenerators-1 && wantsSequencedGenerator(g) {
+			gen = newSequencedGenerator(g.RNG(), name, batteriesRequired)
 		}
-		if placeAdditionalGeneratorInAnyRoom(g, start, avoid, gen, true) {
-			continue
+		if !placeAdditionalGenerator(g, start, avoid, gen) &&
+			!placeAdditionalGeneratorInAnyRoom(g, start, avoid, gen, true) {
+			placeGeneratorInFallbackCell(g, avoid, gen)
 		}
-		placeGeneratorInFallbackCell(g, avoid, gen)
+		placeTaggedBatteries(g, avoid, gen)
 	}
 }
 
@@ -220,7 +234,7 @@ func placeGeneratorInFallbackCell(g *state.Game, avoid *mapset.Set[*world.Cell],
 	}
 	var candidates []*world.Cell
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
-		if cell == nil || !cell.Room || cell.Name == "" || cell.Name == "Corridor" ||
+		if cell == nil || !cell.Room || cell.Name == "" || cell.IsCorridor ||
 			generator.IsPlacementExcludedRoom(cell.Name) || cell.ExitCell {
 			return
 		}
@@ -258,14 +272,15 @@ func placeGeneratorInFallbackCell(g *state.Game, avoid *mapset.Set[*world.Cell],
 }
 
 // numAdditionalGeneratorsForLevel returns how many unpowered generators to place beyond the spawn gen.
-func numAdditionalGeneratorsForLevel(level int) int {
+// Endless decks stop at the deck 9 count; deeper decks get harder through power decay instead.
+func numAdditionalGeneratorsForLevel(level int, minimalSystems bool) int {
 	if level < 3 {
 		return 0
 	}
-	if deck.IsFinalDeck(level) {
+	if minimalSystems {
 		return 1 // GDD §10.2: final deck minimal systems
 	}
-	return level - 3
+	return min(level, deck.TotalDecks-1) - 3
 }
 
 func roomHasGenerator(g *state.Game, roomName string) bool {
@@ -321,7 +336,7 @@ func tryPlaceGeneratorInRoom(g *state.Game, start *world.Cell, avoid *mapset.Set
 func generatorRoomCandidates(g *state.Game, start *world.Cell, avoid *mapset.Set[*world.Cell], preferFar, allowOccupiedRooms bool) []*world.Cell {
 	byRoom := make(map[string]*world.Cell)
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
-		if cell == nil || !cell.Room || cell.Name == "" || cell.Name == "Corridor" {
+		if cell == nil || !cell.Room || cell.Name == "" || cell.IsCorridor {
 			return
 		}
 		if avoid != nil && avoid.Has(cell) {
@@ -356,6 +371,6 @@ func generatorRoomCandidates(g *state.Game, start *world.Cell, avoid *mapset.Set
 		pool = far
 	}
 	SortCellsByPosition(pool)
-	levelrand.Shuffle(len(pool), func(i, j int) { pool[i], pool[j] = pool[j], pool[i] })
+	g.RNG().Shuffle(len(pool), func(i, j int) { pool[i], pool[j] = pool[j], pool[i] })
 	return pool
 }
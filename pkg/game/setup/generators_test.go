@@ -7,6 +7,8 @@ import (
 
 	"darkstation/pkg/engine/world"
 	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/generator"
+	"darkstation/pkg/game/levelrand"
 	"darkstation/pkg/game/state"
 	gameworld "darkstation/pkg/game/world"
 )
@@ -33,7 +35,7 @@ func TestPlaceGenerators_SpawnGeneratorPlaced(t *testing.T) {
 	avoid.Put(g.Grid.StartCell())
 	avoid.Put(g.Grid.ExitCell())
 
-	placeGenerators(g, &avoid)
+	placeGenerators(g, &avoid, generator.DifficultyParamsFor(g.Mode().Difficulty))
 
 	if len(g.Generators) == 0 {
 		t.Fatal("placeGenerators placed no generators on level 1")
@@ -51,7 +53,7 @@ func TestPlaceGenerators_SpawnGeneratorAutoPowered(t *testing.T) {
 	avoid.Put(g.Grid.StartCell())
 	avoid.Put(g.Grid.ExitCell())
 
-	placeGenerators(g, &avoid)
+	placeGenerators(g, &avoid, generator.DifficultyParamsFor(g.Mode().Difficulty))
 
 	if len(g.Generators) == 0 {
 		t.Fatal("no generators placed")
@@ -72,7 +74,7 @@ func TestPlaceGenerators_PowerSupplyUpdatedAfterSpawn(t *testing.T) {
 	avoid.Put(g.Grid.StartCell())
 	avoid.Put(g.Grid.ExitCell())
 
-	placeGenerators(g, &avoid)
+	placeGenerators(g, &avoid, generator.DifficultyParamsFor(g.Mode().Difficulty))
 
 	if g.PowerSupply != 100 {
 		t.Errorf("PowerSupply after spawn generator = %d, want 100", g.PowerSupply)
@@ -101,7 +103,7 @@ func TestPlaceGenerators_Level2OnlySpawn(t *testing.T) {
 	avoid.Put(g.Grid.StartCell())
 	avoid.Put(g.Grid.ExitCell())
 
-	placeGenerators(g, &avoid)
+	placeGenerators(g, &avoid, generator.DifficultyParamsFor(g.Mode().Difficulty))
 
 	if len(g.Generators) != 1 {
 		t.Errorf("level 2: placed %d generators, want 1 (spawn only)", len(g.Generators))
@@ -146,7 +148,7 @@ func TestIsValidForGenerator(t *testing.T) {
 
 func TestCalculateBatteriesForGenerator(t *testing.T) {
 	for level := 3; level <= 10; level++ {
-		bat := calculateBatteriesForGenerator(level)
+		bat := calculateBatteriesForGenerator(levelrand.New(int64(level)), level)
 		if bat < 1 {
 			t.Errorf("level %d: calculateBatteriesForGenerator = %d, want >= 1", level, bat)
 		}
@@ -170,10 +172,10 @@ func countGridGenerators(g *state.Game) int {
 }
 
 func TestNumAdditionalGeneratorsForLevel_finalDeckMinimal(t *testing.T) {
-	if got := numAdditionalGeneratorsForLevel(10); got != 1 {
+	if got := numAdditionalGeneratorsForLevel(10, true); got != 1 {
 		t.Fatalf("final deck additional generators = %d, want 1", got)
 	}
-	if got := numAdditionalGeneratorsForLevel(7); got != 4 {
+	if got := numAdditionalGeneratorsForLevel(7, false); got != 4 {
 		t.Fatalf("level 7 additional generators = %d, want 4", got)
 	}
 }
@@ -203,7 +205,7 @@ func TestPlaceAdditionalGenerators_AfterBootstrap_mapTxtSeed(t *testing.T) {
 	avoid.Put(g.Grid.StartCell())
 	avoid.Put(g.Grid.ExitCell())
 	InitRoomPower(g)
-	placeSpawnGenerator(g, &avoid)
+	placeSpawnGenerator(g, &avoid, generator.DifficultyParamsFor(g.Mode().Difficulty))
 	InitMaintenanceTerminalPower(g)
 	EnsureGeneratorRoomBootstrap(g)
 	PlaceAdditionalGenerators(g, &avoid)
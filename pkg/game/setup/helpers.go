@@ -2,7 +2,6 @@
 package setup
 
 import (
-	"darkstation/pkg/game/levelrand"
 	"sort"
 
 	"github.com/zyedidia/generic/mapset"
@@ -17,7 +16,7 @@ import (
 // Adjacency includes (1) direct: a cell in roomName has a N/S/E/W neighbour in room B;
 // (2) corridor-mediated: a cell in roomName borders a corridor, and that corridor (or
 // corridors reachable from it) borders room B. So rooms connected only by corridors
-// (e.g. A-Corridor-B) are considered adjacent. The name "Corridor" is excluded from
+// (e.g. A-Corridor-B) are considered adjacent. Corridor cells are excluded from
 // the result so the UI shows only named rooms. Result is sorted and includes roomName.
 // Nil is returned for nil grid, empty roomName, or roomName not in grid.
 func GetAdjacentRoomNames(grid *world.Grid, roomName string) []string {
@@ -38,7 +37,7 @@ func GetAdjacentRoomNames(grid *world.Grid, roomName string) []string {
 			if n == nil || !n.Room {
 				continue
 			}
-			if n.Name == "Corridor" {
+			if n.IsCorridor {
 				corridorFrontier = append(corridorFrontier, n)
 				continue
 			}
@@ -66,7 +65,7 @@ func GetAdjacentRoomNames(grid *world.Grid, roomName string) []string {
 				if n == nil || !n.Room {
 					continue
 				}
-				if n.Name == "Corridor" {
+				if n.IsCorridor {
 					if !visited[n] {
 						visited[n] = true
 						queue = append(queue, n)
@@ -148,7 +147,7 @@ func findRoomInReachable(g *state.Game, reachable *mapset.Set[*world.Cell], avoi
 	}
 
 	SortCellsByPosition(candidates)
-	return candidates[levelrand.Intn(len(candidates))]
+	return candidates[g.RNG().Intn(len(candidates))]
 }
 
 // collectReachableRooms collects all reachable rooms from a starting cell using BFS
@@ -207,7 +206,7 @@ func ValidFloorLootPlacementCell(g *state.Game, cell *world.Cell, avoid *mapset.
 	if avoid != nil && avoid.Has(cell) {
 		return false
 	}
-	if cell.Name == "Corridor" || generator.IsPlacementExcludedRoom(cell.Name) {
+	if cell.IsCorridor || generator.IsPlacementExcludedRoom(cell.Name) {
 		return false
 	}
 	if IsLiftShaftBoundsCell(g, cell) {
@@ -248,7 +247,7 @@ func initReachableLootCandidates(g *state.Game, avoid *mapset.Set[*world.Cell])
 	}
 	strict := make(map[string]bool)
 	InitialReachableCells(g).Each(func(cell *world.Cell) {
-		if cell != nil && cell.Name != "" && cell.Name != "Corridor" &&
+		if cell != nil && cell.Name != "" && !cell.IsCorridor &&
 			!generator.IsPlacementExcludedRoom(cell.Name) && !IsLiftShaftBoundsCell(g, cell) {
 			strict[cell.Name] = true
 		}
@@ -307,7 +306,7 @@ func findRoom(g *state.Game, start *world.Cell, avoid *mapset.Set[*world.Cell])
 
 	// Pick a random room from the candidates
 	SortCellsByPosition(farRooms)
-	return farRooms[levelrand.Intn(len(farRooms))]
+	return farRooms[g.RNG().Intn(len(farRooms))]
 }
 
 // placeItem places an item in a random reachable room at an appropriate distance based on level
@@ -322,28 +321,3 @@ func placeItem(g *state.Game, start *world.Cell, item *world.Item, avoid *mapset
 	}
 	return room
 }
-
-// isChokepoint checks if a cell is a chokepoint (removing it would disconnect parts of the map)
-func isChokepoint(grid *world.Grid, cell *world.Cell, start *world.Cell) bool {
-	if cell == nil || !cell.Room {
-		return false
-	}
-
-	// Temporarily mark cell as blocked
-	blocked := mapset.New[*world.Cell]()
-	blocked.Put(cell)
-
-	// Check reachability without this cell
-	reachable := getReachableCells(grid, start, &blocked)
-	totalRooms := 0
-	grid.ForEachCell(func(row, col int, c *world.Cell) {
-		if c != nil && c.Room {
-			totalRooms++
-		}
-	})
-
-	// If removing this cell significantly reduces reachability, it's a chokepoint
-	// Use a threshold: if we lose more than 10% of rooms, it's a chokepoint
-	threshold := totalRooms / 10
-	return reachable.Size() < totalRooms-threshold
-}
@@ -75,7 +75,7 @@ func reachableNamedRooms(reachable *mapset.Set[*world.Cell]) map[string]bool {
 		return rooms
 	}
 	reachable.Each(func(c *world.Cell) {
-		if c.Name != "" && c.Name != "Corridor" {
+		if c.Name != "" && !c.IsCorridor {
 			rooms[c.Name] = true
 		}
 	})
@@ -345,7 +345,7 @@ func pickFloorLootRelocationCell(g *state.Game, reachable *mapset.Set[*world.Cel
 		if cell == nil || cell == entry || cell.ExitCell || IsLiftShaftBoundsCell(g, cell) {
 			return
 		}
-		if cell.Name == "Corridor" || generator.IsPlacementExcludedRoom(cell.Name) ||
+		if cell.IsCorridor || generator.IsPlacementExcludedRoom(cell.Name) ||
 			!rooms[cell.Name] {
 			return
 		}
@@ -375,7 +375,7 @@ func nearestNonShaftLootCell(g *state.Game, from *world.Cell) *world.Cell {
 		if cell == nil || !cell.Room || cell.ExitCell || IsLiftShaftBoundsCell(g, cell) {
 			return
 		}
-		if cell.Name == "Corridor" || generator.IsPlacementExcludedRoom(cell.Name) {
+		if cell.IsCorridor || generator.IsPlacementExcludedRoom(cell.Name) {
 			return
 		}
 		data := gameworld.GetGameData(cell)
@@ -413,15 +413,17 @@ func lootRelocationRoomNames(g *state.Game) map[string]bool {
 	}
 	reach := InitialReachableCells(g)
 	reach.Each(func(cell *world.Cell) {
-		if cell != nil && cell.Name != "" && cell.Name != "Corridor" {
+		if cell != nil && cell.Name != "" && !cell.IsCorridor {
 			names[cell.Name] = true
 		}
 	})
+	checked := make(map[string]bool)
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
-		if cell == nil || !cell.Room || cell.Name == "" || cell.Name == "Corridor" ||
-			generator.IsPlacementExcludedRoom(cell.Name) || names[cell.Name] {
+		if cell == nil || !cell.Room || cell.Name == "" || cell.IsCorridor ||
+			generator.IsPlacementExcludedRoom(cell.Name) || names[cell.Name] || checked[cell.Name] {
 			return
 		}
+		checked[cell.Name] = true
 		if ExitGatingRepairRoomAccessible(g, cell.Name) {
 			names[cell.Name] = true
 		}
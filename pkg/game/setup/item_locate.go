@@ -0,0 +1,51 @@
+package setup
+
+import (
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// FindNearestItem returns the closest item the player has not found yet within radius
+// cells (Euclidean) of from: floor items on undiscovered cells, or an item still hidden
+// in unsearched furniture. name is the item's name (the alphabetically first when a
+// cell holds several). Ties keep the first cell in row-major order.
+func FindNearestItem(g *state.Game, from *world.Cell, radius int) (*world.Cell, string, bool) {
+	if g == nil || g.Grid == nil || from == nil || radius < 0 {
+		return nil, "", false
+	}
+	var best *world.Cell
+	bestName := ""
+	bestDistSq := radius*radius + 1
+	g.Grid.ForEachCell(func(row, col int, c *world.Cell) {
+		if c == nil || !c.Room {
+			return
+		}
+		dr, dc := row-from.Row, col-from.Col
+		distSq := dr*dr + dc*dc
+		if distSq >= bestDistSq {
+			return
+		}
+		if name := undiscoveredItemName(c); name != "" {
+			best, bestName, bestDistSq = c, name, distSq
+		}
+	})
+	return best, bestName, best != nil
+}
+
+// undiscoveredItemName returns the item at c the player has not seen yet, or "".
+func undiscoveredItemName(c *world.Cell) string {
+	if f := gameworld.GetGameData(c).Furniture; f != nil && f.HasItem() && !f.IsChecked() {
+		return f.ContainedItem.Name
+	}
+	if c.Discovered {
+		return ""
+	}
+	name := ""
+	c.ItemsOnFloor.Each(func(item *world.Item) {
+		if item != nil && (name == "" || item.Name < name) {
+			name = item.Name
+		}
+	})
+	return name
+}
@@ -0,0 +1,43 @@
+package setup
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func TestFindNearestItem(t *testing.T) {
+	g := state.NewGame()
+	grid := world.NewGrid(1, 6)
+	for c := 0; c < 6; c++ {
+		grid.MarkAsRoomWithName(0, c, "Hall", "")
+		gameworld.InitGameData(grid.GetCell(0, c))
+	}
+	grid.BuildAllCellConnections()
+	g.Grid = grid
+	from := grid.GetCell(0, 0)
+
+	seen := grid.GetCell(0, 1)
+	seen.Discovered = true
+	seen.ItemsOnFloor.Put(world.NewItem("Map"))
+	grid.GetCell(0, 4).ItemsOnFloor.Put(world.NewItem("Keycard"))
+	locker := entities.NewFurniture("Locker", "", "L")
+	locker.ContainedItem = world.NewItem("Battery")
+	gameworld.GetGameData(grid.GetCell(0, 3)).Furniture = locker
+
+	cell, name, ok := FindNearestItem(g, from, 15)
+	if !ok || name != "Battery" || cell != grid.GetCell(0, 3) {
+		t.Fatalf("nearest = (%v, %q, %v), want the battery hidden in the locker at (0,3)", cell, name, ok)
+	}
+
+	locker.Checked = true
+	if cell, name, _ := FindNearestItem(g, from, 15); name != "Keycard" || cell != grid.GetCell(0, 4) {
+		t.Fatalf("after searching the locker nearest = (%v, %q), want the keycard", cell, name)
+	}
+	if _, _, ok := FindNearestItem(g, from, 3); ok {
+		t.Error("a keycard four cells away should be out of a radius-3 ping")
+	}
+}
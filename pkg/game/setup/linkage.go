@@ -13,7 +13,8 @@ import (
 // gettext msgid — add string to po/default.pot (+ make mo).
 const linkagePlaqueMsgID = "ENV_PLAQUE_LINK_MHOP_A"
 
-// ApplyMultiHopLinkage binds the keyed sequence puzzle (see deck.MultiHopKeyedSequenceSolution) to:
+// ApplyMultiHopLinkage binds the keyed sequence puzzle (the last unsolved PuzzleSequence puzzle in
+// row-major order, so the second of two while observation takes the first) to:
 // corridor junction stamp + relay line on correlating furniture (Story 5.3; specs/multi-hop-linkage-archetype.md).
 //
 // Ordering: PlacePuzzles → ApplyEnvironmentalSignage → ApplyObservationLedPuzzleCues → ApplyMultiHopLinkage.
@@ -25,25 +26,24 @@ func ApplyMultiHopLinkage(g *state.Game) {
 	if g == nil || g.Grid == nil {
 		return
 	}
-	minimal := deck.IsFinalDeck(g.Level)
+	minimal := g.IsFinalDeckLevel(g.Level)
 	if !deck.MultiHopLinkageActive(g.Level, minimal) {
 		return
 	}
 
-	keyed := deck.MultiHopKeyedSequenceSolution
 	tok := deck.MultiHopLinkageToken
 	relay := ". Relay: " + tok // '.' stops CheckForPuzzleCode from absorbing the relay on one line (Story 5.3)
 
 	var puzzleCell *world.Cell
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
-		if puzzleCell != nil || cell == nil {
+		if cell == nil {
 			return
 		}
 		d := gameworld.GetGameData(cell)
 		if d.Puzzle == nil || d.Puzzle.IsSolved() {
 			return
 		}
-		if d.Puzzle.PuzzleType != entities.PuzzleSequence || d.Puzzle.Solution != keyed {
+		if d.Puzzle.PuzzleType != entities.PuzzleSequence {
 			return
 		}
 		puzzleCell = cell
@@ -52,14 +52,15 @@ func ApplyMultiHopLinkage(g *state.Game) {
 		return
 	}
 
-	codeMarker := "Code: " + keyed
+	solution := gameworld.GetGameData(puzzleCell).Puzzle.Solution
 	furnitureMarked := false
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
 		if cell == nil || !gameworld.HasFurniture(cell) {
 			return
 		}
 		f := gameworld.GetGameData(cell).Furniture
-		if !strings.Contains(f.Description, codeMarker) {
+		// Parse rather than substring-match: one generated code can be a prefix of another.
+		if entities.ParseCode(f.Description) != solution {
 			return
 		}
 		furnitureMarked = true
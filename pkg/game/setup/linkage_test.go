@@ -34,11 +34,11 @@ func TestApplyMultiHopLinkage_junctionDistinctFromObservation(t *testing.T) {
 	gameworld.GetGameData(grid.GetCell(5, 7)).EnvPlaqueMsgID = "ENV_PLAQUE_PWR_PHASE"
 
 	p1 := entities.NewPuzzleTerminal("A", entities.PuzzleSequence, "1-2-3-4", "", entities.RewardBattery, "")
-	p2 := entities.NewPuzzleTerminal("B", entities.PuzzleSequence, deck.MultiHopKeyedSequenceSolution, "", entities.RewardBattery, "")
+	p2 := entities.NewPuzzleTerminal("B", entities.PuzzleSequence, "2-4-6-8", "", entities.RewardBattery, "")
 	gameworld.GetGameData(grid.GetCell(10, 7)).Puzzle = p1
 	gameworld.GetGameData(grid.GetCell(12, 7)).Puzzle = p2
 
-	f := entities.NewFurniture("Desk", "Code: "+deck.MultiHopKeyedSequenceSolution, "")
+	f := entities.NewFurniture("Desk", "Code: "+"2-4-6-8", "")
 	gameworld.GetGameData(grid.GetCell(12, 9)).Furniture = f
 
 	ApplyObservationLedPuzzleCues(g)
@@ -76,7 +76,7 @@ func TestApplyMultiHopLinkage_earlyLevel_noChange(t *testing.T) {
 	g := state.NewGame()
 	g.Grid = grid
 	g.Level = 4
-	p := entities.NewPuzzleTerminal("B", entities.PuzzleSequence, deck.MultiHopKeyedSequenceSolution, "", entities.RewardBattery, "")
+	p := entities.NewPuzzleTerminal("B", entities.PuzzleSequence, "2-4-6-8", "", entities.RewardBattery, "")
 	gameworld.GetGameData(grid.GetCell(5, 5)).Puzzle = p
 
 	ApplyMultiHopLinkage(g)
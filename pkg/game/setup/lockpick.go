@@ -0,0 +1,44 @@
+package setup
+
+import (
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/levelrand"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+const (
+	// pickableDoorTag derives the RNG stream that picks which locked rooms have pickable
+	// locks, so the roll does not shift any other placement on the deck.
+	pickableDoorTag = 0x5049434b // "PICK"
+	// pickableRoomChance is the percent chance each locked room's doors can be picked.
+	pickableRoomChance = 50
+)
+
+// MarkPickableDoors makes the locked doors of roughly half the deck's locked rooms
+// pickable, with a pin count scaled to the deck. The room's keycard still opens them;
+// picking is a shortcut that opens one door cell at a time.
+func MarkPickableDoors(g *state.Game) {
+	if g == nil || g.Grid == nil {
+		return
+	}
+	doorsByRoom := make(map[string][]*entities.Door)
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if gameworld.HasLockedDoor(cell) {
+			door := gameworld.GetGameData(cell).Door
+			doorsByRoom[door.RoomName] = append(doorsByRoom[door.RoomName], door)
+		}
+	})
+	rng := levelrand.NewDerived(g.LevelSeed, pickableDoorTag)
+	pins := entities.LockpickDifficulty(g.Level)
+	for _, room := range sortedRoomNames(doorsByRoom) {
+		if rng.Intn(100) >= pickableRoomChance {
+			continue
+		}
+		for _, door := range doorsByRoom[room] {
+			door.Pickable = true
+			door.PickDifficulty = pins
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package setup
+
+import (
+	"github.com/zyedidia/generic/mapset"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/levelrand"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/state"
+)
+
+const (
+	// masterKeycardTag derives the master keycard's RNG stream, so rolling for it does not
+	// shift any other placement on the deck.
+	masterKeycardTag = 0x4d4b4559 // "MKEY"
+	// masterKeycardChance is the percent chance a deck with locked rooms hides a master keycard.
+	masterKeycardChance = 25
+)
+
+// PlaceMasterKeycard occasionally drops a master keycard one tier above the deck's doors
+// as a reward for exploring: on a deck with locked rooms, in the far quarter of the area
+// reachable from the player's entry. The card opens any locked door of its tier or lower
+// and is only used up on a door of exactly its tier, so below Red it outlasts this deck.
+func PlaceMasterKeycard(g *state.Game, avoid *mapset.Set[*world.Cell]) {
+	if g == nil || g.Grid == nil || getNumLockedRooms(g.Level) == 0 {
+		return
+	}
+	rng := levelrand.NewDerived(g.LevelSeed, masterKeycardTag)
+	if rng.Intn(100) >= masterKeycardChance {
+		return
+	}
+	dist := initPathDistances(g, PlayerEntryCell(g))
+	deepest := 0
+	for _, d := range dist {
+		deepest = max(deepest, d)
+	}
+	var candidates []*world.Cell
+	for cell, d := range dist {
+		if d*4 >= deepest*3 && ValidFloorLootPlacementCell(g, cell, avoid) {
+			candidates = append(candidates, cell)
+		}
+	}
+	if len(candidates) == 0 {
+		return
+	}
+	SortCellsByPosition(candidates)
+	cell := candidates[rng.Intn(len(candidates))]
+	name := entities.MasterKeycardName(entities.KeycardTierForLevel(g.Level).Above())
+	cell.ItemsOnFloor.Put(world.NewItem(name))
+	avoid.Put(cell)
+	g.AddHint("The " + renderer.StyledKeycard(name) + " is in " + renderer.StyledCell(cell.Name))
+}
@@ -0,0 +1,73 @@
+package setup
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/deck"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/generator"
+	"darkstation/pkg/game/levelrand"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// setupDeckWithMasterKeycard builds level decks from successive seeds until one drops a
+// master keycard, returning the game, the card and one of the deck's locked doors.
+func setupDeckWithMasterKeycard(t *testing.T, level int) (*state.Game, *world.Item, *entities.Door) {
+	t.Helper()
+	for seed := int64(1); seed <= 200; seed++ {
+		rng := levelrand.New(seed)
+		g := state.NewGame()
+		g.Rand = rng
+		g.Level = level
+		g.LevelSeed = seed
+		g.Grid = generator.DefaultGenerator.Generate(level, deck.ThemeThermalReg, rng)
+		g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+			if cell != nil {
+				gameworld.InitGameData(cell)
+			}
+		})
+		SetupLevel(g, generator.DifficultyParamsFor(g.Mode().Difficulty))
+
+		var card *world.Item
+		var door *entities.Door
+		g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+			cell.ItemsOnFloor.Each(func(item *world.Item) {
+				if entities.MasterKeycardTier(item.Name) != entities.KeycardTierNone {
+					card = item
+				}
+			})
+			if d := gameworld.GetGameData(cell).Door; d != nil && d.Locked && d.RequiresCode == "" {
+				door = d
+			}
+		})
+		if card != nil && door != nil {
+			return g, card, door
+		}
+	}
+	t.Fatalf("no level %d seed placed a master keycard", level)
+	return nil, nil, nil
+}
+
+func TestPlaceMasterKeycard_outranksDeckDoorsAndIsKept(t *testing.T) {
+	g, card, door := setupDeckWithMasterKeycard(t, 4)
+
+	if got, want := entities.MasterKeycardTier(card.Name), entities.KeycardTierBlue.Above(); got != want {
+		t.Fatalf("master keycard tier = %v on a Blue deck, want %v", got, want)
+	}
+	if door.Tier != entities.KeycardTierBlue {
+		t.Fatalf("locked door tier = %v, want Blue", door.Tier)
+	}
+
+	g.AddRunKeycard(world.NewItem(card.Name))
+	if got := g.DoorKeycard(door); got != card.Name {
+		t.Fatalf("DoorKeycard = %q, want the %s", got, card.Name)
+	}
+	if g.UseMasterKeycard(card.Name, door) {
+		t.Error("a higher-tier master keycard should not be used up on a lower door")
+	}
+	if !g.HasKeycardNamed(card.Name) {
+		t.Error("the master keycard should still be carried after opening the door")
+	}
+}
@@ -9,15 +9,15 @@ import (
 )
 
 // ApplyObservationLedPuzzleCues retargets one corridor junction plaque so its
-// stamp echoes the earliest row-major PuzzleSequence puzzle whose solution maps
-// to a plaque msgid on this deck (Story 5.2).
+// stamp echoes the earliest row-major PuzzleSequence puzzle whose solution has a
+// stamp (deck.ObservationSeqStamp) on this deck (Story 5.2).
 // Runs after ApplyEnvironmentalSignage; does not change puzzle reachability
 // or furniture code placement (see specs/level-layout-and-solvability.md).
 func ApplyObservationLedPuzzleCues(g *state.Game) {
 	if g == nil || g.Grid == nil {
 		return
 	}
-	minimal := deck.IsFinalDeck(g.Level)
+	minimal := g.IsFinalDeckLevel(g.Level)
 	if !deck.ObservationLedPuzzleCuesActive(g.Level, minimal) {
 		return
 	}
@@ -36,8 +36,7 @@ func ApplyObservationLedPuzzleCues(g *state.Game) {
 		if data.Puzzle.PuzzleType != entities.PuzzleSequence {
 			return
 		}
-		_, ok := deck.ObservationSeqPlaqueMsgID(data.Puzzle.Solution)
-		if !ok {
+		if _, ok := deck.ObservationSeqStamp(data.Puzzle.Solution); !ok {
 			return
 		}
 		puzzleCell = cell
@@ -48,7 +47,7 @@ func ApplyObservationLedPuzzleCues(g *state.Game) {
 		return
 	}
 
-	msgid, ok := deck.ObservationSeqPlaqueMsgID(puzzleSolution)
+	stamp, ok := deck.ObservationSeqStamp(puzzleSolution)
 	if !ok {
 		return
 	}
@@ -77,7 +76,8 @@ func ApplyObservationLedPuzzleCues(g *state.Game) {
 	if plaque == nil {
 		return
 	}
-	gameworld.GetGameData(plaque).EnvPlaqueMsgID = msgid
+	gameworld.GetGameData(plaque).EnvPlaqueMsgID = deck.ObservationSeqPlaqueMsgID
+	gameworld.GetGameData(plaque).EnvPlaqueArg = stamp
 }
 
 func manhattan(r1, c1, r2, c2 int) int {
@@ -46,9 +46,9 @@ func TestApplyObservationLedPuzzleCues_retargetsNearestJunctionStamp(t *testing.
 	if plaqueCell == nil {
 		t.Fatal("missing junction")
 	}
-	msg := gameworld.GetGameData(plaqueCell).EnvPlaqueMsgID
-	if msg != "ENV_PLAQUE_OBS_SEQ_1234" {
-		t.Fatalf("junction plaque msg = %q, want ENV_PLAQUE_OBS_SEQ_1234", msg)
+	plaque := gameworld.GetGameData(plaqueCell)
+	if plaque.EnvPlaqueMsgID != "ENV_PLAQUE_OBS_SEQ" || plaque.EnvPlaqueArg != "01·02·03·04" {
+		t.Fatalf("junction plaque = %q %q, want ENV_PLAQUE_OBS_SEQ stamped 01·02·03·04", plaque.EnvPlaqueMsgID, plaque.EnvPlaqueArg)
 	}
 }
 
@@ -41,7 +41,7 @@ func forceDevPowerOverload(g *state.Game) {
 		g.RoomCCTVPowered = make(map[string]bool)
 	}
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
-		if cell == nil || !cell.Room || cell.Name == "" || cell.Name == "Corridor" {
+		if cell == nil || !cell.Room || cell.Name == "" || cell.IsCorridor {
 			return
 		}
 		g.RoomDoorsPowered[cell.Name] = true
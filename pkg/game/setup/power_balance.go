@@ -9,9 +9,6 @@ import (
 	gameworld "darkstation/pkg/game/world"
 )
 
-// GeneratorOutputWattsNominal is the fixed output of each powered generator (watts).
-const GeneratorOutputWattsNominal = 100
-
 // PowerShedEntry describes one consumer that would be unpowered during short-out preview or apply.
 type PowerShedEntry struct {
 	Room string
@@ -396,7 +393,7 @@ func calculateConsumptionFromMaps(g *state.Game, online, cctv map[string]bool, g
 		grid.Each(accumulate)
 	}
 	params := deck.DecayParamsForDeck(g.CurrentDeckID)
-	return int(float64(rawConsumption) * params.PowerCostMultiplier)
+	return int(float64(rawConsumption)*params.PowerCostMultiplier) + g.SurgeWatts()
 }
 
 func roomHasCellOnGrid(g *state.Game, roomName string, grid *mapset.Set[*world.Cell]) bool {
@@ -484,7 +481,7 @@ func protectedRoomForInitialPowerBalance(g *state.Game, grid *mapset.Set[*world.
 			return
 		}
 		gen := gameworld.GetGameData(cell).Generator
-		if gen != nil && gen.IsPowered() && cell.Name != "" && cell.Name != "Corridor" {
+		if gen != nil && gen.IsPowered() && cell.Name != "" && !cell.IsCorridor {
 			poweredGenRoom = cell.Name
 		}
 	})
@@ -496,7 +493,7 @@ func protectedRoomForInitialPowerBalance(g *state.Game, grid *mapset.Set[*world.
 		if anyRoom != "" || cell == nil || !grid.Has(cell) {
 			return
 		}
-		if cell.Name != "" && cell.Name != "Corridor" {
+		if cell.Name != "" && !cell.IsCorridor {
 			anyRoom = cell.Name
 		}
 	})
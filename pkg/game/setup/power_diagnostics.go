@@ -0,0 +1,165 @@
+package setup
+
+import (
+	"sort"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/deck"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// RoomPowerBalance is one room's supply and draw, for the power diagnostics view.
+type RoomPowerBalance struct {
+	Room        string
+	Supply      int
+	Consumption int
+}
+
+// Net returns spare watts for the room's grid (negative when it draws more than it gets).
+func (b RoomPowerBalance) Net() int {
+	return b.Supply - b.Consumption
+}
+
+// GeneratorRoomLink ties a powered generator cell to a room on its live grid.
+type GeneratorRoomLink struct {
+	GenRow int
+	GenCol int
+	Room   string
+}
+
+// RoomGenerators counts the generators standing in one room and how many of them run.
+type RoomGenerators struct {
+	Room    string
+	Powered int
+	Total   int
+}
+
+// AllPowered reports whether every generator in the room is running.
+func (r RoomGenerators) AllPowered() bool {
+	return r.Powered == r.Total
+}
+
+// RoomPowerSummary returns supply from generators on the room's armed grid and the room's
+// own draw: doors (10w while online), CCTV (10w), and solved puzzles (3w), scaled by deck decay.
+func RoomPowerSummary(g *state.Game, roomName string) (supply, consumption int) {
+	if g == nil || g.Grid == nil {
+		return 0, 0
+	}
+	params := deck.DecayParamsForDeck(g.CurrentDeckID)
+
+	supply = ArmedGridSupplyForRoom(g, roomName)
+
+	var rawConsumption int
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell == nil {
+			return
+		}
+		data := gameworld.GetGameData(cell)
+		if data.Door != nil && data.Door.RoomName == roomName && RoomIsOnline(g, roomName) {
+			rawConsumption += 10
+		}
+		if cell.Room && cell.Name == roomName {
+			if data.Terminal != nil && g.RoomCCTVPowered[roomName] {
+				rawConsumption += 10
+			}
+			if data.Puzzle != nil && data.Puzzle.IsSolved() {
+				rawConsumption += 3
+			}
+		}
+	})
+	consumption = int(float64(rawConsumption) * params.PowerCostMultiplier)
+	return supply, consumption
+}
+
+// RoomPowerBalances returns RoomPowerSummary for every named room on the deck, sorted by name.
+func RoomPowerBalances(g *state.Game) []RoomPowerBalance {
+	if g == nil || g.Grid == nil {
+		return nil
+	}
+	var out []RoomPowerBalance
+	for _, name := range collectUniqueRoomNames(g.Grid) {
+		supply, consumption := RoomPowerSummary(g, name)
+		out = append(out, RoomPowerBalance{Room: name, Supply: supply, Consumption: consumption})
+	}
+	return out
+}
+
+// GeneratorRoomLinks lists, for each powered generator, the other rooms its live grid reaches.
+// Links are ordered by generator position, then room name.
+func GeneratorRoomLinks(g *state.Game) []GeneratorRoomLink {
+	var links []GeneratorRoomLink
+	for _, gen := range generatorCellsOnGrid(g) {
+		if !isConductivePowerSeed(g, gen) {
+			continue
+		}
+		rooms := make(map[string]struct{})
+		cellsReachableFromGeneratorSeed(g, gen).Each(func(c *world.Cell) {
+			if c != nil && c.Name != "" && !c.IsCorridor && c.Name != gen.Name {
+				rooms[c.Name] = struct{}{}
+			}
+		})
+		names := make([]string, 0, len(rooms))
+		for name := range rooms {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			links = append(links, GeneratorRoomLink{GenRow: gen.Row, GenCol: gen.Col, Room: name})
+		}
+	}
+	return links
+}
+
+// GeneratorsByRoom groups the deck's generators by the name of the room they stand in,
+// sorted by room name, so rooms holding several generators read as one entry.
+func GeneratorsByRoom(g *state.Game) []RoomGenerators {
+	if g == nil || g.Grid == nil {
+		return nil
+	}
+	byRoom := make(map[string]*RoomGenerators)
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell == nil {
+			return
+		}
+		gen := gameworld.GetGameData(cell).Generator
+		if gen == nil {
+			return
+		}
+		entry := byRoom[cell.Name]
+		if entry == nil {
+			entry = &RoomGenerators{Room: cell.Name}
+			byRoom[cell.Name] = entry
+		}
+		entry.Total++
+		if gen.IsPowered() {
+			entry.Powered++
+		}
+	})
+	out := make([]RoomGenerators, 0, len(byRoom))
+	for _, entry := range byRoom {
+		out = append(out, *entry)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Room < out[j].Room })
+	return out
+}
+
+// RoomHoldsSoleSupply reports whether roomName holds every running generator on the deck,
+// so its generator (usually the spawn generator) is the station's only supply.
+func RoomHoldsSoleSupply(g *state.Game, roomName string) bool {
+	if g == nil || g.Grid == nil || roomName == "" {
+		return false
+	}
+	here, elsewhere := 0, 0
+	for _, cell := range generatorCellsOnGrid(g) {
+		if !gameworld.GetGameData(cell).Generator.IsPowered() {
+			continue
+		}
+		if cell.Name == roomName {
+			here++
+		} else {
+			elsewhere++
+		}
+	}
+	return here > 0 && elsewhere == 0
+}
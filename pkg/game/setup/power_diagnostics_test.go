@@ -0,0 +1,108 @@
+package setup
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+func powerDiagnosticsTestGame() *state.Game {
+	g := state.NewGame()
+	grid := world.NewGrid(1, 4)
+	grid.MarkAsRoomWithName(0, 0, "RoomA", "")
+	grid.MarkAsRoomWithName(0, 1, "RoomB", "")
+	grid.MarkAsRoomWithName(0, 2, "RoomB", "")
+	grid.MarkAsRoomWithName(0, 3, "RoomC", "")
+	grid.BuildAllCellConnections()
+	grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		gameworld.InitGameData(cell)
+	})
+	g.Grid = grid
+	gen := entities.NewGenerator("G", 1)
+	gen.InsertBatteriesAndStart(1)
+	gameworld.GetGameData(grid.GetCell(0, 0)).Generator = gen
+	g.RoomDoorsPowered = map[string]bool{"RoomA": true, "RoomB": true}
+	g.RoomPowerOnline = map[string]bool{"RoomA": true, "RoomB": true}
+	return g
+}
+
+func TestRoomPowerBalances_sortedWithSupplyOnArmedGrid(t *testing.T) {
+	g := powerDiagnosticsTestGame()
+	balances := RoomPowerBalances(g)
+	if len(balances) != 3 || balances[0].Room != "RoomA" || balances[2].Room != "RoomC" {
+		t.Fatalf("balances = %+v, want RoomA..RoomC sorted", balances)
+	}
+	if balances[1].Supply == 0 {
+		t.Errorf("RoomB on the armed grid should see generator supply: %+v", balances[1])
+	}
+	if balances[2].Supply != 0 {
+		t.Errorf("unarmed RoomC should have no supply: %+v", balances[2])
+	}
+}
+
+func TestGeneratorRoomLinks_reachArmedRoomsOnly(t *testing.T) {
+	g := powerDiagnosticsTestGame()
+	links := GeneratorRoomLinks(g)
+	if len(links) != 1 || links[0].Room != "RoomB" || links[0].GenRow != 0 || links[0].GenCol != 0 {
+		t.Fatalf("links = %+v, want one link from (0,0) to RoomB", links)
+	}
+}
+
+func TestGeneratorsByRoom_groupsByRoomName(t *testing.T) {
+	g := powerDiagnosticsTestGame()
+	gameworld.GetGameData(g.Grid.GetCell(0, 1)).Generator = entities.NewGenerator("G2", 2)
+	started := entities.NewGenerator("G4", 1)
+	started.InsertBatteriesAndStart(1)
+	gameworld.GetGameData(g.Grid.GetCell(0, 2)).Generator = started
+
+	rooms := GeneratorsByRoom(g)
+	if len(rooms) != 2 || rooms[0].Room != "RoomA" || rooms[1].Room != "RoomB" {
+		t.Fatalf("rooms = %+v, want RoomA and RoomB", rooms)
+	}
+	if !rooms[0].AllPowered() || rooms[0].Total != 1 {
+		t.Errorf("RoomA = %+v, want its one generator powered", rooms[0])
+	}
+	if rooms[1].Powered != 1 || rooms[1].Total != 2 || rooms[1].AllPowered() {
+		t.Errorf("RoomB = %+v, want 1 of 2 generators powered", rooms[1])
+	}
+}
+
+func TestRoomPowerSummary_deviceTogglesChangeConsumption(t *testing.T) {
+	g := powerDiagnosticsTestGame()
+	gameworld.GetGameData(g.Grid.GetCell(0, 1)).Door = entities.NewDoor("RoomB")
+	gameworld.GetGameData(g.Grid.GetCell(0, 2)).Terminal = entities.NewCCTVTerminal("CCTV")
+	g.RoomCCTVPowered = map[string]bool{}
+
+	if _, consumption := RoomPowerSummary(g, "RoomB"); consumption != 10 {
+		t.Fatalf("doors only: consumption = %d, want 10", consumption)
+	}
+	g.RoomCCTVPowered["RoomB"] = true
+	if _, consumption := RoomPowerSummary(g, "RoomB"); consumption != 20 {
+		t.Fatalf("doors and CCTV: consumption = %d, want 20", consumption)
+	}
+	g.RoomPowerOnline["RoomB"] = false
+	if _, consumption := RoomPowerSummary(g, "RoomB"); consumption != 10 {
+		t.Fatalf("CCTV only: consumption = %d, want 10", consumption)
+	}
+}
+
+func TestRoomHoldsSoleSupply_onlyWhileNoOtherGeneratorRuns(t *testing.T) {
+	g := powerDiagnosticsTestGame()
+	if !RoomHoldsSoleSupply(g, "RoomA") {
+		t.Fatal("RoomA holds the only running generator")
+	}
+	if RoomHoldsSoleSupply(g, "RoomB") {
+		t.Fatal("RoomB has no generator")
+	}
+	gameworld.GetGameData(g.Grid.GetCell(0, 3)).Generator = entities.NewGenerator("G2", 1)
+	if !RoomHoldsSoleSupply(g, "RoomA") {
+		t.Fatal("an unstarted generator elsewhere is not a second supply")
+	}
+	gameworld.GetGameData(g.Grid.GetCell(0, 3)).Generator.InsertBatteriesAndStart(1)
+	if RoomHoldsSoleSupply(g, "RoomA") {
+		t.Fatal("a second running generator means RoomA is no longer the sole supply")
+	}
+}
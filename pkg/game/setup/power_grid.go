@@ -12,12 +12,12 @@ import (
 	gameworld "darkstation/pkg/game/world"
 )
 
-// GeneratorOutputWatts returns supply watts from a powered generator (fixed 100 W for now).
+// GeneratorOutputWatts returns supply watts from a powered generator (its rated output).
 func GeneratorOutputWatts(g *state.Game, gen *entities.Generator) int {
 	if g == nil || gen == nil || !gen.IsPowered() {
 		return 0
 	}
-	return GeneratorOutputWattsNominal
+	return gen.RatedOutput()
 }
 
 func roomDoorsPoweredEffective(g *state.Game, override map[string]bool) map[string]bool {
@@ -69,7 +69,7 @@ func CanTraverseCellForPowerGridArmDoors(g *state.Game, cell *world.Cell, doorsP
 		}
 		return true
 	}
-	if cell.Name != "" && cell.Name != "Corridor" && !roomDoorsArmed(g, doorsPowered, cell.Name) {
+	if cell.Name != "" && !cell.IsCorridor && !roomDoorsArmed(g, doorsPowered, cell.Name) {
 		return false
 	}
 	return true
@@ -130,7 +130,7 @@ func CanTraverseCellForPowerGridOverlay(g *state.Game, cell *world.Cell) bool {
 			return false
 		}
 	}
-	if cell.Name != "" && cell.Name != "Corridor" && !roomArmedOrManualEgress(g, cell.Name) {
+	if cell.Name != "" && !cell.IsCorridor && !roomArmedOrManualEgress(g, cell.Name) {
 		return false
 	}
 	return true
@@ -395,7 +395,7 @@ func localCellsFromPoweredGeneratorSeed(g *state.Game, seed *world.Cell) *mapset
 			if !CanTraverseCellForLocalGeneratorFeed(g, n) {
 				continue
 			}
-			if genRoom != "" && genRoom != "Corridor" && n.Name != genRoom {
+			if genRoom != "" && genRoom != world.CorridorName && n.Name != genRoom {
 				continue
 			}
 			visited.Put(n)
@@ -425,7 +425,7 @@ func computeCellsReachableFromPoweredGenerators(g *state.Game) mapset.Set[*world
 	var armedFrontier []*world.Cell
 	for _, seed := range seeds {
 		genRoom := seed.Name
-		if genRoom != "" && genRoom != "Corridor" {
+		if genRoom != "" && genRoom != world.CorridorName {
 			if roomExpanded[genRoom] {
 				union.Put(seed)
 				continue
@@ -487,7 +487,7 @@ func includeAdjacentRepairDeviceCells(g *state.Game, union *mapset.Set[*world.Ce
 				if n == nil || !n.Room || union.Has(n) || !gameworld.HasRepairDevice(n) {
 					continue
 				}
-				if c.Name != "" && c.Name != "Corridor" && n.Name == c.Name {
+				if c.Name != "" && !c.IsCorridor && n.Name == c.Name {
 					added = append(added, n)
 				}
 			}
@@ -538,7 +538,7 @@ func RoomsReachableInPowerGridExcluding(g *state.Game, startCell *world.Cell, ex
 			continue
 		}
 		visited.Put(cur)
-		if cur.Name != "" && cur.Name != "Corridor" {
+		if cur.Name != "" && !cur.IsCorridor {
 			rooms[cur.Name] = true
 		}
 		for _, n := range cur.GetNeighbors() {
@@ -738,7 +738,7 @@ func RoomsFedByPoweredGeneratorGrid(g *state.Game) map[string]bool {
 		return rooms
 	}
 	cellsReachableViaArmedRoutingFromGenerators(g).Each(func(c *world.Cell) {
-		if c == nil || c.Name == "" || c.Name == "Corridor" {
+		if c == nil || c.Name == "" || c.IsCorridor {
 			return
 		}
 		if CanTraverseCellForPowerGridArm(g, c) {
@@ -756,7 +756,7 @@ func RoomsOnConductiveGeneratorGrid(g *state.Game) map[string]bool {
 		return rooms
 	}
 	CellsReachableFromPoweredGenerators(g).Each(func(c *world.Cell) {
-		if c == nil || c.Name == "" || c.Name == "Corridor" {
+		if c == nil || c.Name == "" || c.IsCorridor {
 			return
 		}
 		rooms[c.Name] = true
@@ -781,7 +781,7 @@ func RoomHasLivePower(g *state.Game, roomName string) bool {
 
 // RoomPoweredOnPowerGrid reports whether a room is online on the power grid.
 func RoomPoweredOnPowerGrid(g *state.Game, roomName string, fedRooms map[string]bool) bool {
-	if g == nil || roomName == "" || roomName == "Corridor" {
+	if g == nil || roomName == "" || roomName == world.CorridorName {
 		return false
 	}
 	_ = fedRooms
@@ -796,7 +796,7 @@ func RoomsReachableInPowerGrid(g *state.Game, startCell *world.Cell) []string {
 	}
 	rooms := make(map[string]bool)
 	visited.Each(func(c *world.Cell) {
-		if c.Name != "" && c.Name != "Corridor" {
+		if c.Name != "" && !c.IsCorridor {
 			rooms[c.Name] = true
 		}
 	})
@@ -966,7 +966,7 @@ func UnpowerTerminalsOffGeneratorGrid(g *state.Game) int {
 	conductive := RoomsOnConductiveGeneratorGrid(g)
 	cleared := 0
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
-		if cell == nil || !cell.Room || cell.Name == "" || cell.Name == "Corridor" {
+		if cell == nil || !cell.Room || cell.Name == "" || cell.IsCorridor {
 			return
 		}
 		if g.MaintenanceMenuTerminalRow >= 0 && cell.Row == g.MaintenanceMenuTerminalRow &&
@@ -563,6 +563,7 @@ func TestCellHasLivePower_repairDeviceIsolatedPocketNotPowered(t *testing.T) {
 	}
 	block := grid.GetCell(0, 3)
 	block.Room, block.Name, block.Discovered = true, "Corridor", true
+	block.IsCorridor = true
 	gameworld.InitGameData(block)
 	gameworld.GetGameData(block).PowerRelay = entities.NewPowerRelayOpen()
 	grid.BuildAllCellConnections()
@@ -121,7 +121,7 @@ func PropagateRoomPowerOnlineFromGenerators(g *state.Game) {
 	}
 
 	cellsReachableViaArmedRoutingFromGenerators(g).Each(func(cell *world.Cell) {
-		if cell == nil || cell.Name == "" || cell.Name == "Corridor" {
+		if cell == nil || cell.Name == "" || cell.IsCorridor {
 			return
 		}
 		if CanTraverseCellForPowerGridArm(g, cell) {
@@ -253,7 +253,7 @@ func roomDepthsFromPoweredGenerators(g *state.Game) map[string]int {
 		if cur.depth > 0 && !CanTraverseCellForPowerGridArm(g, cur.cell) {
 			continue
 		}
-		if cur.cell.Name != "" && cur.cell.Name != "Corridor" {
+		if cur.cell.Name != "" && !cur.cell.IsCorridor {
 			if d, ok := depths[cur.cell.Name]; !ok || cur.depth < d {
 				depths[cur.cell.Name] = cur.depth
 			}
@@ -32,7 +32,7 @@ func makePropagationTestGame() *state.Game {
 	for _, col := range []int{1, 3} {
 		c := grid.GetCell(0, col)
 		c.Room = true
-		c.Name = "Corridor"
+		c.Name, c.IsCorridor = "Corridor", true
 		c.Discovered = true
 	}
 	grid.BuildAllCellConnections()
@@ -78,7 +78,7 @@ func TestAdvancePowerPropagation_overloadOnActivation(t *testing.T) {
 		c := grid.GetCell(0, col)
 		c.Room, c.Discovered = true, true
 		if col == 1 || col == 12 {
-			c.Name = "Corridor"
+			c.Name, c.IsCorridor = "Corridor", true
 		} else if col >= 2 && col <= 11 {
 			c.Name = "MidRoom"
 			gameworld.InitGameData(c)
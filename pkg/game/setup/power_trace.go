@@ -80,7 +80,7 @@ func physicalFault(kind PowerFaultKind, from, cell *world.Cell, steps int, label
 		Col:     cell.Col,
 		Label:   label,
 		Steps:   steps,
-		Bearing: compassBearing(from, cell),
+		Bearing: CompassBearing(from, cell),
 	}
 }
 
@@ -137,9 +137,9 @@ func conduitTraceTraversable(cell *world.Cell) bool {
 	return true
 }
 
-// compassBearing returns the rough direction from a to b in screen coordinates
+// CompassBearing returns the rough direction from a to b in screen coordinates
 // (north = decreasing row, east = increasing col).
-func compassBearing(a, b *world.Cell) string {
+func CompassBearing(a, b *world.Cell) string {
 	if a == nil || b == nil {
 		return ""
 	}
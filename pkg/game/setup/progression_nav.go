@@ -169,7 +169,7 @@ func armCriticalInteractableRoomPower(g *state.Game) {
 	}
 	changed := false
 	for _, cell := range criticalInteractableCells(g) {
-		if cell == nil || cell.Name == "" || cell.Name == "Corridor" {
+		if cell == nil || cell.Name == "" || cell.IsCorridor {
 			continue
 		}
 		if g.RoomDoorsPowered[cell.Name] {
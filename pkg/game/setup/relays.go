@@ -4,7 +4,6 @@ import (
 	"sort"
 
 	"darkstation/pkg/engine/world"
-	"darkstation/pkg/game/deck"
 	"darkstation/pkg/game/entities"
 	"darkstation/pkg/game/levelrand"
 	"darkstation/pkg/game/state"
@@ -14,13 +13,13 @@ import (
 const maxRelaysPerDeck = 8
 
 // PowerRelayPlacementActive returns whether corridor relays are placed on this deck.
-func PowerRelayPlacementActive(level int) bool {
-	return level >= 3 && !deck.IsFinalDeck(level)
+func PowerRelayPlacementActive(level int, minimalSystems bool) bool {
+	return level >= 3 && !minimalSystems
 }
 
 // ApplyPowerRelays places corridor routing relays on junction cells (deterministic).
 func ApplyPowerRelays(g *state.Game) {
-	if g == nil || g.Grid == nil || !PowerRelayPlacementActive(g.Level) {
+	if g == nil || g.Grid == nil || !PowerRelayPlacementActive(g.Level, g.IsFinalDeckLevel(g.Level)) {
 		return
 	}
 
@@ -0,0 +1,72 @@
+package setup
+
+import (
+	"github.com/zyedidia/generic/mapset"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/state"
+)
+
+const (
+	// MinRoomLightLevel is the darkest a dimmed room gets; below this the room reads as off.
+	MinRoomLightLevel = 0.35
+	// MaxRoomLightLevel is the brightest a dimmed room gets, so a slight strain still shows.
+	MaxRoomLightLevel = 0.7
+)
+
+// RoomLightLevels returns a brightness for each named room whose lights run dim because
+// its armed grid is strained: supply is positive but below the grid's draw. Rooms dim in
+// shed-queue order (shed-first policy targets, then by name) until the draw of the rooms
+// left at full brightness fits the supply; only rooms that draw power count toward that.
+// The level follows the grid's supply/draw ratio from RoomPowerSummary, clamped to
+// [MinRoomLightLevel, MaxRoomLightLevel]. Rooms missing from the map are at full brightness.
+// Presentation only: illumination and reveal are unaffected.
+func RoomLightLevels(g *state.Game) map[string]float64 {
+	if g == nil || g.Grid == nil {
+		return nil
+	}
+	var levels map[string]float64
+	for _, grid := range armedGridComponentsForBalance(g, nil) {
+		supply := ArmedGridSupply(g, grid)
+		consumption := ConsumptionOnArmedGrid(g, grid)
+		if supply <= 0 || consumption <= supply {
+			continue
+		}
+		level := min(max(float64(supply)/float64(consumption), MinRoomLightLevel), MaxRoomLightLevel)
+
+		var queue []shedConsumer
+		for _, name := range roomNamesOnGrid(g, grid) {
+			if IsAlwaysArmedOverlayRoom(name) {
+				continue
+			}
+			queue = append(queue, shedConsumer{name, "lights"})
+		}
+		sortShedQueue(g, queue)
+		for _, c := range queue {
+			if consumption <= supply {
+				break
+			}
+			_, draw := RoomPowerSummary(g, c.room)
+			if draw <= 0 {
+				continue
+			}
+			if levels == nil {
+				levels = make(map[string]float64)
+			}
+			levels[c.room] = level
+			consumption -= draw
+		}
+	}
+	return levels
+}
+
+// roomNamesOnGrid returns the named rooms with at least one cell on grid, sorted by name.
+func roomNamesOnGrid(g *state.Game, grid *mapset.Set[*world.Cell]) []string {
+	var names []string
+	for _, name := range collectUniqueRoomNames(g.Grid) {
+		if roomHasCellOnGrid(g, name, grid) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,70 @@
+package setup
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// makeStrainedGrid arms doors on Alpha, Beta and Gamma (10w each) off one generator
+// whose output is set to supplyWatts.
+func makeStrainedGrid(t *testing.T, supplyWatts int) *state.Game {
+	t.Helper()
+	g, grid := makeShedPolicyGrid(t)
+	for _, c := range []int{0, 3, 6} {
+		cell := grid.GetCell(0, c)
+		gameworld.GetGameData(cell).Door = &entities.Door{RoomName: cell.Name}
+	}
+	gen := entities.NewGenerator("G", 1)
+	gen.InsertBatteriesAndStart(1)
+	gen.OutputWatts = supplyWatts
+	gameworld.GetGameData(grid.GetCell(0, 1)).Generator = gen
+	g.AddGenerator(gen)
+	g.RoomDoorsPowered = map[string]bool{"Alpha": true, "Beta": true, "Gamma": true}
+	EnergizeArmedRoomsForTest(g)
+	g.UpdatePowerSupply()
+	return g
+}
+
+func TestRoomLightLevels_dimsInShedOrderUntilDrawFits(t *testing.T) {
+	g := makeStrainedGrid(t, 15)
+	if supply, consumption, _ := GridPowerSummary(g, g.Grid.GetCell(0, 4)); supply != 15 || consumption != 30 {
+		t.Fatalf("test setup: supply=%d consumption=%d, want 15/30", supply, consumption)
+	}
+
+	levels := RoomLightLevels(g)
+	if len(levels) != 2 || levels["Alpha"] == 0 || levels["Beta"] == 0 {
+		t.Fatalf("levels = %v, want Alpha and Beta dimmed, Gamma at full", levels)
+	}
+	if levels["Alpha"] != 0.5 {
+		t.Errorf("level = %v, want supply/draw 0.5", levels["Alpha"])
+	}
+
+	g.Policies = []*entities.ConservationPolicy{{
+		ID: "p1", Code: "HAB-PRI", Kind: entities.PolicyShedFirst, TargetRoom: "Gamma",
+	}}
+	levels = RoomLightLevels(g)
+	if _, ok := levels["Gamma"]; !ok || len(levels) != 2 || levels["Beta"] != 0 {
+		t.Fatalf("levels = %v, want shed-first Gamma and then Alpha dimmed", levels)
+	}
+}
+
+func TestRoomLightLevels_fullBrightnessWhenSupplyCovers(t *testing.T) {
+	if levels := RoomLightLevels(makeStrainedGrid(t, 30)); len(levels) != 0 {
+		t.Fatalf("levels = %v, want none when supply covers the draw", levels)
+	}
+}
+
+func TestRoomLightLevels_clampsDeepStrain(t *testing.T) {
+	levels := RoomLightLevels(makeStrainedGrid(t, 3))
+	if len(levels) != 3 {
+		t.Fatalf("levels = %v, want every drawing room dimmed", levels)
+	}
+	for room, level := range levels {
+		if level != MinRoomLightLevel {
+			t.Errorf("%s level = %v, want clamped to %v", room, level, MinRoomLightLevel)
+		}
+	}
+}
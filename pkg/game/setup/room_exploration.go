@@ -0,0 +1,24 @@
+package setup
+
+import (
+	"darkstation/pkg/engine/world"
+)
+
+// RoomExplorationProgress counts the deck's named rooms (corridors excluded) and how
+// many of them the player has visited at least one cell of.
+func RoomExplorationProgress(grid *world.Grid) (explored, total int) {
+	if grid == nil {
+		return 0, 0
+	}
+	names := collectUniqueRoomNames(grid)
+	if len(names) == 0 {
+		return 0, 0
+	}
+	visited := make(map[string]bool, len(names))
+	grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell.Visited && cell.Room && !cell.IsCorridor && cell.Name != "" {
+			visited[cell.Name] = true
+		}
+	})
+	return len(visited), len(names)
+}
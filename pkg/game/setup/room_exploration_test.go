@@ -0,0 +1,33 @@
+package setup
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+)
+
+func TestRoomExplorationProgress_countsRoomsWithAnyVisitedCell(t *testing.T) {
+	grid := world.NewGrid(1, 5)
+	grid.MarkAsRoomWithName(0, 0, "RoomA", "")
+	grid.MarkAsRoomWithName(0, 1, "RoomB", "")
+	grid.MarkAsRoomWithName(0, 2, "RoomB", "")
+	grid.MarkAsRoomWithName(0, 3, "RoomC", "")
+	grid.MarkAsRoomWithName(0, 4, "Corridor", "")
+	grid.GetCell(0, 4).IsCorridor = true
+
+	if explored, total := RoomExplorationProgress(grid); explored != 0 || total != 3 {
+		t.Fatalf("fresh deck = %d/%d, want 0/3", explored, total)
+	}
+
+	grid.GetCell(0, 2).Visited = true
+	grid.GetCell(0, 4).Visited = true
+	if explored, total := RoomExplorationProgress(grid); explored != 1 || total != 3 {
+		t.Errorf("after visiting part of RoomB and a corridor = %d/%d, want 1/3", explored, total)
+	}
+}
+
+func TestRoomExplorationProgress_nilGrid(t *testing.T) {
+	if explored, total := RoomExplorationProgress(nil); explored != 0 || total != 0 {
+		t.Errorf("nil grid = %d/%d, want 0/0", explored, total)
+	}
+}
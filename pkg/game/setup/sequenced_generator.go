@@ -0,0 +1,96 @@
+package setup
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/zyedidia/generic/mapset"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/renderer"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+const (
+	// sequencedGeneratorMinLevel is the first deck whose last additional generator is sequenced.
+	sequencedGeneratorMinLevel = 6
+	// minSequencedBatteries and maxSequencedBatteries bound a sequenced generator's tag count.
+	minSequencedBatteries = 2
+	maxSequencedBatteries = 3
+)
+
+// wantsSequencedGenerator reports whether this deck builds one sequenced generator:
+// late puzzle decks only, and never the minimal-systems final deck.
+func wantsSequencedGenerator(g *state.Game) bool {
+	return g.Level >= sequencedGeneratorMinLevel && g.LevelGen().PlacePuzzles && !g.IsFinalDeckLevel(g.Level)
+}
+
+// newSequencedGenerator creates a sequenced generator asking for batteriesRequired
+// tagged batteries (clamped to the sequence bounds) in a tag order shuffled by rng.
+func newSequencedGenerator(rng *rand.Rand, name string, batteriesRequired int) *entities.Generator {
+	n := min(max(batteriesRequired, minSequencedBatteries), maxSequencedBatteries)
+	tags := append([]string(nil), entities.SequencedBatteryTags[:n]...)
+	rng.Shuffle(len(tags), func(i, j int) { tags[i], tags[j] = tags[j], tags[i] })
+	return entities.NewSequencedGenerator(name, tags)
+}
+
+// placeTaggedBatteries drops one battery per tag of gen on the floor, each in a different
+// room from the generator and from each other when the deck allows, and adds a hint
+// naming the order. If any battery cannot be placed the generator falls back to an
+// ordinary one, so the deck never asks for a battery it does not hold.
+func placeTaggedBatteries(g *state.Game, avoid *mapset.Set[*world.Cell], gen *entities.Generator) {
+	genCell := generatorCell(g, gen)
+	if genCell == nil || !gen.IsSequenced() {
+		return
+	}
+	usedRooms := map[string]bool{genCell.Name: true}
+	entry := PlayerEntryCell(g)
+	placed := make(map[*world.Cell]*world.Item)
+	for _, tag := range gen.BatterySequence {
+		battery := world.NewTaggedItem(entities.TaggedBatteryName(tag), tag)
+		cell := placeItem(g, entry, battery, avoidWithRooms(g, avoid, usedRooms))
+		if cell == nil {
+			cell = placeItem(g, entry, battery, avoid)
+		}
+		if cell == nil {
+			for c, item := range placed {
+				c.ItemsOnFloor.Remove(item)
+			}
+			gen.BatterySequence = nil
+			return
+		}
+		avoid.Put(cell)
+		usedRooms[cell.Name] = true
+		placed[cell] = battery
+	}
+	g.AddHint(fmt.Sprintf("The %s in %s only starts on %s batteries, inserted in that order",
+		renderer.StyledItem("Generator"), renderer.StyledCell(genCell.Name), strings.Join(gen.BatterySequence, " → ")))
+}
+
+// avoidWithRooms returns a copy of avoid that also excludes every cell of the named rooms.
+func avoidWithRooms(g *state.Game, avoid *mapset.Set[*world.Cell], rooms map[string]bool) *mapset.Set[*world.Cell] {
+	out := mapset.New[*world.Cell]()
+	if avoid != nil {
+		avoid.Each(func(c *world.Cell) { out.Put(c) })
+	}
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell != nil && rooms[cell.Name] {
+			out.Put(cell)
+		}
+	})
+	return &out
+}
+
+// generatorCell returns the grid cell holding gen, or nil.
+func generatorCell(g *state.Game, gen *entities.Generator) *world.Cell {
+	var found *world.Cell
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if found == nil && cell != nil && gameworld.GetGameData(cell).Generator == gen {
+			found = cell
+		}
+	})
+	return found
+}
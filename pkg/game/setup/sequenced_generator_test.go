@@ -0,0 +1,92 @@
+package setup
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zyedidia/generic/mapset"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// makeFourRoomRow builds a 2x8 deck of four 2x2 rooms side by side, generator room first.
+func makeFourRoomRow(t *testing.T) *state.Game {
+	t.Helper()
+	g := state.NewGame()
+	g.Level = 7
+	grid := world.NewGrid(2, 8)
+	for col, name := range []string{"Gen Room", "Gen Room", "Room A", "Room A", "Room B", "Room B", "Room C", "Room C"} {
+		for row := range 2 {
+			grid.MarkAsRoomWithName(row, col, name, "desc")
+			gameworld.InitGameData(grid.GetCell(row, col))
+		}
+	}
+	grid.SetStartCellAt(0, 0)
+	grid.SetExitCellAt(0, 0)
+	grid.BuildAllCellConnections()
+	g.Grid = grid
+	return g
+}
+
+func TestPlaceTaggedBatteries_OnePerRoomAwayFromGenerator(t *testing.T) {
+	g := makeFourRoomRow(t)
+	gen := entities.NewSequencedGenerator("Generator #2", []string{"Blue", "Red", "Amber"})
+	gameworld.GetGameData(g.Grid.GetCell(1, 1)).Generator = gen
+	g.AddGenerator(gen)
+	avoid := mapset.New[*world.Cell]()
+	avoid.Put(g.Grid.GetCell(1, 1))
+
+	placeTaggedBatteries(g, &avoid, gen)
+
+	rooms := map[string]string{}
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		cell.ItemsOnFloor.Each(func(item *world.Item) {
+			if item.Tag == "" {
+				return
+			}
+			if prev, dup := rooms[cell.Name]; dup {
+				t.Errorf("%s and %s both placed in %s", prev, item.Name, cell.Name)
+			}
+			rooms[cell.Name] = item.Name
+		})
+	})
+	if len(rooms) != 3 {
+		t.Fatalf("tagged batteries by room = %v, want 3 rooms", rooms)
+	}
+	if _, ok := rooms["Gen Room"]; ok {
+		t.Error("a tagged battery was placed in the generator's own room")
+	}
+	if !gen.IsSequenced() {
+		t.Error("generator lost its sequence although every battery was placed")
+	}
+	if UnpoweredGeneratorBatteryDemand(g) != 0 {
+		t.Error("sequenced generator counted toward plain battery demand")
+	}
+}
+
+func TestSimulatePlaythrough_SequencedGeneratorNeedsEveryTag(t *testing.T) {
+	g := makeFourRoomRow(t)
+	gen := entities.NewSequencedGenerator("Generator #2", []string{"Red", "Blue"})
+	gameworld.GetGameData(g.Grid.GetCell(1, 1)).Generator = gen
+	g.AddGenerator(gen)
+	g.Grid.GetCell(0, 3).ItemsOnFloor.Put(world.NewTaggedItem(entities.TaggedBatteryName("Red"), "Red"))
+
+	started := func() bool {
+		for _, line := range SimulatePlaythrough(g).Trace {
+			if strings.HasPrefix(line, `start "Generator #2"`) {
+				return true
+			}
+		}
+		return false
+	}
+	if started() {
+		t.Fatal("sequenced generator started without the Blue battery")
+	}
+	g.Grid.GetCell(0, 5).ItemsOnFloor.Put(world.NewTaggedItem(entities.TaggedBatteryName("Blue"), "Blue"))
+	if !started() {
+		t.Error("sequenced generator never started with both tagged batteries on the deck")
+	}
+}
@@ -7,9 +7,7 @@ import (
 	"github.com/zyedidia/generic/mapset"
 
 	"darkstation/pkg/engine/world"
-	"darkstation/pkg/game/entities"
 	"darkstation/pkg/game/generator"
-	"darkstation/pkg/game/levelrand"
 	"darkstation/pkg/game/state"
 	gameworld "darkstation/pkg/game/world"
 )
@@ -22,7 +20,8 @@ type SetupConfig struct {
 
 // SetupLevel configures a level with all entities, items, and objectives.
 // Returns the avoid set and locked door cells for use by other placement functions.
-func SetupLevel(g *state.Game) *SetupConfig {
+// params scales the locked rooms and the spawn generator's rating for the run's difficulty.
+func SetupLevel(g *state.Game, params generator.DifficultyParams) *SetupConfig {
 	// Cells to avoid placing items on
 	avoid := mapset.New[*world.Cell]()
 	if g.Grid != nil {
@@ -40,7 +39,8 @@ func SetupLevel(g *state.Game) *SetupConfig {
 	lockedDoorCells := mapset.New[*world.Cell]()
 
 	// Place locked rooms with doors
-	PlaceLockedRooms(g, &avoid, &lockedDoorCells)
+	PlaceLockedRooms(g, &avoid, &lockedDoorCells, params)
+	MarkPickableDoors(g)
 
 	// Ensure every room has at least one door (unlocked for rooms without locked doors)
 	roomEntries := FindRoomEntryPoints(g.Grid)
@@ -53,8 +53,11 @@ func SetupLevel(g *state.Game) *SetupConfig {
 	// blocking entities (generators, batteries) are placed.
 	EnsureKeycardReachability(g)
 
+	// Occasionally reward exploration with a master keycard far from the entry
+	PlaceMasterKeycard(g, &avoid)
+
 	// Place generators (spawn only; additional generators and batteries after bootstrap in lifecycle).
-	PlaceGenerators(g, &avoid)
+	PlaceGenerators(g, &avoid, params)
 
 	// Place CCTV terminals (level 2+)
 	PlaceCCTVTerminals(g, &avoid, roomEntries)
@@ -69,18 +72,18 @@ func SetupLevel(g *state.Game) *SetupConfig {
 }
 
 // PlaceLockedRooms places locked rooms with doors (exported for use in main)
-func PlaceLockedRooms(g *state.Game, avoid *mapset.Set[*world.Cell], lockedDoorCells *mapset.Set[*world.Cell]) {
-	placeLockedRooms(g, avoid, lockedDoorCells)
+func PlaceLockedRooms(g *state.Game, avoid *mapset.Set[*world.Cell], lockedDoorCells *mapset.Set[*world.Cell], params generator.DifficultyParams) {
+	placeLockedRooms(g, avoid, lockedDoorCells, params)
 }
 
 // PlaceGenerators places generators (exported for use in main)
-func PlaceGenerators(g *state.Game, avoid *mapset.Set[*world.Cell]) {
-	placeGenerators(g, avoid)
-	EnsurePoweredSpawnGenerator(g, avoid)
+func PlaceGenerators(g *state.Game, avoid *mapset.Set[*world.Cell], params generator.DifficultyParams) {
+	placeGenerators(g, avoid, params)
+	EnsurePoweredSpawnGenerator(g, avoid, params)
 }
 
 // EnsurePoweredSpawnGenerator guarantees a powered spawn generator exists after placement.
-func EnsurePoweredSpawnGenerator(g *state.Game, avoid *mapset.Set[*world.Cell]) {
+func EnsurePoweredSpawnGenerator(g *state.Game, avoid *mapset.Set[*world.Cell], params generator.DifficultyParams) {
 	if g == nil || g.Grid == nil {
 		return
 	}
@@ -100,11 +103,8 @@ func EnsurePoweredSpawnGenerator(g *state.Game, avoid *mapset.Set[*world.Cell])
 	if cell == nil {
 		return
 	}
-	batteriesRequired := 1
-	if g.Level >= 3 {
-		batteriesRequired = 1 + levelrand.Intn(3)
-	}
-	gen := entities.NewGenerator("Generator #1", batteriesRequired)
+	batteriesRequired := spawnBatteriesRequired(g, params)
+	gen := newRatedGenerator("Generator #1", batteriesRequired)
 	gen.InsertBatteriesAndStart(batteriesRequired)
 	gameworld.GetGameData(cell).Generator = gen
 	g.AddGenerator(gen)
@@ -121,7 +121,7 @@ func findGeneratorCellAnywhere(g *state.Game, avoid *mapset.Set[*world.Cell], re
 	}
 	rooms := map[string]struct{}{}
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
-		if cell != nil && cell.Room && cell.Name != "" && cell.Name != "Corridor" &&
+		if cell != nil && cell.Room && cell.Name != "" && !cell.IsCorridor &&
 			!generator.IsPlacementExcludedRoom(cell.Name) {
 			rooms[cell.Name] = struct{}{}
 		}
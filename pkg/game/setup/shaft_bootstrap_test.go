@@ -15,7 +15,7 @@ import (
 func TestLiftShaftBottomLeftCell(t *testing.T) {
 	g := state.NewGame()
 	g.Level = 2
-	g.Grid = generator.DefaultGenerator.Generate(2, deck.ThemeAirlock)
+	g.Grid = generator.DefaultGenerator.Generate(2, deck.ThemeAirlock, g.RNG())
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
 		if cell != nil {
 			gameworld.InitGameData(cell)
@@ -38,14 +38,14 @@ func TestLiftShaftBottomLeftCell(t *testing.T) {
 func TestPlaceSpawnGenerator_UsesLiftShaftBottomLeft(t *testing.T) {
 	g := state.NewGame()
 	g.Level = 2
-	g.Grid = generator.DefaultGenerator.Generate(2, deck.ThemeAirlock)
+	g.Grid = generator.DefaultGenerator.Generate(2, deck.ThemeAirlock, g.RNG())
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
 		if cell != nil {
 			gameworld.InitGameData(cell)
 		}
 	})
 	avoid := mapset.New[*world.Cell]()
-	placeSpawnGenerator(g, &avoid)
+	placeSpawnGenerator(g, &avoid, generator.DifficultyParamsFor(g.Mode().Difficulty))
 
 	_, leftCol, bottomRow, _ := generator.ShaftBoundsForLevel(g.Grid.Rows(), g.Grid.Cols(), g.Level)
 	genCell := g.Grid.GetCell(bottomRow, leftCol)
@@ -15,10 +15,10 @@ import (
 )
 
 func TestBootstrapDeck1ShipSystems_placesFusionReactorAndConduits(t *testing.T) {
-	levelrand.Seed(42)
 	g := state.NewGame()
+	g.Rand = levelrand.New(42)
 	g.Level = 1
-	g.Grid = generator.BSP.Generate(1, deck.ThemeAirlock)
+	g.Grid = generator.BSP.Generate(1, deck.ThemeAirlock, g.RNG())
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
 		if cell != nil {
 			gameworld.InitGameData(cell)
@@ -26,7 +26,7 @@ func TestBootstrapDeck1ShipSystems_placesFusionReactorAndConduits(t *testing.T)
 	})
 
 	avoid := mapset.New[*world.Cell]()
-	placeSpawnGenerator(g, &avoid)
+	placeSpawnGenerator(g, &avoid, generator.DifficultyParamsFor(g.Mode().Difficulty))
 	BootstrapDeck1ShipSystems(g, &avoid)
 
 	fusionCell := g.Grid.GetCell(generator.Deck1FusionReactorRow, generator.Deck1FusionReactorCol)
@@ -68,7 +68,7 @@ func TestBootstrapDeck1ShipSystems_placesFusionReactorAndConduits(t *testing.T)
 func TestBootstrapDeck1ShipSystems_skipsOtherDecks(t *testing.T) {
 	g := state.NewGame()
 	g.Level = 2
-	g.Grid = generator.DefaultGenerator.Generate(2, deck.ThemeAirlock)
+	g.Grid = generator.DefaultGenerator.Generate(2, deck.ThemeAirlock, g.RNG())
 	BootstrapDeck1ShipSystems(g, nil)
 
 	var fusionCount int
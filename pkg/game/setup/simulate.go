@@ -42,8 +42,12 @@ type SimReport struct {
 // simState is the simulated player's knowledge/inventory/world deltas.
 type simState struct {
 	g *state.Game
+	// live runs against a deck in play (SimulateFromCurrentState): start from the
+	// player, and leave the real world untouched.
+	live bool
 
-	items     map[string]int // item name -> count (includes batteries)
+	items     map[string]int  // item name -> count (includes batteries)
+	codes     map[string]bool // access codes read from furniture
 	batteries int
 
 	doorsPowered map[string]bool // room -> door circuit armed (sim view)
@@ -62,6 +66,7 @@ func newSimState(g *state.Game) *simState {
 	s := &simState{
 		g:               g,
 		items:           map[string]int{},
+		codes:           map[string]bool{},
 		doorsPowered:    map[string]bool{},
 		roomOnline:      map[string]bool{},
 		pickedUp:        map[*world.Cell]map[string]bool{},
@@ -113,7 +118,11 @@ func (s *simState) simPassable(cell *world.Cell) bool {
 		if d.Locked && !s.hasItem(d.KeycardName()) {
 			return false
 		}
-		if !d.Locked && !d.KeycardGated && !s.doorsPowered[d.RoomName] {
+		if d.Locked && d.IsVault() && !s.codes[d.RequiresCode] {
+			return false
+		}
+		// A player in the deck can always hand-crank an unpowered door (manual egress).
+		if !d.Locked && !d.KeycardGated && !s.doorsPowered[d.RoomName] && !s.live {
 			return false
 		}
 		// Locked door + keycard: passable (keycard overrides power).
@@ -136,6 +145,10 @@ func (s *simState) simPassable(cell *world.Cell) bool {
 // reachable returns the set of cells the simulated player can stand on.
 func (s *simState) reachable() *mapset.Set[*world.Cell] {
 	out := mapset.New[*world.Cell]()
+	if s.live {
+		s.bfsSimReachable(s.g.CurrentCell, &out)
+		return &out
+	}
 	for _, entry := range entryDoorPowerSeeds(s.g) {
 		s.bfsSimReachable(entry, &out)
 	}
@@ -215,7 +228,7 @@ func (s *simState) simStep(reach *mapset.Set[*world.Cell]) bool {
 		})
 	})
 
-	// 2. Open furniture next to a standable cell; take contained items.
+	// 2. Open furniture next to a standable cell; take contained items and read codes.
 	grid.ForEachCell(func(row, col int, cell *world.Cell) {
 		if cell == nil {
 			return
@@ -225,6 +238,11 @@ func (s *simState) simStep(reach *mapset.Set[*world.Cell]) bool {
 			return
 		}
 		s.furnitureOpened[furn] = true
+		if code := entities.ParseCode(furn.Description); code != "" && !s.codes[code] {
+			s.codes[code] = true
+			s.addTrace("read code %q on %q at x:%d y:%d", code, furn.Name, cell.Col, cell.Row)
+			progress = true
+		}
 		if furn.ContainedItem != nil {
 			name := furn.ContainedItem.Name
 			s.items[name]++
@@ -252,7 +270,7 @@ func (s *simState) simStep(reach *mapset.Set[*world.Cell]) bool {
 		targets := []string{term.RoomName}
 		targets = append(targets, GetAdjacentRoomNames(grid, term.RoomName)...)
 		for _, target := range targets {
-			if target == "" || target == "Corridor" || s.doorsPowered[target] {
+			if target == "" || target == world.CorridorName || s.doorsPowered[target] {
 				continue
 			}
 			s.doorsPowered[target] = true
@@ -273,6 +291,21 @@ func (s *simState) simStep(reach *mapset.Set[*world.Cell]) bool {
 		if gen == nil || s.generatorOn[gen] || !adjacentReachable(reach, cell) {
 			return
 		}
+		if gen.IsSequenced() {
+			// Order only matters at the generator; holding every tagged battery is enough.
+			for _, tag := range gen.BatterySequence[gen.BatteriesInserted:] {
+				if s.items[entities.TaggedBatteryName(tag)] < 1 {
+					return
+				}
+			}
+			for _, tag := range gen.BatterySequence[gen.BatteriesInserted:] {
+				s.items[entities.TaggedBatteryName(tag)]--
+			}
+			s.generatorOn[gen] = true
+			s.addTrace("start %q with batteries %v at x:%d y:%d", gen.Name, gen.BatterySequence, cell.Col, cell.Row)
+			progress = true
+			return
+		}
 		needed := gen.BatteriesNeeded()
 		if s.batteries < needed {
 			return
@@ -318,7 +351,11 @@ func (s *simState) simStep(reach *mapset.Set[*world.Cell]) bool {
 	}
 
 	if !s.unlockKeycardsSpawned && s.allExitGatingRepairsDone() {
-		DropPendingUnlockKeycards(s.g)
+		if s.live {
+			s.creditPendingUnlockKeycards()
+		} else {
+			DropPendingUnlockKeycards(s.g)
+		}
 		s.unlockKeycardsSpawned = true
 		progress = true
 	}
@@ -352,7 +389,7 @@ func (s *simState) simStep(reach *mapset.Set[*world.Cell]) bool {
 
 // repairRoomPowered approximates RoomConsideredPowered under sim state.
 func (s *simState) repairRoomPowered(roomName string) bool {
-	if roomName == "" || roomName == "Corridor" || generator.IsPlacementExcludedRoom(roomName) {
+	if roomName == "" || roomName == world.CorridorName || generator.IsPlacementExcludedRoom(roomName) {
 		return s.anyGeneratorOn()
 	}
 	return s.roomOnline[roomName]
@@ -384,6 +421,54 @@ func SimulatePlaythrough(g *state.Game) SimReport {
 	}
 
 	s := newSimState(g)
+	reach := s.run(&report)
+	report.Failures = append(report.Failures, s.objectiveFailures(reach)...)
+
+	for _, room := range simNamedRooms(g) {
+		if !simRoomEntered(g, reach, room) {
+			report.Failures = append(report.Failures,
+				fmt.Sprintf("room %q never enterable", room))
+		}
+	}
+
+	report.Failures = append(report.Failures, simUnlockPayoffFailures(g, s)...)
+	report.Failures = append(report.Failures, simUnlockKeycardObtainabilityFailures(g, s)...)
+
+	report.Solvable = len(report.Failures) == 0
+	return report
+}
+
+// SimulateFromCurrentState runs the same greedy player from where the player stands,
+// with what they carry and the deck as it is now. It only checks the deck objectives
+// (exit reachable, repairs and hazards completable), so a failure means the deck can
+// no longer be finished: the player is soft-locked. The game world is not modified.
+func SimulateFromCurrentState(g *state.Game) SimReport {
+	report := SimReport{}
+	if g == nil || g.Grid == nil || g.CurrentCell == nil {
+		report.Failures = append(report.Failures, "no player on a grid")
+		return report
+	}
+	s := newSimState(g)
+	s.live = true
+	s.batteries = g.Batteries
+	for _, set := range []world.ItemSet{g.OwnedItems, g.RunInventory} {
+		set.Each(func(item *world.Item) {
+			if item != nil {
+				s.items[item.Name]++
+			}
+		})
+	}
+	for code, found := range g.FoundCodes {
+		s.codes[code] = found
+	}
+	reach := s.run(&report)
+	report.Failures = append(report.Failures, s.objectiveFailures(reach)...)
+	report.Solvable = len(report.Failures) == 0
+	return report
+}
+
+// run steps the simulated player to a fixed point and returns the final reachable set.
+func (s *simState) run(report *SimReport) *mapset.Set[*world.Cell] {
 	const maxIterations = 256
 	reach := s.reachable()
 	for i := 0; i < maxIterations; i++ {
@@ -394,16 +479,21 @@ func SimulatePlaythrough(g *state.Game) SimReport {
 		reach = s.reachable()
 	}
 	report.Trace = s.trace
+	return reach
+}
 
-	// --- Acceptance criteria ---
+// objectiveFailures lists the deck objectives the simulated player never completed.
+func (s *simState) objectiveFailures(reach *mapset.Set[*world.Cell]) []string {
+	var failures []string
+	g := s.g
 	if exit := g.Grid.ExitCell(); exit != nil && !reach.Has(exit) {
-		report.Failures = append(report.Failures,
+		failures = append(failures,
 			fmt.Sprintf("exit lift at x:%d y:%d never reachable", exit.Col, exit.Row))
 	}
 
 	for _, rep := range sortedRepairs(g) {
 		if !s.repairDone[rep.ID] && !rep.IsComplete() {
-			report.Failures = append(report.Failures,
+			failures = append(failures,
 				fmt.Sprintf("repair %q (%s) in %q never completable", rep.Name, rep.ID, rep.RoomName))
 		}
 	}
@@ -413,23 +503,22 @@ func SimulatePlaythrough(g *state.Game) SimReport {
 			return
 		}
 		if h := gameworld.GetGameData(cell).Hazard; h != nil && h.IsBlocking() && !s.hazardCleared[h] {
-			report.Failures = append(report.Failures,
+			failures = append(failures,
 				fmt.Sprintf("hazard %q at x:%d y:%d never clearable", h.Name, cell.Col, cell.Row))
 		}
 	})
+	return failures
+}
 
-	for _, room := range simNamedRooms(g) {
-		if !simRoomEntered(g, reach, room) {
-			report.Failures = append(report.Failures,
-				fmt.Sprintf("room %q never enterable", room))
+// creditPendingUnlockKeycards hands the live simulated player the keycards that
+// completing the exit-gating repairs would drop, without dropping them for real.
+func (s *simState) creditPendingUnlockKeycards() {
+	s.g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if name := gameworld.GetGameData(cell).PendingUnlockKeycard; name != "" {
+			s.items[name]++
+			s.addTrace("receive %q from completed repairs", name)
 		}
-	}
-
-	report.Failures = append(report.Failures, simUnlockPayoffFailures(g, s)...)
-	report.Failures = append(report.Failures, simUnlockKeycardObtainabilityFailures(g, s)...)
-
-	report.Solvable = len(report.Failures) == 0
-	return report
+	})
 }
 
 func (s *simState) allExitGatingRepairsDone() bool {
@@ -451,7 +540,7 @@ func simNamedRooms(g *state.Game) []string {
 	seen := map[string]bool{}
 	var out []string
 	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
-		if cell == nil || !cell.Room || cell.Name == "" || cell.Name == "Corridor" || seen[cell.Name] {
+		if cell == nil || !cell.Room || cell.Name == "" || cell.IsCorridor || seen[cell.Name] {
 			return
 		}
 		seen[cell.Name] = true
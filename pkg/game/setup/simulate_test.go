@@ -77,6 +77,25 @@ func TestSimulatePlaythrough_KeycardInFurnitureChain(t *testing.T) {
 	}
 }
 
+func TestSimulatePlaythrough_VaultNeedsKeycardAndCode(t *testing.T) {
+	g, grid := simulateTestGame(t)
+	gameworld.GetGameData(grid.GetCell(1, 3)).Door.RequiresCode = "4-1-7-3"
+	grid.GetCell(1, 1).ItemsOnFloor.Put(world.NewItem("Room B Keycard"))
+
+	if report := SimulatePlaythrough(g); report.Solvable {
+		t.Fatalf("vault with no reachable code reported solvable; trace=%v", report.Trace)
+	}
+
+	grid.MarkAsRoomWithName(0, 1, "Room A", "desc")
+	gameworld.InitGameData(grid.GetCell(0, 1))
+	grid.BuildAllCellConnections()
+	gameworld.GetGameData(grid.GetCell(0, 1)).Furniture = entities.NewFurniture("Desk", "A cluttered desk. Code: 4-1-7-3", "▦")
+
+	if report := SimulatePlaythrough(g); !report.Solvable {
+		t.Fatalf("vault with keycard and code reachable reported unsolvable: %v", report.Failures)
+	}
+}
+
 func TestSimulatePlaythrough_SealedRepairDeviceFails(t *testing.T) {
 	g, grid := simulateTestGame(t)
 	grid.GetCell(1, 1).ItemsOnFloor.Put(world.NewItem("Room B Keycard"))
@@ -138,3 +157,36 @@ func TestSimulatePlaythrough_HazardWithControl(t *testing.T) {
 		t.Fatalf("hazard with reachable control reported unsolvable: %v", report.Failures)
 	}
 }
+
+func TestSimulateFromCurrentState_HazardWithoutFixItemIsSoftLock(t *testing.T) {
+	g, grid := simulateTestGame(t)
+	g.CurrentCell = grid.GetCell(1, 0)
+	gameworld.GetGameData(grid.GetCell(1, 2)).Hazard = entities.NewHazard(entities.HazardVacuum)
+
+	report := SimulateFromCurrentState(g)
+	if report.Solvable {
+		t.Fatalf("vacuum breach with no Patch Kit left on the deck reported solvable; trace=%v", report.Trace)
+	}
+
+	g.RunInventory.Put(world.NewItem("Patch Kit"))
+	report = SimulateFromCurrentState(g)
+	if !report.Solvable {
+		t.Fatalf("vacuum breach with a carried Patch Kit reported unsolvable: %v", report.Failures)
+	}
+}
+
+func TestSimulateFromCurrentState_DoesNotModifyDeck(t *testing.T) {
+	g, grid := simulateTestGame(t)
+	g.CurrentCell = grid.GetCell(1, 1)
+	kit := world.NewItem("Patch Kit")
+	grid.GetCell(1, 0).ItemsOnFloor.Put(kit)
+	hazard := entities.NewHazard(entities.HazardVacuum)
+	gameworld.GetGameData(grid.GetCell(1, 2)).Hazard = hazard
+
+	if report := SimulateFromCurrentState(g); !report.Solvable {
+		t.Fatalf("Patch Kit on the floor behind the player reported unsolvable: %v", report.Failures)
+	}
+	if !grid.GetCell(1, 0).ItemsOnFloor.Has(kit) || hazard.Fixed || g.RunInventory.Size() != 0 {
+		t.Fatal("SimulateFromCurrentState changed the deck it simulated")
+	}
+}
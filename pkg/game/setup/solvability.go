@@ -53,6 +53,16 @@ func getReachableCellsBlockingDoorsInto(grid *world.Grid, start *world.Cell, blo
 	return &reachable
 }
 
+// ReachableWithoutEnteringRoom returns cells the player can walk to from the lift entry
+// without passing a door into roomName or any locked door. Door power is ignored.
+func ReachableWithoutEnteringRoom(g *state.Game, roomName string) *mapset.Set[*world.Cell] {
+	if g == nil || g.Grid == nil {
+		empty := mapset.New[*world.Cell]()
+		return &empty
+	}
+	return getReachableCellsBlockingDoorsInto(g.Grid, PlayerEntryCell(g), roomName)
+}
+
 // roomsWithDoors returns the set of room names that have at least one door (cell with Door leading to that room).
 func roomsWithDoors(grid *world.Grid) map[string]bool {
 	out := make(map[string]bool)
@@ -103,7 +113,7 @@ func EnsureSolvabilityDoorPower(g *state.Game) {
 
 		isGatekeeper := false
 		for name := range roomsAll {
-			if name == roomName || name == "Corridor" || generator.IsPlacementExcludedRoom(name) {
+			if name == roomName || name == world.CorridorName || generator.IsPlacementExcludedRoom(name) {
 				continue
 			}
 			if !roomsWithout[name] {
@@ -116,7 +126,7 @@ func EnsureSolvabilityDoorPower(g *state.Game) {
 				if _, hasR := roomsWithout[roomName]; !hasR {
 					otherRooms := 0
 					for name := range roomsAll {
-						if name != roomName && name != "Corridor" && !generator.IsPlacementExcludedRoom(name) {
+						if name != roomName && name != world.CorridorName && !generator.IsPlacementExcludedRoom(name) {
 							otherRooms++
 						}
 					}
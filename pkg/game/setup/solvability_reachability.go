@@ -41,7 +41,7 @@ func CanEnterCellAtInit(g *state.Game, cell *world.Cell) (bool, MovementBlockRea
 		gameworld.HasTerminal(cell) || gameworld.HasPuzzle(cell) ||
 		gameworld.HasMaintenanceTerminal(cell) || gameworld.HasHazardControl(cell) ||
 		gameworld.RepairDeviceBlocksMovement(cell) || gameworld.HasBlockingRepairBlocker(cell) ||
-		gameworld.HasBlockingHazard(cell) {
+		gameworld.HasBlockingHazard(cell) || gameworld.HasWaitingSurvivor(cell) {
 		return false, MovementBlockedEntity
 	}
 	return true, MovementOK
@@ -160,7 +160,7 @@ func CanPowerRoomDoorsFromReachable(g *state.Game, reachable *mapset.Set[*world.
 	}
 	controllerRooms := make(map[string]bool)
 	reachable.Each(func(c *world.Cell) {
-		if c.Name != "" && c.Name != "Corridor" {
+		if c.Name != "" && !c.IsCorridor {
 			controllerRooms[c.Name] = true
 		}
 	})
@@ -274,7 +274,7 @@ func AnalyzeSolvability(g *state.Game) SolvabilityReport {
 	report.InitialReachableCells = reachable.Size()
 	roomSet := make(map[string]bool)
 	reachable.Each(func(c *world.Cell) {
-		if c.Name != "" && c.Name != "Corridor" {
+		if c.Name != "" && !c.IsCorridor {
 			roomSet[c.Name] = true
 		}
 	})
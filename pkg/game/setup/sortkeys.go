@@ -16,6 +16,13 @@ func SortCellsByPosition(cells []*world.Cell) {
 	})
 }
 
+// SortItemsByName orders items by name for deterministic iteration over item sets.
+func SortItemsByName(items []*world.Item) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+}
+
 func sortedRoomNames[V any](m map[string]V) []string {
 	if len(m) == 0 {
 		return nil
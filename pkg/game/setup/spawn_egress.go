@@ -0,0 +1,67 @@
+package setup
+
+import (
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// SpawnHasEgress reports whether the player can step off the entry cell at level init.
+func SpawnHasEgress(g *state.Game) bool {
+	entry := PlayerEntryCell(g)
+	if entry == nil {
+		return false
+	}
+	for _, n := range entry.GetNeighbors() {
+		if ok, _ := CanEnterCellAtInit(g, n); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// EnsureSpawnEgress guarantees the player can take at least one step from the entry
+// cell. Unpowered doors and removable blockers beside the entry are cleared first;
+// when the entry is walled in, a corridor is carved to the nearest enterable floor.
+func EnsureSpawnEgress(g *state.Game) {
+	if g == nil || g.Grid == nil || SpawnHasEgress(g) {
+		return
+	}
+	entry := PlayerEntryCell(g)
+	if entry == nil {
+		return
+	}
+	powerUnpoweredEgressDoorsAdjacent(g, entry)
+	if SpawnHasEgress(g) {
+		return
+	}
+	for _, n := range entry.GetNeighbors() {
+		if IsPermanentlyBlockingCell(n) && !gameworld.HasGenerator(n) {
+			clearPermanentBlocker(g, n)
+			if ok, _ := CanEnterCellAtInit(g, n); ok {
+				return
+			}
+		}
+	}
+	carveSpawnCorridor(g, entry)
+}
+
+// carveSpawnCorridor turns the shortest run of wall cells between entry and enterable
+// floor into corridor. The outer wall ring is never carved.
+func carveSpawnCorridor(g *state.Game, entry *world.Cell) {
+	rows, cols := g.Grid.Rows(), g.Grid.Cols()
+	path := world.FindNearest(entry, func(cell *world.Cell) bool {
+		ok, _ := CanEnterCellAtInit(g, cell)
+		return cell != entry && ok
+	}, func(cell *world.Cell) bool {
+		return !cell.Room && cell.Row > 0 && cell.Col > 0 && cell.Row < rows-1 && cell.Col < cols-1
+	})
+	if len(path) < 3 {
+		return
+	}
+	for _, cell := range path[1 : len(path)-1] {
+		g.Grid.MarkAsCorridor(cell.Row, cell.Col, "ROOM_CORRIDOR")
+		gameworld.InitGameData(cell)
+	}
+	g.InvalidateLivePowerCache()
+}
@@ -0,0 +1,66 @@
+package setup
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	"darkstation/pkg/game/state"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// makeBoxedInSpawn builds a deck whose lift entry at (3,2) is a lone floor cell walled
+// off from a room spanning columns roomFrom..7.
+func makeBoxedInSpawn(roomFrom int) *state.Game {
+	grid := world.NewGrid(7, 9)
+	grid.MarkAsRoomWithName(3, 2, "Lift Shaft", "desc")
+	for r := 1; r <= 5; r++ {
+		for c := roomFrom; c <= 7; c++ {
+			grid.MarkAsRoomWithName(r, c, "Crew Quarters", "desc")
+		}
+	}
+	grid.SetExitCellAt(3, 2)
+	grid.SetStartCellAt(5, 7)
+	grid.BuildAllCellConnections()
+	grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell != nil {
+			gameworld.InitGameData(cell)
+		}
+	})
+	g := state.NewGame()
+	g.Grid = grid
+	return g
+}
+
+func TestEnsureSpawnEgress_boxedInStart(t *testing.T) {
+	tests := []struct {
+		name     string
+		roomFrom int
+		blocker  bool
+		carved   []int // columns on row 3 expected to become corridor
+	}{
+		{"walled in carves corridor", 5, false, []int{3, 4}},
+		{"furniture beside entry is cleared", 3, true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := makeBoxedInSpawn(tt.roomFrom)
+			if tt.blocker {
+				gameworld.GetGameData(g.Grid.GetCell(3, 3)).Furniture = entities.NewFurniture("Crate", "desc", "C")
+			}
+			if SpawnHasEgress(g) {
+				t.Fatal("expected boxed-in spawn before EnsureSpawnEgress")
+			}
+
+			EnsureSpawnEgress(g)
+			if !SpawnHasEgress(g) {
+				t.Fatal("EnsureSpawnEgress left the spawn without egress")
+			}
+			for _, col := range tt.carved {
+				if cell := g.Grid.GetCell(3, col); !cell.Room || cell.Name != "Corridor" {
+					t.Errorf("cell (3,%d) = room %v name %q, want carved corridor", col, cell.Room, cell.Name)
+				}
+			}
+		})
+	}
+}
@@ -2,7 +2,6 @@
 package setup
 
 import (
-	"darkstation/pkg/game/levelrand"
 	"fmt"
 	"sort"
 
@@ -18,7 +17,7 @@ import (
 func collectUniqueRoomNames(grid *world.Grid) []string {
 	namesSet := mapset.New[string]()
 	grid.ForEachCell(func(row, col int, cell *world.Cell) {
-		if cell.Room && cell.Name != "Corridor" && cell.Name != "" {
+		if cell.Room && !cell.IsCorridor && cell.Name != "" {
 			namesSet.Put(cell.Name)
 		}
 	})
@@ -51,7 +50,7 @@ func placeCCTVTerminals(g *state.Game, avoid *mapset.Set[*world.Cell], roomEntri
 		terminal := entities.NewCCTVTerminal(fmt.Sprintf("CCTV Terminal #%d", i+1))
 
 		// Assign a random room for this terminal to reveal
-		targetIdx := levelrand.Intn(len(roomNames))
+		targetIdx := g.RNG().Intn(len(roomNames))
 		terminal.TargetRoom = roomNames[targetIdx]
 		// Remove this room from the list so each terminal reveals a different room
 		roomNames = append(roomNames[:targetIdx], roomNames[targetIdx+1:]...)
@@ -107,7 +106,7 @@ func placeTerminalInRoom(g *state.Game, terminal *entities.CCTVTerminal, termina
 	}
 
 	SortCellsByPosition(connectedCandidates)
-	selectedCell := connectedCandidates[levelrand.Intn(len(connectedCandidates))]
+	selectedCell := connectedCandidates[g.RNG().Intn(len(connectedCandidates))]
 	gameworld.GetGameData(selectedCell).Terminal = terminal
 	avoid.Put(selectedCell)
 }
@@ -0,0 +1,25 @@
+package state
+
+import "darkstation/pkg/game/entities"
+
+// AmbientHazardState tracks the player's exposure to the ambient room hazard they are
+// standing in. Reset whenever the player leaves the hazard or changes deck.
+type AmbientHazardState struct {
+	// Hazard is the ambient hazard underfoot (nil when outside any ambient room).
+	Hazard *entities.Hazard
+	// Turns counts moves made inside Hazard (battery drain cadence).
+	Turns int
+	// TurnAt is the MovementCount of the last counted turn, so inputs that are not moves
+	// (menus, hints, unbound keys) do not count as lingering.
+	TurnAt int
+	// Wading is set after a flooded move attempt was spent wading; the next move proceeds.
+	Wading bool
+}
+
+// ResetAmbientHazard clears ambient exposure tracking.
+func (g *Game) ResetAmbientHazard() {
+	if g == nil {
+		return
+	}
+	g.AmbientHazard = AmbientHazardState{}
+}
@@ -0,0 +1,20 @@
+package state
+
+import "darkstation/pkg/engine/world"
+
+// AutoExploreSession tracks an in-progress auto-explore walk.
+type AutoExploreSession struct {
+	Seen      map[*world.Cell]bool // Notable discovered cells already reported; they do not stop the walk again
+	Exhausted map[*world.Cell]bool // Frontier cells that revealed nothing when reached
+	// PatrolInView records whether the patrol bot was in sight after the last step, so
+	// only its coming into view stops the walk.
+	PatrolInView bool
+}
+
+// NewAutoExploreSession returns an empty session.
+func NewAutoExploreSession() *AutoExploreSession {
+	return &AutoExploreSession{
+		Seen:      make(map[*world.Cell]bool),
+		Exhausted: make(map[*world.Cell]bool),
+	}
+}
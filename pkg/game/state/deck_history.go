@@ -0,0 +1,110 @@
+package state
+
+import (
+	"fmt"
+	"time"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/debuglog"
+)
+
+// DeckClearRecord is one entry in the descent history: a deck the player cleared.
+type DeckClearRecord struct {
+	DeckID          int
+	Level           int
+	ClearedAtSecs   int64 // Seconds since the run began
+	ItemsCollected  int
+	AllRoomsVisited bool // Every named room on the deck was stepped into
+}
+
+// NoteItemCollected counts one pickup toward the current deck's history record.
+func (g *Game) NoteItemCollected() {
+	if g == nil {
+		return
+	}
+	if g.DeckItemsCollected == nil {
+		g.DeckItemsCollected = make(map[int]int)
+	}
+	g.DeckItemsCollected[g.CurrentDeckID]++
+}
+
+// RecordDeckCleared appends the current deck to DeckHistory. Each deck is recorded
+// once, the first time it is cleared; revisits do not add entries.
+func (g *Game) RecordDeckCleared() {
+	if g == nil || g.HasClearedDeck(g.CurrentDeckID) {
+		return
+	}
+	elapsed := int64(0)
+	if g.RunStartedAt > 0 {
+		elapsed = (time.Now().UnixMilli() - g.RunStartedAt) / 1000
+	}
+	g.DeckHistory = append(g.DeckHistory, DeckClearRecord{
+		DeckID:          g.CurrentDeckID,
+		Level:           g.Level,
+		ClearedAtSecs:   elapsed,
+		ItemsCollected:  g.DeckItemsCollected[g.CurrentDeckID],
+		AllRoomsVisited: allRoomsVisited(g.Grid),
+	})
+	debuglog.Info("deck.cleared", "level", g.Level, "secs", elapsed, "items", g.DeckItemsCollected[g.CurrentDeckID])
+}
+
+// HasClearedDeck reports whether deckID already has a history record.
+func (g *Game) HasClearedDeck(deckID int) bool {
+	if g == nil {
+		return false
+	}
+	for _, rec := range g.DeckHistory {
+		if rec.DeckID == deckID {
+			return true
+		}
+	}
+	return false
+}
+
+// allRoomsVisited reports whether every named room (corridors excluded) has a visited cell.
+func allRoomsVisited(grid *world.Grid) bool {
+	if grid == nil {
+		return false
+	}
+	visited := make(map[string]bool)
+	grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell == nil || !cell.Room || cell.Name == "" || cell.IsCorridor {
+			return
+		}
+		visited[cell.Name] = visited[cell.Name] || cell.Visited
+	})
+	for _, v := range visited {
+		if !v {
+			return false
+		}
+	}
+	return len(visited) > 0
+}
+
+// FormatDeckClearLine formats one history record as plain text for the completion overlay.
+func FormatDeckClearLine(rec DeckClearRecord) string {
+	line := fmt.Sprintf("Deck %d  %s  %s", rec.Level, FormatClearClock(rec.ClearedAtSecs), FormatItemCount(rec.ItemsCollected))
+	if rec.AllRoomsVisited {
+		line += "  all rooms"
+	}
+	return line
+}
+
+// FormatClearClock formats run-relative seconds as m:ss (or h:mm:ss past an hour).
+func FormatClearClock(seconds int64) string {
+	if seconds < 0 {
+		seconds = 0
+	}
+	if seconds >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+	}
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
+
+// FormatItemCount formats a pickup count with the right plural.
+func FormatItemCount(n int) string {
+	if n == 1 {
+		return "1 item"
+	}
+	return fmt.Sprintf("%d items", n)
+}
@@ -0,0 +1,58 @@
+package state
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+)
+
+func TestRecordDeckCleared_OncePerDeck(t *testing.T) {
+	g := NewGame()
+	g.NoteItemCollected()
+	g.NoteItemCollected()
+	g.RecordDeckCleared()
+	g.RecordDeckCleared()
+	if len(g.DeckHistory) != 1 {
+		t.Fatalf("DeckHistory len = %d, want 1", len(g.DeckHistory))
+	}
+	if rec := g.DeckHistory[0]; rec.Level != 1 || rec.ItemsCollected != 2 {
+		t.Errorf("record = %+v, want level 1 with 2 items", rec)
+	}
+
+	g.CurrentDeckID, g.Level = 1, 2
+	g.RecordDeckCleared()
+	if len(g.DeckHistory) != 2 || g.DeckHistory[1].ItemsCollected != 0 {
+		t.Errorf("second deck record = %+v, want fresh item count", g.DeckHistory)
+	}
+}
+
+func TestAllRoomsVisited(t *testing.T) {
+	grid := world.NewGrid(1, 3)
+	grid.MarkAsRoomWithName(0, 0, "Bay", "ROOM_BAY")
+	grid.MarkAsRoomWithName(0, 1, "Corridor", "ROOM_CORRIDOR")
+	grid.MarkAsRoomWithName(0, 2, "Lab", "ROOM_LAB")
+	grid.GetCell(0, 0).Visited = true
+	if allRoomsVisited(grid) {
+		t.Error("allRoomsVisited = true with Lab unvisited")
+	}
+	grid.GetCell(0, 2).Visited = true
+	if !allRoomsVisited(grid) {
+		t.Error("allRoomsVisited = false with every named room visited (corridor excluded)")
+	}
+}
+
+func TestFormatClearClock(t *testing.T) {
+	tests := []struct {
+		secs int64
+		want string
+	}{
+		{0, "0:00"},
+		{75, "1:15"},
+		{3725, "1:02:05"},
+	}
+	for _, tt := range tests {
+		if got := FormatClearClock(tt.secs); got != tt.want {
+			t.Errorf("FormatClearClock(%d) = %q, want %q", tt.secs, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package state
+
+// DeckRadiationMax is the top of the deck radiation meter; at the top it starts to hurt.
+const DeckRadiationMax = 100
+
+// DeckRadiationState tracks the deck-wide radiation meter. Uncleared radiation leaks
+// raise it every move and it bleeds off once they are contained. Reset on deck change.
+type DeckRadiationState struct {
+	// Level is the meter reading, 0..DeckRadiationMax.
+	Level int
+	// TurnsAtMax counts moves made with the meter full (battery burn cadence).
+	TurnsAtMax int
+	// TurnAt is the MovementCount of the last meter update, so inputs that are not moves
+	// (menus, hints, unbound keys) neither raise nor drain it.
+	TurnAt int
+	// Warned is set once the rising-radiation warning has been logged; cleared when the meter drains.
+	Warned bool
+}
+
+// ResetDeckRadiation clears the deck radiation meter.
+func (g *Game) ResetDeckRadiation() {
+	if g == nil {
+		return
+	}
+	g.DeckRadiation = DeckRadiationState{}
+}
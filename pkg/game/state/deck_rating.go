@@ -0,0 +1,123 @@
+package state
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// DeckRating summarises how demanding a freshly generated deck is, for the lift's
+// pre-descent preview.
+type DeckRating struct {
+	LockedRooms        int                         // Rooms behind keycard doors
+	Hazards            map[entities.HazardType]int // Blocking hazards by type
+	BatteriesRequired  int                         // Slots on unpowered generators
+	BatteriesAvailable int                         // Batteries on the floor and hidden in furniture
+}
+
+// PreviewDeck is a deck generated ahead of its first visit so the lift could rate it.
+type PreviewDeck struct {
+	State            *DeckState // Saved deck, ready for LoadDeckState
+	Hints            []string   // Hints generation added (LoadDeckState does not keep hints)
+	LevelGenAttempts int        // Generation attempts used, for the run log
+	RunItems         int        // RunInventory size at generation; run keycards shape the deck
+}
+
+// deckRatingLabels names each rating tier from easiest to hardest.
+var deckRatingLabels = []string{"Light", "Moderate", "Demanding", "Severe", "Brutal"}
+
+// RateDeck counts the current deck's locked rooms, blocking hazards and battery budget.
+func (g *Game) RateDeck() DeckRating {
+	r := DeckRating{Hazards: make(map[entities.HazardType]int)}
+	if g == nil || g.Grid == nil {
+		return r
+	}
+	lockedRooms := make(map[string]bool)
+	isBattery := func(item *world.Item) bool {
+		return item != nil && (item.Name == "Battery" || item.Tag != "")
+	}
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell == nil {
+			return
+		}
+		data := gameworld.GetGameData(cell)
+		if data.Door != nil && data.Door.Locked && data.Door.KeycardGated {
+			lockedRooms[data.Door.RoomName] = true
+		}
+		if gameworld.HasBlockingHazard(cell) {
+			r.Hazards[data.Hazard.Type]++
+		}
+		if data.Generator != nil && !data.Generator.IsPowered() {
+			r.BatteriesRequired += data.Generator.BatteriesNeeded()
+		}
+		cell.ItemsOnFloor.Each(func(item *world.Item) {
+			if isBattery(item) {
+				r.BatteriesAvailable++
+			}
+		})
+		if data.Furniture != nil && isBattery(data.Furniture.ContainedItem) {
+			r.BatteriesAvailable++
+		}
+	})
+	r.LockedRooms = len(lockedRooms)
+	return r
+}
+
+// HazardCount returns every blocking hazard on the deck.
+func (r DeckRating) HazardCount() int {
+	total := 0
+	for _, n := range r.Hazards {
+		total += n
+	}
+	return total
+}
+
+// Tier folds the counts into 1 (light) .. 5 (brutal). Hazards weigh double, and so does
+// each battery the deck is short of.
+func (r DeckRating) Tier() int {
+	score := r.LockedRooms + 2*r.HazardCount() + r.BatteriesRequired/4
+	if short := r.BatteriesRequired - r.BatteriesAvailable; short > 0 {
+		score += 2 * short
+	}
+	switch {
+	case score <= 3:
+		return 1
+	case score <= 6:
+		return 2
+	case score <= 10:
+		return 3
+	case score <= 15:
+		return 4
+	}
+	return 5
+}
+
+// Label names the rating tier, e.g. "Demanding".
+func (r DeckRating) Label() string {
+	return deckRatingLabels[r.Tier()-1]
+}
+
+// Summary lists what drives the rating, e.g. "2 locked rooms, 3 hazards (Vacuum ×2, Gas Leak), 6 batteries needed / 7 found".
+func (r DeckRating) Summary() string {
+	parts := []string{fmt.Sprintf("%d locked room(s)", r.LockedRooms)}
+	if n := r.HazardCount(); n > 0 {
+		var types []string
+		for t, count := range r.Hazards {
+			name := entities.HazardTypes[t].Name
+			if count > 1 {
+				name = fmt.Sprintf("%s ×%d", name, count)
+			}
+			types = append(types, name)
+		}
+		sort.Strings(types)
+		parts = append(parts, fmt.Sprintf("%d hazard(s) (%s)", n, strings.Join(types, ", ")))
+	} else {
+		parts = append(parts, "no hazards")
+	}
+	parts = append(parts, fmt.Sprintf("%d batteries needed / %d found", r.BatteriesRequired, r.BatteriesAvailable))
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,27 @@
+package state
+
+import (
+	"testing"
+
+	"darkstation/pkg/game/entities"
+)
+
+func TestDeckRating_TierAndSummary(t *testing.T) {
+	easy := DeckRating{LockedRooms: 1, BatteriesRequired: 2, BatteriesAvailable: 3}
+	if easy.Tier() != 1 || easy.Label() != "Light" {
+		t.Errorf("easy deck = tier %d %q, want 1 Light", easy.Tier(), easy.Label())
+	}
+	hard := DeckRating{
+		LockedRooms:        3,
+		Hazards:            map[entities.HazardType]int{entities.HazardVacuum: 2, entities.HazardGas: 1},
+		BatteriesRequired:  8,
+		BatteriesAvailable: 6,
+	}
+	if hard.Tier() != 4 || hard.Label() != "Severe" {
+		t.Errorf("hard deck = tier %d %q, want 4 Severe", hard.Tier(), hard.Label())
+	}
+	want := "3 locked room(s), 3 hazard(s) (Gas Leak, Vacuum ×2), 8 batteries needed / 6 found"
+	if got := hard.Summary(); got != want {
+		t.Errorf("Summary = %q, want %q", got, want)
+	}
+}
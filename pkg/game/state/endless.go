@@ -0,0 +1,23 @@
+package state
+
+// ScoreDeckClear returns the endless-mode points for a cleared deck: base points
+// weighted by depth, a bonus per pickup, and a depth bonus for visiting every room.
+func ScoreDeckClear(rec DeckClearRecord) int {
+	score := 100*rec.Level + 10*rec.ItemsCollected
+	if rec.AllRoomsVisited {
+		score += 50 * rec.Level
+	}
+	return score
+}
+
+// AwardEndlessDeckClear records the current deck as cleared and adds its points to
+// EndlessScore. Returns the points awarded, or 0 when the deck was already scored.
+func (g *Game) AwardEndlessDeckClear() int {
+	if g == nil || g.HasClearedDeck(g.CurrentDeckID) {
+		return 0
+	}
+	g.RecordDeckCleared()
+	points := ScoreDeckClear(g.DeckHistory[len(g.DeckHistory)-1])
+	g.EndlessScore += points
+	return points
+}
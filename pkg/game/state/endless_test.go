@@ -0,0 +1,36 @@
+package state
+
+import "testing"
+
+func TestScoreDeckClear(t *testing.T) {
+	tests := []struct {
+		name string
+		rec  DeckClearRecord
+		want int
+	}{
+		{"base", DeckClearRecord{Level: 3}, 300},
+		{"items", DeckClearRecord{Level: 3, ItemsCollected: 4}, 340},
+		{"full sweep", DeckClearRecord{Level: 12, ItemsCollected: 1, AllRoomsVisited: true}, 1810},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ScoreDeckClear(tt.rec); got != tt.want {
+				t.Errorf("ScoreDeckClear = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAwardEndlessDeckClear_OncePerDeck(t *testing.T) {
+	g := NewGame()
+	g.CurrentDeckID, g.Level = 11, 12
+	if got := g.AwardEndlessDeckClear(); got != 1200 {
+		t.Fatalf("first award = %d, want 1200", got)
+	}
+	if got := g.AwardEndlessDeckClear(); got != 0 {
+		t.Fatalf("second award = %d, want 0", got)
+	}
+	if g.EndlessScore != 1200 {
+		t.Errorf("EndlessScore = %d, want 1200", g.EndlessScore)
+	}
+}
@@ -8,6 +8,11 @@ const (
 	ExitLiftLockedUnpowered ExitLiftState = iota
 	// ExitLiftLockedIncomplete — exit room has grid power but hazards remain; yellow locked icon.
 	ExitLiftLockedIncomplete
+	// ExitLiftLockedLowPower — objectives done but the lift's grid lacks free watts for the motor; yellow locked icon.
+	ExitLiftLockedLowPower
 	// ExitLiftReady — lift is usable; green pulsing icon and background.
 	ExitLiftReady
 )
+
+// ExitAnimDurationMs is how long the deck exit transition plays before it ends on its own.
+const ExitAnimDurationMs = 2000
@@ -0,0 +1,24 @@
+package state
+
+import "fmt"
+
+// GameOverCauseReactorMeltdown is the cause shown when a reactor meltdown ends the run.
+const GameOverCauseReactorMeltdown = "Reactor meltdown"
+
+// GameOverCauseAsphyxiated describes running out of air, naming the room when known.
+func GameOverCauseAsphyxiated(room string) string {
+	if room == "" {
+		return "Asphyxiated"
+	}
+	return fmt.Sprintf("Asphyxiated in %s", room)
+}
+
+// SnapshotFailedRunStats records run metrics when the run ends in failure. Only decks
+// actually cleared count, unlike SnapshotRunStats which assumes the whole descent.
+func (g *Game) SnapshotFailedRunStats() RunStats {
+	stats := g.SnapshotRunStats()
+	if g != nil {
+		stats.DecksCompleted = len(g.DeckHistory)
+	}
+	return stats
+}
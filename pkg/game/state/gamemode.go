@@ -1,6 +1,8 @@
 package state
 
 import (
+	"fmt"
+
 	"darkstation/pkg/game/deck"
 	"darkstation/pkg/game/gamemode"
 )
@@ -24,6 +26,24 @@ func (g *Game) Mode() gamemode.Mode {
 	return g.GameMode
 }
 
+// ParVariant names the generator settings that shape a deck besides its level and seed:
+// the mode, difficulty and deck size, e.g. "SinglePlayerPuzzle.Normal.Standard".
+func (g *Game) ParVariant() string {
+	m := g.Mode()
+	return fmt.Sprintf("%s.%s.%s", m.ID, m.Difficulty, m.DeckSize)
+}
+
+// Creative reports whether this is a creative run: every deck starts powered, unlocked
+// and revealed, and scores are not recorded.
+func (g *Game) Creative() bool {
+	return g.Mode().Creative
+}
+
+// Survival reports whether running generators slowly drain their batteries this run.
+func (g *Game) Survival() bool {
+	return g.Mode().Survival
+}
+
 // ItemPlacement returns item placement preferences for the active mode.
 func (g *Game) ItemPlacement() gamemode.ItemPlacementPrefs {
 	return g.Mode().Items
@@ -49,11 +69,18 @@ func (g *Game) FinalDeckIndex() int {
 }
 
 // IsFinalDeckLevel reports whether level (1-based) is the final deck for this mode.
+// Endless runs have no final deck.
 func (g *Game) IsFinalDeckLevel(level int) bool {
+	if g.Mode().Endless {
+		return false
+	}
 	return level >= g.TotalDecks()
 }
 
 // NextDeckID returns the next deck ID from the current deck, or false at the final deck.
 func (g *Game) NextDeckID(deckID int) (nextID int, ok bool) {
+	if g.Mode().Endless && deckID >= 0 {
+		return deckID + 1, true
+	}
 	return deck.NextDeckIDFor(g.TotalDecks(), deckID)
 }
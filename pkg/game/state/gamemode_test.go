@@ -3,6 +3,7 @@ package state
 import (
 	"testing"
 
+	"darkstation/pkg/game/deck"
 	"darkstation/pkg/game/gamemode"
 )
 
@@ -38,3 +39,20 @@ func TestInitRunUnlocks_SingleDeckSkipsCrossDeckPlan(t *testing.T) {
 		t.Fatalf("LiftRoutingPowered = %#v, want only deck 0", g.LiftRoutingPowered)
 	}
 }
+
+func TestGame_SetMode_EndlessHasNoFinalDeck(t *testing.T) {
+	g := NewGame()
+	g.SetMode(gamemode.Endless)
+	g.InitRunUnlocks(42)
+	if g.IsFinalDeckLevel(deck.TotalDecks) || g.IsFinalDeckLevel(40) {
+		t.Fatal("IsFinalDeckLevel = true, want no final deck in endless mode")
+	}
+	if next, ok := g.NextDeckID(deck.TotalDecks + 5); !ok || next != deck.TotalDecks+6 {
+		t.Fatalf("NextDeckID = %d, %v; want %d, true", next, ok, deck.TotalDecks+6)
+	}
+	for deckID := deck.FinalDeckIndex; deckID < 30; deckID++ {
+		if theme := g.ThemeForDeck(deckID); theme == deck.ThemeExitDeck || theme == deck.ThemeAirlock {
+			t.Fatalf("ThemeForDeck(%d) = %q, want a middle-deck theme", deckID, theme)
+		}
+	}
+}
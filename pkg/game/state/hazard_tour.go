@@ -72,3 +72,19 @@ func (s *HazardTourSession) panFrom() (float64, float64) {
 	prev := s.Targets[s.Index-1]
 	return float64(prev.Row), float64(prev.Col)
 }
+
+// ReturnNow skips the rest of the tour and starts the pan back to the return position. A
+// first pan still leaving the return position reverses from where it is instead of jumping.
+func (s *HazardTourSession) ReturnNow(nowMs int64) {
+	if s == nil || len(s.Targets) == 0 || s.Phase == HazardTourPanBack {
+		return
+	}
+	startMs := nowMs
+	if s.Phase == HazardTourPanTo && s.Index == 0 {
+		elapsed := min(max(nowMs-s.PhaseStartMs, 0), HazardClearPanMs)
+		startMs = nowMs - (HazardClearPanMs - elapsed)
+	}
+	s.Targets = s.Targets[:s.Index+1]
+	s.Phase = HazardTourPanBack
+	s.PhaseStartMs = startMs
+}
@@ -0,0 +1,26 @@
+package state
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHazardTourReturnNow_reversesFirstPanInPlace(t *testing.T) {
+	s := &HazardTourSession{
+		Targets:      []HazardTourTarget{{Row: 0, Col: 10}, {Row: 5, Col: 5}},
+		ReturnCamRow: 0,
+		ReturnCamCol: 0,
+		Phase:        HazardTourPanTo,
+		PhaseStartMs: 1000,
+	}
+	now := int64(1000 + HazardClearPanMs/4)
+	_, before, _ := s.CameraAt(now)
+
+	s.ReturnNow(now)
+	if s.Phase != HazardTourPanBack || len(s.Targets) != 1 {
+		t.Fatalf("phase=%v targets=%d, want pan back with the remaining targets dropped", s.Phase, len(s.Targets))
+	}
+	if _, after, _ := s.CameraAt(now); math.Abs(after-before) > 1e-9 {
+		t.Errorf("camera jumped from col %.3f to %.3f", before, after)
+	}
+}
@@ -0,0 +1,23 @@
+package state
+
+// SetInteractCycleNext records the adjacent interactable the next USE press will hit.
+func (g *Game) SetInteractCycleNext(row, col int) {
+	if g == nil {
+		return
+	}
+	g.CycleNextRow = row
+	g.CycleNextCol = col
+}
+
+// ClearInteractCycleNext drops the next-in-cycle highlight (player moved, single target).
+func (g *Game) ClearInteractCycleNext() {
+	g.SetInteractCycleNext(-1, -1)
+}
+
+// InteractCycleNext returns the next-in-cycle interactable cell, if one is tracked.
+func (g *Game) InteractCycleNext() (row, col int, ok bool) {
+	if g == nil || g.CycleNextRow < 0 || g.CycleNextCol < 0 {
+		return -1, -1, false
+	}
+	return g.CycleNextRow, g.CycleNextCol, true
+}
@@ -0,0 +1,96 @@
+package state
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"darkstation/pkg/engine/world"
+)
+
+// CountsTowardInventoryCap reports whether a carried item takes an inventory slot.
+// Batteries and the deck map never do.
+func CountsTowardInventoryCap(name string) bool {
+	return name != "Map" && !strings.Contains(strings.ToLower(name), "battery")
+}
+
+// CarriedItemCount returns how many inventory slots the player's carried items fill:
+// deck-local items and run-wide keycards, less batteries and the map.
+func (g *Game) CarriedItemCount() int {
+	if g == nil {
+		return 0
+	}
+	n := 0
+	count := func(item *world.Item) {
+		if item != nil && CountsTowardInventoryCap(item.Name) {
+			n++
+		}
+	}
+	g.OwnedItems.Each(count)
+	g.RunInventory.Each(count)
+	return n
+}
+
+// DroppableItems returns the carried items that can be put down, sorted by name:
+// deck-local items (tagged batteries included) and run-wide keycards. The map and
+// the plain battery count cannot be dropped.
+func (g *Game) DroppableItems() []*world.Item {
+	if g == nil {
+		return nil
+	}
+	var items []*world.Item
+	collect := func(item *world.Item) {
+		if item != nil && item.Name != "" && item.Name != "Map" {
+			items = append(items, item)
+		}
+	}
+	g.OwnedItems.Each(collect)
+	g.RunInventory.Each(collect)
+	slices.SortFunc(items, func(a, b *world.Item) int {
+		return cmp.Or(strings.Compare(a.Name, b.Name), strings.Compare(a.Tag, b.Tag))
+	})
+	return items
+}
+
+// DropItem moves item from the player's inventory onto the floor of their cell, where
+// auto pick-up leaves it until they step away. The same item goes down, so keycards
+// and kits keep their identity when picked up again. Returns false when item is not
+// carried or the player is nowhere.
+func (g *Game) DropItem(item *world.Item) bool {
+	if g == nil || item == nil || g.CurrentCell == nil {
+		return false
+	}
+	switch {
+	case g.OwnedItems.Has(item):
+		g.OwnedItems.Remove(item)
+	case g.RunInventory.Has(item):
+		g.RunInventory.Remove(item)
+	default:
+		return false
+	}
+	g.CurrentCell.ItemsOnFloor.Put(item)
+	g.DroppedOnCell = g.CurrentCell
+	g.NoteItemDropped(item)
+	return true
+}
+
+// NoteItemDropped records that item went from the player's inventory to the floor.
+func (g *Game) NoteItemDropped(item *world.Item) {
+	if g == nil || item == nil {
+		return
+	}
+	if g.DroppedItems == nil {
+		g.DroppedItems = make(map[*world.Item]struct{})
+	}
+	g.DroppedItems[item] = struct{}{}
+}
+
+// WasDropped reports whether item has been carried and put down before, so picking it
+// up again is not a new find.
+func (g *Game) WasDropped(item *world.Item) bool {
+	if g == nil {
+		return false
+	}
+	_, ok := g.DroppedItems[item]
+	return ok
+}
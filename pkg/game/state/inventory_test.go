@@ -0,0 +1,33 @@
+package state
+
+import (
+	"testing"
+
+	"darkstation/pkg/engine/world"
+)
+
+func TestCarriedItemCount_skipsBatteriesAndMap(t *testing.T) {
+	g := NewGame()
+	g.OwnedItems.Put(world.NewItem("Map"))
+	g.OwnedItems.Put(world.NewTaggedItem("Red Battery", "red"))
+	g.OwnedItems.Put(world.NewItem("Patch Kit"))
+	g.RunInventory.Put(world.NewItem("Reactor Authorization — Observatory"))
+
+	if n := g.CarriedItemCount(); n != 2 {
+		t.Errorf("CarriedItemCount = %d, want 2 (patch kit and keycard)", n)
+	}
+	if n := len(g.DroppableItems()); n != 3 {
+		t.Errorf("DroppableItems = %d items, want 3 (everything but the map)", n)
+	}
+}
+
+func TestDropItem_requiresCarriedItem(t *testing.T) {
+	g := NewGame()
+	g.CurrentCell = world.NewCell(0, 0, "Cargo Bay", "")
+	if g.DropItem(world.NewItem("Patch Kit")) {
+		t.Fatal("an item not carried should not drop")
+	}
+	if g.CurrentCell.ItemsOnFloor.Size() != 0 || g.DroppedOnCell != nil {
+		t.Error("a failed drop should leave the floor untouched")
+	}
+}
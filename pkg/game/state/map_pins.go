@@ -0,0 +1,86 @@
+package state
+
+import (
+	"sort"
+	"strings"
+)
+
+// MapPinNoteMaxLen caps a pin note so it fits a callout line.
+const MapPinNoteMaxLen = 40
+
+// CellCoord identifies a grid cell by position (stable across deck save/restore).
+type CellCoord struct {
+	Row, Col int
+}
+
+// MapPin is a player note dropped on a discovered cell.
+type MapPin struct {
+	CellCoord
+	Note string
+}
+
+// SetMapPin stores a note on the cell at row, col. Markup braces are stripped and the
+// note is trimmed to MapPinNoteMaxLen; an empty note removes the pin.
+func (g *Game) SetMapPin(row, col int, note string) {
+	if g == nil {
+		return
+	}
+	note = strings.TrimSpace(strings.NewReplacer("{", "", "}", "").Replace(note))
+	if r := []rune(note); len(r) > MapPinNoteMaxLen {
+		note = strings.TrimSpace(string(r[:MapPinNoteMaxLen]))
+	}
+	if note == "" {
+		g.RemoveMapPin(row, col)
+		return
+	}
+	if g.MapPins == nil {
+		g.MapPins = make(map[CellCoord]string)
+	}
+	g.MapPins[CellCoord{row, col}] = note
+}
+
+// RemoveMapPin deletes the pin on the cell at row, col, if any.
+func (g *Game) RemoveMapPin(row, col int) {
+	if g == nil {
+		return
+	}
+	delete(g.MapPins, CellCoord{row, col})
+}
+
+// MapPinAt returns the note pinned on the cell at row, col.
+func (g *Game) MapPinAt(row, col int) (string, bool) {
+	if g == nil {
+		return "", false
+	}
+	note, ok := g.MapPins[CellCoord{row, col}]
+	return note, ok
+}
+
+// SortedMapPins lists this deck's pins in row-major order.
+func (g *Game) SortedMapPins() []MapPin {
+	if g == nil || len(g.MapPins) == 0 {
+		return nil
+	}
+	pins := make([]MapPin, 0, len(g.MapPins))
+	for at, note := range g.MapPins {
+		pins = append(pins, MapPin{CellCoord: at, Note: note})
+	}
+	sort.Slice(pins, func(i, j int) bool {
+		if pins[i].Row != pins[j].Row {
+			return pins[i].Row < pins[j].Row
+		}
+		return pins[i].Col < pins[j].Col
+	})
+	return pins
+}
+
+func copyMapPins(pins map[CellCoord]string) map[CellCoord]string {
+	if len(pins) == 0 {
+		return nil
+	}
+	out := make(map[CellCoord]string, len(pins))
+	for at, note := range pins {
+		out[at] = note
+	}
+	return out
+}
@@ -0,0 +1,58 @@
+package state
+
+import "testing"
+
+func TestSetMapPin_SanitisesAndRemovesEmpty(t *testing.T) {
+	g := NewGame()
+	g.SetMapPin(2, 3, "  battery {here}  ")
+	if note, ok := g.MapPinAt(2, 3); !ok || note != "battery here" {
+		t.Fatalf("MapPinAt = %q, %v; want %q, true", note, ok, "battery here")
+	}
+	g.SetMapPin(2, 3, "   ")
+	if _, ok := g.MapPinAt(2, 3); ok {
+		t.Error("empty note should remove the pin")
+	}
+}
+
+func TestSetMapPin_TruncatesLongNote(t *testing.T) {
+	g := NewGame()
+	long := ""
+	for range MapPinNoteMaxLen + 10 {
+		long += "x"
+	}
+	g.SetMapPin(0, 0, long)
+	if note, _ := g.MapPinAt(0, 0); len(note) != MapPinNoteMaxLen {
+		t.Errorf("note length = %d, want %d", len(note), MapPinNoteMaxLen)
+	}
+}
+
+func TestSortedMapPins_RowMajor(t *testing.T) {
+	g := NewGame()
+	g.SetMapPin(4, 1, "c")
+	g.SetMapPin(1, 5, "b")
+	g.SetMapPin(1, 2, "a")
+	pins := g.SortedMapPins()
+	if len(pins) != 3 || pins[0].Note != "a" || pins[1].Note != "b" || pins[2].Note != "c" {
+		t.Errorf("SortedMapPins = %+v, want a, b, c", pins)
+	}
+}
+
+func TestSaveAndLoadDeckState_MapPinsPerDeck(t *testing.T) {
+	g := NewGame()
+	g.CurrentDeckID = 0
+	g.Grid = makeMinimalGrid()
+	g.SetMapPin(0, 1, "locked vault")
+	g.SaveCurrentDeckState()
+
+	g.MapPins = nil
+	g.CurrentDeckID = 1
+	g.SaveCurrentDeckState()
+	g.LoadDeckState(0)
+	if note, ok := g.MapPinAt(0, 1); !ok || note != "locked vault" {
+		t.Fatalf("after load, MapPinAt = %q, %v; want restored pin", note, ok)
+	}
+	g.LoadDeckState(1)
+	if len(g.MapPins) != 0 {
+		t.Errorf("deck 1 pins = %v, want none", g.MapPins)
+	}
+}
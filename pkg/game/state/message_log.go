@@ -0,0 +1,28 @@
+package state
+
+import "time"
+
+// MessageLogLimit caps the run's message history; the oldest entries are dropped first.
+const MessageLogLimit = 500
+
+// LoggedMessage is one entry in the run's message history (message log menu).
+type LoggedMessage struct {
+	Text   string
+	Level  int   // Deck level the message was shown on
+	AtSecs int64 // Seconds since the run began
+}
+
+// recordMessage appends msg to MessageLog. Unlike Messages, the log does not expire.
+func (g *Game) recordMessage(msg string) {
+	if msg == "" {
+		return
+	}
+	elapsed := int64(0)
+	if g.RunStartedAt > 0 {
+		elapsed = max((time.Now().UnixMilli()-g.RunStartedAt)/1000, 0)
+	}
+	g.MessageLog = append(g.MessageLog, LoggedMessage{Text: msg, Level: g.Level, AtSecs: elapsed})
+	if over := len(g.MessageLog) - MessageLogLimit; over > 0 {
+		g.MessageLog = append(g.MessageLog[:0:0], g.MessageLog[over:]...)
+	}
+}
@@ -0,0 +1,35 @@
+package state
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAddMessage_KeepsLogAfterTransientMessagesExpire(t *testing.T) {
+	g := NewGame()
+	g.Level = 3
+	for i := range 7 {
+		g.AddMessage(fmt.Sprintf("msg %d", i))
+	}
+	g.ClearMessages()
+	if len(g.MessageLog) != 7 {
+		t.Fatalf("MessageLog len = %d, want 7", len(g.MessageLog))
+	}
+	if first := g.MessageLog[0]; first.Text != "msg 0" || first.Level != 3 {
+		t.Errorf("first entry = %+v, want msg 0 on deck 3", first)
+	}
+}
+
+func TestAddMessage_LogDropsOldestPastLimit(t *testing.T) {
+	g := NewGame()
+	g.AddMessage("")
+	for i := range MessageLogLimit + 2 {
+		g.AddMessage(fmt.Sprintf("msg %d", i))
+	}
+	if len(g.MessageLog) != MessageLogLimit {
+		t.Fatalf("MessageLog len = %d, want %d", len(g.MessageLog), MessageLogLimit)
+	}
+	if g.MessageLog[0].Text != "msg 2" {
+		t.Errorf("oldest entry = %q, want msg 2", g.MessageLog[0].Text)
+	}
+}
@@ -0,0 +1,18 @@
+package state
+
+import "darkstation/pkg/engine/world"
+
+// ObjectiveRoute is the traced path from the player to a hinted objective.
+// Cells run from the cell after the player up to and including the target.
+type ObjectiveRoute struct {
+	Target *world.Cell
+	Cells  []*world.Cell
+}
+
+// ClearObjectiveRoute drops any traced route (deck change, arrival, objective done).
+func (g *Game) ClearObjectiveRoute() {
+	if g == nil {
+		return
+	}
+	g.ObjectiveRoute = nil
+}
@@ -0,0 +1,20 @@
+package state
+
+// MarkDeckEntry notes the move and interaction counts on arrival, so DeckActions
+// measures only this visit to the deck.
+func (g *Game) MarkDeckEntry() {
+	if g == nil {
+		return
+	}
+	g.DeckEntryMoves = g.MovementCount
+	g.DeckEntryInteractions = g.InteractionsCount
+}
+
+// DeckActions is the moves plus interactions made since the player arrived on the
+// current deck; it is the score compared against the deck's stored par.
+func (g *Game) DeckActions() int {
+	if g == nil {
+		return 0
+	}
+	return max(g.MovementCount-g.DeckEntryMoves, 0) + max(g.InteractionsCount-g.DeckEntryInteractions, 0)
+}
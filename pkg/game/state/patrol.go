@@ -0,0 +1,25 @@
+package state
+
+import (
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// DeckPatrol returns the current deck's patrol bot and the cell it stands on, or nils
+// when the deck has none.
+func (g *Game) DeckPatrol() (*world.Cell, *entities.Patrol) {
+	if g == nil || g.Grid == nil {
+		return nil, nil
+	}
+	var at *world.Cell
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if at == nil && gameworld.HasPatrol(cell) {
+			at = cell
+		}
+	})
+	if at == nil {
+		return nil, nil
+	}
+	return at, gameworld.GetGameData(at).Patrol
+}
@@ -0,0 +1,25 @@
+package state
+
+// PowerSurge schedules a grid surge on deep decks. A surge is telegraphed once
+// WarnAtMs passes, adds Watts to every grid's consumption between StartMs and EndMs,
+// then recovers and schedules the next one.
+type PowerSurge struct {
+	WarnAtMs int64 // Unix ms when the warning fires; 0 until scheduled
+	StartMs  int64 // Unix ms when the extra load hits; set when the warning fires
+	EndMs    int64 // Unix ms when the grid stabilises; set when the warning fires
+	Watts    int   // Extra load while live; 0 otherwise
+	Warned   bool  // Warning shown for the scheduled surge
+}
+
+// SurgeWatts returns the extra load a live power surge adds to consumption.
+func (g *Game) SurgeWatts() int {
+	if g == nil {
+		return 0
+	}
+	return g.PowerSurge.Watts
+}
+
+// PowerSurgeInProgress reports whether a surge is telegraphed or live.
+func (g *Game) PowerSurgeInProgress() bool {
+	return g != nil && (g.PowerSurge.Warned || g.PowerSurge.Watts > 0)
+}
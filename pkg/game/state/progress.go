@@ -0,0 +1,20 @@
+package state
+
+// ProgressSignature summarises objective progress on the current deck. Any change
+// between turns counts as the player advancing.
+type ProgressSignature struct {
+	DeckID           int
+	RoomsVisited     int
+	GeneratorsOnline int
+	HazardsBlocking  int
+	ItemsHeld        int
+}
+
+// ProgressTracker remembers when the player last advanced so a stuck player can be
+// nudged toward the next objective.
+type ProgressTracker struct {
+	Signature ProgressSignature
+	Moves     int   // MovementCount when Signature last changed
+	AtMs      int64 // Unix ms when Signature last changed; 0 until first tracked
+	Nudged    bool  // A nudge has been shown since the last progress
+}
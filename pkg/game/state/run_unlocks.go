@@ -5,6 +5,7 @@ import (
 
 	"darkstation/pkg/engine/world"
 	"darkstation/pkg/game/deck"
+	"darkstation/pkg/game/entities"
 	"darkstation/pkg/game/unlocks"
 )
 
@@ -51,8 +52,12 @@ func (g *Game) ThemeForCurrentDeck() deck.Theme {
 	return deck.ThemeForDeckID(g.DeckThemes, g.CurrentDeckID)
 }
 
-// ThemeForDeck returns the theme assigned to a deck ID.
+// ThemeForDeck returns the theme assigned to a deck ID. Endless decks past the
+// station's last middle deck reuse the middle themes in order.
 func (g *Game) ThemeForDeck(deckID int) deck.Theme {
+	if g.Mode().Endless && deckID >= deck.FinalDeckIndex {
+		deckID = 1 + (deckID-1)%(deck.FinalDeckIndex-1)
+	}
 	return deck.ThemeForDeckID(g.DeckThemes, deckID)
 }
 
@@ -70,6 +75,68 @@ func (g *Game) HasRunKeycard(name string) bool {
 	return found
 }
 
+// CanUnlockDoor reports whether the player holds everything a locked door needs:
+// its keycard (or a master keycard that outranks it) and, for vault doors, the access code.
+func (g *Game) CanUnlockDoor(door *entities.Door) bool {
+	if g == nil || door == nil || g.DoorKeycard(door) == "" {
+		return false
+	}
+	return !door.IsVault() || g.HasFoundCode(door.RequiresCode)
+}
+
+// DoorKeycard returns the name of the keycard the player would use on door: the room's
+// own keycard when carried, otherwise the highest-tier master keycard that opens it.
+// Returns "" when nothing carried opens the door.
+func (g *Game) DoorKeycard(door *entities.Door) string {
+	if g == nil || door == nil {
+		return ""
+	}
+	if name := door.KeycardName(); g.HasKeycardNamed(name) {
+		return name
+	}
+	best := entities.KeycardTierNone
+	check := func(item *world.Item) {
+		if item == nil {
+			return
+		}
+		if t := entities.MasterKeycardTier(item.Name); t > best && t.Opens(door.Tier) {
+			best = t
+		}
+	}
+	g.RunInventory.Each(check)
+	g.OwnedItems.Each(check)
+	if best == entities.KeycardTierNone {
+		return ""
+	}
+	return entities.MasterKeycardName(best)
+}
+
+// UseMasterKeycard spends a master keycard swiped at door. A card of exactly the door's
+// tier is used up; a higher-tier card stays in inventory. Returns true when the card
+// was consumed; room keycards and unknown names are left alone.
+func (g *Game) UseMasterKeycard(name string, door *entities.Door) bool {
+	if g == nil || door == nil {
+		return false
+	}
+	tier := entities.MasterKeycardTier(name)
+	if tier == entities.KeycardTierNone || tier != max(door.Tier, entities.KeycardTierGreen) {
+		return false
+	}
+	for _, inv := range []*world.ItemSet{&g.RunInventory, &g.OwnedItems} {
+		var found *world.Item
+		inv.Each(func(item *world.Item) {
+			if found == nil && item != nil && item.Name == name {
+				found = item
+			}
+		})
+		if found != nil {
+			inv.Remove(found)
+			return true
+		}
+	}
+	return false
+}
+
 // HasKeycardNamed checks run-wide and deck-local inventory for a keycard.
 func (g *Game) HasKeycardNamed(name string) bool {
 	if g == nil || name == "" {
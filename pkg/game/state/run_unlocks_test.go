@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
 	"darkstation/pkg/game/unlocks"
 )
 
@@ -42,6 +43,56 @@ func TestIsRunWideKeycardName(t *testing.T) {
 	}
 }
 
+func TestDoorKeycard_roomCardThenHighestMaster(t *testing.T) {
+	g := NewGame()
+	door := entities.NewDoor("Lab")
+	door.Tier = entities.KeycardTierBlue
+
+	if got := g.DoorKeycard(door); got != "" {
+		t.Fatalf("empty inventory DoorKeycard = %q, want none", got)
+	}
+	g.AddRunKeycard(world.NewItem(entities.MasterKeycardName(entities.KeycardTierGreen)))
+	if g.CanUnlockDoor(door) {
+		t.Fatal("a Green master keycard must not open a Blue door")
+	}
+	g.AddRunKeycard(world.NewItem(entities.MasterKeycardName(entities.KeycardTierBlue)))
+	g.AddRunKeycard(world.NewItem(entities.MasterKeycardName(entities.KeycardTierRed)))
+	if got, want := g.DoorKeycard(door), entities.MasterKeycardName(entities.KeycardTierRed); got != want {
+		t.Fatalf("DoorKeycard = %q, want %q", got, want)
+	}
+	g.AddRunKeycard(world.NewItem("Lab Keycard"))
+	if got := g.DoorKeycard(door); got != "Lab Keycard" {
+		t.Fatalf("DoorKeycard = %q, want the room's own keycard", got)
+	}
+}
+
+func TestUseMasterKeycard_consumesExactTierOnly(t *testing.T) {
+	g := NewGame()
+	door := entities.NewDoor("Lab")
+	door.Tier = entities.KeycardTierBlue
+	blue := entities.MasterKeycardName(entities.KeycardTierBlue)
+	red := entities.MasterKeycardName(entities.KeycardTierRed)
+	g.AddRunKeycard(world.NewItem(blue))
+	g.AddRunKeycard(world.NewItem(red))
+
+	if g.UseMasterKeycard(red, door) {
+		t.Fatal("a higher-tier master keycard should not be consumed")
+	}
+	if !g.HasKeycardNamed(red) {
+		t.Fatal("Red master keycard should still be carried")
+	}
+	if !g.UseMasterKeycard(blue, door) {
+		t.Fatal("an exact-tier master keycard should be consumed")
+	}
+	if g.HasKeycardNamed(blue) {
+		t.Fatal("Blue master keycard should be gone after use")
+	}
+	g.AddRunKeycard(world.NewItem("Lab Keycard"))
+	if g.UseMasterKeycard("Lab Keycard", door) || !g.HasKeycardNamed("Lab Keycard") {
+		t.Fatal("room keycards are never consumed")
+	}
+}
+
 func TestPromoteOwnedRunKeycards(t *testing.T) {
 	g := NewGame()
 	g.InitRunUnlocks(99)
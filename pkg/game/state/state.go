@@ -2,6 +2,7 @@ package state
 
 import (
 	"fmt"
+	"math/rand"
 	"time"
 
 	"github.com/zyedidia/generic/mapset"
@@ -10,6 +11,7 @@ import (
 	"darkstation/pkg/game/deck"
 	"darkstation/pkg/game/entities"
 	"darkstation/pkg/game/gamemode"
+	"darkstation/pkg/game/levelrand"
 	"darkstation/pkg/game/unlocks"
 	gameworld "darkstation/pkg/game/world"
 )
@@ -39,6 +41,7 @@ type DeckState struct {
 	ManualEgressReleasedAtMs map[string]int64
 	Policies                 []*entities.ConservationPolicy
 	OwnedItems               world.ItemSet // keycards and other deck-local pickup inventory
+	MapPins                  map[CellCoord]string
 }
 
 // Game represents the game state for Abandoned Station
@@ -54,8 +57,15 @@ type Game struct {
 	HasMap bool
 
 	OwnedItems world.ItemSet
+	// DroppedOnCell is where the player last dropped an item; auto pick-up skips it until they step away.
+	DroppedOnCell *world.Cell
+	// DroppedItems holds every item that has left the player's hands onto the floor, so
+	// picking one up again does not count as a new collection.
+	DroppedItems map[*world.Item]struct{}
 
 	Messages []MessageEntry
+	// MessageLog keeps every message this run, oldest first, for the message log menu.
+	MessageLog []LoggedMessage
 
 	NavStyle NavStyle
 
@@ -63,9 +73,21 @@ type Game struct {
 
 	// PerfMapScenario is set on developer performance test maps (console perfmap); empty during normal play.
 	PerfMapScenario string
+	// DeckName and DeckObjectives override the deck title and objectives list on a
+	// hand-authored deck loaded from a file (-deck / console loaddeck); empty otherwise.
+	DeckName       string
+	DeckObjectives []string
 
 	CurrentDeckID int                // 0-based deck index (source of truth for which deck we're in)
 	DeckStates    map[int]*DeckState // Per-deck generated state; key = deck ID (0-based)
+	// DeckRatings caches difficulty previews of decks not yet visited; key = deck ID (0-based).
+	DeckRatings map[int]DeckRating
+	// PreviewDecks holds decks generated for a rating before their first visit; the first
+	// visit adopts the entry instead of generating the deck again. Key = deck ID (0-based).
+	PreviewDecks map[int]*PreviewDeck
+	// LootedDecks marks decks visited before a resumed autosave. They are rebuilt from
+	// their seed on the next visit and emptied again; key = deck ID (0-based).
+	LootedDecks map[int]bool
 
 	// GameMode selects deck count, unlock rules, and item placement for this run.
 	GameMode gamemode.Mode
@@ -84,20 +106,34 @@ type Game struct {
 	FoundCodes               map[string]bool       // Puzzle codes found by the player (code -> found)
 	ExitAnimating            bool                  // True when exit animation is playing
 	ExitAnimStartTime        int64                 // Timestamp when exit animation started (milliseconds)
+	ExitAnimSkipRequested    bool                  // True when a key press asked the exit animation to end early
 	LastInteractedRow        int                   // Row of last cell interacted with (for cycling)
 	LastInteractedCol        int                   // Col of last cell interacted with (for cycling)
 	InteractionPlayerRow     int                   // Player row when interaction order was established
 	InteractionPlayerCol     int                   // Player col when interaction order was established
+	CycleNextRow             int                   // Row of the adjacent interactable the next press will hit (-1 when none)
+	CycleNextCol             int                   // Col of the adjacent interactable the next press will hit (-1 when none)
+	BlockedMoveRow           int                   // Row of the cell the last refused move targeted (-1 when none)
+	BlockedMoveCol           int                   // Col of the cell the last refused move targeted (-1 when none)
+	BlockedMoveAtMs          int64                 // When that move was last refused (held-key callout throttle)
+	RiskyMoveRow             int                   // Row of the risky cell waiting for a confirming move (-1 when none)
+	RiskyMoveCol             int                   // Col of the risky cell waiting for a confirming move (-1 when none)
+	RiskyMoveAtMs            int64                 // When a move onto that cell was last held back
 	InteractionsCount        int                   // Number of objects the player has interacted with (for hint system)
 	MovementCount            int                   // Number of times the player has moved (for movement hint)
+	DeckEntryMoves           int                   // MovementCount when the player arrived on the current deck (for par)
+	DeckEntryInteractions    int                   // InteractionsCount when the player arrived on the current deck (for par)
 	LevelSeed                int64                 // Random seed used for current level generation (for reset)
 	LevelGenAttempts         int                   // Generation attempts used (1 = first layout passed the solvability gate)
+	Rand                     *rand.Rand            // Level-generation RNG, reseeded for each generation attempt (see RNG)
 	PowerSupply              int                   // Total available power from generators
 	PowerConsumption         int                   // Total power being consumed by active devices
 	PowerOverloadWarned      bool                  // Whether we've warned about power overload this cycle
 	RepairObjectives         []*entities.RepairObjective
 	QuitToTitle              bool            // Set to true to quit to main menu
 	GameComplete             bool            // True when player reached final deck and lift has no destination (completion)
+	GameOver                 bool            // True when the run has failed (see gameplay.TriggerGameOver)
+	GameOverCause            string          // Descriptive cause shown on the game-over overlay
 	RunStartedAt             int64           // Unix ms when the current run began
 	CompletionPhase          CompletionPhase // Summary stats or credits roll
 	RunStatsSnapshot         RunStats        // Stats frozen at completion
@@ -106,13 +142,57 @@ type Game struct {
 	CreditsExitStartMs       int64           // Non-zero while the current line is sliding out (manual/auto advance)
 	CreditsTransitionStartMs int64           // Non-zero during summary→credits crossfade
 
+	// DeckHistory lists cleared decks in descent order (completion overlay, history menu).
+	DeckHistory []DeckClearRecord
+	// DeckItemsCollected maps deck ID -> items picked up on that deck.
+	DeckItemsCollected map[int]int
+	// EndlessScore is the cumulative endless-mode score (see ScoreDeckClear).
+	EndlessScore int
+
+	// ObjectiveRoute is the hint-traced path to the next objective; nil when none is shown.
+	ObjectiveRoute *ObjectiveRoute
+	// ObjectiveCycle is the index the next cycle-objectives press focuses (see CycleObjectiveFocus).
+	ObjectiveCycle int
+	// SoftLockCheckedAt is the MovementCount of the last soft-lock check (see CheckSoftLock).
+	SoftLockCheckedAt int
+	// HazardSpreadAt is the MovementCount of the last spreading-hazard tick (see SpreadHazards).
+	HazardSpreadAt int
+	// PatrolStepAt is the MovementCount of the last patrol bot step (see MovePatrol).
+	PatrolStepAt int
+	// GeneratorDrainAt is the MovementCount of the last survival drain tick (see DrainGenerators).
+	GeneratorDrainAt int
+	// OverloadStreak counts consecutive moves spent with an overloaded grid; a generator
+	// blows out when it reaches the limit (see advanceOverloadStreak).
+	OverloadStreak int
+	// OverloadStreakAt is the MovementCount OverloadStreak was last advanced on.
+	OverloadStreakAt int
+	// SoftLockDismissed is set when the player chose to keep playing a soft-locked deck.
+	SoftLockDismissed bool
+	// LockAlarm is set when a lock pick snaps; no lock on the deck can be picked after that.
+	LockAlarm bool
+
+	// Progress tracks the last objective progress for the stuck-player nudge.
+	Progress ProgressTracker
+
+	// MapPins holds the player's notes on this deck's cells (saved with the deck).
+	MapPins map[CellCoord]string
+
+	// PowerSurge schedules timed grid surges on deep decks.
+	PowerSurge PowerSurge
+
+	// AutoExplore is non-nil while the player is auto-exploring (stepped from the main loop).
+	AutoExplore *AutoExploreSession
+	// WalkTo is non-nil while the player is walking to a clicked cell (stepped from the main loop).
+	WalkTo *WalkToSession
+
 	// Room power: doors and CCTV/hazard controls are unpowered by default.
 	// Start room's doors are powered so the player can leave.
-	RoomDoorsPowered     map[string]bool // room name -> power grid armed (player enabled circuit at maint terminal)
-	RoomCCTVPowered      map[string]bool // room name -> CCTV requested when room is online
-	RoomLightsPowered    map[string]bool // room name -> lights enabled (0w; toggled at maintenance terminal)
-	RoomPowerOnline      map[string]bool // room name -> propagated power has reached the room
-	ManualEgressReleased map[string]bool // room name -> hold-to-release bypass active (routing still offline)
+	RoomDoorsPowered     map[string]bool    // room name -> power grid armed (player enabled circuit at maint terminal)
+	RoomCCTVPowered      map[string]bool    // room name -> CCTV requested when room is online
+	RoomLightsPowered    map[string]bool    // room name -> lights enabled (0w; toggled at maintenance terminal)
+	RoomLightLevel       map[string]float64 // room name -> brightness below 1 while its lights run dim on a strained grid (recomputed with lighting)
+	RoomPowerOnline      map[string]bool    // room name -> propagated power has reached the room
+	ManualEgressReleased map[string]bool    // room name -> hold-to-release bypass active (routing still offline)
 
 	// ManualEgressReleasedAtMs records when each manual release was performed (egress-seal policies).
 	ManualEgressReleasedAtMs map[string]int64
@@ -160,6 +240,10 @@ type Game struct {
 	PowerGridOverlaySeedRow int
 	PowerGridOverlaySeedCol int
 
+	// PowerDiagnosticsActive shows the deck-wide power diagnostics view (room balance tint,
+	// generator-to-room links, and total available power).
+	PowerDiagnosticsActive bool
+
 	// LongUse holds an in-progress hold-to-use interaction (nil when inactive).
 	LongUse *LongUseSession
 
@@ -172,6 +256,12 @@ type Game struct {
 	// SlimePops holds short drain pop animations for toxic-slime cells.
 	SlimePops []SlimePop
 
+	// AmbientHazard tracks exposure to the passable room hazard underfoot (flooding, reactor bleed).
+	AmbientHazard AmbientHazardState
+
+	// DeckRadiation is the deck-wide radiation meter fed by uncleared radiation leaks.
+	DeckRadiation DeckRadiationState
+
 	// livePowerCellsCache caches CellsReachableFromPoweredGenerators for the current routing state.
 	livePowerCellsCache *mapset.Set[*world.Cell]
 	livePowerCacheValid bool
@@ -205,6 +295,15 @@ type PowerPropEntry struct {
 	ActivateAt int64
 }
 
+// RNG returns the level-generation RNG that layout and placement draw from, seeding
+// it from LevelSeed on first use.
+func (g *Game) RNG() *rand.Rand {
+	if g.Rand == nil {
+		g.Rand = levelrand.New(g.LevelSeed)
+	}
+	return g.Rand
+}
+
 // NewGame creates a new game instance
 func NewGame() *Game {
 	return &Game{
@@ -226,6 +325,12 @@ func NewGame() *Game {
 		LastInteractedCol:     -1,
 		InteractionPlayerRow:  -1,
 		InteractionPlayerCol:  -1,
+		CycleNextRow:          -1,
+		CycleNextCol:          -1,
+		BlockedMoveRow:        -1,
+		BlockedMoveCol:        -1,
+		RiskyMoveRow:          -1,
+		RiskyMoveCol:          -1,
 		PowerSupply:           0,
 		PowerConsumption:      0,
 		PowerOverloadWarned:   false,
@@ -235,6 +340,7 @@ func NewGame() *Game {
 		RoomLightsPowered:     make(map[string]bool),
 		RoomPowerOnline:       make(map[string]bool),
 		ManualEgressReleased:  make(map[string]bool),
+		DeckItemsCollected:    make(map[int]int),
 		GeneratorShutdownRow:  -1,
 		GeneratorShutdownCol:  -1,
 		ObservationCueVisited: make(map[string]struct{}),
@@ -363,6 +469,20 @@ func (g *Game) AllHazardsCleared() bool {
 	return !hasBlockingHazard
 }
 
+// ActiveHazardCount returns how many uncleared blocking hazards of type t are on the deck.
+func (g *Game) ActiveHazardCount(t entities.HazardType) int {
+	if g == nil || g.Grid == nil {
+		return 0
+	}
+	count := 0
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if cell != nil && gameworld.HasBlockingHazard(cell) && gameworld.GetGameData(cell).Hazard.Type == t {
+			count++
+		}
+	})
+	return count
+}
+
 // RebuildRepairObjectivesFromGrid repopulates the deck objective index from placed cells.
 func (g *Game) RebuildRepairObjectivesFromGrid() {
 	if g == nil || g.Grid == nil {
@@ -532,6 +652,18 @@ func (g *Game) UnpoweredGeneratorCount() int {
 	return count
 }
 
+// TotalBatteriesNeeded returns how many more batteries the deck's unpowered generators
+// need between them; a partly filled generator counts only what it still lacks.
+func (g *Game) TotalBatteriesNeeded() int {
+	total := 0
+	for _, gen := range g.Generators {
+		if gen != nil && !gen.IsPowered() {
+			total += gen.BatteriesNeeded()
+		}
+	}
+	return total
+}
+
 // AddMessage adds a message to the game's message log
 func (g *Game) AddMessage(msg string) {
 	const maxMessages = 5
@@ -549,6 +681,7 @@ func (g *Game) AddMessage(msg string) {
 	if len(g.Messages) > maxMessages {
 		g.Messages = g.Messages[len(g.Messages)-maxMessages:]
 	}
+	g.recordMessage(msg)
 }
 
 // RemoveOldMessages removes messages older than 10 seconds from the buffer
@@ -610,6 +743,7 @@ func (g *Game) AdvanceLevel() {
 	g.RoomDoorsPowered = make(map[string]bool)
 	g.RoomCCTVPowered = make(map[string]bool)
 	g.RoomLightsPowered = make(map[string]bool)
+	g.RoomLightLevel = nil
 	g.RoomPowerOnline = make(map[string]bool)
 	g.ManualEgressReleasedAtMs = nil
 	g.Policies = nil
@@ -667,7 +801,8 @@ func copyOwnedItems(items world.ItemSet) world.ItemSet {
 	out := mapset.New[*world.Item]()
 	items.Each(func(item *world.Item) {
 		if item != nil {
-			out.Put(world.NewItem(item.Name))
+			copied := *item
+			out.Put(&copied)
 		}
 	})
 	return out
@@ -693,8 +828,10 @@ func (g *Game) SaveCurrentDeckState() {
 			Name:              gen.Name,
 			BatteriesRequired: gen.BatteriesRequired,
 			BatteriesInserted: gen.BatteriesInserted,
+			BatterySequence:   append([]string(nil), gen.BatterySequence...),
 			Online:            gen.Online,
 			Tripped:           gen.Tripped,
+			Spent:             gen.Spent,
 		}
 	}
 	g.DeckStates[g.CurrentDeckID] = &DeckState{
@@ -712,6 +849,7 @@ func (g *Game) SaveCurrentDeckState() {
 		Generators:               genCopy,
 		RepairObjectives:         append([]*entities.RepairObjective(nil), g.RepairObjectives...),
 		OwnedItems:               copyOwnedItems(g.OwnedItems),
+		MapPins:                  copyMapPins(g.MapPins),
 	}
 }
 
@@ -746,6 +884,7 @@ func (g *Game) LoadDeckState(deckID int) {
 		g.RoomPowerOffPending = nil
 	}
 	g.OwnedItems = copyOwnedItems(ds.OwnedItems)
+	g.MapPins = copyMapPins(ds.MapPins)
 	g.PromoteOwnedRunKeycards()
 	g.RebuildGeneratorsFromGrid()
 	if ds.RepairObjectives != nil {
@@ -772,12 +911,15 @@ func (g *Game) GetAvailablePower() int {
 	return g.PowerSupply - g.PowerConsumption
 }
 
-// UpdatePowerSupply recalculates total deck power generation from all powered generators.
+// UpdatePowerSupply recalculates total deck power generation from all powered generators,
+// plus the brownout trickle from partly fueled ones (see Generator.PartialOutput).
 func (g *Game) UpdatePowerSupply() {
 	totalPower := 0
 	for _, gen := range g.Generators {
 		if gen.IsPowered() {
-			totalPower += 100
+			totalPower += gen.RatedOutput()
+		} else {
+			totalPower += gen.PartialOutput()
 		}
 	}
 	g.PowerSupply = totalPower
@@ -818,7 +960,7 @@ func calculateConsumptionFromMaps(g *Game, online, cctv map[string]bool) int {
 		}
 	})
 	params := deck.DecayParamsForDeck(g.CurrentDeckID)
-	return int(float64(rawConsumption) * params.PowerCostMultiplier)
+	return int(float64(rawConsumption)*params.PowerCostMultiplier) + g.SurgeWatts()
 }
 
 // AddFoundCode records that the player has found a puzzle code
@@ -130,6 +130,31 @@ func TestUnpoweredGeneratorCount(t *testing.T) {
 	}
 }
 
+func TestTotalBatteriesNeeded(t *testing.T) {
+	g := NewGame()
+	if g.TotalBatteriesNeeded() != 0 {
+		t.Error("TotalBatteriesNeeded() != 0 with no generators")
+	}
+
+	empty := entities.NewGenerator("G1", 2)
+	partial := entities.NewGenerator("G2", 3)
+	partial.InsertBatteries(1)
+	running := entities.NewGenerator("G3", 2)
+	running.InsertBatteriesAndStart(2)
+	g.AddGenerator(empty)
+	g.AddGenerator(partial)
+	g.AddGenerator(running)
+
+	if got := g.TotalBatteriesNeeded(); got != 4 {
+		t.Errorf("TotalBatteriesNeeded() = %d, want 4 (2 empty + 2 left on the partial one)", got)
+	}
+
+	partial.InsertBatteries(2)
+	if got := g.TotalBatteriesNeeded(); got != 2 {
+		t.Errorf("TotalBatteriesNeeded() = %d once the partial generator is full, want 2", got)
+	}
+}
+
 func TestRepairObjectives_DependencyAndTimers(t *testing.T) {
 	g := NewGame()
 	valve := entities.NewRepairObjective("valve", entities.RepairPressureValve, "A", 0, 0)
@@ -212,20 +237,23 @@ func TestUpdatePowerSupply_Deck0(t *testing.T) {
 	}
 }
 
-func TestUpdatePowerSupply_UnpoweredGeneratorNotCounted(t *testing.T) {
+func TestUpdatePowerSupply_PartialGeneratorCountsBrownout(t *testing.T) {
 	g := NewGame()
 	g.CurrentDeckID = 0
 
 	powered := entities.NewGenerator("Powered", 1)
 	powered.InsertBatteriesAndStart(1)
-	unpowered := entities.NewGenerator("Unpowered", 2)
-	unpowered.InsertBatteries(1) // partial
+	partial := entities.NewGenerator("Partial", 2)
+	partial.InsertBatteries(1)
+	fueled := entities.NewGenerator("Fueled", 1)
+	fueled.InsertBatteries(1) // awaiting startup: no output yet
 	g.AddGenerator(powered)
-	g.AddGenerator(unpowered)
+	g.AddGenerator(partial)
+	g.AddGenerator(fueled)
 
 	g.UpdatePowerSupply()
-	if g.PowerSupply != 100 {
-		t.Errorf("PowerSupply = %d, want 100 (only 1 powered gen)", g.PowerSupply)
+	if g.PowerSupply != 150 {
+		t.Errorf("PowerSupply = %d, want 150 (100 powered + 50 brownout)", g.PowerSupply)
 	}
 }
 
@@ -0,0 +1,40 @@
+package state
+
+import (
+	"slices"
+
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+	gameworld "darkstation/pkg/game/world"
+)
+
+// DeckSurvivor returns the current deck's survivor and the cell they occupy, or nils
+// when the deck has no one to escort.
+func (g *Game) DeckSurvivor() (*world.Cell, *entities.Survivor) {
+	if g == nil || g.Grid == nil {
+		return nil, nil
+	}
+	var at *world.Cell
+	g.Grid.ForEachCell(func(row, col int, cell *world.Cell) {
+		if at == nil && gameworld.HasSurvivor(cell) {
+			at = cell
+		}
+	})
+	if at == nil {
+		return nil, nil
+	}
+	return at, gameworld.GetGameData(at).Survivor
+}
+
+// SurvivorEscorted reports whether the deck's survivor (if any) is following and
+// right beside the player, as the lift requires.
+func (g *Game) SurvivorEscorted() bool {
+	cell, survivor := g.DeckSurvivor()
+	if survivor == nil {
+		return true
+	}
+	if !survivor.IsFollowing() || g.CurrentCell == nil {
+		return false
+	}
+	return cell == g.CurrentCell || slices.Contains(g.CurrentCell.GetNeighbors(), cell)
+}
@@ -0,0 +1,17 @@
+package state
+
+import "darkstation/pkg/engine/world"
+
+// WalkToSession tracks a click-to-walk toward a chosen cell.
+type WalkToSession struct {
+	Target *world.Cell
+	Seen   map[*world.Cell]bool // Doors and hazards already known when the walk began; only new ones stop it
+	// PatrolInView records whether the patrol bot was in sight after the last step, so
+	// only its coming into view stops the walk.
+	PatrolInView bool
+}
+
+// NewWalkToSession returns a session heading for target.
+func NewWalkToSession(target *world.Cell) *WalkToSession {
+	return &WalkToSession{Target: target, Seen: make(map[*world.Cell]bool)}
+}
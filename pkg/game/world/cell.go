@@ -16,16 +16,23 @@ type GameCellData struct {
 	Puzzle          *entities.PuzzleTerminal      // Puzzle terminal in this cell (if any)
 	Furniture       *entities.Furniture           // Furniture in this cell (if any)
 	Hazard          *entities.Hazard              // Environmental hazard in this cell (if any)
+	AmbientHazard   *entities.Hazard              // Non-blocking room hazard covering this cell (if any)
 	HazardControl   *entities.HazardControl       // Hazard control panel in this cell (if any)
 	MaintenanceTerm *entities.MaintenanceTerminal // Maintenance terminal in this cell (if any)
 	RepairDevice    *entities.RepairObjective     // Deck repair device in this cell (if any)
 	RepairBlocker   *entities.RepairObjective     // Repair-gated blocker in this cell (if any)
+	Survivor        *entities.Survivor            // Crew member to escort to the lift (if any)
+	Patrol          *entities.Patrol              // Roaming maintenance bot (if any)
 	LightsOn        bool                          // Whether lights are on in this cell
 	GridLit         bool                          // Grid-powered illumination (excludes headlamp); cached for cheap cone refresh
 	Lighted         bool                          // Whether this cell has been lit (stays explored)
+	SeenViaCamera   bool                          // Revealed on a CCTV feed and not yet seen in person
+	CameraSignature uint8                         // CellCameraSignature when the feed was viewed
 	// EnvPlaqueMsgID is an optional gettext msgid for diegetic corridor signage (Story 5.1).
 	// Empty means no plaque on this cell.
 	EnvPlaqueMsgID string
+	// EnvPlaqueArg fills the plaque text's %s when set (observation sequence stamps).
+	EnvPlaqueArg string
 	// LinkageTag (Story 5.3): correlates with PuzzleTerminal.LinkageToken; satisfied by visiting this cell.
 	LinkageTag string
 	// PowerRelay (power-routing Phase 3): corridor routing switch; nil on non-relay cells.
@@ -79,6 +86,25 @@ func HasCheckedFurniture(cell *world.Cell) bool {
 	return data.Furniture != nil && data.Furniture.Checked
 }
 
+// HasSurvivor returns true if this cell holds the deck's survivor
+func HasSurvivor(cell *world.Cell) bool {
+	data := GetGameData(cell)
+	return data.Survivor != nil
+}
+
+// HasPatrol returns true if the deck's patrol bot stands on this cell
+func HasPatrol(cell *world.Cell) bool {
+	data := GetGameData(cell)
+	return data.Patrol != nil
+}
+
+// HasWaitingSurvivor returns true if this cell holds a survivor not yet recruited.
+// Waiting survivors block movement until the player recruits them.
+func HasWaitingSurvivor(cell *world.Cell) bool {
+	data := GetGameData(cell)
+	return data.Survivor != nil && !data.Survivor.Following
+}
+
 // HasHazard returns true if this cell contains a hazard
 func HasHazard(cell *world.Cell) bool {
 	data := GetGameData(cell)
@@ -97,6 +123,14 @@ func HasFixedHazard(cell *world.Cell) bool {
 	return data.Hazard != nil && !data.Hazard.IsBlocking()
 }
 
+// AmbientHazardAt returns the ambient (non-blocking) hazard covering this cell, or nil.
+func AmbientHazardAt(cell *world.Cell) *entities.Hazard {
+	if cell == nil {
+		return nil
+	}
+	return GetGameData(cell).AmbientHazard
+}
+
 // HasHazardControl returns true if this cell contains a hazard control
 func HasHazardControl(cell *world.Cell) bool {
 	data := GetGameData(cell)
@@ -260,6 +294,41 @@ func SetLightsOn(cell *world.Cell, on bool) {
 	}
 }
 
+// Camera signature bits: the parts of a cell a CCTV feed shows that can change later.
+const (
+	cameraSigHazard uint8 = 1 << iota
+	cameraSigLockedDoor
+	cameraSigFloorItems
+)
+
+// CellCameraSignature summarises what a CCTV feed shows of a cell.
+func CellCameraSignature(cell *world.Cell) uint8 {
+	var sig uint8
+	if HasBlockingHazard(cell) {
+		sig |= cameraSigHazard
+	}
+	if HasLockedDoor(cell) {
+		sig |= cameraSigLockedDoor
+	}
+	if cell.ItemsOnFloor.Size() > 0 {
+		sig |= cameraSigFloorItems
+	}
+	return sig
+}
+
+// MarkSeenViaCamera records that a cell was observed on a CCTV feed, with its state at the time.
+func MarkSeenViaCamera(cell *world.Cell) {
+	data := GetGameData(cell)
+	data.SeenViaCamera = true
+	data.CameraSignature = CellCameraSignature(cell)
+}
+
+// CameraViewStale returns true if a cell known only from a CCTV feed has changed since it was viewed
+func CameraViewStale(cell *world.Cell) bool {
+	data := GetGameData(cell)
+	return data.SeenViaCamera && data.CameraSignature != CellCameraSignature(cell)
+}
+
 // IsLighted returns true if this cell has been lit (stays explored)
 func IsLighted(cell *world.Cell) bool {
 	data := GetGameData(cell)
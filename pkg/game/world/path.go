@@ -0,0 +1,30 @@
+package world
+
+import "darkstation/pkg/engine/world"
+
+// BlocksWalking reports whether the player cannot step onto cell as things stand: a
+// locked door, an unfixed hazard, or a solid device or piece of furniture.
+func BlocksWalking(cell *world.Cell) bool {
+	return HasLockedDoor(cell) || HasBlockingHazard(cell) ||
+		HasGenerator(cell) || FurnitureBlocksMovement(cell) ||
+		HasTerminal(cell) || HasPuzzle(cell) || HasMaintenanceTerminal(cell) ||
+		RepairDeviceBlocksMovement(cell) || HasHazardControl(cell) ||
+		HasBlockingRepairBlocker(cell) || HasWaitingSurvivor(cell)
+}
+
+// walkable reports whether a known route may pass through cell.
+func walkable(cell *world.Cell) bool {
+	return cell != nil && cell.Room && cell.Discovered && !BlocksWalking(cell)
+}
+
+// ComputePath returns the shortest walk on grid from `from` to `to` over discovered
+// floor, inclusive of both ends, or nil when there is no known route. Locked doors,
+// blocking hazards and solid objects are routed around, and `to` must itself be a
+// cell the player could stand on.
+func ComputePath(grid *world.Grid, from, to *world.Cell) []*world.Cell {
+	if grid == nil || from == nil || !walkable(to) ||
+		grid.GetCell(from.Row, from.Col) != from || grid.GetCell(to.Row, to.Col) != to {
+		return nil
+	}
+	return world.FindPath(from, to, walkable)
+}
@@ -0,0 +1,58 @@
+package world
+
+import (
+	"darkstation/pkg/engine/world"
+	"darkstation/pkg/game/entities"
+)
+
+// patrolSightBlocker stops a patrol's sight at locked doors (walls stop it anyway).
+func patrolSightBlocker(cell *world.Cell) bool {
+	return HasLockedDoor(cell)
+}
+
+// PatrolSeesPlayer reports whether patrol has playerCell in its vision cone with a clear
+// line of sight, using the same ray casting as the player's FOV. The cone reaches
+// entities.PatrolSightRange cells into lit cells but only entities.PatrolDarkSightRange
+// into unpowered ones, so a dark room is the place to slip past.
+func PatrolSeesPlayer(grid *world.Grid, patrol *entities.Patrol, playerCell *world.Cell) bool {
+	if grid == nil || patrol == nil || playerCell == nil {
+		return false
+	}
+	bot := grid.GetCell(patrol.Row, patrol.Col)
+	return bot != nil && patrolSees(grid, patrol, bot, playerCell)
+}
+
+// PatrolSightCells returns every room cell patrol can currently see (see PatrolSeesPlayer).
+func PatrolSightCells(grid *world.Grid, patrol *entities.Patrol) []*world.Cell {
+	if grid == nil || patrol == nil {
+		return nil
+	}
+	bot := grid.GetCell(patrol.Row, patrol.Col)
+	if bot == nil {
+		return nil
+	}
+	var cells []*world.Cell
+	for dr := -entities.PatrolSightRange; dr <= entities.PatrolSightRange; dr++ {
+		for dc := -entities.PatrolSightRange; dc <= entities.PatrolSightRange; dc++ {
+			cell := grid.GetCell(bot.Row+dr, bot.Col+dc)
+			if cell != nil && patrolSees(grid, patrol, bot, cell) {
+				cells = append(cells, cell)
+			}
+		}
+	}
+	return cells
+}
+
+func patrolSees(grid *world.Grid, patrol *entities.Patrol, bot, target *world.Cell) bool {
+	if !target.Room {
+		return false
+	}
+	reach := entities.PatrolSightRange
+	if !GetGameData(target).GridLit {
+		reach = entities.PatrolDarkSightRange
+	}
+	if !patrol.ConeCovers(target.Row-bot.Row, target.Col-bot.Col, reach) {
+		return false
+	}
+	return world.HasLineOfSight(grid, bot, target, patrolSightBlocker)
+}
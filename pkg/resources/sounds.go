@@ -0,0 +1,33 @@
+package resources
+
+import (
+	_ "embed"
+)
+
+// Sound effects: 16-bit mono WAV at 22.05 kHz, resampled by the Ebiten audio context.
+
+//go:embed sounds/pickup.wav
+var SoundPickup []byte
+
+//go:embed sounds/battery.wav
+var SoundBattery []byte
+
+//go:embed sounds/generator.wav
+var SoundGenerator []byte
+
+//go:embed sounds/door.wav
+var SoundDoor []byte
+
+//go:embed sounds/hazard_cleared.wav
+var SoundHazardCleared []byte
+
+//go:embed sounds/exit.wav
+var SoundExit []byte
+
+//go:embed sounds/power_warning.wav
+var SoundPowerWarning []byte
+
+// AmbientHum is a one-second seamless loop played while the deck has power.
+//
+//go:embed sounds/hum.wav
+var AmbientHum []byte